@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/github/github-mcp-server/internal/ghmcp"
@@ -31,33 +32,86 @@ var (
 		Short: "Start stdio server",
 		Long:  `Start a server that communicates via standard input/output streams using JSON-RPC messages.`,
 		RunE: func(_ *cobra.Command, _ []string) error {
-			token := viper.GetString("personal_access_token")
-			if token == "" {
-				return errors.New("GITHUB_PERSONAL_ACCESS_TOKEN not set")
+			cfg, err := serverConfig()
+			if err != nil {
+				return err
 			}
+			return ghmcp.RunStdioServer(cfg)
+		},
+	}
+
+	sseCmd = &cobra.Command{
+		Use:   "sse",
+		Short: "Start SSE server",
+		Long:  `Start a server that serves MCP over HTTP with Server-Sent Events, so that several clients can share one long-running server.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// Bound here rather than in init, because the sse and http commands each have an
+			// --addr flag for the same setting.
+			_ = viper.BindPFlag("addr", cmd.Flags().Lookup("addr"))
+			_ = viper.BindPFlag("socket-mode", cmd.Flags().Lookup("socket-mode"))
+			cfg, err := serverConfig()
+			if err != nil {
+				return err
+			}
+			addr, err := listenAddr(cmd)
+			if err != nil {
+				return err
+			}
+			socketMode, err := parseSocketMode(viper.GetString("socket-mode"))
+			if err != nil {
+				return err
+			}
+			return ghmcp.RunSSEServer(ghmcp.SSEServerConfig{
+				StdioServerConfig: cfg,
+				Addr:              addr,
+				SocketMode:        socketMode,
+			})
+		},
+	}
 
-			// If you're wondering why we're not using viper.GetStringSlice("toolsets"),
-			// it's because viper doesn't handle comma-separated values correctly for env
-			// vars when using GetStringSlice.
-			// https://github.com/spf13/viper/issues/380
-			var enabledToolsets []string
-			if err := viper.UnmarshalKey("toolsets", &enabledToolsets); err != nil {
-				return fmt.Errorf("failed to unmarshal toolsets: %w", err)
+	httpCmd = &cobra.Command{
+		Use:   "http",
+		Short: "Start Streamable HTTP server",
+		Long:  `Start a server that serves MCP over the Streamable HTTP transport at /mcp, with a session per client. Clients may send their own GitHub token in an Authorization header.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			_ = viper.BindPFlag("addr", cmd.Flags().Lookup("addr"))
+			_ = viper.BindPFlag("socket-mode", cmd.Flags().Lookup("socket-mode"))
+			cfg, err := serverConfig()
+			if err != nil {
+				return err
+			}
+			addr, err := listenAddr(cmd)
+			if err != nil {
+				return err
 			}
+			socketMode, err := parseSocketMode(viper.GetString("socket-mode"))
+			if err != nil {
+				return err
+			}
+			return ghmcp.RunHTTPServer(ghmcp.HTTPServerConfig{
+				StdioServerConfig:  cfg,
+				Addr:               addr,
+				SocketMode:         socketMode,
+				SessionIdleTimeout: viper.GetDuration("session-timeout"),
+			})
+		},
+	}
 
-			stdioServerConfig := ghmcp.StdioServerConfig{
-				Version:              version,
-				Host:                 viper.GetString("host"),
-				Token:                token,
-				EnabledToolsets:      enabledToolsets,
-				DynamicToolsets:      viper.GetBool("dynamic_toolsets"),
-				ReadOnly:             viper.GetBool("read-only"),
-				ExportTranslations:   viper.GetBool("export-translations"),
-				EnableCommandLogging: viper.GetBool("enable-command-logging"),
-				LogFilePath:          viper.GetString("log-file"),
-				ContentWindowSize:    viper.GetInt("content-window-size"),
+	loginCmd = &cobra.Command{
+		Use:   "login",
+		Short: "Log in to GitHub",
+		Long:  `Authorize the server with the GitHub OAuth device flow and store the token in the user's config directory, where the servers pick it up when GITHUB_PERSONAL_ACCESS_TOKEN is not set.`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			var scopes []string
+			if err := viper.UnmarshalKey("scopes", &scopes); err != nil {
+				return fmt.Errorf("failed to unmarshal scopes: %w", err)
 			}
-			return ghmcp.RunStdioServer(stdioServerConfig)
+			return ghmcp.Login(ghmcp.LoginConfig{
+				Version:  version,
+				Host:     viper.GetString("host"),
+				ClientID: viper.GetString("oauth_client_id"),
+				Scopes:   scopes,
+			})
 		},
 	}
 )
@@ -73,10 +127,21 @@ func init() {
 	rootCmd.PersistentFlags().Bool("dynamic-toolsets", false, "Enable dynamic toolsets")
 	rootCmd.PersistentFlags().Bool("read-only", false, "Restrict the server to read-only operations")
 	rootCmd.PersistentFlags().String("log-file", "", "Path to log file")
-	rootCmd.PersistentFlags().Bool("enable-command-logging", false, "When enabled, the server will log all command requests and responses to the log file")
+	rootCmd.PersistentFlags().Bool("enable-command-logging", false, "When enabled, the server will log all command requests and responses to the log file. Sensitive tool arguments, such as the value given to set_org_secret, are redacted")
 	rootCmd.PersistentFlags().Bool("export-translations", false, "Save translations to a JSON file")
 	rootCmd.PersistentFlags().String("gh-host", "", "Specify the GitHub hostname (for GitHub Enterprise etc.)")
 	rootCmd.PersistentFlags().Int("content-window-size", 5000, "Specify the content window size")
+	rootCmd.PersistentFlags().Bool("allow-dangerous", false, "Enable powerful tools, such as the raw GitHub API request tool, in the experiments toolset")
+	rootCmd.PersistentFlags().Int("max-idle-conns", ghmcp.DefaultMaxIdleConns, "Maximum number of idle keep-alive connections to the GitHub API")
+	rootCmd.PersistentFlags().Int("max-conns-per-host", 0, "Maximum number of connections per host, 0 for no limit")
+	rootCmd.PersistentFlags().Duration("idle-conn-timeout", ghmcp.DefaultIdleConnTimeout, "How long an idle keep-alive connection is kept open")
+	rootCmd.PersistentFlags().Int("immutable-cache-size", ghmcp.DefaultImmutableCacheSize, "Number of responses for content addressed by commit SHA (blobs, trees, commits) to keep in memory, 0 to disable")
+	rootCmd.PersistentFlags().StringSlice("additional-hosts", nil, "Other GitHub hosts, e.g. github.com, that tools may target through a host argument. The token for each host is read from GITHUB_PERSONAL_ACCESS_TOKEN_<HOST>, e.g. GITHUB_PERSONAL_ACCESS_TOKEN_GITHUB_COM")
+	rootCmd.PersistentFlags().Bool("strict-config", false, "Refuse to start when the configuration has problems, such as flags that conflict, instead of logging a warning")
+	rootCmd.PersistentFlags().StringSlice("mask-variables", nil, "Comma separated name patterns, e.g. '*_URL,*TOKEN*', of Actions variables whose values are replaced with *** in tool results")
+	rootCmd.PersistentFlags().Bool("resolve-lfs", false, "Let get_raw_content download the Git LFS objects that pointer files refer to, through the LFS API of the host")
+	rootCmd.PersistentFlags().String("token-file", "", "Read the token from this file, e.g. a mounted Docker or Kubernetes secret, instead of GITHUB_PERSONAL_ACCESS_TOKEN. The file is read again on SIGHUP. Can also be set with GITHUB_TOKEN_FILE")
+	rootCmd.PersistentFlags().String("list-result-style", string(github.ListResultStyleArray), "How list tools return results: 'array' for a bare JSON array, or 'envelope' for an object with a count and a message when nothing matched")
 
 	// Bind flag to viper
 	_ = viper.BindPFlag("toolsets", rootCmd.PersistentFlags().Lookup("toolsets"))
@@ -87,9 +152,124 @@ func init() {
 	_ = viper.BindPFlag("export-translations", rootCmd.PersistentFlags().Lookup("export-translations"))
 	_ = viper.BindPFlag("host", rootCmd.PersistentFlags().Lookup("gh-host"))
 	_ = viper.BindPFlag("content-window-size", rootCmd.PersistentFlags().Lookup("content-window-size"))
+	_ = viper.BindPFlag("allow-dangerous", rootCmd.PersistentFlags().Lookup("allow-dangerous"))
+	_ = viper.BindPFlag("max-idle-conns", rootCmd.PersistentFlags().Lookup("max-idle-conns"))
+	_ = viper.BindPFlag("max-conns-per-host", rootCmd.PersistentFlags().Lookup("max-conns-per-host"))
+	_ = viper.BindPFlag("idle-conn-timeout", rootCmd.PersistentFlags().Lookup("idle-conn-timeout"))
+	_ = viper.BindPFlag("list-result-style", rootCmd.PersistentFlags().Lookup("list-result-style"))
+	_ = viper.BindPFlag("immutable-cache-size", rootCmd.PersistentFlags().Lookup("immutable-cache-size"))
+	_ = viper.BindPFlag("additional-hosts", rootCmd.PersistentFlags().Lookup("additional-hosts"))
+	_ = viper.BindPFlag("strict-config", rootCmd.PersistentFlags().Lookup("strict-config"))
+	_ = viper.BindPFlag("mask-variables", rootCmd.PersistentFlags().Lookup("mask-variables"))
+	_ = viper.BindPFlag("resolve-lfs", rootCmd.PersistentFlags().Lookup("resolve-lfs"))
+	_ = viper.BindPFlag("token_file", rootCmd.PersistentFlags().Lookup("token-file"))
+
+	sseCmd.Flags().String("addr", ghmcp.DefaultListenAddr, "Address to listen on, or unix:///path for a unix domain socket. Every client can act with the server's token, so only listen beyond localhost behind an authenticating proxy")
+	httpCmd.Flags().String("addr", ghmcp.DefaultListenAddr, "Address to listen on, or unix:///path for a unix domain socket. Clients that send no token act with the server's token, so only listen beyond localhost behind an authenticating proxy")
+	for _, cmd := range []*cobra.Command{sseCmd, httpCmd} {
+		cmd.Flags().String("listen", "", "Same as --addr, e.g. --listen unix:///tmp/gh-mcp.sock to listen on a unix domain socket")
+		cmd.Flags().String("socket-mode", fmt.Sprintf("%04o", ghmcp.DefaultSocketMode), "Octal permissions of the unix domain socket, e.g. 0660 to let the socket's group connect")
+	}
+	httpCmd.Flags().Duration("session-timeout", ghmcp.DefaultSessionIdleTimeout, "How long a session may go unused before it expires")
+	_ = viper.BindPFlag("session-timeout", httpCmd.Flags().Lookup("session-timeout"))
+	loginCmd.Flags().String("oauth-client-id", "", "Client ID of the OAuth app to authorize, which must have the device flow enabled. Can also be set with GITHUB_OAUTH_CLIENT_ID")
+	loginCmd.Flags().StringSlice("scopes", ghmcp.DefaultLoginScopes, "Comma separated OAuth scopes to request")
+	_ = viper.BindPFlag("oauth_client_id", loginCmd.Flags().Lookup("oauth-client-id"))
+	_ = viper.BindEnv("oauth_client_id", "GITHUB_OAUTH_CLIENT_ID")
+	_ = viper.BindPFlag("scopes", loginCmd.Flags().Lookup("scopes"))
 
 	// Add subcommands
 	rootCmd.AddCommand(stdioCmd)
+	rootCmd.AddCommand(sseCmd)
+	rootCmd.AddCommand(httpCmd)
+	rootCmd.AddCommand(loginCmd)
+}
+
+// serverConfig builds the server configuration shared by all transports from flags and
+// environment variables.
+func serverConfig() (ghmcp.StdioServerConfig, error) {
+	tokenFile := viper.GetString("token_file")
+	token, err := serverToken(viper.GetString("host"), tokenFile)
+	if err != nil {
+		return ghmcp.StdioServerConfig{}, err
+	}
+
+	// If you're wondering why we're not using viper.GetStringSlice("toolsets"),
+	// it's because viper doesn't handle comma-separated values correctly for env
+	// vars when using GetStringSlice.
+	// https://github.com/spf13/viper/issues/380
+	var enabledToolsets []string
+	if err := viper.UnmarshalKey("toolsets", &enabledToolsets); err != nil {
+		return ghmcp.StdioServerConfig{}, fmt.Errorf("failed to unmarshal toolsets: %w", err)
+	}
+
+	listResultStyle, err := github.ParseListResultStyle(viper.GetString("list-result-style"))
+	if err != nil {
+		return ghmcp.StdioServerConfig{}, err
+	}
+
+	var additionalHostNames []string
+	if err := viper.UnmarshalKey("additional-hosts", &additionalHostNames); err != nil {
+		return ghmcp.StdioServerConfig{}, fmt.Errorf("failed to unmarshal additional hosts: %w", err)
+	}
+	additionalHosts, err := additionalHostTokens(additionalHostNames)
+	if err != nil {
+		return ghmcp.StdioServerConfig{}, err
+	}
+
+	var maskPatterns []string
+	if err := viper.UnmarshalKey("mask-variables", &maskPatterns); err != nil {
+		return ghmcp.StdioServerConfig{}, fmt.Errorf("failed to unmarshal mask variables: %w", err)
+	}
+	variableMask, err := github.ParseVariableMask(maskPatterns)
+	if err != nil {
+		return ghmcp.StdioServerConfig{}, err
+	}
+
+	return ghmcp.StdioServerConfig{
+		Version:              version,
+		Host:                 viper.GetString("host"),
+		Token:                token,
+		EnabledToolsets:      enabledToolsets,
+		DynamicToolsets:      viper.GetBool("dynamic_toolsets"),
+		ReadOnly:             viper.GetBool("read-only"),
+		ExportTranslations:   viper.GetBool("export-translations"),
+		EnableCommandLogging: viper.GetBool("enable-command-logging"),
+		LogFilePath:          viper.GetString("log-file"),
+		ContentWindowSize:    viper.GetInt("content-window-size"),
+		AllowDangerous:       viper.GetBool("allow-dangerous"),
+		MaxIdleConns:         viper.GetInt("max-idle-conns"),
+		MaxConnsPerHost:      viper.GetInt("max-conns-per-host"),
+		IdleConnTimeout:      viper.GetDuration("idle-conn-timeout"),
+		ListResultStyle:      listResultStyle,
+		ImmutableCacheSize:   viper.GetInt("immutable-cache-size"),
+		AdditionalHosts:      additionalHosts,
+		StrictConfig:         viper.GetBool("strict-config"),
+		VariableMask:         variableMask,
+		ResolveLFS:           viper.GetBool("resolve-lfs"),
+		TokenFile:            tokenFile,
+	}, nil
+}
+
+// serverToken returns the token for host: the contents of tokenFile, or else
+// GITHUB_PERSONAL_ACCESS_TOKEN, or else the token stored by the login command, or else the token
+// the gh CLI is logged in with.
+func serverToken(host, tokenFile string) (string, error) {
+	if tokenFile != "" {
+		return ghmcp.ReadTokenFile(tokenFile)
+	}
+	if token := viper.GetString("personal_access_token"); token != "" {
+		return token, nil
+	}
+	token, err := ghmcp.StoredToken(host)
+	if err != nil || token != "" {
+		return token, err
+	}
+	token, err = ghmcp.GHCLIToken(host)
+	if err != nil || token != "" {
+		return token, err
+	}
+	return "", errors.New("GITHUB_PERSONAL_ACCESS_TOKEN not set, no token stored by the login command, and the gh CLI is not logged in")
 }
 
 func initConfig() {
@@ -106,6 +286,55 @@ func main() {
 	}
 }
 
+// additionalHostTokens looks up the token for each additional host. Tokens are only valid for the
+// host that issued them, so every host needs its own.
+func additionalHostTokens(hosts []string) (map[string]string, error) {
+	if len(hosts) == 0 {
+		return nil, nil
+	}
+	tokens := make(map[string]string, len(hosts))
+	for _, host := range hosts {
+		name := github.NormalizeHost(host)
+		if name == "" {
+			return nil, fmt.Errorf("invalid additional host %q", host)
+		}
+		envVar := "GITHUB_PERSONAL_ACCESS_TOKEN_" + strings.ToUpper(strings.Map(func(r rune) rune {
+			if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+				return r
+			}
+			return '_'
+		}, name))
+		token := os.Getenv(envVar)
+		if token == "" {
+			return nil, fmt.Errorf("no token configured for additional host %s: set %s", name, envVar)
+		}
+		tokens[name] = token
+	}
+	return tokens, nil
+}
+
+// parseSocketMode parses octal unix socket permissions such as 0660.
+func parseSocketMode(s string) (os.FileMode, error) {
+	mode, err := strconv.ParseUint(s, 8, 32)
+	if err != nil || mode > 0o777 {
+		return 0, fmt.Errorf("invalid socket mode %q: expected octal permissions such as 0600", s)
+	}
+	return os.FileMode(mode), nil
+}
+
+// listenAddr returns the address given with --listen, or else with --addr.
+func listenAddr(cmd *cobra.Command) (string, error) {
+	addr := viper.GetString("addr")
+	listen, _ := cmd.Flags().GetString("listen")
+	if listen == "" {
+		return addr, nil
+	}
+	if cmd.Flags().Changed("addr") && addr != listen {
+		return "", fmt.Errorf("--listen %q and --addr %q name different addresses, use only one of them", listen, addr)
+	}
+	return listen, nil
+}
+
 func wordSepNormalizeFunc(_ *pflag.FlagSet, name string) pflag.NormalizedName {
 	from := []string{"_"}
 	to := "-"
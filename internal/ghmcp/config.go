@@ -0,0 +1,71 @@
+package ghmcp
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/translations"
+)
+
+// validateConfig looks for settings that are each valid but do not make sense together, such as
+// toolsets that offer nothing in read-only mode. These are otherwise accepted silently and only
+// show up later as missing tools. It returns a message for every problem found.
+func validateConfig(cfg StdioServerConfig) []string {
+	var problems []string
+
+	if cfg.DynamicToolsets && slices.Contains(cfg.EnabledToolsets, "all") {
+		problems = append(problems, `--toolsets=all is ignored with --dynamic-toolsets, which starts with only the listed toolsets and enables others on request; list the toolsets to start with instead`)
+	}
+
+	if cfg.AllowDangerous && !cfg.DynamicToolsets && !slices.Contains(cfg.EnabledToolsets, "all") && !slices.Contains(cfg.EnabledToolsets, "experiments") {
+		problems = append(problems, "--allow-dangerous has no effect because the experiments toolset, which holds the dangerous tools, is not enabled")
+	}
+
+	defaultHost := "github.com"
+	if cfg.Host != "" {
+		defaultHost = github.NormalizeHost(cfg.Host)
+	}
+	for host := range cfg.AdditionalHosts {
+		if github.NormalizeHost(host) == defaultHost {
+			problems = append(problems, fmt.Sprintf("additional host %s is already the default host, so its GITHUB_PERSONAL_ACCESS_TOKEN_* token is never used", host))
+		}
+	}
+
+	if !cfg.DynamicToolsets {
+		if count, ok := exposedToolCount(cfg); ok && count == 0 {
+			msg := fmt.Sprintf("the server will expose no tools: the enabled toolsets (%s) contain none", strings.Join(cfg.EnabledToolsets, ", "))
+			if cfg.ReadOnly {
+				msg += " in read-only mode; enable toolsets with read tools or drop --read-only"
+			}
+			problems = append(problems, msg)
+		}
+	}
+
+	return problems
+}
+
+// exposedToolCount works out how many tools the server would register for cfg, without creating
+// any clients. It reports false when the toolsets cannot be enabled, which newMCPServer reports
+// itself.
+func exposedToolCount(cfg StdioServerConfig) (int, bool) {
+	tsg := github.DefaultToolsetGroup(cfg.ReadOnly, nil, nil, nil, translations.NullTranslationHelper, cfg.ContentWindowSize)
+	if cfg.AllowDangerous {
+		if err := github.AddDangerousTools(tsg, cfg.ReadOnly, nil, translations.NullTranslationHelper); err != nil {
+			return 0, false
+		}
+	}
+	if err := github.AddCheckRunTools(tsg, nil, nil, translations.NullTranslationHelper); err != nil {
+		return 0, false
+	}
+	if err := tsg.EnableToolsets(cfg.EnabledToolsets); err != nil {
+		return 0, false
+	}
+
+	count := 0
+	for _, toolset := range tsg.Toolsets {
+		count += len(toolset.GetActiveTools())
+	}
+	return count, true
+}
@@ -0,0 +1,111 @@
+package ghmcp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// storedCredential is a token obtained by the login command.
+type storedCredential struct {
+	Token  string   `json:"token"`
+	User   string   `json:"user,omitempty"`
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// storedCredentials is the contents of the credentials file, with a credential per host name.
+type storedCredentials struct {
+	Hosts map[string]storedCredential `json:"hosts"`
+}
+
+// CredentialsPath returns the file the login command stores tokens in, in the user's config
+// directory.
+func CredentialsPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find the config directory: %w", err)
+	}
+	return filepath.Join(dir, "github-mcp-server", "credentials.json"), nil
+}
+
+// credentialHost returns the name credentials for host are stored under: the host name of its
+// web interface, e.g. github.com.
+func credentialHost(host string) (string, error) {
+	apiHost, err := parseAPIHost(host)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse API host: %w", err)
+	}
+	return apiHost.webURL.Hostname(), nil
+}
+
+func readCredentials(path string) (storedCredentials, error) {
+	creds := storedCredentials{Hosts: map[string]storedCredential{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return creds, nil
+	}
+	if err != nil {
+		return creds, fmt.Errorf("failed to read credentials: %w", err)
+	}
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return creds, fmt.Errorf("failed to parse credentials in %s: %w", path, err)
+	}
+	if creds.Hosts == nil {
+		creds.Hosts = map[string]storedCredential{}
+	}
+	return creds, nil
+}
+
+// writeCredential stores cred for host, keeping the credentials of other hosts. The file is only
+// readable by the user, and is replaced in one step so that a failed write cannot lose them.
+func writeCredential(path, host string, cred storedCredential) error {
+	creds, err := readCredentials(path)
+	if err != nil {
+		return err
+	}
+	creds.Hosts[host] = cred
+	data, err := json.MarshalIndent(creds, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal credentials: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".credentials-*")
+	if err != nil {
+		return fmt.Errorf("failed to write credentials: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write credentials: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write credentials: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write credentials: %w", err)
+	}
+	return nil
+}
+
+// StoredToken returns the token the login command stored for host, or "" if there is none.
+func StoredToken(host string) (string, error) {
+	name, err := credentialHost(host)
+	if err != nil {
+		return "", err
+	}
+	path, err := CredentialsPath()
+	if err != nil {
+		return "", err
+	}
+	creds, err := readCredentials(path)
+	if err != nil {
+		return "", err
+	}
+	return creds.Hosts[name].Token, nil
+}
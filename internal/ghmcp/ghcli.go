@@ -0,0 +1,84 @@
+package ghmcp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// GHCLIToken returns the token the gh CLI is logged in with for host, or "" if it is not logged
+// in or not installed. It asks gh itself first, which also finds tokens gh keeps in the system
+// keyring, and otherwise reads the gh configuration.
+func GHCLIToken(host string) (string, error) {
+	name, err := credentialHost(host)
+	if err != nil {
+		return "", err
+	}
+	if token := ghAuthToken(name); token != "" {
+		return token, nil
+	}
+	return ghHostsFileToken(filepath.Join(ghConfigDir(), "hosts.yml"), name)
+}
+
+// ghAuthToken runs gh auth token for host. Any failure, including gh not being installed, gives "".
+func ghAuthToken(host string) string {
+	path, err := exec.LookPath("gh")
+	if err != nil {
+		return ""
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, path, "auth", "token", "--hostname", host)
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(stdout.String())
+}
+
+// ghConfigDir returns the configuration directory of the gh CLI, which is found the way gh finds it.
+func ghConfigDir() string {
+	if dir := os.Getenv("GH_CONFIG_DIR"); dir != "" {
+		return dir
+	}
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "gh")
+	}
+	if runtime.GOOS == "windows" {
+		if dir := os.Getenv("AppData"); dir != "" {
+			return filepath.Join(dir, "GitHub CLI")
+		}
+	}
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".config", "gh")
+}
+
+// ghHostsFileToken reads the token for host from the hosts.yml file of the gh CLI. Recent gh
+// versions keep the token in the system keyring instead, in which case there is none in the file.
+func ghHostsFileToken(path, host string) (string, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read gh CLI hosts: %w", err)
+	}
+	var hosts map[string]struct {
+		OAuthToken string `yaml:"oauth_token"`
+	}
+	if err := yaml.Unmarshal(data, &hosts); err != nil {
+		return "", fmt.Errorf("failed to parse gh CLI hosts in %s: %w", path, err)
+	}
+	return hosts[host].OAuthToken, nil
+}
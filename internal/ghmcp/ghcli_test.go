@@ -0,0 +1,48 @@
+package ghmcp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGHHostsFileToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hosts.yml")
+	require.NoError(t, os.WriteFile(path, []byte(`github.com:
+    user: octocat
+    oauth_token: gho_dotcom
+    git_protocol: https
+ghe.example.com:
+    user: octocat
+    git_protocol: ssh
+`), 0o600))
+
+	token, err := ghHostsFileToken(path, "github.com")
+	require.NoError(t, err)
+	assert.Equal(t, "gho_dotcom", token)
+
+	// gh keeps the token in the keyring
+	token, err = ghHostsFileToken(path, "ghe.example.com")
+	require.NoError(t, err)
+	assert.Empty(t, token)
+
+	token, err = ghHostsFileToken(path, "tenant.ghe.com")
+	require.NoError(t, err)
+	assert.Empty(t, token)
+
+	token, err = ghHostsFileToken(filepath.Join(t.TempDir(), "hosts.yml"), "github.com")
+	require.NoError(t, err)
+	assert.Empty(t, token)
+}
+
+func TestGHConfigDir(t *testing.T) {
+	t.Setenv("GH_CONFIG_DIR", "/gh/config")
+	t.Setenv("XDG_CONFIG_HOME", "/xdg")
+	assert.Equal(t, "/gh/config", ghConfigDir())
+
+	t.Setenv("GH_CONFIG_DIR", "")
+	assert.Equal(t, filepath.Join("/xdg", "gh"), ghConfigDir())
+}
@@ -0,0 +1,138 @@
+package ghmcp
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/errors"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const httpServerLogPrefix = "httpserver"
+
+// DefaultListenAddr is where the network transports listen unless told otherwise. It only accepts
+// local connections, because clients act with the server's GitHub token.
+const DefaultListenAddr = "localhost:8080"
+
+// httpShutdownTimeout is how long open requests get to finish when a network server is stopped.
+const httpShutdownTimeout = 10 * time.Second
+
+// HTTPServerConfig configures a server that serves MCP over the Streamable HTTP transport. It
+// takes the same settings as the stdio server, plus the address to listen on and how long
+// sessions last.
+type HTTPServerConfig struct {
+	StdioServerConfig
+
+	// Addr is the TCP address to listen on, e.g. localhost:8080, or unix:///path for a unix domain
+	// socket. Empty means DefaultListenAddr.
+	Addr string
+
+	// SocketMode is the permissions of the unix domain socket. Zero means DefaultSocketMode.
+	SocketMode os.FileMode
+
+	// SessionIdleTimeout is how long a session may go unused before it expires. Zero means
+	// DefaultSessionIdleTimeout.
+	SessionIdleTimeout time.Duration
+}
+
+// RunHTTPServer serves MCP over the Streamable HTTP transport at /mcp until it is interrupted.
+// Each client gets its own session. A client that sends "Authorization: Bearer <token>" has its
+// tool calls made with that token instead of the server's.
+func RunHTTPServer(cfg HTTPServerConfig) error {
+	addr := cfg.Addr
+	if addr == "" {
+		addr = DefaultListenAddr
+	}
+
+	sessions := newSessionStore(cfg.SessionIdleTimeout)
+	running, err := startServer(cfg.StdioServerConfig, "http", sessions)
+	if err != nil {
+		return err
+	}
+	defer running.closeLog()
+	if cfg.EnableCommandLogging {
+		running.logger.Warn("command logging is only supported on stdio and is disabled")
+	}
+
+	// Create app context
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	running.reloadTokenOnHangup(ctx, cfg.TokenFile)
+
+	// Listen before printing the banner, so a port that is in use is reported right away.
+	listener, err := listen(addr, cfg.SocketMode)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	// Output github-mcp-server banner
+	if err := running.writeBanner(ctx, cfg.StdioServerConfig, "Streamable HTTP at "+describeEndpoint(listener, "/mcp")); err != nil {
+		_ = listener.Close()
+		return err
+	}
+
+	return serveStreamableHTTP(ctx, running, sessions, listener)
+}
+
+// serveStreamableHTTP serves the MCP server on listener until ctx is done.
+func serveStreamableHTTP(ctx context.Context, running *runningServer, sessions *sessionStore, listener net.Listener) error {
+	httpServer := newHTTPServer(running, httpServerLogPrefix)
+	streamableServer := server.NewStreamableHTTPServer(running.ghServer,
+		server.WithStreamableHTTPServer(httpServer),
+		server.WithSessionIdManager(sessions),
+		server.WithHTTPContextFunc(func(ctx context.Context, r *http.Request) context.Context {
+			if token := bearerToken(r); token != "" {
+				ctx = contextWithSessionToken(ctx, token)
+			}
+			if client, ok := sessions.clientInfo(r.Header.Get(server.HeaderKeySessionID)); ok {
+				ctx = contextWithClientInfo(ctx, client)
+			}
+			// enable GitHub errors in the context
+			return errors.ContextWithGitHubErrors(ctx)
+		}),
+	)
+	mux := http.NewServeMux()
+	mux.Handle("/mcp", streamableServer)
+	httpServer.Handler = mux
+	return serveHTTP(ctx, running.logger, httpServer, listener, streamableServer.Shutdown)
+}
+
+// newHTTPServer creates the HTTP server for a network transport, logging to the server's log.
+func newHTTPServer(running *runningServer, logPrefix string) *http.Server {
+	return &http.Server{
+		ReadHeaderTimeout: 10 * time.Second,
+		ErrorLog:          log.New(running.logOutput, logPrefix, 0),
+	}
+}
+
+// serveHTTP runs httpServer on listener until ctx is done, and then stops it with shutdown.
+func serveHTTP(ctx context.Context, logger *slog.Logger, httpServer *http.Server, listener net.Listener, shutdown func(context.Context) error) error {
+	errC := make(chan error, 1)
+	go func() {
+		errC <- httpServer.Serve(listener)
+	}()
+
+	// Wait for shutdown signal
+	select {
+	case <-ctx.Done():
+		logger.Info("shutting down server", "signal", "context done")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), httpShutdownTimeout)
+		defer cancel()
+		if err := shutdown(shutdownCtx); err != nil {
+			logger.Error("error shutting down server", "error", err)
+		}
+	case err := <-errC:
+		logger.Error("error running server", "error", err)
+		return fmt.Errorf("error running server: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package ghmcp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/client/transport"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServeStreamableHTTP(t *testing.T) {
+	var userAgent, authorization string
+	ghServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent, authorization = r.Header.Get("User-Agent"), r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`{"login": "octocat"}`))
+	}))
+	defer ghServer.Close()
+
+	sessions := newSessionStore(time.Minute)
+	running, err := startServer(StdioServerConfig{
+		Token:           "ghp_test",
+		EnabledToolsets: []string{"context"},
+	}, "http", sessions)
+	require.NoError(t, err)
+	defer running.closeLog()
+	restURL, err := url.Parse(ghServer.URL + "/")
+	require.NoError(t, err)
+	sessions.newClients = func(token string) *hostClients {
+		cfg := MCPServerConfig{Version: "1.2.3"}
+		return newHostClients(cfg, apiHost{baseRESTURL: restURL, graphqlURL: restURL.JoinPath("graphql")}, newServerToken(token), newHTTPTransport(cfg))
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	serveCtx, stop := context.WithCancel(ctx)
+	errC := make(chan error, 1)
+	go func() { errC <- serveStreamableHTTP(serveCtx, running, sessions, listener) }()
+
+	c, err := client.NewStreamableHttpClient(fmt.Sprintf("http://%s/mcp", listener.Addr()),
+		transport.WithHTTPHeaders(map[string]string{"Authorization": "Bearer ghp_client"}),
+	)
+	require.NoError(t, err)
+	require.NoError(t, c.Start(ctx))
+
+	initRequest := mcp.InitializeRequest{}
+	initRequest.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+	initRequest.Params.ClientInfo = mcp.Implementation{Name: "web-agent", Version: "1.0.0"}
+	_, err = c.Initialize(ctx, initRequest)
+	require.NoError(t, err)
+	sessions.mu.Lock()
+	assert.Len(t, sessions.sessions, 1)
+	sessions.mu.Unlock()
+
+	tools, err := c.ListTools(ctx, mcp.ListToolsRequest{})
+	require.NoError(t, err)
+	var names []string
+	for _, tool := range tools.Tools {
+		names = append(names, tool.Name)
+	}
+	assert.Contains(t, names, "get_me")
+
+	// Calls with the session's own token name the client that initialized the session.
+	getMe := mcp.CallToolRequest{}
+	getMe.Params.Name = "get_me"
+	result, err := c.CallTool(ctx, getMe)
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Equal(t, "Bearer ghp_client", authorization)
+	assert.Equal(t, "github-mcp-server/1.2.3 (web-agent/1.0.0)", userAgent)
+
+	// Closing the client ends its session.
+	require.NoError(t, c.Close())
+	sessions.mu.Lock()
+	assert.Empty(t, sessions.sessions)
+	sessions.mu.Unlock()
+
+	stop()
+	assert.NoError(t, <-errC)
+}
@@ -0,0 +1,61 @@
+package ghmcp
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"strings"
+)
+
+// DefaultSocketMode is the permissions of a unix domain socket the server listens on: only the
+// user running the server may connect.
+const DefaultSocketMode os.FileMode = 0o600
+
+// listen opens the listener of a network transport. addr is a TCP address, or unix:///path for a
+// unix domain socket, which is created with the permissions socketMode.
+func listen(addr string, socketMode os.FileMode) (net.Listener, error) {
+	path, ok := strings.CutPrefix(addr, "unix://")
+	if !ok {
+		return net.Listen("tcp", addr)
+	}
+	if path == "" {
+		return nil, errors.New("unix socket address has no path")
+	}
+	if socketMode == 0 {
+		socketMode = DefaultSocketMode
+	}
+	if err := removeStaleSocket(path); err != nil {
+		return nil, err
+	}
+	return listenUnix(path, socketMode)
+}
+
+// removeStaleSocket removes a socket left behind by a server that did not shut down cleanly, so
+// that restarting it does not fail. Sockets in use and other files are left alone.
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Mode().Type() != fs.ModeSocket {
+		return fmt.Errorf("%s exists and is not a socket", path)
+	}
+	if conn, err := net.Dial("unix", path); err == nil {
+		_ = conn.Close()
+		return fmt.Errorf("%s is in use by another server", path)
+	}
+	return os.Remove(path)
+}
+
+// describeEndpoint says where clients reach path on listener, for the startup banner.
+func describeEndpoint(listener net.Listener, path string) string {
+	if listener.Addr().Network() == "unix" {
+		return fmt.Sprintf("%s on unix socket %s", path, listener.Addr())
+	}
+	return fmt.Sprintf("http://%s%s", listener.Addr(), path)
+}
@@ -0,0 +1,87 @@
+//go:build !windows
+
+package ghmcp
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenUnixSocket(t *testing.T) {
+	// Socket paths are limited to about 100 bytes, which t.TempDir can exceed on macOS.
+	dir, err := os.MkdirTemp("", "ghmcp")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	t.Run("socket is created with the requested permissions", func(t *testing.T) {
+		path := filepath.Join(dir, "mode.sock")
+		listener, err := listen("unix://"+path, 0o660)
+		require.NoError(t, err)
+		defer listener.Close()
+
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o660), info.Mode().Perm())
+		assert.Equal(t, "/mcp on unix socket "+path, describeEndpoint(listener, "/mcp"))
+	})
+
+	t.Run("zero mode means the default", func(t *testing.T) {
+		path := filepath.Join(dir, "default.sock")
+		listener, err := listen("unix://"+path, 0)
+		require.NoError(t, err)
+		defer listener.Close()
+
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, DefaultSocketMode, info.Mode().Perm())
+	})
+
+	t.Run("stale socket is replaced", func(t *testing.T) {
+		path := filepath.Join(dir, "stale.sock")
+		stale, err := net.Listen("unix", path)
+		require.NoError(t, err)
+		// Keep the file, as a server that crashed would.
+		stale.(*net.UnixListener).SetUnlinkOnClose(false)
+		require.NoError(t, stale.Close())
+
+		listener, err := listen("unix://"+path, 0)
+		require.NoError(t, err)
+		_ = listener.Close()
+	})
+
+	t.Run("socket in use is refused", func(t *testing.T) {
+		path := filepath.Join(dir, "busy.sock")
+		other, err := net.Listen("unix", path)
+		require.NoError(t, err)
+		defer other.Close()
+
+		_, err = listen("unix://"+path, 0)
+		assert.ErrorContains(t, err, "in use by another server")
+	})
+
+	t.Run("other files are refused", func(t *testing.T) {
+		path := filepath.Join(dir, "file")
+		require.NoError(t, os.WriteFile(path, nil, 0o600))
+
+		_, err := listen("unix://"+path, 0)
+		assert.ErrorContains(t, err, "is not a socket")
+	})
+
+	t.Run("empty path is refused", func(t *testing.T) {
+		_, err := listen("unix://", 0)
+		assert.ErrorContains(t, err, "has no path")
+	})
+}
+
+func TestDescribeEndpointTCP(t *testing.T) {
+	listener, err := listen("127.0.0.1:0", 0)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	assert.Equal(t, "http://"+listener.Addr().String()+"/sse", describeEndpoint(listener, "/sse"))
+}
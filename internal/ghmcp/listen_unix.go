@@ -0,0 +1,18 @@
+//go:build !windows
+
+package ghmcp
+
+import (
+	"net"
+	"os"
+	"syscall"
+)
+
+// listenUnix creates a unix domain socket at path. The umask is set while the socket is created,
+// so that it never exists with more permissions than mode, not even briefly.
+func listenUnix(path string, mode os.FileMode) (net.Listener, error) {
+	oldMask := syscall.Umask(int(^mode.Perm() & 0o777))
+	listener, err := net.Listen("unix", path)
+	syscall.Umask(oldMask)
+	return listener, err
+}
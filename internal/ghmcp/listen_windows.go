@@ -0,0 +1,22 @@
+//go:build windows
+
+package ghmcp
+
+import (
+	"net"
+	"os"
+)
+
+// listenUnix creates a unix domain socket at path. Windows has no umask, so the permissions are
+// set after the socket is created.
+func listenUnix(path string, mode os.FileMode) (net.Listener, error) {
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+	return listener, nil
+}
@@ -0,0 +1,251 @@
+package ghmcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	gogithub "github.com/google/go-github/v74/github"
+)
+
+// DefaultLoginScopes are the OAuth scopes the login command requests, which cover the default
+// toolsets.
+var DefaultLoginScopes = []string{"repo", "read:org", "workflow", "gist", "notifications", "project"}
+
+// LoginConfig configures the login command.
+type LoginConfig struct {
+	// Version of the server
+	Version string
+
+	// GitHub Host to log in to (e.g. github.com or github.enterprise.com)
+	Host string
+
+	// ClientID is the client ID of the OAuth app to authorize. The app must have the device flow
+	// enabled.
+	ClientID string
+
+	// Scopes are the OAuth scopes to request. Empty means DefaultLoginScopes.
+	Scopes []string
+}
+
+// deviceFlow runs the OAuth device authorization flow against the web interface of a host.
+type deviceFlow struct {
+	webURL     *url.URL
+	clientID   string
+	httpClient *http.Client
+	// wait sleeps between polls for the token. It is replaced in tests.
+	wait func(ctx context.Context, d time.Duration) error
+}
+
+// deviceCode is the answer to a device flow start: the code the user enters at verificationURI,
+// and the code the token is polled with.
+type deviceCode struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+// deviceFlowResponse is the answer to a token poll, which has either a token or an error.
+type deviceFlowResponse struct {
+	AccessToken      string `json:"access_token"`
+	Scope            string `json:"scope"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+	Interval         int    `json:"interval"`
+}
+
+func waitContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// post sends form to path on the web interface and decodes the JSON answer into v.
+func (f *deviceFlow) post(ctx context.Context, path string, form url.Values, v any) error {
+	endpoint := f.webURL.JoinPath(path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s returned %s: %s", endpoint, resp.Status, strings.TrimSpace(string(body)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to parse the answer of %s: %w", endpoint, err)
+	}
+	return nil
+}
+
+// start asks for a device code with access to scopes.
+func (f *deviceFlow) start(ctx context.Context, scopes []string) (*deviceCode, error) {
+	var code struct {
+		deviceCode
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	err := f.post(ctx, "login/device/code", url.Values{
+		"client_id": {f.clientID},
+		"scope":     {strings.Join(scopes, " ")},
+	}, &code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start the device flow: %w", err)
+	}
+	if code.Error != "" {
+		return nil, fmt.Errorf("failed to start the device flow: %s: %s", code.Error, code.ErrorDescription)
+	}
+	return &code.deviceCode, nil
+}
+
+// poll waits until the user has entered the code and returns the token and its scopes.
+func (f *deviceFlow) poll(ctx context.Context, code *deviceCode) (string, []string, error) {
+	interval := time.Duration(code.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	if code.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(code.ExpiresIn)*time.Second)
+		defer cancel()
+	}
+
+	for {
+		if err := f.wait(ctx, interval); err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return "", nil, errors.New("the code expired before it was entered, run login again")
+			}
+			return "", nil, err
+		}
+
+		var answer deviceFlowResponse
+		err := f.post(ctx, "login/oauth/access_token", url.Values{
+			"client_id":   {f.clientID},
+			"device_code": {code.DeviceCode},
+			"grant_type":  {"urn:ietf:params:oauth:grant-type:device_code"},
+		}, &answer)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to get the token: %w", err)
+		}
+
+		switch answer.Error {
+		case "":
+			if answer.AccessToken == "" {
+				return "", nil, errors.New("failed to get the token: the answer has no token")
+			}
+			var scopes []string
+			if answer.Scope != "" {
+				scopes = strings.Split(answer.Scope, ",")
+			}
+			return answer.AccessToken, scopes, nil
+		case "authorization_pending":
+		case "slow_down":
+			// GitHub sends the new interval, which is 5 seconds longer than the last one.
+			if answer.Interval > 0 {
+				interval = time.Duration(answer.Interval) * time.Second
+			} else {
+				interval += 5 * time.Second
+			}
+		case "expired_token":
+			return "", nil, errors.New("the code expired before it was entered, run login again")
+		case "access_denied":
+			return "", nil, errors.New("the authorization was denied")
+		default:
+			return "", nil, fmt.Errorf("failed to get the token: %s: %s", answer.Error, answer.ErrorDescription)
+		}
+	}
+}
+
+// Login authorizes the server with the OAuth device flow: the user enters a code in their
+// browser, and the token GitHub issues is stored in the credentials file, where the server picks
+// it up when no token is configured.
+func Login(cfg LoginConfig) error {
+	if cfg.ClientID == "" {
+		return errors.New("an OAuth app client ID is needed to log in: pass --oauth-client-id or set GITHUB_OAUTH_CLIENT_ID")
+	}
+	scopes := cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = DefaultLoginScopes
+	}
+	apiHost, err := parseAPIHost(cfg.Host)
+	if err != nil {
+		return fmt.Errorf("failed to parse API host: %w", err)
+	}
+	path, err := CredentialsPath()
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	flow := &deviceFlow{
+		webURL:     apiHost.webURL,
+		clientID:   cfg.ClientID,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		wait:       waitContext,
+	}
+	return login(ctx, os.Stderr, flow, apiHost, cfg.Version, scopes, path)
+}
+
+// login runs flow, checks the token against the API of apiHost and stores it in the credentials
+// file at path.
+func login(ctx context.Context, w io.Writer, flow *deviceFlow, apiHost apiHost, version string, scopes []string, path string) error {
+	code, err := flow.start(ctx, scopes)
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(w, "First copy your one-time code: %s\n", code.UserCode)
+	_, _ = fmt.Fprintf(w, "Then open %s in your browser and enter it.\n", code.VerificationURI)
+	_, _ = fmt.Fprintln(w, "Waiting for authorization...")
+
+	token, grantedScopes, err := flow.poll(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	client := gogithub.NewClient(flow.httpClient).WithAuthToken(token)
+	client.UserAgent = fmt.Sprintf("github-mcp-server/%s", version)
+	client.BaseURL = apiHost.baseRESTURL
+	user, resp, err := client.Users.Get(ctx, "")
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check the new token: %w", err)
+	}
+
+	host := apiHost.webURL.Hostname()
+	if err := writeCredential(path, host, storedCredential{
+		Token:  token,
+		User:   user.GetLogin(),
+		Scopes: grantedScopes,
+	}); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(w, "Logged in to %s as %s. The token is stored in %s.\n", host, user.GetLogin(), path)
+	return nil
+}
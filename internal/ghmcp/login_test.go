@@ -0,0 +1,145 @@
+package ghmcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestDeviceFlow serves the device flow and the user API. The token is issued on the third
+// poll, after one pending and one slow down answer, unless tokenError is set.
+func newTestDeviceFlow(t *testing.T, tokenError string) (*deviceFlow, apiHost, *[]time.Duration) {
+	t.Helper()
+	polls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /login/device/code", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "client-id", r.PostForm.Get("client_id"))
+		assert.Equal(t, "repo read:org", r.PostForm.Get("scope"))
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+		_ = json.NewEncoder(w).Encode(deviceCode{
+			DeviceCode:      "device-code",
+			UserCode:        "ABCD-1234",
+			VerificationURI: "https://github.com/login/device",
+			ExpiresIn:       900,
+			Interval:        5,
+		})
+	})
+	mux.HandleFunc("POST /login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "device-code", r.PostForm.Get("device_code"))
+		assert.Equal(t, "urn:ietf:params:oauth:grant-type:device_code", r.PostForm.Get("grant_type"))
+		polls++
+		var answer deviceFlowResponse
+		switch {
+		case tokenError != "":
+			answer.Error = tokenError
+		case polls == 1:
+			answer.Error = "authorization_pending"
+		case polls == 2:
+			answer.Error = "slow_down"
+			answer.Interval = 10
+		default:
+			answer.AccessToken = "gho_token"
+			answer.Scope = "repo,read:org"
+		}
+		_ = json.NewEncoder(w).Encode(answer)
+	})
+	mux.HandleFunc("GET /api/v3/user", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer gho_token", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"login":"octocat"}`))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	webURL, err := url.Parse(srv.URL + "/")
+	require.NoError(t, err)
+	restURL, err := url.Parse(srv.URL + "/api/v3/")
+	require.NoError(t, err)
+
+	var waits []time.Duration
+	flow := &deviceFlow{
+		webURL:     webURL,
+		clientID:   "client-id",
+		httpClient: srv.Client(),
+		wait: func(_ context.Context, d time.Duration) error {
+			waits = append(waits, d)
+			return nil
+		},
+	}
+	return flow, apiHost{baseRESTURL: restURL, webURL: webURL}, &waits
+}
+
+func TestLogin(t *testing.T) {
+	t.Run("token is stored for the host", func(t *testing.T) {
+		flow, host, waits := newTestDeviceFlow(t, "")
+		path := filepath.Join(t.TempDir(), "github-mcp-server", "credentials.json")
+		require.NoError(t, writeCredential(path, "ghe.example.com", storedCredential{Token: "other"}))
+
+		var out bytes.Buffer
+		require.NoError(t, login(context.Background(), &out, flow, host, "test", []string{"repo", "read:org"}, path))
+
+		assert.Contains(t, out.String(), "ABCD-1234")
+		assert.Contains(t, out.String(), "https://github.com/login/device")
+		assert.Contains(t, out.String(), "as octocat")
+		assert.Equal(t, []time.Duration{5 * time.Second, 5 * time.Second, 10 * time.Second}, *waits)
+
+		creds, err := readCredentials(path)
+		require.NoError(t, err)
+		assert.Equal(t, storedCredential{Token: "gho_token", User: "octocat", Scopes: []string{"repo", "read:org"}}, creds.Hosts["127.0.0.1"])
+		assert.Equal(t, "other", creds.Hosts["ghe.example.com"].Token)
+
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		if runtime.GOOS != "windows" && info.Mode().Perm()&0o077 != 0 {
+			t.Errorf("credentials file is readable by others: %v", info.Mode().Perm())
+		}
+	})
+
+	t.Run("denied authorization is reported", func(t *testing.T) {
+		flow, host, _ := newTestDeviceFlow(t, "access_denied")
+		path := filepath.Join(t.TempDir(), "credentials.json")
+
+		err := login(context.Background(), &bytes.Buffer{}, flow, host, "test", []string{"repo", "read:org"}, path)
+		assert.EqualError(t, err, "the authorization was denied")
+		_, statErr := os.Stat(path)
+		assert.True(t, os.IsNotExist(statErr))
+	})
+
+	t.Run("expired code is reported", func(t *testing.T) {
+		flow, host, _ := newTestDeviceFlow(t, "expired_token")
+
+		err := login(context.Background(), &bytes.Buffer{}, flow, host, "test", []string{"repo", "read:org"}, filepath.Join(t.TempDir(), "credentials.json"))
+		assert.ErrorContains(t, err, "expired")
+	})
+}
+
+func TestCredentialHost(t *testing.T) {
+	for host, want := range map[string]string{
+		"":                            "github.com",
+		"https://github.com":          "github.com",
+		"https://tenant.ghe.com":      "tenant.ghe.com",
+		"https://ghe.example.com:443": "ghe.example.com",
+	} {
+		got, err := credentialHost(host)
+		require.NoError(t, err)
+		assert.Equal(t, want, got, host)
+	}
+}
+
+func TestReadCredentialsMissingFile(t *testing.T) {
+	creds, err := readCredentials(filepath.Join(t.TempDir(), "credentials.json"))
+	require.NoError(t, err)
+	assert.Empty(t, creds.Hosts)
+}
@@ -10,9 +10,12 @@ import (
 	"net/url"
 	"os"
 	"os/signal"
+	"sort"
 	"strings"
 	"syscall"
+	"time"
 
+	"github.com/github/github-mcp-server/pkg/cache"
 	"github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/github"
 	mcplog "github.com/github/github-mcp-server/pkg/log"
@@ -50,52 +53,115 @@ type MCPServerConfig struct {
 
 	// Content window size
 	ContentWindowSize int
+
+	// AllowDangerous enables powerful tools, such as raw API access, in the experiments toolset
+	AllowDangerous bool
+
+	// MaxIdleConns is the maximum number of idle (keep-alive) connections kept to the GitHub API.
+	// Zero means DefaultMaxIdleConns.
+	MaxIdleConns int
+
+	// MaxConnsPerHost limits the total number of connections per host. Zero means no limit.
+	MaxConnsPerHost int
+
+	// IdleConnTimeout is how long an idle connection is kept before closing. Zero means DefaultIdleConnTimeout.
+	IdleConnTimeout time.Duration
+
+	// ListResultStyle controls how list tools shape their results. Empty means github.ListResultStyleArray.
+	ListResultStyle github.ListResultStyle
+
+	// ImmutableCacheSize is the number of SHA-addressed responses (blobs, trees and commits) kept in
+	// memory. Zero disables the cache.
+	ImmutableCacheSize int
+
+	// AdditionalHosts maps other GitHub hosts to the tokens used for them. When set, tools accept a
+	// host argument that sends a single call to one of these hosts instead of Host.
+	AdditionalHosts map[string]string
+
+	// VariableMask lists name patterns of Actions variables whose values are hidden from tool results.
+	VariableMask github.VariableMask
+
+	// ResolveLFS makes get_raw_content download the Git LFS objects that pointer files refer to.
+	ResolveLFS bool
+
+	// sessions, when set, gives Streamable HTTP sessions that send their own token their own clients.
+	sessions *sessionStore
+
+	// token, when set, is used for Host instead of Token, so that the token can be replaced while
+	// the server runs.
+	token *serverToken
 }
 
 const stdioServerLogPrefix = "stdioserver"
 
+const (
+	// DefaultMaxIdleConns is the default size of the keep-alive connection pool.
+	DefaultMaxIdleConns = 100
+	// DefaultIdleConnTimeout is the default time an idle connection is kept open.
+	DefaultIdleConnTimeout = 90 * time.Second
+	// DefaultImmutableCacheSize is the default number of SHA-addressed responses kept in memory.
+	DefaultImmutableCacheSize = 1000
+)
+
+// newHTTPTransport builds the transport shared by the REST and GraphQL clients, tuned by the
+// connection pooling settings in cfg.
+func newHTTPTransport(cfg MCPServerConfig) *http.Transport {
+	maxIdleConns := cfg.MaxIdleConns
+	if maxIdleConns <= 0 {
+		maxIdleConns = DefaultMaxIdleConns
+	}
+	idleConnTimeout := cfg.IdleConnTimeout
+	if idleConnTimeout <= 0 {
+		idleConnTimeout = DefaultIdleConnTimeout
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = maxIdleConns
+	transport.MaxConnsPerHost = cfg.MaxConnsPerHost
+	// Almost every request goes to the same API host, so let that host use the whole idle pool
+	// rather than Go's default of 2 idle connections per host.
+	transport.MaxIdleConnsPerHost = maxIdleConns
+	if cfg.MaxConnsPerHost > 0 && cfg.MaxConnsPerHost < maxIdleConns {
+		transport.MaxIdleConnsPerHost = cfg.MaxConnsPerHost
+	}
+	transport.IdleConnTimeout = idleConnTimeout
+	return transport
+}
+
 func NewMCPServer(cfg MCPServerConfig) (*server.MCPServer, error) {
+	ghServer, _, err := newMCPServer(cfg)
+	return ghServer, err
+}
+
+// newMCPServer creates the MCP server and also returns the number of tools it registered.
+func newMCPServer(cfg MCPServerConfig) (*server.MCPServer, int, error) {
 	apiHost, err := parseAPIHost(cfg.Host)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse API host: %w", err)
+		return nil, 0, fmt.Errorf("failed to parse API host: %w", err)
 	}
 
-	// Construct our REST client
-	restClient := gogithub.NewClient(nil).WithAuthToken(cfg.Token)
-	restClient.UserAgent = fmt.Sprintf("github-mcp-server/%s", cfg.Version)
-	restClient.BaseURL = apiHost.baseRESTURL
-	restClient.UploadURL = apiHost.uploadURL
+	transport := newHTTPTransport(cfg)
 
-	// Construct our GraphQL client
-	// We're using NewEnterpriseClient here unconditionally as opposed to NewClient because we already
-	// did the necessary API host parsing so that github.com will return the correct URL anyway.
-	gqlHTTPClient := &http.Client{
-		Transport: &bearerAuthTransport{
-			transport: http.DefaultTransport,
-			token:     cfg.Token,
-		},
-	} // We're going to wrap the Transport later in beforeInit
-	gqlClient := githubv4.NewEnterpriseClient(apiHost.graphqlURL.String(), gqlHTTPClient)
-
-	// When a client send an initialize request, update the user agent to include the client info.
-	beforeInit := func(_ context.Context, _ any, message *mcp.InitializeRequest) {
-		userAgent := fmt.Sprintf(
-			"github-mcp-server/%s (%s/%s)",
-			cfg.Version,
-			message.Params.ClientInfo.Name,
-			message.Params.ClientInfo.Version,
-		)
-
-		restClient.UserAgent = userAgent
-
-		gqlHTTPClient.Transport = &userAgentTransport{
-			transport: gqlHTTPClient.Transport,
-			agent:     userAgent,
+	clients, err := newHostClientSet(cfg, apiHost, transport)
+	if err != nil {
+		return nil, 0, err
+	}
+	if cfg.sessions != nil {
+		cfg.sessions.newClients = func(token string) *hostClients {
+			return newHostClients(cfg, apiHost, newServerToken(token), transport)
 		}
+		clients.sessions = cfg.sessions
 	}
 
 	hooks := &server.Hooks{
-		OnBeforeInitialize: []server.OnBeforeInitializeFunc{beforeInit},
+		OnBeforeInitialize: []server.OnBeforeInitializeFunc{
+			func(ctx context.Context, _ any, message *mcp.InitializeRequest) {
+				// Streamable HTTP sessions do not keep their client, so the session store does.
+				if session := server.ClientSessionFromContext(ctx); session != nil && cfg.sessions != nil {
+					cfg.sessions.setClientInfo(session.SessionID(), message.Params.ClientInfo)
+				}
+			},
+		},
 		OnBeforeAny: []server.BeforeAnyHookFunc{
 			func(ctx context.Context, _ any, _ mcp.MCPMethod, _ any) {
 				// Ensure the context is cleared of any previous errors
@@ -105,7 +171,19 @@ func NewMCPServer(cfg MCPServerConfig) (*server.MCPServer, error) {
 		},
 	}
 
-	ghServer := github.NewServer(cfg.Version, server.WithHooks(hooks))
+	listResultStyle, err := github.ParseListResultStyle(string(cfg.ListResultStyle))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	serverOpts := []server.ServerOption{server.WithHooks(hooks), github.WithListResultStyle(listResultStyle)}
+	if hostNames := clients.hostNames(); len(hostNames) > 0 {
+		serverOpts = append(serverOpts, github.WithHostOverride(hostNames))
+	}
+	if len(cfg.VariableMask) > 0 {
+		serverOpts = append(serverOpts, github.WithVariableMask(cfg.VariableMask))
+	}
+	ghServer := github.NewServer(cfg.Version, serverOpts...)
 
 	enabledToolsets := cfg.EnabledToolsets
 	if cfg.DynamicToolsets {
@@ -118,39 +196,240 @@ func NewMCPServer(cfg MCPServerConfig) (*server.MCPServer, error) {
 		}
 	}
 
-	getClient := func(_ context.Context) (*gogithub.Client, error) {
-		return restClient, nil // closing over client
+	getClient := func(ctx context.Context) (*gogithub.Client, error) {
+		c, err := clients.forContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return c.rest, nil
 	}
 
-	getGQLClient := func(_ context.Context) (*githubv4.Client, error) {
-		return gqlClient, nil // closing over client
+	getGQLClient := func(ctx context.Context) (*githubv4.Client, error) {
+		c, err := clients.forContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return c.gql, nil
 	}
 
 	getRawClient := func(ctx context.Context) (*raw.Client, error) {
-		client, err := getClient(ctx)
+		c, err := clients.forContext(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 		}
-		return raw.NewClient(client, apiHost.rawURL), nil // closing over client
+		rawClient := raw.NewClient(c.rest, c.rawURL)
+		if c.lfs != nil {
+			rawClient = rawClient.WithLFS(c.webURL, c.lfs)
+		}
+		return rawClient, nil
+	}
+
+	// The token can change per request, with HTTP sessions or a reloaded token file, so the
+	// check run tools look at the token each request resolves to.
+	usesAppToken := func(ctx context.Context) (bool, error) {
+		c, err := clients.forContext(ctx)
+		if err != nil {
+			return false, err
+		}
+		return isAppInstallationToken(c.token.get()), nil
 	}
 
 	// Create default toolsets
 	tsg := github.DefaultToolsetGroup(cfg.ReadOnly, getClient, getGQLClient, getRawClient, cfg.Translator, cfg.ContentWindowSize)
+	// Logs examples that do not match their schema, so it is left out of exposedToolCount, which
+	// runs before logging is set up.
+	github.AddToolExamples(tsg)
+	if cfg.AllowDangerous {
+		if err := github.AddDangerousTools(tsg, cfg.ReadOnly, getClient, cfg.Translator); err != nil {
+			return nil, 0, fmt.Errorf("failed to add dangerous tools: %w", err)
+		}
+	}
+	if err := github.AddCheckRunTools(tsg, getClient, usesAppToken, cfg.Translator); err != nil {
+		return nil, 0, fmt.Errorf("failed to add check run tools: %w", err)
+	}
+	if len(clients.hostNames()) > 0 {
+		tsg.UpdateTools(github.AddHostParameter)
+	}
 	err = tsg.EnableToolsets(enabledToolsets)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to enable toolsets: %w", err)
+		return nil, 0, fmt.Errorf("failed to enable toolsets: %w", err)
 	}
 
 	// Register all mcp functionality with the server
 	tsg.RegisterAll(ghServer)
 
+	toolCount := 0
+	for _, toolset := range tsg.Toolsets {
+		toolCount += len(toolset.GetActiveTools())
+	}
+
 	if cfg.DynamicToolsets {
 		dynamic := github.InitDynamicToolset(ghServer, tsg, cfg.Translator)
 		dynamic.RegisterTools(ghServer)
+		toolCount += len(dynamic.GetActiveTools())
+	}
+
+	return ghServer, toolCount, nil
+}
+
+// isAppInstallationToken reports whether token is a GitHub App installation access token, which
+// GitHub issues with a ghs_ prefix. Some APIs, such as checks, only accept these.
+func isAppInstallationToken(token string) bool {
+	return strings.HasPrefix(token, "ghs_")
+}
+
+// hostClients are the API clients for one GitHub host.
+type hostClients struct {
+	rest    *gogithub.Client
+	gqlHTTP *http.Client
+	gql     *githubv4.Client
+	rawURL  *url.URL
+	// lfs is only set when Git LFS resolution is enabled. It downloads LFS objects from storage
+	// outside GitHub, so it does not add the token.
+	lfs    *http.Client
+	webURL *url.URL
+	token  *serverToken
+}
+
+// newHostClients creates the REST and GraphQL clients for host, authenticated with token.
+func newHostClients(cfg MCPServerConfig, host apiHost, token *serverToken, transport *http.Transport) *hostClients {
+	// Construct our REST client. GraphQL resolves renamed repositories itself, so only REST
+	// needs the redirect handling.
+	var restTransport http.RoundTripper = transport
+	if cfg.ImmutableCacheSize > 0 {
+		restTransport = cache.NewTransport(restTransport, cfg.ImmutableCacheSize)
+	}
+	restClient := gogithub.NewClient(&http.Client{
+		Transport: &userAgentTransport{
+			transport: &bearerAuthTransport{
+				transport: github.NewRepoRedirectTransport(restTransport),
+				token:     token,
+			},
+			version: cfg.Version,
+		},
+	})
+	restClient.BaseURL = host.baseRESTURL
+	restClient.UploadURL = host.uploadURL
+
+	// Construct our GraphQL client
+	// We're using NewEnterpriseClient here unconditionally as opposed to NewClient because we already
+	// did the necessary API host parsing so that github.com will return the correct URL anyway.
+	gqlHTTPClient := &http.Client{
+		Transport: &userAgentTransport{
+			transport: &bearerAuthTransport{
+				transport: transport,
+				token:     token,
+			},
+			version: cfg.Version,
+		},
+	}
+
+	clients := &hostClients{
+		rest:    restClient,
+		gqlHTTP: gqlHTTPClient,
+		gql:     githubv4.NewEnterpriseClient(host.graphqlURL.String(), gqlHTTPClient),
+		rawURL:  host.rawURL,
+		webURL:  host.webURL,
+		token:   token,
+	}
+	if cfg.ResolveLFS {
+		clients.lfs = &http.Client{Transport: transport}
+	}
+	return clients
+}
+
+// hostClientSet holds the clients for the configured host and any additional hosts.
+type hostClientSet struct {
+	defaultClients *hostClients
+	// byHost is only set when additional hosts are configured, and then also holds the default host.
+	byHost map[string]*hostClients
+	// sessions is only set for the Streamable HTTP server, and holds the clients of sessions that
+	// send their own token.
+	sessions *sessionStore
+}
+
+// newHostClientSet creates the clients for cfg.Host, at host, and for cfg.AdditionalHosts.
+func newHostClientSet(cfg MCPServerConfig, host apiHost, transport *http.Transport) (*hostClientSet, error) {
+	token := cfg.token
+	if token == nil {
+		token = newServerToken(cfg.Token)
+	}
+	set := &hostClientSet{defaultClients: newHostClients(cfg, host, token, transport)}
+	if len(cfg.AdditionalHosts) == 0 {
+		return set, nil
+	}
+
+	defaultHost := "github.com"
+	if cfg.Host != "" {
+		defaultHost = github.NormalizeHost(cfg.Host)
+	}
+	set.byHost = map[string]*hostClients{defaultHost: set.defaultClients}
+	for name, token := range cfg.AdditionalHosts {
+		name = github.NormalizeHost(name)
+		if _, ok := set.byHost[name]; ok {
+			continue
+		}
+		additionalHost, err := parseAPIHost("https://" + name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse additional host %s: %w", name, err)
+		}
+		set.byHost[name] = newHostClients(cfg, additionalHost, newServerToken(token), transport)
+	}
+	return set, nil
+}
+
+// hostNames returns the hosts a tool call may ask for, or nil if host overrides are disabled.
+func (s *hostClientSet) hostNames() []string {
+	var names []string
+	for name := range s.byHost {
+		names = append(names, name)
 	}
+	sort.Strings(names)
+	return names
+}
 
-	return ghServer, nil
+// forContext returns the clients for the host a tool call asked for with its host argument. Calls
+// that carry their own token get clients for that token, and are limited to the default host.
+func (s *hostClientSet) forContext(ctx context.Context) (*hostClients, error) {
+	host := github.HostFromContext(ctx)
+	c := s.defaultClients
+	if host != "" {
+		var ok bool
+		if c, ok = s.byHost[host]; !ok {
+			return nil, fmt.Errorf("no credentials are configured for host %s", host)
+		}
+	}
+	if token := sessionTokenFromContext(ctx); token != "" && s.sessions != nil {
+		if c != s.defaultClients {
+			return nil, fmt.Errorf("host %s can only be used with the server's token, not with a token sent by the client", host)
+		}
+		return s.sessions.clientsFor(ctx, token)
+	}
+	return c, nil
+}
+
+// writeStartupBanner prints the server version, API host, authenticated user and number of
+// enabled tools, so users can see at a glance that their configuration is correct. Failing to
+// look up the user only produces a warning.
+func writeStartupBanner(ctx context.Context, w io.Writer, client *gogithub.Client, transport, version string, toolCount int) {
+	_, _ = fmt.Fprintf(w, "GitHub MCP Server running on %s\n", transport)
+	_, _ = fmt.Fprintf(w, "  Version: %s\n", version)
+	_, _ = fmt.Fprintf(w, "  API: %s\n", client.BaseURL)
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	user, resp, err := client.Users.Get(ctx, "")
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		_, _ = fmt.Fprintf(w, "  Warning: could not fetch the authenticated user: %v\n", err)
+	} else {
+		_, _ = fmt.Fprintf(w, "  Authenticated as: %s\n", user.GetLogin())
+	}
+
+	_, _ = fmt.Fprintf(w, "  Tools enabled: %d\n", toolCount)
 }
 
 type StdioServerConfig struct {
@@ -186,71 +465,184 @@ type StdioServerConfig struct {
 
 	// Content window size
 	ContentWindowSize int
-}
 
-// RunStdioServer is not concurrent safe.
-func RunStdioServer(cfg StdioServerConfig) error {
-	// Create app context
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
-	defer stop()
+	// AllowDangerous enables powerful tools, such as raw API access, in the experiments toolset
+	AllowDangerous bool
 
-	t, dumpTranslations := translations.TranslationHelper()
+	// MaxIdleConns is the maximum number of idle (keep-alive) connections kept to the GitHub API.
+	// Zero means DefaultMaxIdleConns.
+	MaxIdleConns int
 
-	ghServer, err := NewMCPServer(MCPServerConfig{
-		Version:           cfg.Version,
-		Host:              cfg.Host,
-		Token:             cfg.Token,
-		EnabledToolsets:   cfg.EnabledToolsets,
-		DynamicToolsets:   cfg.DynamicToolsets,
-		ReadOnly:          cfg.ReadOnly,
-		Translator:        t,
-		ContentWindowSize: cfg.ContentWindowSize,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to create MCP server: %w", err)
+	// MaxConnsPerHost limits the total number of connections per host. Zero means no limit.
+	MaxConnsPerHost int
+
+	// IdleConnTimeout is how long an idle connection is kept before closing. Zero means DefaultIdleConnTimeout.
+	IdleConnTimeout time.Duration
+
+	// ListResultStyle controls how list tools shape their results. Empty means github.ListResultStyleArray.
+	ListResultStyle github.ListResultStyle
+
+	// ImmutableCacheSize is the number of SHA-addressed responses kept in memory. Zero disables the cache.
+	ImmutableCacheSize int
+
+	// AdditionalHosts maps other GitHub hosts to the tokens used for them. When set, tools accept a
+	// host argument that sends a single call to one of these hosts instead of Host.
+	AdditionalHosts map[string]string
+
+	// VariableMask lists name patterns of Actions variables whose values are hidden from tool results.
+	VariableMask github.VariableMask
+
+	// ResolveLFS makes get_raw_content download the Git LFS objects that pointer files refer to.
+	ResolveLFS bool
+
+	// StrictConfig makes the server refuse to start when the configuration has problems that are
+	// otherwise only logged as warnings.
+	StrictConfig bool
+
+	// TokenFile is the file Token was read from. When set, the server reads it again on SIGHUP, so
+	// that a rotated token is used without a restart.
+	TokenFile string
+}
+
+// runningServer is the MCP server and logging that RunStdioServer and RunSSEServer share.
+type runningServer struct {
+	ghServer  *server.MCPServer
+	toolCount int
+	logger    *slog.Logger
+	logOutput io.Writer
+	closeLog  func()
+	// token is the token of the default host, which is replaced when TokenFile is reloaded.
+	token *serverToken
+}
+
+// startServer validates cfg, creates the MCP server and sets up logging for a server on
+// transport. sessions is only set for the Streamable HTTP server. The caller must call closeLog
+// once the server has stopped.
+func startServer(cfg StdioServerConfig, transport string, sessions *sessionStore) (*runningServer, error) {
+	configProblems := validateConfig(cfg)
+	if cfg.StrictConfig && len(configProblems) > 0 {
+		return nil, fmt.Errorf("invalid configuration:\n  %s", strings.Join(configProblems, "\n  "))
 	}
 
-	stdioServer := server.NewStdioServer(ghServer)
+	t, dumpTranslations := translations.TranslationHelper()
+	token := newServerToken(cfg.Token)
 
+	running := &runningServer{closeLog: func() {}, token: token}
 	var slogHandler slog.Handler
-	var logOutput io.Writer
 	if cfg.LogFilePath != "" {
 		file, err := os.OpenFile(cfg.LogFilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
 		if err != nil {
-			return fmt.Errorf("failed to open log file: %w", err)
+			return nil, fmt.Errorf("failed to open log file: %w", err)
 		}
-		logOutput = file
-		slogHandler = slog.NewTextHandler(logOutput, &slog.HandlerOptions{Level: slog.LevelDebug})
+		// Closed by the caller after the final entries, so the file is synced first.
+		running.closeLog = func() {
+			_ = file.Sync()
+			_ = file.Close()
+		}
+		running.logOutput = file
+		slogHandler = slog.NewTextHandler(running.logOutput, &slog.HandlerOptions{Level: slog.LevelDebug})
 	} else {
-		logOutput = os.Stderr
-		slogHandler = slog.NewTextHandler(logOutput, &slog.HandlerOptions{Level: slog.LevelInfo})
+		running.logOutput = os.Stderr
+		slogHandler = slog.NewTextHandler(running.logOutput, &slog.HandlerOptions{Level: slog.LevelInfo})
+	}
+	running.logger = slog.New(slogHandler)
+	// Packages that log through slog directly, such as the GitHub error helpers, use the same output.
+	// This is set before the MCP server is created, so problems found while building it are logged.
+	slog.SetDefault(running.logger)
+	running.logger.Info("starting server", "version", cfg.Version, "transport", transport, "host", cfg.Host, "dynamicToolsets", cfg.DynamicToolsets, "readOnly", cfg.ReadOnly)
+	for _, problem := range configProblems {
+		running.logger.Warn("configuration problem", "problem", problem)
+	}
+
+	ghServer, toolCount, err := newMCPServer(MCPServerConfig{
+		Version:            cfg.Version,
+		Host:               cfg.Host,
+		Token:              cfg.Token,
+		EnabledToolsets:    cfg.EnabledToolsets,
+		DynamicToolsets:    cfg.DynamicToolsets,
+		ReadOnly:           cfg.ReadOnly,
+		Translator:         t,
+		ContentWindowSize:  cfg.ContentWindowSize,
+		AllowDangerous:     cfg.AllowDangerous,
+		MaxIdleConns:       cfg.MaxIdleConns,
+		MaxConnsPerHost:    cfg.MaxConnsPerHost,
+		IdleConnTimeout:    cfg.IdleConnTimeout,
+		ListResultStyle:    cfg.ListResultStyle,
+		ImmutableCacheSize: cfg.ImmutableCacheSize,
+		AdditionalHosts:    cfg.AdditionalHosts,
+		VariableMask:       cfg.VariableMask,
+		ResolveLFS:         cfg.ResolveLFS,
+		sessions:           sessions,
+		token:              token,
+	})
+	if err != nil {
+		running.closeLog()
+		return nil, fmt.Errorf("failed to create MCP server: %w", err)
 	}
-	logger := slog.New(slogHandler)
-	logger.Info("starting server", "version", cfg.Version, "host", cfg.Host, "dynamicToolsets", cfg.DynamicToolsets, "readOnly", cfg.ReadOnly)
-	stdLogger := log.New(logOutput, stdioServerLogPrefix, 0)
-	stdioServer.SetErrorLogger(stdLogger)
+	running.ghServer, running.toolCount = ghServer, toolCount
 
 	if cfg.ExportTranslations {
 		// Once server is initialized, all translations are loaded
 		dumpTranslations()
 	}
 
+	return running, nil
+}
+
+// writeBanner prints the startup banner for a server on transport to stderr.
+func (r *runningServer) writeBanner(ctx context.Context, cfg StdioServerConfig, transport string) error {
+	apiHost, err := parseAPIHost(cfg.Host)
+	if err != nil {
+		return fmt.Errorf("failed to parse API host: %w", err)
+	}
+	bannerClient := gogithub.NewClient(nil).WithAuthToken(cfg.Token)
+	bannerClient.UserAgent = fmt.Sprintf("github-mcp-server/%s", cfg.Version)
+	bannerClient.BaseURL = apiHost.baseRESTURL
+	writeStartupBanner(ctx, os.Stderr, bannerClient, transport, cfg.Version, r.toolCount)
+	return nil
+}
+
+// RunStdioServer is not concurrent safe.
+func RunStdioServer(cfg StdioServerConfig) error {
+	running, err := startServer(cfg, "stdio", nil)
+	if err != nil {
+		return err
+	}
+	defer running.closeLog()
+	logger := running.logger
+
+	// Create app context
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	running.reloadTokenOnHangup(ctx, cfg.TokenFile)
+
+	stdioServer := server.NewStdioServer(running.ghServer)
+	stdLogger := log.New(running.logOutput, stdioServerLogPrefix, 0)
+	stdioServer.SetErrorLogger(stdLogger)
+
+	in, out := io.Reader(os.Stdin), io.Writer(os.Stdout)
+	if cfg.EnableCommandLogging {
+		loggedIO := mcplog.NewIOLogger(in, out, logger, mcplog.WithRedactor(github.RedactToolArguments))
+		in, out = loggedIO, loggedIO
+		defer func() {
+			if err := loggedIO.Close(); err != nil {
+				logger.Error("failed to flush command logger", "error", err)
+			}
+		}()
+	}
+
 	// Start listening for messages
 	errC := make(chan error, 1)
 	go func() {
-		in, out := io.Reader(os.Stdin), io.Writer(os.Stdout)
-
-		if cfg.EnableCommandLogging {
-			loggedIO := mcplog.NewIOLogger(in, out, logger)
-			in, out = loggedIO, loggedIO
-		}
 		// enable GitHub errors in the context
 		ctx := errors.ContextWithGitHubErrors(ctx)
 		errC <- stdioServer.Listen(ctx, in, out)
 	}()
 
-	// Output github-mcp-server string
-	_, _ = fmt.Fprintf(os.Stderr, "GitHub MCP Server running on stdio\n")
+	// Output github-mcp-server banner
+	if err := running.writeBanner(ctx, cfg, "stdio"); err != nil {
+		return err
+	}
 
 	// Wait for shutdown signal
 	select {
@@ -271,6 +663,8 @@ type apiHost struct {
 	graphqlURL  *url.URL
 	uploadURL   *url.URL
 	rawURL      *url.URL
+	// webURL is the root of the web interface, which also serves the Git LFS API.
+	webURL *url.URL
 }
 
 func newDotcomHost() (apiHost, error) {
@@ -294,11 +688,17 @@ func newDotcomHost() (apiHost, error) {
 		return apiHost{}, fmt.Errorf("failed to parse dotcom Raw URL: %w", err)
 	}
 
+	webURL, err := url.Parse("https://github.com/")
+	if err != nil {
+		return apiHost{}, fmt.Errorf("failed to parse dotcom Web URL: %w", err)
+	}
+
 	return apiHost{
 		baseRESTURL: baseRestURL,
 		graphqlURL:  gqlURL,
 		uploadURL:   uploadURL,
 		rawURL:      rawURL,
+		webURL:      webURL,
 	}, nil
 }
 
@@ -333,11 +733,17 @@ func newGHECHost(hostname string) (apiHost, error) {
 		return apiHost{}, fmt.Errorf("failed to parse GHEC Raw URL: %w", err)
 	}
 
+	webURL, err := url.Parse(fmt.Sprintf("https://%s/", u.Hostname()))
+	if err != nil {
+		return apiHost{}, fmt.Errorf("failed to parse GHEC Web URL: %w", err)
+	}
+
 	return apiHost{
 		baseRESTURL: restURL,
 		graphqlURL:  gqlURL,
 		uploadURL:   uploadURL,
 		rawURL:      rawURL,
+		webURL:      webURL,
 	}, nil
 }
 
@@ -366,11 +772,17 @@ func newGHESHost(hostname string) (apiHost, error) {
 		return apiHost{}, fmt.Errorf("failed to parse GHES Raw URL: %w", err)
 	}
 
+	webURL, err := url.Parse(fmt.Sprintf("%s://%s/", u.Scheme, u.Hostname()))
+	if err != nil {
+		return apiHost{}, fmt.Errorf("failed to parse GHES Web URL: %w", err)
+	}
+
 	return apiHost{
 		baseRESTURL: restURL,
 		graphqlURL:  gqlURL,
 		uploadURL:   uploadURL,
 		rawURL:      rawURL,
+		webURL:      webURL,
 	}, nil
 }
 
@@ -400,24 +812,47 @@ func parseAPIHost(s string) (apiHost, error) {
 	return newGHESHost(s)
 }
 
+// userAgentTransport sets the User-Agent of each request. Requests made for an MCP session whose
+// client introduced itself on initialize also name that client. The client is looked up from the
+// request's context, so sessions sharing the transport never see each other's.
 type userAgentTransport struct {
 	transport http.RoundTripper
-	agent     string
+	version   string
 }
 
 func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	req = req.Clone(req.Context())
-	req.Header.Set("User-Agent", t.agent)
+	req.Header.Set("User-Agent", userAgent(t.version, clientInfoFromContext(req.Context())))
 	return t.transport.RoundTrip(req)
 }
 
+// userAgent is the User-Agent of requests made for client, which is empty if it is unknown.
+func userAgent(version string, client mcp.Implementation) string {
+	if client.Name == "" {
+		return fmt.Sprintf("github-mcp-server/%s", version)
+	}
+	return fmt.Sprintf("github-mcp-server/%s (%s/%s)", version, client.Name, client.Version)
+}
+
+// clientInfoFromContext returns the name and version the MCP client of ctx's session sent on
+// initialize, if its session or, for Streamable HTTP, the session store keeps them.
+func clientInfoFromContext(ctx context.Context) mcp.Implementation {
+	if client, ok := ctx.Value(clientInfoKey{}).(mcp.Implementation); ok {
+		return client
+	}
+	if session, ok := server.ClientSessionFromContext(ctx).(server.SessionWithClientInfo); ok {
+		return session.GetClientInfo()
+	}
+	return mcp.Implementation{}
+}
+
 type bearerAuthTransport struct {
 	transport http.RoundTripper
-	token     string
+	token     *serverToken
 }
 
 func (t *bearerAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	req = req.Clone(req.Context())
-	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Set("Authorization", "Bearer "+t.token.get())
 	return t.transport.RoundTrip(req)
 }
@@ -0,0 +1,363 @@
+package ghmcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	mcpgithub "github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHTTPTransport(t *testing.T) {
+	tests := []struct {
+		name                        string
+		cfg                         MCPServerConfig
+		expectedMaxIdleConns        int
+		expectedMaxIdleConnsPerHost int
+		expectedMaxConnsPerHost     int
+		expectedIdleConnTimeout     time.Duration
+	}{
+		{
+			name:                        "defaults",
+			cfg:                         MCPServerConfig{},
+			expectedMaxIdleConns:        DefaultMaxIdleConns,
+			expectedMaxIdleConnsPerHost: DefaultMaxIdleConns,
+			expectedMaxConnsPerHost:     0,
+			expectedIdleConnTimeout:     DefaultIdleConnTimeout,
+		},
+		{
+			name: "configured values",
+			cfg: MCPServerConfig{
+				MaxIdleConns:    200,
+				MaxConnsPerHost: 50,
+				IdleConnTimeout: 30 * time.Second,
+			},
+			expectedMaxIdleConns:        200,
+			expectedMaxIdleConnsPerHost: 50,
+			expectedMaxConnsPerHost:     50,
+			expectedIdleConnTimeout:     30 * time.Second,
+		},
+		{
+			name: "connection limit above idle pool",
+			cfg: MCPServerConfig{
+				MaxIdleConns:    10,
+				MaxConnsPerHost: 50,
+			},
+			expectedMaxIdleConns:        10,
+			expectedMaxIdleConnsPerHost: 10,
+			expectedMaxConnsPerHost:     50,
+			expectedIdleConnTimeout:     DefaultIdleConnTimeout,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := newHTTPTransport(tc.cfg)
+
+			assert.Equal(t, tc.expectedMaxIdleConns, transport.MaxIdleConns)
+			assert.Equal(t, tc.expectedMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+			assert.Equal(t, tc.expectedMaxConnsPerHost, transport.MaxConnsPerHost)
+			assert.Equal(t, tc.expectedIdleConnTimeout, transport.IdleConnTimeout)
+		})
+	}
+}
+
+func TestWriteStartupBanner(t *testing.T) {
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		expectedLines    []string
+		notExpectedLines []string
+	}{
+		{
+			name: "authenticated user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUser,
+					&github.User{Login: github.Ptr("octocat")},
+				),
+			),
+			expectedLines: []string{
+				"GitHub MCP Server running on stdio\n",
+				"  Version: 1.2.3\n",
+				"  API: https://api.github.com/\n",
+				"  Authenticated as: octocat\n",
+				"  Tools enabled: 42\n",
+			},
+			notExpectedLines: []string{"Warning"},
+		},
+		{
+			name: "user lookup fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUser,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnauthorized)
+						_, _ = w.Write([]byte(`{"message": "Bad credentials"}`))
+					}),
+				),
+			),
+			expectedLines: []string{
+				"GitHub MCP Server running on stdio\n",
+				"  Warning: could not fetch the authenticated user:",
+				"Bad credentials",
+				"  Tools enabled: 42\n",
+			},
+			notExpectedLines: []string{"Authenticated as"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writeStartupBanner(context.Background(), &buf, github.NewClient(tc.mockedClient), "stdio", "1.2.3", 42)
+
+			for _, line := range tc.expectedLines {
+				assert.Contains(t, buf.String(), line)
+			}
+			for _, line := range tc.notExpectedLines {
+				assert.NotContains(t, buf.String(), line)
+			}
+		})
+	}
+}
+
+func TestHostClientSet(t *testing.T) {
+	cfg := MCPServerConfig{
+		Host:            "https://ghes.example.com",
+		Token:           "ghes-token",
+		AdditionalHosts: map[string]string{"github.com": "dotcom-token"},
+	}
+	apiHost, err := parseAPIHost(cfg.Host)
+	require.NoError(t, err)
+
+	clients, err := newHostClientSet(cfg, apiHost, newHTTPTransport(cfg))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ghes.example.com", "github.com"}, clients.hostNames())
+	assert.Len(t, clients.byHost, 2)
+
+	t.Run("default host", func(t *testing.T) {
+		c, err := clients.forContext(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "https://ghes.example.com/api/v3/", c.rest.BaseURL.String())
+	})
+
+	t.Run("override to an additional host", func(t *testing.T) {
+		c, err := clients.forContext(mcpgithub.ContextWithHost(context.Background(), "github.com"))
+		require.NoError(t, err)
+		assert.Equal(t, "https://api.github.com/", c.rest.BaseURL.String())
+		assert.Equal(t, "https://raw.githubusercontent.com/", c.rawURL.String())
+	})
+
+	t.Run("override to the default host", func(t *testing.T) {
+		c, err := clients.forContext(mcpgithub.ContextWithHost(context.Background(), "ghes.example.com"))
+		require.NoError(t, err)
+		assert.Same(t, clients.defaultClients, c)
+	})
+
+	t.Run("unconfigured host", func(t *testing.T) {
+		_, err := clients.forContext(mcpgithub.ContextWithHost(context.Background(), "other.example.com"))
+		assert.EqualError(t, err, "no credentials are configured for host other.example.com")
+	})
+
+	t.Run("overrides disabled without additional hosts", func(t *testing.T) {
+		clients, err := newHostClientSet(MCPServerConfig{}, apiHost, newHTTPTransport(cfg))
+		require.NoError(t, err)
+		assert.Empty(t, clients.hostNames())
+		_, err = clients.forContext(mcpgithub.ContextWithHost(context.Background(), "github.com"))
+		assert.Error(t, err)
+	})
+}
+
+// clientInfoSession is a session whose client introduced itself on initialize.
+type clientInfoSession struct {
+	fakeSession
+	info mcp.Implementation
+}
+
+func (s clientInfoSession) GetClientInfo() mcp.Implementation { return s.info }
+func (s clientInfoSession) SetClientInfo(mcp.Implementation)  {}
+func (s clientInfoSession) GetClientCapabilities() mcp.ClientCapabilities {
+	return mcp.ClientCapabilities{}
+}
+func (s clientInfoSession) SetClientCapabilities(mcp.ClientCapabilities) {}
+
+func TestUserAgentPerSession(t *testing.T) {
+	agents := make(chan string, 4)
+	ghServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		agents <- r.Header.Get("User-Agent")
+		_, _ = w.Write([]byte(`{"login": "octocat"}`))
+	}))
+	defer ghServer.Close()
+
+	restURL, err := url.Parse(ghServer.URL + "/")
+	require.NoError(t, err)
+	cfg := MCPServerConfig{Version: "1.2.3", Token: "token"}
+	clients := newHostClients(cfg, apiHost{baseRESTURL: restURL, graphqlURL: restURL.JoinPath("graphql")}, newServerToken(cfg.Token), newHTTPTransport(cfg))
+
+	mcpServer := server.NewMCPServer("test", "1.0.0")
+	sessionCtx := func(id, name string) context.Context {
+		return mcpServer.WithContext(context.Background(), clientInfoSession{
+			fakeSession: fakeSession{id: id},
+			info:        mcp.Implementation{Name: name, Version: "0.1"},
+		})
+	}
+
+	// Two sessions share the clients, and each request names the client of its own session.
+	_, _, err = clients.rest.Users.Get(sessionCtx("a", "vscode"), "")
+	require.NoError(t, err)
+	assert.Equal(t, "github-mcp-server/1.2.3 (vscode/0.1)", <-agents)
+
+	_, _, err = clients.rest.Users.Get(sessionCtx("b", "cursor"), "")
+	require.NoError(t, err)
+	assert.Equal(t, "github-mcp-server/1.2.3 (cursor/0.1)", <-agents)
+
+	var q struct {
+		Viewer struct{ Login githubv4.String }
+	}
+	require.NoError(t, clients.gql.Query(sessionCtx("a", "vscode"), &q, nil))
+	assert.Equal(t, "github-mcp-server/1.2.3 (vscode/0.1)", <-agents)
+
+	// Requests made outside a session only name the server.
+	_, _, err = clients.rest.Users.Get(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, "github-mcp-server/1.2.3", <-agents)
+}
+
+func TestCheckRunToolsCheckTheTokenOfEachRequest(t *testing.T) {
+	token := newServerToken("ghp_personal")
+	srv, _, err := newMCPServer(MCPServerConfig{
+		Token:           "ghp_personal",
+		token:           token,
+		EnabledToolsets: []string{"actions"},
+		Translator:      translations.NullTranslationHelper,
+	})
+	require.NoError(t, err)
+
+	// The context is cancelled so that a call that gets past the token check fails before
+	// reaching GitHub.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	createCheckRun := func() string {
+		t.Helper()
+		response := srv.HandleMessage(ctx, json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"create_check_run","arguments":{"owner":"owner","repo":"repo","name":"lint","head_sha":"abc123"}}}`))
+		raw, err := json.Marshal(response)
+		require.NoError(t, err)
+		return string(raw)
+	}
+
+	// The tools are registered for any token, but only an installation token can use them.
+	assert.Contains(t, createCheckRun(), "only accepts GitHub App installation tokens")
+
+	// A reloaded token file can switch the server to an installation token.
+	token.set("ghs_installation")
+	result := createCheckRun()
+	assert.NotContains(t, result, "only accepts GitHub App installation tokens")
+	assert.Contains(t, result, "failed to create check run")
+
+	assert.True(t, isAppInstallationToken("ghs_installation"))
+	assert.False(t, isAppInstallationToken("ghp_personal"))
+}
+
+func TestToolListIsStable(t *testing.T) {
+	listTools := func() (string, []string) {
+		srv, _, err := newMCPServer(MCPServerConfig{
+			Token:           "ghp_personal",
+			EnabledToolsets: []string{"repos", "issues"},
+			DynamicToolsets: true,
+			Translator:      translations.NullTranslationHelper,
+		})
+		require.NoError(t, err)
+
+		response := srv.HandleMessage(context.Background(), json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`))
+		raw, err := json.Marshal(response)
+		require.NoError(t, err)
+
+		var decoded struct {
+			Result mcp.ListToolsResult `json:"result"`
+		}
+		require.NoError(t, json.Unmarshal(raw, &decoded))
+		names := make([]string, 0, len(decoded.Result.Tools))
+		for _, tool := range decoded.Result.Tools {
+			names = append(names, tool.Name)
+		}
+		return string(raw), names
+	}
+
+	firstRaw, firstNames := listTools()
+	require.NotEmpty(t, firstNames)
+	for range 5 {
+		raw, names := listTools()
+		assert.Equal(t, firstNames, names)
+		assert.Equal(t, firstRaw, raw)
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      StdioServerConfig
+		expected []string
+	}{
+		{
+			name: "default configuration is valid",
+			cfg:  StdioServerConfig{EnabledToolsets: []string{"all"}},
+		},
+		{
+			name: "read-only with only write tools exposes nothing",
+			cfg:  StdioServerConfig{EnabledToolsets: []string{"experiments"}, ReadOnly: true},
+			expected: []string{
+				"the server will expose no tools: the enabled toolsets (experiments) contain none in read-only mode; enable toolsets with read tools or drop --read-only",
+			},
+		},
+		{
+			name: "dangerous tools without the experiments toolset",
+			cfg:  StdioServerConfig{EnabledToolsets: []string{"repos"}, AllowDangerous: true},
+			expected: []string{
+				"--allow-dangerous has no effect because the experiments toolset, which holds the dangerous tools, is not enabled",
+			},
+		},
+		{
+			name: "additional host repeats the default host",
+			cfg: StdioServerConfig{
+				Host:            "https://ghes.example.com",
+				EnabledToolsets: []string{"repos"},
+				AdditionalHosts: map[string]string{"ghes.example.com": "token"},
+			},
+			expected: []string{
+				"additional host ghes.example.com is already the default host, so its GITHUB_PERSONAL_ACCESS_TOKEN_* token is never used",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, validateConfig(tc.cfg))
+		})
+	}
+}
+
+func TestRunStdioServerStrictConfig(t *testing.T) {
+	err := RunStdioServer(StdioServerConfig{
+		Token:           "ghp_test",
+		EnabledToolsets: []string{"experiments"},
+		ReadOnly:        true,
+		StrictConfig:    true,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid configuration")
+	assert.Contains(t, err.Error(), "the server will expose no tools")
+}
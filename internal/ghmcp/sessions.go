@@ -0,0 +1,173 @@
+package ghmcp
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// DefaultSessionIdleTimeout is how long a Streamable HTTP session may go unused before it expires.
+const DefaultSessionIdleTimeout = 30 * time.Minute
+
+// sessionTokenKey is the context key for the GitHub token a Streamable HTTP request carried.
+type sessionTokenKey struct{}
+
+func contextWithSessionToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, sessionTokenKey{}, token)
+}
+
+func sessionTokenFromContext(ctx context.Context) string {
+	token, _ := ctx.Value(sessionTokenKey{}).(string)
+	return token
+}
+
+// clientInfoKey is the context key for the MCP client a Streamable HTTP request came from.
+type clientInfoKey struct{}
+
+func contextWithClientInfo(ctx context.Context, client mcp.Implementation) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, client)
+}
+
+// bearerToken returns the token of an "Authorization: Bearer" header, or "" if there is none.
+func bearerToken(r *http.Request) string {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+// httpSession is a live Streamable HTTP session. clients is only set once the session made a
+// call with its own token. Streamable HTTP sessions of mcp-go only live as long as a request, so
+// the client the session introduced on initialize is kept here.
+type httpSession struct {
+	lastUsed   time.Time
+	token      string
+	clients    *hostClients
+	clientInfo mcp.Implementation
+}
+
+// sessionStore tracks the live sessions of the Streamable HTTP server and implements
+// server.SessionIdManager for it. Sessions expire after idleTimeout without requests. A session
+// that sends its own GitHub token gets its own clients, so that nothing, including the response
+// cache, is shared between the users of one server.
+type sessionStore struct {
+	idleTimeout time.Duration
+	now         func() time.Time
+	// newClients creates the clients for a token. It is set by newMCPServer.
+	newClients func(token string) *hostClients
+
+	mu       sync.Mutex
+	sessions map[string]*httpSession
+}
+
+var _ server.SessionIdManager = (*sessionStore)(nil)
+
+func newSessionStore(idleTimeout time.Duration) *sessionStore {
+	if idleTimeout <= 0 {
+		idleTimeout = DefaultSessionIdleTimeout
+	}
+	return &sessionStore{
+		idleTimeout: idleTimeout,
+		now:         time.Now,
+		sessions:    map[string]*httpSession{},
+	}
+}
+
+// expireLocked drops the sessions that have been idle for too long. s.mu must be held.
+func (s *sessionStore) expireLocked(now time.Time) {
+	for id, session := range s.sessions {
+		if now.Sub(session.lastUsed) > s.idleTimeout {
+			delete(s.sessions, id)
+		}
+	}
+}
+
+// Generate starts a new session and returns its ID.
+func (s *sessionStore) Generate() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	id := hex.EncodeToString(b)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	s.expireLocked(now)
+	s.sessions[id] = &httpSession{lastUsed: now}
+	return id
+}
+
+// Validate reports unknown and expired sessions as terminated, so that clients start a new one.
+func (s *sessionStore) Validate(sessionID string) (isTerminated bool, err error) {
+	if sessionID == "" {
+		return false, errors.New("missing session ID")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	s.expireLocked(now)
+	session, ok := s.sessions[sessionID]
+	if !ok {
+		return true, nil
+	}
+	session.lastUsed = now
+	return false, nil
+}
+
+// Terminate ends a session at the client's request.
+func (s *sessionStore) Terminate(sessionID string) (isNotAllowed bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, sessionID)
+	return false, nil
+}
+
+// setClientInfo records the MCP client of a session, as sent on initialize.
+func (s *sessionStore) setClientInfo(sessionID string, client mcp.Implementation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if session, ok := s.sessions[sessionID]; ok {
+		session.clientInfo = client
+	}
+}
+
+// clientInfo returns the MCP client of a session, if it introduced itself.
+func (s *sessionStore) clientInfo(sessionID string) (mcp.Implementation, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, ok := s.sessions[sessionID]
+	if !ok || session.clientInfo.Name == "" {
+		return mcp.Implementation{}, false
+	}
+	return session.clientInfo, true
+}
+
+// clientsFor returns the clients for the session of ctx, authenticated with token. Clients are
+// created on first use and again when the session switches tokens.
+func (s *sessionStore) clientsFor(ctx context.Context, token string) (*hostClients, error) {
+	clientSession := server.ClientSessionFromContext(ctx)
+	if clientSession == nil {
+		return nil, errors.New("no session for the request")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, ok := s.sessions[clientSession.SessionID()]
+	if !ok {
+		return nil, errors.New("session expired, start a new one")
+	}
+	if session.clients == nil || session.token != token {
+		session.token = token
+		session.clients = s.newClients(token)
+	}
+	return session.clients, nil
+}
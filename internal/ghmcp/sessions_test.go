@@ -0,0 +1,144 @@
+package ghmcp
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	mcpgithub "github.com/github/github-mcp-server/pkg/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSession struct{ id string }
+
+func (s fakeSession) Initialize()                                         {}
+func (s fakeSession) Initialized() bool                                   { return true }
+func (s fakeSession) NotificationChannel() chan<- mcp.JSONRPCNotification { return nil }
+func (s fakeSession) SessionID() string                                   { return s.id }
+
+func TestBearerToken(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected string
+	}{
+		{header: "Bearer ghp_abc", expected: "ghp_abc"},
+		{header: "bearer  ghp_abc ", expected: "ghp_abc"},
+		{header: "Basic dXNlcjpwYXNz", expected: ""},
+		{header: "", expected: ""},
+	}
+	for _, tc := range tests {
+		r, err := http.NewRequest(http.MethodPost, "http://localhost/mcp", nil)
+		require.NoError(t, err)
+		if tc.header != "" {
+			r.Header.Set("Authorization", tc.header)
+		}
+		assert.Equal(t, tc.expected, bearerToken(r), tc.header)
+	}
+}
+
+func TestSessionStore(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	sessions := newSessionStore(time.Minute)
+	sessions.now = func() time.Time { return now }
+
+	id := sessions.Generate()
+	other := sessions.Generate()
+	assert.NotEqual(t, id, other)
+
+	terminated, err := sessions.Validate(id)
+	require.NoError(t, err)
+	assert.False(t, terminated)
+
+	_, err = sessions.Validate("")
+	assert.Error(t, err)
+
+	terminated, err = sessions.Validate("unknown")
+	require.NoError(t, err)
+	assert.True(t, terminated, "unknown sessions are reported as terminated")
+
+	// Using a session keeps it alive; the other one expires.
+	now = now.Add(45 * time.Second)
+	_, _ = sessions.Validate(id)
+	now = now.Add(45 * time.Second)
+	terminated, _ = sessions.Validate(id)
+	assert.False(t, terminated)
+	terminated, _ = sessions.Validate(other)
+	assert.True(t, terminated)
+
+	_, err = sessions.Terminate(id)
+	require.NoError(t, err)
+	terminated, _ = sessions.Validate(id)
+	assert.True(t, terminated)
+}
+
+func TestHostClientSetSessionTokens(t *testing.T) {
+	cfg := MCPServerConfig{
+		Token:           "server-token",
+		AdditionalHosts: map[string]string{"ghes.example.com": "ghes-token"},
+	}
+	host, err := parseAPIHost("")
+	require.NoError(t, err)
+	transport := newHTTPTransport(cfg)
+	clients, err := newHostClientSet(cfg, host, transport)
+	require.NoError(t, err)
+
+	sessions := newSessionStore(0)
+	var created []string
+	sessions.newClients = func(token string) *hostClients {
+		created = append(created, token)
+		return newHostClients(cfg, host, newServerToken(token), transport)
+	}
+	clients.sessions = sessions
+
+	mcpServer := server.NewMCPServer("test", "1.0.0")
+	sessionCtx := func(id, token string) context.Context {
+		ctx := mcpServer.WithContext(context.Background(), fakeSession{id: id})
+		return contextWithSessionToken(ctx, token)
+	}
+	first, second := sessions.Generate(), sessions.Generate()
+
+	t.Run("calls without a token use the server's clients", func(t *testing.T) {
+		c, err := clients.forContext(mcpServer.WithContext(context.Background(), fakeSession{id: first}))
+		require.NoError(t, err)
+		assert.Same(t, clients.defaultClients, c)
+	})
+
+	t.Run("each session gets its own clients", func(t *testing.T) {
+		a1, err := clients.forContext(sessionCtx(first, "token-a"))
+		require.NoError(t, err)
+		a2, err := clients.forContext(sessionCtx(first, "token-a"))
+		require.NoError(t, err)
+		b, err := clients.forContext(sessionCtx(second, "token-b"))
+		require.NoError(t, err)
+
+		assert.Same(t, a1, a2)
+		assert.NotSame(t, a1, b)
+		assert.NotSame(t, clients.defaultClients, a1)
+		assert.Equal(t, []string{"token-a", "token-b"}, created)
+	})
+
+	t.Run("a new token replaces the session's clients", func(t *testing.T) {
+		before, err := clients.forContext(sessionCtx(first, "token-a"))
+		require.NoError(t, err)
+		after, err := clients.forContext(sessionCtx(first, "token-c"))
+		require.NoError(t, err)
+		assert.NotSame(t, before, after)
+	})
+
+	t.Run("session tokens are not used for additional hosts", func(t *testing.T) {
+		ctx := mcpgithub.ContextWithHost(sessionCtx(first, "token-a"), "ghes.example.com")
+		_, err := clients.forContext(ctx)
+		assert.EqualError(t, err, "host ghes.example.com can only be used with the server's token, not with a token sent by the client")
+	})
+
+	t.Run("expired sessions", func(t *testing.T) {
+		_, err := sessions.Terminate(second)
+		require.NoError(t, err)
+		_, err = clients.forContext(sessionCtx(second, "token-b"))
+		assert.EqualError(t, err, "session expired, start a new one")
+	})
+}
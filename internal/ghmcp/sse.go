@@ -0,0 +1,83 @@
+package ghmcp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/github/github-mcp-server/pkg/errors"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const sseServerLogPrefix = "sseserver"
+
+// SSEServerConfig configures a server that serves MCP over Server-Sent Events. It takes the same
+// settings as the stdio server, plus the address to listen on.
+type SSEServerConfig struct {
+	StdioServerConfig
+
+	// Addr is the TCP address to listen on, e.g. localhost:8080, or unix:///path for a unix domain
+	// socket. Empty means DefaultListenAddr.
+	Addr string
+
+	// SocketMode is the permissions of the unix domain socket. Zero means DefaultSocketMode.
+	SocketMode os.FileMode
+}
+
+// RunSSEServer serves MCP over Server-Sent Events until it is interrupted. Clients open an event
+// stream at /sse and post their messages to the endpoint it announces; every client gets its own
+// session, so several editors can share one server.
+func RunSSEServer(cfg SSEServerConfig) error {
+	addr := cfg.Addr
+	if addr == "" {
+		addr = DefaultListenAddr
+	}
+
+	running, err := startServer(cfg.StdioServerConfig, "sse", nil)
+	if err != nil {
+		return err
+	}
+	defer running.closeLog()
+	logger := running.logger
+	if cfg.EnableCommandLogging {
+		logger.Warn("command logging is only supported on stdio and is disabled")
+	}
+
+	// Create app context
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	running.reloadTokenOnHangup(ctx, cfg.TokenFile)
+
+	// Listen before printing the banner, so a port that is in use is reported right away.
+	listener, err := listen(addr, cfg.SocketMode)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	// Output github-mcp-server banner
+	if err := running.writeBanner(ctx, cfg.StdioServerConfig, "SSE at "+describeEndpoint(listener, "/sse")); err != nil {
+		_ = listener.Close()
+		return err
+	}
+
+	return serveSSE(ctx, running, listener)
+}
+
+// serveSSE serves the MCP server on listener until ctx is done.
+func serveSSE(ctx context.Context, running *runningServer, listener net.Listener) error {
+	httpServer := newHTTPServer(running, sseServerLogPrefix)
+	sseServer := server.NewSSEServer(running.ghServer,
+		server.WithHTTPServer(httpServer),
+		server.WithKeepAlive(true),
+		server.WithSSEContextFunc(func(ctx context.Context, _ *http.Request) context.Context {
+			// enable GitHub errors in the context
+			return errors.ContextWithGitHubErrors(ctx)
+		}),
+	)
+	httpServer.Handler = sseServer
+	return serveHTTP(ctx, running.logger, httpServer, listener, sseServer.Shutdown)
+}
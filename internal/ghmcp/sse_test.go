@@ -0,0 +1,57 @@
+package ghmcp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServeSSE(t *testing.T) {
+	running, err := startServer(StdioServerConfig{
+		Token:           "ghp_test",
+		EnabledToolsets: []string{"context"},
+	}, "sse", nil)
+	require.NoError(t, err)
+	defer running.closeLog()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	serveCtx, stop := context.WithCancel(ctx)
+	errC := make(chan error, 1)
+	go func() { errC <- serveSSE(serveCtx, running, listener) }()
+
+	// Two clients share the server, each in its own session.
+	for _, name := range []string{"editor-a", "editor-b"} {
+		c, err := client.NewSSEMCPClient(fmt.Sprintf("http://%s/sse", listener.Addr()))
+		require.NoError(t, err)
+		require.NoError(t, c.Start(ctx))
+
+		initRequest := mcp.InitializeRequest{}
+		initRequest.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+		initRequest.Params.ClientInfo = mcp.Implementation{Name: name, Version: "1.0.0"}
+		_, err = c.Initialize(ctx, initRequest)
+		require.NoError(t, err)
+
+		tools, err := c.ListTools(ctx, mcp.ListToolsRequest{})
+		require.NoError(t, err)
+		var names []string
+		for _, tool := range tools.Tools {
+			names = append(names, tool.Name)
+		}
+		assert.Contains(t, names, "get_me")
+		require.NoError(t, c.Close())
+	}
+
+	stop()
+	assert.NoError(t, <-errC)
+}
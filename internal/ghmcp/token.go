@@ -0,0 +1,75 @@
+package ghmcp
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"sync/atomic"
+	"syscall"
+)
+
+// serverToken is the token of the default host, which can be replaced while the server runs.
+type serverToken struct {
+	value atomic.Pointer[string]
+}
+
+func newServerToken(token string) *serverToken {
+	t := &serverToken{}
+	t.set(token)
+	return t
+}
+
+func (t *serverToken) get() string {
+	return *t.value.Load()
+}
+
+func (t *serverToken) set(token string) {
+	t.value.Store(&token)
+}
+
+// ReadTokenFile reads a token from a file, such as a mounted Docker or Kubernetes secret.
+// Surrounding whitespace, such as a trailing newline, is ignored.
+func ReadTokenFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", path)
+	}
+	return token, nil
+}
+
+// reloadTokenOnHangup reads the token from path again whenever the process receives SIGHUP, until
+// ctx is done. A file that cannot be read keeps the previous token.
+func (r *runningServer) reloadTokenOnHangup(ctx context.Context, path string) {
+	if path == "" {
+		return
+	}
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	go func() {
+		defer signal.Stop(hangup)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hangup:
+				r.reloadToken(path)
+			}
+		}
+	}()
+}
+
+func (r *runningServer) reloadToken(path string) {
+	token, err := ReadTokenFile(path)
+	if err != nil {
+		r.logger.Error("failed to reload token, keeping the previous one", "error", err)
+		return
+	}
+	r.token.set(token)
+	r.logger.Info("reloaded token", "file", path)
+}
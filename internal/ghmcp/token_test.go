@@ -0,0 +1,78 @@
+package ghmcp
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadTokenFile(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "token")
+	require.NoError(t, os.WriteFile(path, []byte("ghp_secret\n"), 0o600))
+	token, err := ReadTokenFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "ghp_secret", token)
+
+	empty := filepath.Join(dir, "empty")
+	require.NoError(t, os.WriteFile(empty, []byte("\n"), 0o600))
+	_, err = ReadTokenFile(empty)
+	assert.ErrorContains(t, err, "is empty")
+
+	_, err = ReadTokenFile(filepath.Join(dir, "missing"))
+	assert.ErrorContains(t, err, "failed to read token file")
+}
+
+func TestReloadToken(t *testing.T) {
+	var mu sync.Mutex
+	var seen []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		seen = append(seen, r.Header.Get("Authorization"))
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"login":"octocat"}`))
+	}))
+	defer srv.Close()
+	restURL, err := url.Parse(srv.URL + "/")
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("old-token"), 0o600))
+
+	cfg := MCPServerConfig{Version: "test", Token: "old-token"}
+	running := &runningServer{
+		logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+		token:  newServerToken(cfg.Token),
+	}
+	clients := newHostClients(cfg, apiHost{baseRESTURL: restURL, graphqlURL: restURL.JoinPath("graphql")}, running.token, newHTTPTransport(cfg))
+
+	getUser := func() {
+		t.Helper()
+		_, resp, err := clients.rest.Users.Get(context.Background(), "")
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+
+	getUser()
+	require.NoError(t, os.WriteFile(path, []byte("new-token\n"), 0o600))
+	running.reloadToken(path)
+	getUser()
+
+	// A file that cannot be read keeps the token that works
+	require.NoError(t, os.Remove(path))
+	running.reloadToken(path)
+	getUser()
+
+	assert.Equal(t, []string{"Bearer old-token", "Bearer new-token", "Bearer new-token"}, seen)
+}
@@ -40,6 +40,11 @@ func objectsAreEqualValues(expected, actual any) bool {
 
 	expectedValue := reflect.ValueOf(expected)
 	actualValue := reflect.ValueOf(actual)
+	// There is a modification to compare the value a non-nil pointer points to, because a pointer
+	// is how an optional variable is provided, but the request body only has the value.
+	if expectedValue.Kind() == reflect.Pointer && !expectedValue.IsNil() {
+		return objectsAreEqualValues(expectedValue.Elem().Interface(), actual)
+	}
 	if !expectedValue.IsValid() || !actualValue.IsValid() {
 		return false
 	}
@@ -1,6 +1,7 @@
 // The contents of this file are taken from https://github.com/stretchr/testify/blob/016e2e9c269209287f33ec203f340a9a723fe22c/assert/assertions_test.go#L140-L174
 //
-// There is a modification to test objectsAreEqualValues to check that typed nils are equal, even if their types are different.
+// There is a modification to test objectsAreEqualValues to check that typed nils are equal, even if their types are different,
+// and that a non-nil pointer equals the value it points to.
 
 // The original license, copied from https://github.com/stretchr/testify/blob/016e2e9c269209287f33ec203f340a9a723fe22c/LICENSE
 //
@@ -59,6 +60,8 @@ func TestObjectsAreEqualValues(t *testing.T) {
 		{complex64(1e+10 + 1e+10i), complex128(1e+10 + 1e+10i), true},
 		{(*string)(nil), nil, true},         // typed nil vs untyped nil
 		{(*string)(nil), (*int)(nil), true}, // different typed nils
+		{ptr("HEART"), "HEART", true},       // pointer vs the value it points to
+		{ptr("HEART"), "EYES", false},
 	}
 
 	for _, c := range cases {
@@ -71,3 +74,7 @@ func TestObjectsAreEqualValues(t *testing.T) {
 		})
 	}
 }
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -3,6 +3,7 @@ package buffer
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 )
@@ -26,44 +27,63 @@ import (
 // The function uses a ring buffer to efficiently store only the last maxJobLogLines lines.
 // If the response contains more lines than maxJobLogLines, only the most recent lines are kept.
 func ProcessResponseAsRingBufferToEnd(httpResp *http.Response, maxJobLogLines int) (string, int, *http.Response, error) {
-	lines := make([]string, maxJobLogLines)
-	validLines := make([]bool, maxJobLogLines)
-	totalLines := 0
-	writeIndex := 0
+	ring := NewRingBuffer(maxJobLogLines)
+	if err := ring.ReadLines(httpResp.Body); err != nil {
+		return "", 0, httpResp, err
+	}
+	return ring.String(), ring.TotalLines(), httpResp, nil
+}
+
+// RingBuffer keeps the last lines read into it, so that the tail of a long log can be taken
+// without holding the whole log in memory.
+type RingBuffer struct {
+	maxLines   int
+	lines      []string
+	writeIndex int
+	totalLines int
+}
 
-	scanner := bufio.NewScanner(httpResp.Body)
+// NewRingBuffer creates a RingBuffer that keeps the last maxLines lines, or every line if
+// maxLines is not positive.
+func NewRingBuffer(maxLines int) *RingBuffer {
+	return &RingBuffer{maxLines: maxLines}
+}
+
+// ReadLines reads r line by line to its end. Text after the last newline of r counts as a
+// line of its own, so reading several readers in turn never joins their lines.
+func (b *RingBuffer) ReadLines(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
 	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
 
 	for scanner.Scan() {
-		line := scanner.Text()
-		totalLines++
-
-		lines[writeIndex] = line
-		validLines[writeIndex] = true
-		writeIndex = (writeIndex + 1) % maxJobLogLines
+		b.add(scanner.Text())
 	}
 
 	if err := scanner.Err(); err != nil {
-		return "", 0, httpResp, fmt.Errorf("failed to read log content: %w", err)
-	}
-
-	var result []string
-	linesInBuffer := totalLines
-	if linesInBuffer > maxJobLogLines {
-		linesInBuffer = maxJobLogLines
+		return fmt.Errorf("failed to read log content: %w", err)
 	}
+	return nil
+}
 
-	startIndex := 0
-	if totalLines > maxJobLogLines {
-		startIndex = writeIndex
+func (b *RingBuffer) add(line string) {
+	b.totalLines++
+	if b.maxLines <= 0 || len(b.lines) < b.maxLines {
+		b.lines = append(b.lines, line)
+		return
 	}
+	b.lines[b.writeIndex] = line
+	b.writeIndex = (b.writeIndex + 1) % b.maxLines
+}
 
-	for i := 0; i < linesInBuffer; i++ {
-		idx := (startIndex + i) % maxJobLogLines
-		if validLines[idx] {
-			result = append(result, lines[idx])
-		}
-	}
+// TotalLines returns the number of lines read, including those no longer kept.
+func (b *RingBuffer) TotalLines() int {
+	return b.totalLines
+}
 
-	return strings.Join(result, "\n"), totalLines, httpResp, nil
+// String returns the kept lines, oldest first, separated by newlines.
+func (b *RingBuffer) String() string {
+	result := make([]string, 0, len(b.lines))
+	result = append(result, b.lines[b.writeIndex:]...)
+	result = append(result, b.lines[:b.writeIndex]...)
+	return strings.Join(result, "\n")
 }
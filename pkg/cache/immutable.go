@@ -0,0 +1,150 @@
+// Package cache provides HTTP caching for GitHub API responses.
+package cache
+
+import (
+	"bytes"
+	"container/list"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// immutablePathPattern matches REST endpoints whose content is fully determined by a full commit,
+// tree or blob SHA (SHA-1 or SHA-256). Short SHAs and ref names are excluded, because what they
+// point at can change.
+var immutablePathPattern = regexp.MustCompile(`/repos/[^/]+/[^/]+/(?:git/blobs|git/trees|git/commits|commits)/(?:[0-9a-fA-F]{40}|[0-9a-fA-F]{64})$`)
+
+// IsImmutable reports whether a GET request to req's URL always returns the same content.
+func IsImmutable(req *http.Request) bool {
+	return req.Method == http.MethodGet && immutablePathPattern.MatchString(req.URL.Path)
+}
+
+type entry struct {
+	key    string
+	header http.Header
+	body   []byte
+}
+
+// ImmutableCache is an in-memory LRU cache of responses for content addressed by SHA. Entries
+// never go stale, so they are only dropped to stay within the size limit. It is safe for
+// concurrent use.
+type ImmutableCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List
+	items      map[string]*list.Element
+}
+
+// NewImmutableCache creates a cache holding at most maxEntries responses.
+func NewImmutableCache(maxEntries int) *ImmutableCache {
+	return &ImmutableCache{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+// Len returns the number of cached responses.
+func (c *ImmutableCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+func (c *ImmutableCache) get(key string) (*entry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+	return el.Value.(*entry), true
+}
+
+func (c *ImmutableCache) add(e *entry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[e.key]; ok {
+		c.ll.MoveToFront(el)
+		el.Value = e
+		return
+	}
+	c.items[e.key] = c.ll.PushFront(e)
+	for c.maxEntries > 0 && c.ll.Len() > c.maxEntries {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*entry).key)
+	}
+}
+
+// Transport is an http.RoundTripper that serves repeated requests for SHA-addressed content from
+// an ImmutableCache. All other requests are passed straight through.
+type Transport struct {
+	Transport http.RoundTripper
+	Cache     *ImmutableCache
+}
+
+// NewTransport wraps transport, or http.DefaultTransport if it is nil, with an immutable cache
+// holding at most maxEntries responses.
+func NewTransport(transport http.RoundTripper, maxEntries int) *Transport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &Transport{
+		Transport: transport,
+		Cache:     NewImmutableCache(maxEntries),
+	}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !IsImmutable(req) {
+		return t.Transport.RoundTrip(req)
+	}
+
+	// The same URL can be served as JSON or as raw content depending on the Accept header.
+	key := req.URL.String() + "\n" + req.Header.Get("Accept")
+	if e, ok := t.Cache.get(key); ok {
+		return cachedResponse(req, e), nil
+	}
+
+	resp, err := t.Transport.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	header := resp.Header.Clone()
+	// Rate limit headers describe the moment the response was fetched. Replaying them later
+	// could make the client think it is still rate limited.
+	for name := range header {
+		if strings.HasPrefix(strings.ToLower(name), "x-ratelimit-") {
+			header.Del(name)
+		}
+	}
+	t.Cache.add(&entry{key: key, header: header, body: body})
+
+	return resp, nil
+}
+
+func cachedResponse(req *http.Request, e *entry) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
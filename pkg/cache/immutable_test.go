@@ -0,0 +1,120 @@
+package cache
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	blobSHA  = "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15"
+	blobSHA2 = "95b966ae1c166bd92f8ae7d1c313e738c731dfc3"
+)
+
+// newTestClient returns a GitHub client whose requests go through a cache of the given size
+// to a server that counts how often each path is requested.
+func newTestClient(t *testing.T, maxEntries int) (*github.Client, *Transport, map[string]*atomic.Int32) {
+	t.Helper()
+
+	hits := map[string]*atomic.Int32{}
+	for _, sha := range []string{blobSHA, blobSHA2} {
+		hits["/repos/owner/repo/git/blobs/"+sha] = &atomic.Int32{}
+	}
+	hits["/repos/owner/repo/git/trees/main"] = &atomic.Int32{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		counter, ok := hits[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		counter.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		_, _ = w.Write([]byte(`{"sha":"` + r.URL.Path + `","content":"aGVsbG8=","encoding":"base64"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	transport := NewTransport(srv.Client().Transport, maxEntries)
+	client := github.NewClient(&http.Client{Transport: transport})
+	baseURL, err := url.Parse(srv.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	return client, transport, hits
+}
+
+func TestTransport_RepeatedBlobFetchIsCached(t *testing.T) {
+	client, transport, hits := newTestClient(t, 10)
+	ctx := context.Background()
+
+	first, _, err := client.Git.GetBlob(ctx, "owner", "repo", blobSHA)
+	require.NoError(t, err)
+	second, resp, err := client.Git.GetBlob(ctx, "owner", "repo", blobSHA)
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.Equal(t, int32(1), hits["/repos/owner/repo/git/blobs/"+blobSHA].Load())
+	assert.Equal(t, 1, transport.Cache.Len())
+	// Replayed rate limit headers must not make the client think it is still rate limited.
+	assert.Empty(t, resp.Header.Get("X-RateLimit-Remaining"))
+
+	// Raw blob content is a different representation of the same URL, so it is cached separately.
+	_, _, err = client.Git.GetBlobRaw(ctx, "owner", "repo", blobSHA)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), hits["/repos/owner/repo/git/blobs/"+blobSHA].Load())
+}
+
+func TestTransport_RefsAreNotCached(t *testing.T) {
+	client, transport, hits := newTestClient(t, 10)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, _, err := client.Git.GetTree(ctx, "owner", "repo", "main", false)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, int32(2), hits["/repos/owner/repo/git/trees/main"].Load())
+	assert.Equal(t, 0, transport.Cache.Len())
+}
+
+func TestTransport_LRUEviction(t *testing.T) {
+	client, transport, hits := newTestClient(t, 1)
+	ctx := context.Background()
+
+	_, _, err := client.Git.GetBlob(ctx, "owner", "repo", blobSHA)
+	require.NoError(t, err)
+	// Fetching a second blob evicts the first from a cache that only holds one entry.
+	_, _, err = client.Git.GetBlob(ctx, "owner", "repo", blobSHA2)
+	require.NoError(t, err)
+	_, _, err = client.Git.GetBlob(ctx, "owner", "repo", blobSHA)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), hits["/repos/owner/repo/git/blobs/"+blobSHA].Load())
+	assert.Equal(t, int32(1), hits["/repos/owner/repo/git/blobs/"+blobSHA2].Load())
+	assert.Equal(t, 1, transport.Cache.Len())
+}
+
+func TestImmutableCache_GetMarksRecentlyUsed(t *testing.T) {
+	c := NewImmutableCache(2)
+	c.add(&entry{key: "a"})
+	c.add(&entry{key: "b"})
+
+	_, ok := c.get("a")
+	require.True(t, ok)
+	c.add(&entry{key: "c"})
+
+	_, ok = c.get("a")
+	assert.True(t, ok, "recently used entry should be kept")
+	_, ok = c.get("b")
+	assert.False(t, ok, "least recently used entry should be evicted")
+	_, ok = c.get("c")
+	assert.True(t, ok)
+}
@@ -2,25 +2,48 @@ package errors
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 
 	"github.com/google/go-github/v74/github"
 	"github.com/mark3labs/mcp-go/mcp"
 )
 
+// RequestIDHeader is the response header carrying the ID GitHub assigns to every request. GitHub
+// Support can use it to look up a failed request.
+const RequestIDHeader = "X-GitHub-Request-Id"
+
 type GitHubAPIError struct {
-	Message  string           `json:"message"`
-	Response *github.Response `json:"-"`
-	Err      error            `json:"-"`
+	Message   string           `json:"message"`
+	RequestID string           `json:"request_id,omitempty"`
+	Response  *github.Response `json:"-"`
+	Err       error            `json:"-"`
 }
 
 // NewGitHubAPIError creates a new GitHubAPIError with the provided message, response, and error.
 func newGitHubAPIError(message string, resp *github.Response, err error) *GitHubAPIError {
 	return &GitHubAPIError{
-		Message:  message,
-		Response: resp,
-		Err:      err,
+		Message:   message,
+		RequestID: requestID(resp, err),
+		Response:  resp,
+		Err:       err,
+	}
+}
+
+// requestID returns the GitHub request ID of a failed call, taken from the response or, when the
+// caller did not keep it, from the response attached to a go-github error.
+func requestID(resp *github.Response, err error) string {
+	if resp != nil && resp.Response != nil {
+		if id := resp.Header.Get(RequestIDHeader); id != "" {
+			return id
+		}
 	}
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil {
+		return errResp.Response.Header.Get(RequestIDHeader)
+	}
+	return ""
 }
 
 func (e *GitHubAPIError) Error() string {
@@ -112,7 +135,16 @@ func NewGitHubAPIErrorResponse(ctx context.Context, message string, resp *github
 	if ctx != nil {
 		_, _ = addGitHubAPIErrorToContext(ctx, apiErr) // Explicitly ignore error for graceful handling
 	}
-	return mcp.NewToolResultErrorFromErr(message, err)
+	slog.Warn("GitHub API request failed", "message", message, "error", err, "request_id", apiErr.RequestID)
+	text := message
+	if err != nil {
+		text = fmt.Sprintf("%s: %v", message, err)
+	}
+	if apiErr.RequestID != "" {
+		// Quoting the request ID lets GitHub Support find the failed request.
+		text = fmt.Sprintf("%s (GitHub request ID: %s)", text, apiErr.RequestID)
+	}
+	return mcp.NewToolResultError(text)
 }
 
 // NewGitHubGraphQLErrorResponse returns an mcp.NewToolResultError and retains the error in the context for access via middleware
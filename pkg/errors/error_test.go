@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -41,6 +42,31 @@ func TestGitHubErrorContext(t *testing.T) {
 		assert.Equal(t, "failed to fetch resource: resource not found", apiError.Error())
 	})
 
+	t.Run("API errors keep the GitHub request ID", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+
+		header := http.Header{}
+		header.Set(RequestIDHeader, "CAFE:1234:5678AB:9ABCDE:66F0A1B2")
+		resp := &github.Response{Response: &http.Response{StatusCode: 502, Header: header}}
+
+		result := NewGitHubAPIErrorResponse(ctx, "failed to fetch resource", resp, fmt.Errorf("bad gateway"))
+		require.True(t, result.IsError)
+		assert.Equal(t, "failed to fetch resource: bad gateway (GitHub request ID: CAFE:1234:5678AB:9ABCDE:66F0A1B2)", result.Content[0].(mcp.TextContent).Text)
+
+		apiErrors, err := GetGitHubAPIErrors(ctx)
+		require.NoError(t, err)
+		require.Len(t, apiErrors, 1)
+		assert.Equal(t, "CAFE:1234:5678AB:9ABCDE:66F0A1B2", apiErrors[0].RequestID)
+	})
+
+	t.Run("request ID is read from a go-github error when the response is missing", func(t *testing.T) {
+		header := http.Header{}
+		header.Set(RequestIDHeader, "BEEF:1")
+		errResp := &github.ErrorResponse{Response: &http.Response{StatusCode: 404, Header: header}, Message: "Not Found"}
+
+		assert.Equal(t, "BEEF:1", newGitHubAPIError("failed", nil, errResp).RequestID)
+	})
+
 	t.Run("GraphQL errors can be added to context and retrieved", func(t *testing.T) {
 		// Given a context with GitHub error tracking enabled
 		ctx := ContextWithGitHubErrors(context.Background())
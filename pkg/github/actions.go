@@ -2,11 +2,15 @@ package github
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/github/github-mcp-server/internal/profiler"
 	buffer "github.com/github/github-mcp-server/pkg/buffer"
@@ -73,12 +77,7 @@ func ListWorkflows(getClient GetClientFn, t translations.TranslationHelperFunc)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(workflows)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledListPageResult(ctx, workflows, workflows.Workflows, ListPageInfo{TotalCount: workflows.TotalCount}, "no workflows found"), nil
 		}
 }
 
@@ -212,12 +211,7 @@ func ListWorkflowRuns(getClient GetClientFn, t translations.TranslationHelperFun
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(workflowRuns)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledListPageResult(ctx, workflowRuns, workflowRuns.WorkflowRuns, ListPageInfo{TotalCount: workflowRuns.TotalCount}, "no workflow runs matched"), nil
 		}
 }
 
@@ -246,7 +240,7 @@ func RunWorkflow(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				mcp.Description("The git reference for the workflow. The reference can be a branch or tag name."),
 			),
 			mcp.WithObject("inputs",
-				mcp.Description("Inputs the workflow accepts"),
+				mcp.Description("Inputs the workflow accepts, as declared under on.workflow_dispatch.inputs. Boolean and number inputs can be given as JSON booleans and numbers; choice inputs must be one of their options."),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -280,6 +274,27 @@ func RunWorkflow(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
+			// Check the inputs against the workflow when it can be read, so that mistakes are
+			// reported with the accepted inputs rather than as a bare 422 from the API.
+			var declared map[string]workflowDispatchInput
+			if content, err := getWorkflowFile(ctx, client, owner, repo, workflowID, ref); err == nil {
+				var dispatchable bool
+				declared, dispatchable, err = parseWorkflowDispatchInputs(content)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if !dispatchable {
+					return mcp.NewToolResultError(fmt.Sprintf("workflow %s has no workflow_dispatch trigger on %s", workflowID, ref)), nil
+				}
+				if declared == nil {
+					declared = map[string]workflowDispatchInput{}
+				}
+			}
+			inputs, err = workflowDispatchInputs(declared, inputs)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
 			event := github.CreateWorkflowDispatchEventRequest{
 				Ref:    ref,
 				Inputs: inputs,
@@ -320,6 +335,108 @@ func RunWorkflow(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 		}
 }
 
+const (
+	// maxDispatchPayloadProperties is the number of top-level client_payload properties GitHub accepts.
+	maxDispatchPayloadProperties = 10
+	// maxDispatchPayloadBytes caps the encoded client_payload size.
+	maxDispatchPayloadBytes = 64 * 1024
+)
+
+// parseDispatchPayload validates a repository_dispatch client_payload, which may be given either
+// as an object or as a JSON-encoded string, and returns its JSON encoding.
+func parseDispatchPayload(value any) (json.RawMessage, error) {
+	var payload map[string]any
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case map[string]any:
+		payload = v
+	case string:
+		if err := json.Unmarshal([]byte(v), &payload); err != nil {
+			return nil, fmt.Errorf("client_payload must be a JSON object: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("client_payload must be a JSON object, got %T", value)
+	}
+
+	if len(payload) > maxDispatchPayloadProperties {
+		return nil, fmt.Errorf("client_payload has %d top-level properties, GitHub allows at most %d", len(payload), maxDispatchPayloadProperties)
+	}
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode client_payload: %w", err)
+	}
+	if len(encoded) > maxDispatchPayloadBytes {
+		return nil, fmt.Errorf("client_payload is %d bytes, the limit is %d bytes", len(encoded), maxDispatchPayloadBytes)
+	}
+	return encoded, nil
+}
+
+// CreateRepositoryDispatch creates a tool to trigger a repository_dispatch event
+func CreateRepositoryDispatch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_repository_dispatch",
+			mcp.WithDescription(t("TOOL_CREATE_REPOSITORY_DISPATCH_DESCRIPTION", "Trigger a repository_dispatch event, which runs any workflows in the repository listening for it")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_REPOSITORY_DISPATCH_USER_TITLE", "Create repository dispatch event"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("event_type",
+				mcp.Required(),
+				mcp.Description("A custom event name that workflows filter on with 'on.repository_dispatch.types' (max 100 characters)"),
+			),
+			mcp.WithObject("client_payload",
+				mcp.Description("JSON payload available to workflows as github.event.client_payload (max 10 top-level properties)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			eventType, err := RequiredParam[string](request, "event_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(eventType) > 100 {
+				return mcp.NewToolResultError("event_type must be at most 100 characters"), nil
+			}
+			payload, err := parseDispatchPayload(request.GetArguments()["client_payload"])
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := github.DispatchRequestOptions{EventType: eventType}
+			if payload != nil {
+				opts.ClientPayload = &payload
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			_, resp, err := client.Repositories.Dispatch(ctx, owner, repo, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create repository dispatch event", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Repository dispatch event %q was created for %s/%s", eventType, owner, repo)), nil
+		}
+}
+
 // GetWorkflowRun creates a tool to get details of a specific workflow run
 func GetWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_workflow_run",
@@ -376,10 +493,196 @@ func GetWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFunc)
 		}
 }
 
+// workflowRunPollBackoff controls how wait_for_workflow_run waits between checks of a run.
+var workflowRunPollBackoff = pollBackoff{Initial: 5 * time.Second, Max: 30 * time.Second, Factor: 1.5}
+
+// FailedJob summarizes a job that did not succeed in a workflow run.
+type FailedJob struct {
+	ID          int64    `json:"id"`
+	Name        string   `json:"name"`
+	Conclusion  string   `json:"conclusion"`
+	FailedSteps []string `json:"failed_steps,omitempty"`
+	HTMLURL     string   `json:"html_url"`
+}
+
+// WorkflowRunOutcome is the state of a workflow run at the end of wait_for_workflow_run.
+// TimedOut is set when the run was still going when the wait ended, in which case Conclusion is empty.
+type WorkflowRunOutcome struct {
+	RunID          int64       `json:"run_id"`
+	Name           string      `json:"name"`
+	Status         string      `json:"status"`
+	Conclusion     string      `json:"conclusion,omitempty"`
+	HTMLURL        string      `json:"html_url"`
+	TimedOut       bool        `json:"timed_out"`
+	Cancelled      bool        `json:"cancelled,omitempty"`
+	Attempts       int         `json:"attempts"`
+	FailedJobs     []FailedJob `json:"failed_jobs,omitempty"`
+	Message        string      `json:"message"`
+	OperationToken string      `json:"operation_token,omitempty"`
+}
+
+// failedJobs returns the jobs of the latest attempt of a run that failed or timed out.
+func failedJobs(ctx context.Context, client *github.Client, owner, repo string, runID int64) ([]FailedJob, error) {
+	jobs, resp, err := client.Actions.ListWorkflowJobs(ctx, owner, repo, runID, &github.ListWorkflowJobsOptions{
+		Filter:      "latest",
+		ListOptions: github.ListOptions{PerPage: 100},
+	})
+	if err != nil {
+		return nil, err
+	}
+	_ = resp.Body.Close()
+
+	var failed []FailedJob
+	for _, job := range jobs.Jobs {
+		if job.GetConclusion() != "failure" && job.GetConclusion() != "timed_out" {
+			continue
+		}
+		f := FailedJob{
+			ID:         job.GetID(),
+			Name:       job.GetName(),
+			Conclusion: job.GetConclusion(),
+			HTMLURL:    job.GetHTMLURL(),
+		}
+		for _, step := range job.Steps {
+			if step.GetConclusion() == "failure" || step.GetConclusion() == "timed_out" {
+				f.FailedSteps = append(f.FailedSteps, step.GetName())
+			}
+		}
+		failed = append(failed, f)
+	}
+	return failed, nil
+}
+
+// WaitForWorkflowRun creates a tool to wait for a workflow run to complete
+func WaitForWorkflowRun(getClient GetClientFn, operations *OperationRegistry, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("wait_for_workflow_run",
+			mcp.WithDescription(t("TOOL_WAIT_FOR_WORKFLOW_RUN_DESCRIPTION", "Wait for a workflow run to complete, polling with backoff, and return its conclusion along with the failed jobs and steps. If the run is still going when the timeout is reached, the result has timed_out set instead of a conclusion; call again to keep waiting.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_WAIT_FOR_WORKFLOW_RUN_USER_TITLE", "Wait for workflow run"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("run_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the workflow run"),
+			),
+			mcp.WithNumber("timeout_seconds",
+				mcp.Description("How long to wait for the run to complete (default 300, max 600)"),
+				mcp.Min(1),
+				mcp.Max(600),
+			),
+			WithOperationToken(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runIDInt, err := RequiredInt(request, "run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID := int64(runIDInt)
+			timeoutSeconds, err := OptionalIntParamWithDefault(request, "timeout_seconds", 300)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if timeoutSeconds < 1 || timeoutSeconds > 600 {
+				return mcp.NewToolResultError("timeout_seconds must be between 1 and 600"), nil
+			}
+			token, err := OptionalParam[string](request, operationTokenParameter)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opCtx, done, err := operations.start(ctx, token)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			defer done()
+
+			waitCtx, cancel := context.WithTimeout(opCtx, time.Duration(timeoutSeconds)*time.Second)
+			defer cancel()
+
+			var run *github.WorkflowRun
+			var apiErrResult *mcp.CallToolResult
+			completed, attempts, err := pollUntil(waitCtx, workflowRunPollBackoff, 0, func(waitCtx context.Context) (bool, error) {
+				latest, resp, getErr := client.Actions.GetWorkflowRunByID(waitCtx, owner, repo, runID)
+				if getErr != nil {
+					// A request cut short by the timeout is not an API failure.
+					if waitCtx.Err() == nil {
+						apiErrResult = ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get workflow run", resp, getErr)
+					}
+					return false, getErr
+				}
+				defer func() { _ = resp.Body.Close() }()
+
+				run = latest
+				return run.GetStatus() == "completed", nil
+			})
+			if apiErrResult != nil {
+				return apiErrResult, nil
+			}
+			if err != nil && ctx.Err() != nil {
+				return nil, fmt.Errorf("failed to wait for workflow run: %w", ctx.Err())
+			}
+
+			outcome := WorkflowRunOutcome{
+				RunID:          runID,
+				Name:           run.GetName(),
+				Status:         run.GetStatus(),
+				HTMLURL:        run.GetHTMLURL(),
+				Attempts:       attempts,
+				OperationToken: token,
+			}
+			if operationCancelled(opCtx) {
+				outcome.Cancelled = true
+				outcome.Message = "stopped waiting for the workflow run because the operation was cancelled"
+				return MarshalledTextResult(outcome), nil
+			}
+			if run == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("timed out after %d seconds before workflow run %d could be fetched", timeoutSeconds, runID)), nil
+			}
+			if !completed {
+				outcome.TimedOut = true
+				outcome.Message = fmt.Sprintf("workflow run is still %s after waiting %d seconds", run.GetStatus(), timeoutSeconds)
+				return MarshalledTextResult(outcome), nil
+			}
+
+			outcome.Conclusion = run.GetConclusion()
+			outcome.Message = fmt.Sprintf("workflow run completed with conclusion %s", outcome.Conclusion)
+			if outcome.Conclusion != "success" && outcome.Conclusion != "skipped" && outcome.Conclusion != "neutral" {
+				jobs, err := failedJobs(ctx, client, owner, repo, runID)
+				if err != nil {
+					outcome.Message += "; the failed jobs could not be listed: " + err.Error()
+				}
+				outcome.FailedJobs = jobs
+			}
+
+			return MarshalledTextResult(outcome), nil
+		}
+}
+
 // GetWorkflowRunLogs creates a tool to download logs for a specific workflow run
-func GetWorkflowRunLogs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+func GetWorkflowRunLogs(getClient GetClientFn, t translations.TranslationHelperFunc, contentWindowSize int) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_workflow_run_logs",
-			mcp.WithDescription(t("TOOL_GET_WORKFLOW_RUN_LOGS_DESCRIPTION", "Download logs for a specific workflow run (EXPENSIVE: downloads ALL logs as ZIP. Consider using get_job_logs with failed_only=true for debugging failed jobs)")),
+			mcp.WithDescription(t("TOOL_GET_WORKFLOW_RUN_LOGS_DESCRIPTION", "Download logs for a specific workflow run (EXPENSIVE: downloads ALL logs as ZIP. Consider using get_job_logs with failed_only=true for debugging failed jobs). With return_content, the job logs are extracted from the archive and returned, optionally only for jobs matching job_name.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_WORKFLOW_RUN_LOGS_USER_TITLE", "Get workflow run logs"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -396,6 +699,16 @@ func GetWorkflowRunLogs(getClient GetClientFn, t translations.TranslationHelperF
 				mcp.Required(),
 				mcp.Description("The unique identifier of the workflow run"),
 			),
+			mcp.WithBoolean("return_content",
+				mcp.Description("Returns the job logs extracted from the archive instead of its URL"),
+			),
+			mcp.WithString("job_name",
+				mcp.Description("With return_content, only return the logs of jobs whose name contains this text, ignoring case"),
+			),
+			mcp.WithNumber("tail_lines",
+				mcp.Description("With return_content, number of lines to return from the end of each job log"),
+				mcp.DefaultNumber(500),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -411,6 +724,21 @@ func GetWorkflowRunLogs(getClient GetClientFn, t translations.TranslationHelperF
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 			runID := int64(runIDInt)
+			returnContent, err := OptionalParam[bool](request, "return_content")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			jobName, err := OptionalParam[string](request, "job_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tail, err := OptionalIntParamWithDefault(request, "tail_lines", 500)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if contentWindowSize > 0 && tail > contentWindowSize {
+				tail = contentWindowSize
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
@@ -424,11 +752,27 @@ func GetWorkflowRunLogs(getClient GetClientFn, t translations.TranslationHelperF
 			}
 			defer func() { _ = resp.Body.Close() }()
 
+			if returnContent {
+				archive, err := downloadArchive(ctx, url.String())
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to download logs: %v; use get_job_logs for single jobs instead", err)), nil
+				}
+				jobs, err := extractJobLogs(archive, jobName, tail)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				logs := WorkflowRunLogs{RunID: runID, Jobs: jobs}
+				if len(jobs) == 0 && jobName != "" {
+					logs.Notes = append(logs.Notes, fmt.Sprintf("no job name contains %q", jobName))
+				}
+				return MarshalledTextResult(logs), nil
+			}
+
 			// Create response with the logs URL and information
 			result := map[string]any{
 				"logs_url":         url.String(),
 				"message":          "Workflow run logs are available for download",
-				"note":             "The logs_url provides a download link for the complete workflow run logs as a ZIP archive. You can download this archive to extract and examine individual job logs.",
+				"note":             "The logs_url provides a download link for the complete workflow run logs as a ZIP archive. Use return_content=true to get the job logs extracted from it.",
 				"warning":          "This downloads ALL logs as a ZIP file which can be large and expensive. For debugging failed jobs, consider using get_job_logs with failed_only=true and run_id instead.",
 				"optimization_tip": "Use: get_job_logs with parameters {run_id: " + fmt.Sprintf("%d", runID) + ", failed_only: true} for more efficient failed job debugging",
 			}
@@ -521,7 +865,397 @@ func ListWorkflowJobs(getClient GetClientFn, t translations.TranslationHelperFun
 				"optimization_tip": "For debugging failed jobs, consider using get_job_logs with failed_only=true and run_id=" + fmt.Sprintf("%d", runID) + " to get logs directly without needing to list jobs first",
 			}
 
-			r, err := json.Marshal(response)
+			return MarshalledListPageResult(ctx, response, jobs.Jobs, ListPageInfo{TotalCount: jobs.TotalCount}, "no workflow jobs matched"), nil
+		}
+}
+
+// StepTiming is the time spent in a single job step.
+type StepTiming struct {
+	Name            string `json:"name"`
+	Number          int64  `json:"number"`
+	Status          string `json:"status"`
+	Conclusion      string `json:"conclusion,omitempty"`
+	DurationSeconds int64  `json:"duration_seconds"`
+	InProgress      bool   `json:"in_progress,omitempty"`
+}
+
+// JobTiming is the time spent queued and running for a single job.
+type JobTiming struct {
+	ID              int64        `json:"id"`
+	Name            string       `json:"name"`
+	Status          string       `json:"status"`
+	Conclusion      string       `json:"conclusion,omitempty"`
+	QueuedSeconds   int64        `json:"queued_seconds"`
+	DurationSeconds int64        `json:"duration_seconds"`
+	PercentOfTotal  float64      `json:"percent_of_total"`
+	InProgress      bool         `json:"in_progress,omitempty"`
+	Steps           []StepTiming `json:"steps"`
+}
+
+// SlowJob identifies one of the slowest jobs in a run.
+type SlowJob struct {
+	Name            string  `json:"name"`
+	DurationSeconds int64   `json:"duration_seconds"`
+	PercentOfTotal  float64 `json:"percent_of_total"`
+}
+
+// SlowStep identifies one of the slowest steps in a run.
+type SlowStep struct {
+	Job             string `json:"job"`
+	Step            string `json:"step"`
+	DurationSeconds int64  `json:"duration_seconds"`
+}
+
+// WorkflowRunAnalysis is a breakdown of where time was spent in a workflow run.
+type WorkflowRunAnalysis struct {
+	RunID            int64       `json:"run_id"`
+	Status           string      `json:"status"`
+	Conclusion       string      `json:"conclusion,omitempty"`
+	Partial          bool        `json:"partial"`
+	WallClockSeconds int64       `json:"wall_clock_seconds"`
+	TotalJobSeconds  int64       `json:"total_job_seconds"`
+	Jobs             []JobTiming `json:"jobs"`
+	SlowestJobs      []SlowJob   `json:"slowest_jobs"`
+	SlowestSteps     []SlowStep  `json:"slowest_steps"`
+}
+
+// elapsedSeconds returns the seconds between start and end. If end is not set yet the
+// time elapsed until now is returned instead and inProgress is true.
+func elapsedSeconds(start, end *github.Timestamp, now time.Time) (seconds int64, inProgress bool) {
+	if start == nil || start.IsZero() {
+		return 0, false
+	}
+	if end == nil || end.IsZero() {
+		return int64(now.Sub(start.Time).Seconds()), true
+	}
+	return int64(end.Sub(start.Time).Seconds()), false
+}
+
+// analyzeWorkflowRunTimings builds a timing breakdown for a run from its jobs, flagging the
+// top slowest jobs and steps. Jobs and steps that have not completed yet are measured up to now.
+func analyzeWorkflowRunTimings(run *github.WorkflowRun, jobs []*github.WorkflowJob, now time.Time, top int) WorkflowRunAnalysis {
+	analysis := WorkflowRunAnalysis{
+		RunID:        run.GetID(),
+		Status:       run.GetStatus(),
+		Conclusion:   run.GetConclusion(),
+		Partial:      run.GetStatus() != "completed",
+		Jobs:         make([]JobTiming, 0, len(jobs)),
+		SlowestJobs:  []SlowJob{},
+		SlowestSteps: []SlowStep{},
+	}
+
+	var slowSteps []SlowStep
+	var lastCompleted time.Time
+	for _, job := range jobs {
+		duration, inProgress := elapsedSeconds(job.StartedAt, job.CompletedAt, now)
+		queued, _ := elapsedSeconds(job.CreatedAt, job.StartedAt, now)
+		jobTiming := JobTiming{
+			ID:              job.GetID(),
+			Name:            job.GetName(),
+			Status:          job.GetStatus(),
+			Conclusion:      job.GetConclusion(),
+			QueuedSeconds:   queued,
+			DurationSeconds: duration,
+			InProgress:      inProgress,
+			Steps:           make([]StepTiming, 0, len(job.Steps)),
+		}
+		if inProgress {
+			analysis.Partial = true
+		}
+		if job.CompletedAt != nil && job.CompletedAt.After(lastCompleted) {
+			lastCompleted = job.CompletedAt.Time
+		}
+
+		for _, step := range job.Steps {
+			stepDuration, stepInProgress := elapsedSeconds(step.StartedAt, step.CompletedAt, now)
+			jobTiming.Steps = append(jobTiming.Steps, StepTiming{
+				Name:            step.GetName(),
+				Number:          step.GetNumber(),
+				Status:          step.GetStatus(),
+				Conclusion:      step.GetConclusion(),
+				DurationSeconds: stepDuration,
+				InProgress:      stepInProgress,
+			})
+			slowSteps = append(slowSteps, SlowStep{
+				Job:             job.GetName(),
+				Step:            step.GetName(),
+				DurationSeconds: stepDuration,
+			})
+		}
+
+		analysis.TotalJobSeconds += duration
+		analysis.Jobs = append(analysis.Jobs, jobTiming)
+	}
+
+	for i := range analysis.Jobs {
+		if analysis.TotalJobSeconds > 0 {
+			percent := float64(analysis.Jobs[i].DurationSeconds) / float64(analysis.TotalJobSeconds) * 100
+			analysis.Jobs[i].PercentOfTotal = math.Round(percent*10) / 10
+		}
+	}
+
+	if analysis.Partial {
+		analysis.WallClockSeconds, _ = elapsedSeconds(run.RunStartedAt, nil, now)
+	} else if !lastCompleted.IsZero() {
+		analysis.WallClockSeconds, _ = elapsedSeconds(run.RunStartedAt, &github.Timestamp{Time: lastCompleted}, now)
+	}
+
+	slowJobs := make([]SlowJob, 0, len(analysis.Jobs))
+	for _, job := range analysis.Jobs {
+		slowJobs = append(slowJobs, SlowJob{Name: job.Name, DurationSeconds: job.DurationSeconds, PercentOfTotal: job.PercentOfTotal})
+	}
+	sort.SliceStable(slowJobs, func(i, j int) bool {
+		return slowJobs[i].DurationSeconds > slowJobs[j].DurationSeconds
+	})
+	sort.SliceStable(slowSteps, func(i, j int) bool {
+		return slowSteps[i].DurationSeconds > slowSteps[j].DurationSeconds
+	})
+	analysis.SlowestJobs = append(analysis.SlowestJobs, slowJobs[:min(top, len(slowJobs))]...)
+	analysis.SlowestSteps = append(analysis.SlowestSteps, slowSteps[:min(top, len(slowSteps))]...)
+
+	return analysis
+}
+
+// AnalyzeWorkflowRun creates a tool to break down where time was spent in a workflow run
+func AnalyzeWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("analyze_workflow_run",
+			mcp.WithDescription(t("TOOL_ANALYZE_WORKFLOW_RUN_DESCRIPTION", "Analyze the timing of a workflow run: how long each job and step took, how long jobs were queued, and which jobs and steps were the slowest. In-progress runs report partial timings measured up to now.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ANALYZE_WORKFLOW_RUN_USER_TITLE", "Analyze workflow run timing"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("run_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the workflow run"),
+			),
+			mcp.WithNumber("top",
+				mcp.Description("Number of slowest jobs and steps to flag (default 3, max 20)"),
+				mcp.Min(1),
+				mcp.Max(20),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runIDInt, err := RequiredInt(request, "run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID := int64(runIDInt)
+			top, err := OptionalIntParamWithDefault(request, "top", 3)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if top < 1 || top > 20 {
+				return mcp.NewToolResultError("top must be between 1 and 20"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			run, resp, err := client.Actions.GetWorkflowRunByID(ctx, owner, repo, runID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get workflow run", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			var jobs []*github.WorkflowJob
+			opts := &github.ListWorkflowJobsOptions{
+				Filter:      "latest",
+				ListOptions: github.ListOptions{PerPage: 100},
+			}
+			for {
+				page, resp, err := client.Actions.ListWorkflowJobs(ctx, owner, repo, runID, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list workflow jobs", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				jobs = append(jobs, page.Jobs...)
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			r, err := json.Marshal(analyzeWorkflowRunTimings(run, jobs, time.Now(), top))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// WorkflowRunConfig is the configuration a workflow run was triggered with.
+type WorkflowRunConfig struct {
+	ID                  int64    `json:"id"`
+	WorkflowID          int64    `json:"workflow_id"`
+	WorkflowName        string   `json:"workflow_name"`
+	Path                string   `json:"path"`
+	Event               string   `json:"event"`
+	HeadBranch          string   `json:"head_branch"`
+	HeadSHA             string   `json:"head_sha"`
+	HeadRepository      string   `json:"head_repository,omitempty"`
+	Actor               string   `json:"actor,omitempty"`
+	TriggeringActor     string   `json:"triggering_actor,omitempty"`
+	RunAttempt          int      `json:"run_attempt"`
+	Conclusion          string   `json:"conclusion,omitempty"`
+	ReferencedWorkflows []string `json:"referenced_workflows,omitempty"`
+}
+
+// WorkflowRunDifference is a single configuration value that differs between two runs.
+type WorkflowRunDifference struct {
+	Field   string `json:"field"`
+	Base    string `json:"base"`
+	Compare string `json:"compare"`
+}
+
+// WorkflowRunComparison is the result of comparing the configuration of two workflow runs.
+type WorkflowRunComparison struct {
+	Base         WorkflowRunConfig       `json:"base"`
+	Compare      WorkflowRunConfig       `json:"compare"`
+	SameWorkflow bool                    `json:"same_workflow"`
+	Differences  []WorkflowRunDifference `json:"differences"`
+	Notes        []string                `json:"notes,omitempty"`
+}
+
+func workflowRunConfig(run *github.WorkflowRun) WorkflowRunConfig {
+	cfg := WorkflowRunConfig{
+		ID:              run.GetID(),
+		WorkflowID:      run.GetWorkflowID(),
+		WorkflowName:    run.GetName(),
+		Path:            run.GetPath(),
+		Event:           run.GetEvent(),
+		HeadBranch:      run.GetHeadBranch(),
+		HeadSHA:         run.GetHeadSHA(),
+		HeadRepository:  run.GetHeadRepository().GetFullName(),
+		Actor:           run.GetActor().GetLogin(),
+		TriggeringActor: run.GetTriggeringActor().GetLogin(),
+		RunAttempt:      run.GetRunAttempt(),
+		Conclusion:      run.GetConclusion(),
+	}
+	for _, ref := range run.ReferencedWorkflows {
+		cfg.ReferencedWorkflows = append(cfg.ReferencedWorkflows, fmt.Sprintf("%s@%s", ref.GetPath(), ref.GetSHA()))
+	}
+	sort.Strings(cfg.ReferencedWorkflows)
+	return cfg
+}
+
+// compareWorkflowRunConfigs reports the configuration values that differ between two runs.
+func compareWorkflowRunConfigs(base, compare *github.WorkflowRun) WorkflowRunComparison {
+	comparison := WorkflowRunComparison{
+		Base:         workflowRunConfig(base),
+		Compare:      workflowRunConfig(compare),
+		SameWorkflow: base.GetWorkflowID() == compare.GetWorkflowID(),
+		Differences:  []WorkflowRunDifference{},
+	}
+
+	fields := []struct {
+		name          string
+		base, compare string
+	}{
+		{"workflow", comparison.Base.Path, comparison.Compare.Path},
+		{"event", comparison.Base.Event, comparison.Compare.Event},
+		{"head_branch", comparison.Base.HeadBranch, comparison.Compare.HeadBranch},
+		{"head_sha", comparison.Base.HeadSHA, comparison.Compare.HeadSHA},
+		{"head_repository", comparison.Base.HeadRepository, comparison.Compare.HeadRepository},
+		{"actor", comparison.Base.Actor, comparison.Compare.Actor},
+		{"triggering_actor", comparison.Base.TriggeringActor, comparison.Compare.TriggeringActor},
+		{"run_attempt", strconv.Itoa(comparison.Base.RunAttempt), strconv.Itoa(comparison.Compare.RunAttempt)},
+		{"referenced_workflows", strings.Join(comparison.Base.ReferencedWorkflows, ", "), strings.Join(comparison.Compare.ReferencedWorkflows, ", ")},
+		{"conclusion", comparison.Base.Conclusion, comparison.Compare.Conclusion},
+	}
+	for _, f := range fields {
+		if f.base != f.compare {
+			comparison.Differences = append(comparison.Differences, WorkflowRunDifference{Field: f.name, Base: f.base, Compare: f.compare})
+		}
+	}
+
+	if !comparison.SameWorkflow {
+		comparison.Notes = append(comparison.Notes, fmt.Sprintf("The runs belong to different workflows (%d and %d), so differences may come from the workflow definitions themselves.", base.GetWorkflowID(), compare.GetWorkflowID()))
+	}
+	if comparison.Base.Event == "workflow_dispatch" || comparison.Compare.Event == "workflow_dispatch" {
+		comparison.Notes = append(comparison.Notes, "The runs API does not expose workflow_dispatch inputs, so they are not compared.")
+	}
+
+	return comparison
+}
+
+// CompareWorkflowRuns creates a tool to compare the configuration of two workflow runs
+func CompareWorkflowRuns(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("compare_workflow_runs",
+			mcp.WithDescription(t("TOOL_COMPARE_WORKFLOW_RUNS_DESCRIPTION", "Compare how two workflow runs were triggered (workflow file, event, branch, commit, actor, reusable workflow versions) and report the differences that might explain divergent outcomes")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_COMPARE_WORKFLOW_RUNS_USER_TITLE", "Compare workflow runs"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("base_run_id",
+				mcp.Required(),
+				mcp.Description("The ID of the run to compare against, typically the one that behaved as expected"),
+			),
+			mcp.WithNumber("compare_run_id",
+				mcp.Required(),
+				mcp.Description("The ID of the run to compare"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			baseRunID, err := RequiredInt(request, "base_run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			compareRunID, err := RequiredInt(request, "compare_run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			runs := make([]*github.WorkflowRun, 0, 2)
+			for _, runID := range []int{baseRunID, compareRunID} {
+				run, resp, err := client.Actions.GetWorkflowRunByID(ctx, owner, repo, int64(runID))
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get workflow run %d", runID), resp, err), nil
+				}
+				_ = resp.Body.Close()
+				runs = append(runs, run)
+			}
+
+			r, err := json.Marshal(compareWorkflowRunConfigs(runs[0], runs[1]))
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -1029,19 +1763,14 @@ func ListWorkflowRunArtifacts(getClient GetClientFn, t translations.TranslationH
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(artifacts)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledListPageResult(ctx, artifacts, artifacts.Artifacts, ListPageInfo{TotalCount: github.Ptr(int(artifacts.GetTotalCount()))}, "no artifacts found"), nil
 		}
 }
 
 // DownloadWorkflowRunArtifact creates a tool to download a workflow run artifact
 func DownloadWorkflowRunArtifact(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("download_workflow_run_artifact",
-			mcp.WithDescription(t("TOOL_DOWNLOAD_WORKFLOW_RUN_ARTIFACT_DESCRIPTION", "Get download URL for a workflow run artifact")),
+			mcp.WithDescription(t("TOOL_DOWNLOAD_WORKFLOW_RUN_ARTIFACT_DESCRIPTION", "Get download URL for a workflow run artifact, or its contents: with return_content, the files in the artifact with the contents of text files, such as test reports; with path, a single file as a resource")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_DOWNLOAD_WORKFLOW_RUN_ARTIFACT_USER_TITLE", "Download workflow artifact"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -1058,6 +1787,12 @@ func DownloadWorkflowRunArtifact(getClient GetClientFn, t translations.Translati
 				mcp.Required(),
 				mcp.Description("The unique identifier of the artifact"),
 			),
+			mcp.WithBoolean("return_content",
+				mcp.Description("Returns the files in the artifact, with the contents of text files, instead of its download URL"),
+			),
+			mcp.WithString("path",
+				mcp.Description("Path of a single file in the artifact to return as a resource"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -1073,6 +1808,14 @@ func DownloadWorkflowRunArtifact(getClient GetClientFn, t translations.Translati
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 			artifactID := int64(artifactIDInt)
+			returnContent, err := OptionalParam[bool](request, "return_content")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := OptionalParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
@@ -1086,11 +1829,44 @@ func DownloadWorkflowRunArtifact(getClient GetClientFn, t translations.Translati
 			}
 			defer func() { _ = resp.Body.Close() }()
 
+			if returnContent || path != "" {
+				archive, err := downloadArchive(ctx, url.String())
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to download artifact: %v", err)), nil
+				}
+
+				if path != "" {
+					content, err := findArtifactFile(archive, path)
+					if err != nil {
+						return mcp.NewToolResultError(err.Error()), nil
+					}
+					uri := fmt.Sprintf("repo://%s/%s/actions/artifacts/%d/%s", owner, repo, artifactID, path)
+					if isText(content) {
+						return mcp.NewToolResultResource("successfully downloaded text file", mcp.TextResourceContents{
+							URI:      uri,
+							Text:     string(content),
+							MIMEType: "text/plain",
+						}), nil
+					}
+					return mcp.NewToolResultResource("successfully downloaded binary file", mcp.BlobResourceContents{
+						URI:      uri,
+						Blob:     base64.StdEncoding.EncodeToString(content),
+						MIMEType: http.DetectContentType(content),
+					}), nil
+				}
+
+				files, notes, err := extractArtifactFiles(archive, maxArtifactTextBytes)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				return MarshalledTextResult(ArtifactContents{ArtifactID: artifactID, Files: files, Notes: notes}), nil
+			}
+
 			// Create response with the download URL and information
 			result := map[string]any{
 				"download_url": url.String(),
 				"message":      "Artifact is available for download",
-				"note":         "The download_url provides a download link for the artifact as a ZIP archive. The link is temporary and expires after a short time.",
+				"note":         "The download_url provides a download link for the artifact as a ZIP archive. The link is temporary and expires after a short time. Use return_content=true to get the files in the artifact instead.",
 				"artifact_id":  artifactID,
 			}
 
@@ -1222,3 +1998,155 @@ func GetWorkflowRunUsage(getClient GetClientFn, t translations.TranslationHelper
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
+
+// WorkflowUsageBreakdown is the billable time for a single runner OS.
+type WorkflowUsageBreakdown struct {
+	OS      string  `json:"os"`
+	TotalMS int64   `json:"total_ms"`
+	Minutes float64 `json:"minutes"`
+}
+
+// WorkflowUsageSummary is the billable usage of a workflow broken down by runner OS.
+type WorkflowUsageSummary struct {
+	WorkflowID   string                   `json:"workflow_id"`
+	TotalMS      int64                    `json:"total_ms"`
+	TotalMinutes float64                  `json:"total_minutes"`
+	Billable     []WorkflowUsageBreakdown `json:"billable"`
+}
+
+// summarizeWorkflowUsage flattens the billable map returned by the API into a per-OS
+// breakdown sorted by OS name, so that the output is stable across calls.
+func summarizeWorkflowUsage(workflowID string, usage *github.WorkflowUsage) WorkflowUsageSummary {
+	summary := WorkflowUsageSummary{
+		WorkflowID: workflowID,
+		Billable:   []WorkflowUsageBreakdown{},
+	}
+	if usage == nil || usage.Billable == nil {
+		return summary
+	}
+
+	for runnerOS, bill := range *usage.Billable {
+		totalMS := bill.GetTotalMS()
+		summary.Billable = append(summary.Billable, WorkflowUsageBreakdown{
+			OS:      runnerOS,
+			TotalMS: totalMS,
+			Minutes: float64(totalMS) / float64(time.Minute/time.Millisecond),
+		})
+		summary.TotalMS += totalMS
+	}
+	sort.Slice(summary.Billable, func(i, j int) bool {
+		return summary.Billable[i].OS < summary.Billable[j].OS
+	})
+	summary.TotalMinutes = float64(summary.TotalMS) / float64(time.Minute/time.Millisecond)
+
+	return summary
+}
+
+// billingErrorMessage returns an error message for billing endpoints, pointing at the
+// missing scope when GitHub rejects the request due to insufficient permissions.
+func billingErrorMessage(message string, resp *github.Response) string {
+	if resp != nil && (resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusNotFound) {
+		return fmt.Sprintf("%s: the token may be missing billing access (the admin:org scope or an organization billing manager role is required)", message)
+	}
+	return message
+}
+
+// GetWorkflowUsage creates a tool to get the billable usage of a workflow by runner OS
+func GetWorkflowUsage(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_workflow_usage",
+			mcp.WithDescription(t("TOOL_GET_WORKFLOW_USAGE_DESCRIPTION", "Get the billable minutes used by a workflow in the current billing cycle, broken down by runner OS")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_WORKFLOW_USAGE_USER_TITLE", "Get workflow billable usage"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("workflow_id",
+				mcp.Required(),
+				mcp.Description("The workflow ID or workflow file name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			workflowID, err := RequiredParam[string](request, "workflow_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var usage *github.WorkflowUsage
+			var resp *github.Response
+			if workflowIDInt, parseErr := strconv.ParseInt(workflowID, 10, 64); parseErr == nil {
+				usage, resp, err = client.Actions.GetWorkflowUsageByID(ctx, owner, repo, workflowIDInt)
+			} else {
+				usage, resp, err = client.Actions.GetWorkflowUsageByFileName(ctx, owner, repo, workflowID)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, billingErrorMessage("failed to get workflow usage", resp), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(summarizeWorkflowUsage(workflowID, usage))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetActionsBilling creates a tool to get the GitHub Actions billing summary for an organization
+func GetActionsBilling(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_actions_billing",
+			mcp.WithDescription(t("TOOL_GET_ACTIONS_BILLING_DESCRIPTION", "Get the GitHub Actions billing summary for an organization, including total, paid and included minutes and a breakdown by runner OS. Requires billing access to the organization.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_ACTIONS_BILLING_USER_TITLE", "Get organization Actions billing"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			billing, resp, err := client.Billing.GetActionsBillingOrg(ctx, org)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, billingErrorMessage("failed to get actions billing", resp), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(billing)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
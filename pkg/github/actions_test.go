@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -10,12 +11,16 @@ import (
 	"runtime"
 	"runtime/debug"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/github/github-mcp-server/internal/profiler"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
 	buffer "github.com/github/github-mcp-server/pkg/buffer"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/migueleliasweb/go-github-mock/src/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -308,6 +313,227 @@ func Test_RunWorkflow_WithFilename(t *testing.T) {
 	}
 }
 
+func Test_RunWorkflow_ChecksInputs(t *testing.T) {
+	workflow := &github.Workflow{ID: github.Ptr(int64(7)), Path: github.Ptr(".github/workflows/deploy.yml")}
+	workflowFile := &github.RepositoryContent{
+		Type: github.Ptr("file"),
+		Path: github.Ptr(".github/workflows/deploy.yml"),
+		Content: github.Ptr(`on:
+  workflow_dispatch:
+    inputs:
+      environment:
+        type: choice
+        options: [staging, production]
+        required: true
+      dry_run:
+        type: boolean
+`),
+	}
+
+	var dispatched map[string]any
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposActionsWorkflowsByOwnerByRepoByWorkflowId,
+			expectPath(t, "/repos/owner/repo/actions/workflows/deploy.yml").andThen(
+				mockResponse(t, http.StatusOK, workflow),
+			),
+		),
+		mock.WithRequestMatchHandler(
+			mock.GetReposContentsByOwnerByRepoByPath,
+			expectQueryParams(t, map[string]string{"ref": "main"}).andThen(
+				mockResponse(t, http.StatusOK, workflowFile),
+			),
+		),
+		mock.WithRequestMatchHandler(
+			mock.PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowId,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body struct {
+					Inputs map[string]any `json:"inputs"`
+				}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				dispatched = body.Inputs
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		),
+	)
+	_, handler := RunWorkflow(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+	t.Run("typed inputs are sent as strings", func(t *testing.T) {
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":       "owner",
+			"repo":        "repo",
+			"workflow_id": "deploy.yml",
+			"ref":         "main",
+			"inputs":      map[string]any{"environment": "staging", "dry_run": true},
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.Equal(t, map[string]any{"environment": "staging", "dry_run": "true"}, dispatched)
+	})
+
+	t.Run("invalid inputs are not dispatched", func(t *testing.T) {
+		dispatched = nil
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":       "owner",
+			"repo":        "repo",
+			"workflow_id": "deploy.yml",
+			"ref":         "main",
+			"inputs":      map[string]any{"environment": "qa"},
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, `input environment must be one of staging, production, got "qa"`, getErrorResult(t, result).Text)
+		assert.Nil(t, dispatched)
+	})
+}
+
+func Test_CreateRepositoryDispatch(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateRepositoryDispatch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_repository_dispatch", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "event_type")
+	assert.Contains(t, tool.InputSchema.Properties, "client_payload")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "event_type"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tooManyProperties := map[string]any{}
+	for i := 0; i < 11; i++ {
+		tooManyProperties[fmt.Sprintf("key%d", i)] = i
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "dispatch with object payload",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposDispatchesByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"event_type":     "deploy",
+						"client_payload": map[string]any{"env": "staging"},
+					}).andThen(
+						mockResponse(t, http.StatusNoContent, ""),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"event_type":     "deploy",
+				"client_payload": map[string]any{"env": "staging"},
+			},
+		},
+		{
+			name: "dispatch with JSON string payload",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposDispatchesByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"event_type":     "deploy",
+						"client_payload": map[string]any{"env": "production"},
+					}).andThen(
+						mockResponse(t, http.StatusNoContent, ""),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"event_type":     "deploy",
+				"client_payload": `{"env": "production"}`,
+			},
+		},
+		{
+			name:         "invalid JSON payload",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"event_type":     "deploy",
+				"client_payload": `{"env": `,
+			},
+			expectError:    true,
+			expectedErrMsg: "client_payload must be a JSON object",
+		},
+		{
+			name:         "too many top-level properties",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"event_type":     "deploy",
+				"client_payload": tooManyProperties,
+			},
+			expectError:    true,
+			expectedErrMsg: "GitHub allows at most 10",
+		},
+		{
+			name:         "payload too large",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"event_type":     "deploy",
+				"client_payload": map[string]any{"blob": strings.Repeat("a", maxDispatchPayloadBytes)},
+			},
+			expectError:    true,
+			expectedErrMsg: "the limit is 65536 bytes",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateRepositoryDispatch(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			assert.Contains(t, textContent.Text, `Repository dispatch event "deploy" was created`)
+		})
+	}
+
+	t.Run("not offered in read-only mode", func(t *testing.T) {
+		for _, readOnly := range []bool{false, true} {
+			tsg := DefaultToolsetGroup(readOnly, stubGetClientFn(mockClient), nil, nil, translations.NullTranslationHelper, 5000)
+			actions, err := tsg.GetToolset("actions")
+			require.NoError(t, err)
+
+			var names []string
+			for _, tool := range actions.GetAvailableTools() {
+				names = append(names, tool.Tool.Name)
+			}
+			if readOnly {
+				assert.NotContains(t, names, "create_repository_dispatch")
+			} else {
+				assert.Contains(t, names, "create_repository_dispatch")
+			}
+		}
+	})
+}
+
 func Test_CancelWorkflowRun(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -629,6 +855,97 @@ func Test_DownloadWorkflowRunArtifact(t *testing.T) {
 	}
 }
 
+func Test_DownloadWorkflowRunArtifact_Content(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := DownloadWorkflowRunArtifact(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	archive := zipArchive(t, map[string]string{
+		"junit.xml": "<testsuite failures=\"1\"/>",
+		"app.bin":   "\x00\x01\x02",
+	})
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(archive)
+	}))
+	defer testServer.Close()
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.EndpointPattern{
+				Pattern: "/repos/owner/repo/actions/artifacts/123/zip",
+				Method:  "GET",
+			},
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Location", testServer.URL)
+				w.WriteHeader(http.StatusFound)
+			}),
+		),
+	)
+	_, handler := DownloadWorkflowRunArtifact(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+	t.Run("files with text contents", func(t *testing.T) {
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":          "owner",
+			"repo":           "repo",
+			"artifact_id":    float64(123),
+			"return_content": true,
+		}))
+		require.NoError(t, err)
+
+		var contents ArtifactContents
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &contents))
+		assert.Equal(t, int64(123), contents.ArtifactID)
+		assert.ElementsMatch(t, []ArtifactFile{
+			{Path: "junit.xml", Size: 25, Content: "<testsuite failures=\"1\"/>"},
+			{Path: "app.bin", Size: 3, Binary: true},
+		}, contents.Files)
+	})
+
+	t.Run("single text file as resource", func(t *testing.T) {
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":       "owner",
+			"repo":        "repo",
+			"artifact_id": float64(123),
+			"path":        "junit.xml",
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		require.Len(t, result.Content, 2)
+		resource, ok := result.Content[1].(mcp.EmbeddedResource)
+		require.True(t, ok)
+		text, ok := resource.Resource.(mcp.TextResourceContents)
+		require.True(t, ok)
+		assert.Equal(t, "repo://owner/repo/actions/artifacts/123/junit.xml", text.URI)
+		assert.Equal(t, "<testsuite failures=\"1\"/>", text.Text)
+	})
+
+	t.Run("single binary file as blob", func(t *testing.T) {
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":       "owner",
+			"repo":        "repo",
+			"artifact_id": float64(123),
+			"path":        "app.bin",
+		}))
+		require.NoError(t, err)
+		resource, ok := result.Content[1].(mcp.EmbeddedResource)
+		require.True(t, ok)
+		blob, ok := resource.Resource.(mcp.BlobResourceContents)
+		require.True(t, ok)
+		assert.Equal(t, "AAEC", blob.Blob)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":       "owner",
+			"repo":        "repo",
+			"artifact_id": float64(123),
+			"path":        "missing.txt",
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "the artifact has no file missing.txt", getErrorResult(t, result).Text)
+	})
+}
+
 func Test_DeleteWorkflowRunLogs(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -811,6 +1128,957 @@ func Test_GetWorkflowRunUsage(t *testing.T) {
 	}
 }
 
+func Test_GetWorkflowUsage(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetWorkflowUsage(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_workflow_usage", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "workflow_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "workflow_id"})
+
+	usage := &github.WorkflowUsage{
+		Billable: &github.WorkflowBillMap{
+			"UBUNTU":  &github.WorkflowBill{TotalMS: github.Ptr(int64(180000))},
+			"WINDOWS": &github.WorkflowBill{TotalMS: github.Ptr(int64(60000))},
+			"MACOS":   &github.WorkflowBill{TotalMS: github.Ptr(int64(30000))},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]any
+		expectError     bool
+		expectedErrMsg  string
+		expectedSummary WorkflowUsageSummary
+	}{
+		{
+			name: "usage by workflow id is broken down per OS",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsWorkflowsTimingByOwnerByRepoByWorkflowId,
+					expectPath(t, "/repos/owner/repo/actions/workflows/123/timing").andThen(
+						mockResponse(t, http.StatusOK, usage),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "123",
+			},
+			expectedSummary: WorkflowUsageSummary{
+				WorkflowID:   "123",
+				TotalMS:      270000,
+				TotalMinutes: 4.5,
+				Billable: []WorkflowUsageBreakdown{
+					{OS: "MACOS", TotalMS: 30000, Minutes: 0.5},
+					{OS: "UBUNTU", TotalMS: 180000, Minutes: 3},
+					{OS: "WINDOWS", TotalMS: 60000, Minutes: 1},
+				},
+			},
+		},
+		{
+			name: "usage by workflow file name with no billable time",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsWorkflowsTimingByOwnerByRepoByWorkflowId,
+					expectPath(t, "/repos/owner/repo/actions/workflows/ci.yml/timing").andThen(
+						mockResponse(t, http.StatusOK, &github.WorkflowUsage{}),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "ci.yml",
+			},
+			expectedSummary: WorkflowUsageSummary{
+				WorkflowID: "ci.yml",
+				Billable:   []WorkflowUsageBreakdown{},
+			},
+		},
+		{
+			name: "permission error mentions billing access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsWorkflowsTimingByOwnerByRepoByWorkflowId,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "123",
+			},
+			expectError:    true,
+			expectedErrMsg: "billing access",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetWorkflowUsage(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectError, result.IsError)
+
+			textContent := getTextResult(t, result)
+
+			if tc.expectError {
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			var response WorkflowUsageSummary
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSummary, response)
+		})
+	}
+}
+
+func Test_GetActionsBilling(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetActionsBilling(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_actions_billing", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful billing fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsSettingsBillingActionsByOrg,
+					mockResponse(t, http.StatusOK, &github.ActionBilling{
+						TotalMinutesUsed:     305,
+						TotalPaidMinutesUsed: 0,
+						IncludedMinutes:      3000,
+						MinutesUsedBreakdown: github.MinutesUsedBreakdown{
+							"UBUNTU":  205,
+							"MACOS":   10,
+							"WINDOWS": 90,
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"org": "octo-org",
+			},
+		},
+		{
+			name: "missing billing scope",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsSettingsBillingActionsByOrg,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]any{
+				"org": "octo-org",
+			},
+			expectError:    true,
+			expectedErrMsg: "billing access",
+		},
+		{
+			name:           "missing required parameter org",
+			mockedClient:   mock.NewMockedHTTPClient(),
+			requestArgs:    map[string]any{},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: org",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetActionsBilling(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectError, result.IsError)
+
+			textContent := getTextResult(t, result)
+
+			if tc.expectError {
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			var response github.ActionBilling
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			require.NoError(t, err)
+			assert.Equal(t, float64(305), response.TotalMinutesUsed)
+			assert.Equal(t, 205, response.MinutesUsedBreakdown["UBUNTU"])
+			assert.Equal(t, 90, response.MinutesUsedBreakdown["WINDOWS"])
+		})
+	}
+}
+
+func Test_AnalyzeWorkflowRun(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AnalyzeWorkflowRun(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "analyze_workflow_run", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "run_id")
+	assert.Contains(t, tool.InputSchema.Properties, "top")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+
+	start := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	at := func(seconds int) *github.Timestamp {
+		return &github.Timestamp{Time: start.Add(time.Duration(seconds) * time.Second)}
+	}
+
+	mockRun := &github.WorkflowRun{
+		ID:           github.Ptr(int64(12345)),
+		Status:       github.Ptr("completed"),
+		Conclusion:   github.Ptr("success"),
+		RunStartedAt: at(0),
+	}
+	mockJobs := &github.Jobs{
+		TotalCount: github.Ptr(2),
+		Jobs: []*github.WorkflowJob{
+			{
+				ID:          github.Ptr(int64(1)),
+				Name:        github.Ptr("lint"),
+				Status:      github.Ptr("completed"),
+				Conclusion:  github.Ptr("success"),
+				CreatedAt:   at(0),
+				StartedAt:   at(10),
+				CompletedAt: at(70),
+				Steps: []*github.TaskStep{
+					{Name: github.Ptr("checkout"), Number: github.Ptr(int64(1)), Status: github.Ptr("completed"), StartedAt: at(10), CompletedAt: at(15)},
+					{Name: github.Ptr("golangci-lint"), Number: github.Ptr(int64(2)), Status: github.Ptr("completed"), StartedAt: at(15), CompletedAt: at(70)},
+				},
+			},
+			{
+				ID:          github.Ptr(int64(2)),
+				Name:        github.Ptr("test"),
+				Status:      github.Ptr("completed"),
+				Conclusion:  github.Ptr("success"),
+				CreatedAt:   at(0),
+				StartedAt:   at(30),
+				CompletedAt: at(210),
+				Steps: []*github.TaskStep{
+					{Name: github.Ptr("checkout"), Number: github.Ptr(int64(1)), Status: github.Ptr("completed"), StartedAt: at(30), CompletedAt: at(40)},
+					{Name: github.Ptr("go test"), Number: github.Ptr(int64(2)), Status: github.Ptr("completed"), StartedAt: at(40), CompletedAt: at(210)},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]any
+		expectError      bool
+		expectedErrMsg   string
+		expectedAnalysis WorkflowRunAnalysis
+	}{
+		{
+			name: "breakdown of a completed run",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					mockRun,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsJobsByOwnerByRepoByRunId,
+					expectQueryParams(t, map[string]string{
+						"filter":   "latest",
+						"per_page": "100",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockJobs),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(12345),
+				"top":    float64(2),
+			},
+			expectedAnalysis: WorkflowRunAnalysis{
+				RunID:            12345,
+				Status:           "completed",
+				Conclusion:       "success",
+				WallClockSeconds: 210,
+				TotalJobSeconds:  240,
+				Jobs: []JobTiming{
+					{
+						ID: 1, Name: "lint", Status: "completed", Conclusion: "success",
+						QueuedSeconds: 10, DurationSeconds: 60, PercentOfTotal: 25,
+						Steps: []StepTiming{
+							{Name: "checkout", Number: 1, Status: "completed", DurationSeconds: 5},
+							{Name: "golangci-lint", Number: 2, Status: "completed", DurationSeconds: 55},
+						},
+					},
+					{
+						ID: 2, Name: "test", Status: "completed", Conclusion: "success",
+						QueuedSeconds: 30, DurationSeconds: 180, PercentOfTotal: 75,
+						Steps: []StepTiming{
+							{Name: "checkout", Number: 1, Status: "completed", DurationSeconds: 10},
+							{Name: "go test", Number: 2, Status: "completed", DurationSeconds: 170},
+						},
+					},
+				},
+				SlowestJobs: []SlowJob{
+					{Name: "test", DurationSeconds: 180, PercentOfTotal: 75},
+					{Name: "lint", DurationSeconds: 60, PercentOfTotal: 25},
+				},
+				SlowestSteps: []SlowStep{
+					{Job: "test", Step: "go test", DurationSeconds: 170},
+					{Job: "lint", Step: "golangci-lint", DurationSeconds: 55},
+				},
+			},
+		},
+		{
+			name: "run not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get workflow run",
+		},
+		{
+			name:         "top out of range",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(12345),
+				"top":    float64(50),
+			},
+			expectError:    true,
+			expectedErrMsg: "top must be between 1 and 20",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AnalyzeWorkflowRun(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+
+			var returned WorkflowRunAnalysis
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAnalysis, returned)
+		})
+	}
+}
+
+func Test_analyzeWorkflowRunTimings_InProgress(t *testing.T) {
+	start := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	at := func(seconds int) *github.Timestamp {
+		return &github.Timestamp{Time: start.Add(time.Duration(seconds) * time.Second)}
+	}
+	now := start.Add(100 * time.Second)
+
+	run := &github.WorkflowRun{
+		ID:           github.Ptr(int64(1)),
+		Status:       github.Ptr("in_progress"),
+		RunStartedAt: at(0),
+	}
+	jobs := []*github.WorkflowJob{
+		{
+			Name:        github.Ptr("build"),
+			Status:      github.Ptr("completed"),
+			CreatedAt:   at(0),
+			StartedAt:   at(0),
+			CompletedAt: at(40),
+		},
+		{
+			Name:      github.Ptr("test"),
+			Status:    github.Ptr("in_progress"),
+			CreatedAt: at(40),
+			StartedAt: at(45),
+			Steps: []*github.TaskStep{
+				{Name: github.Ptr("setup"), Status: github.Ptr("completed"), StartedAt: at(45), CompletedAt: at(50)},
+				{Name: github.Ptr("go test"), Status: github.Ptr("in_progress"), StartedAt: at(50)},
+				{Name: github.Ptr("upload"), Status: github.Ptr("queued")},
+			},
+		},
+	}
+
+	analysis := analyzeWorkflowRunTimings(run, jobs, now, 3)
+
+	assert.True(t, analysis.Partial)
+	assert.Equal(t, int64(100), analysis.WallClockSeconds)
+	assert.Equal(t, int64(95), analysis.TotalJobSeconds)
+
+	testJob := analysis.Jobs[1]
+	assert.True(t, testJob.InProgress)
+	assert.Equal(t, int64(55), testJob.DurationSeconds)
+	assert.Equal(t, int64(5), testJob.QueuedSeconds)
+	assert.Equal(t, int64(50), testJob.Steps[1].DurationSeconds)
+	assert.True(t, testJob.Steps[1].InProgress)
+	assert.Equal(t, int64(0), testJob.Steps[2].DurationSeconds)
+	assert.False(t, testJob.Steps[2].InProgress)
+
+	require.Len(t, analysis.SlowestSteps, 3)
+	assert.Equal(t, SlowStep{Job: "test", Step: "go test", DurationSeconds: 50}, analysis.SlowestSteps[0])
+	assert.Equal(t, "test", analysis.SlowestJobs[0].Name)
+}
+
+func Test_CompareWorkflowRuns(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CompareWorkflowRuns(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "compare_workflow_runs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "base_run_id")
+	assert.Contains(t, tool.InputSchema.Properties, "compare_run_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "base_run_id", "compare_run_id"})
+
+	baseRun := &github.WorkflowRun{
+		ID:              github.Ptr(int64(1)),
+		WorkflowID:      github.Ptr(int64(100)),
+		Name:            github.Ptr("CI"),
+		Path:            github.Ptr(".github/workflows/ci.yml"),
+		Event:           github.Ptr("push"),
+		HeadBranch:      github.Ptr("main"),
+		HeadSHA:         github.Ptr("aaa111"),
+		Actor:           &github.User{Login: github.Ptr("alice")},
+		TriggeringActor: &github.User{Login: github.Ptr("alice")},
+		RunAttempt:      github.Ptr(1),
+		Conclusion:      github.Ptr("success"),
+		ReferencedWorkflows: []*github.ReferencedWorkflow{
+			{Path: github.Ptr("org/shared/.github/workflows/build.yml@v1"), SHA: github.Ptr("s1")},
+		},
+	}
+	compareRun := &github.WorkflowRun{
+		ID:              github.Ptr(int64(2)),
+		WorkflowID:      github.Ptr(int64(100)),
+		Name:            github.Ptr("CI"),
+		Path:            github.Ptr(".github/workflows/ci.yml"),
+		Event:           github.Ptr("push"),
+		HeadBranch:      github.Ptr("main"),
+		HeadSHA:         github.Ptr("bbb222"),
+		Actor:           &github.User{Login: github.Ptr("alice")},
+		TriggeringActor: &github.User{Login: github.Ptr("bob")},
+		RunAttempt:      github.Ptr(1),
+		Conclusion:      github.Ptr("failure"),
+		ReferencedWorkflows: []*github.ReferencedWorkflow{
+			{Path: github.Ptr("org/shared/.github/workflows/build.yml@v1"), SHA: github.Ptr("s2")},
+		},
+	}
+	otherWorkflowRun := &github.WorkflowRun{
+		ID:              github.Ptr(int64(3)),
+		WorkflowID:      github.Ptr(int64(200)),
+		Name:            github.Ptr("Release"),
+		Path:            github.Ptr(".github/workflows/release.yml"),
+		Event:           github.Ptr("workflow_dispatch"),
+		HeadBranch:      github.Ptr("main"),
+		HeadSHA:         github.Ptr("aaa111"),
+		Actor:           &github.User{Login: github.Ptr("alice")},
+		TriggeringActor: &github.User{Login: github.Ptr("alice")},
+		RunAttempt:      github.Ptr(1),
+		Conclusion:      github.Ptr("success"),
+		ReferencedWorkflows: []*github.ReferencedWorkflow{
+			{Path: github.Ptr("org/shared/.github/workflows/build.yml@v1"), SHA: github.Ptr("s1")},
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		mockedClient         *http.Client
+		requestArgs          map[string]any
+		expectError          bool
+		expectedErrMsg       string
+		expectedSameWorkflow bool
+		expectedDifferences  []WorkflowRunDifference
+		expectedNotes        int
+	}{
+		{
+			name: "runs of the same workflow",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					baseRun,
+					compareRun,
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"base_run_id":    float64(1),
+				"compare_run_id": float64(2),
+			},
+			expectedSameWorkflow: true,
+			expectedDifferences: []WorkflowRunDifference{
+				{Field: "head_sha", Base: "aaa111", Compare: "bbb222"},
+				{Field: "triggering_actor", Base: "alice", Compare: "bob"},
+				{Field: "referenced_workflows", Base: "org/shared/.github/workflows/build.yml@v1@s1", Compare: "org/shared/.github/workflows/build.yml@v1@s2"},
+				{Field: "conclusion", Base: "success", Compare: "failure"},
+			},
+		},
+		{
+			name: "runs of different workflows",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					baseRun,
+					otherWorkflowRun,
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"base_run_id":    float64(1),
+				"compare_run_id": float64(3),
+			},
+			expectedSameWorkflow: false,
+			expectedDifferences: []WorkflowRunDifference{
+				{Field: "workflow", Base: ".github/workflows/ci.yml", Compare: ".github/workflows/release.yml"},
+				{Field: "event", Base: "push", Compare: "workflow_dispatch"},
+			},
+			expectedNotes: 2,
+		},
+		{
+			name: "run not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"base_run_id":    float64(1),
+				"compare_run_id": float64(2),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get workflow run 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CompareWorkflowRuns(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+
+			var returned WorkflowRunComparison
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSameWorkflow, returned.SameWorkflow)
+			assert.Equal(t, tc.expectedDifferences, returned.Differences)
+			assert.Len(t, returned.Notes, tc.expectedNotes)
+		})
+	}
+}
+
+func Test_WaitForWorkflowRun(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := WaitForWorkflowRun(stubGetClientFn(mockClient), NewOperationRegistry(), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "wait_for_workflow_run", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "timeout_seconds")
+	assert.Contains(t, tool.InputSchema.Properties, "operation_token")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+
+	originalBackoff := workflowRunPollBackoff
+	workflowRunPollBackoff = pollBackoff{Initial: 10 * time.Millisecond, Max: 10 * time.Millisecond, Factor: 1}
+	t.Cleanup(func() { workflowRunPollBackoff = originalBackoff })
+
+	inProgressRun := &github.WorkflowRun{
+		ID:      github.Ptr(int64(12345)),
+		Name:    github.Ptr("CI"),
+		Status:  github.Ptr("in_progress"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/actions/runs/12345"),
+	}
+
+	t.Run("in progress then completed", func(t *testing.T) {
+		completedRun := &github.WorkflowRun{
+			ID:         github.Ptr(int64(12345)),
+			Name:       github.Ptr("CI"),
+			Status:     github.Ptr("completed"),
+			Conclusion: github.Ptr("failure"),
+			HTMLURL:    github.Ptr("https://github.com/owner/repo/actions/runs/12345"),
+		}
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(
+				mock.GetReposActionsRunsByOwnerByRepoByRunId,
+				inProgressRun,
+				inProgressRun,
+				completedRun,
+			),
+			mock.WithRequestMatchHandler(
+				mock.GetReposActionsRunsJobsByOwnerByRepoByRunId,
+				expectQueryParams(t, map[string]string{
+					"filter":   "latest",
+					"per_page": "100",
+				}).andThen(
+					mockResponse(t, http.StatusOK, &github.Jobs{
+						TotalCount: github.Ptr(2),
+						Jobs: []*github.WorkflowJob{
+							{
+								ID:         github.Ptr(int64(1)),
+								Name:       github.Ptr("lint"),
+								Conclusion: github.Ptr("success"),
+							},
+							{
+								ID:         github.Ptr(int64(2)),
+								Name:       github.Ptr("test"),
+								Conclusion: github.Ptr("failure"),
+								HTMLURL:    github.Ptr("https://github.com/owner/repo/actions/runs/12345/job/2"),
+								Steps: []*github.TaskStep{
+									{Name: github.Ptr("Checkout"), Conclusion: github.Ptr("success")},
+									{Name: github.Ptr("Run tests"), Conclusion: github.Ptr("failure")},
+								},
+							},
+						},
+					}),
+				),
+			),
+		)
+		client := github.NewClient(mockedClient)
+		_, handler := WaitForWorkflowRun(stubGetClientFn(client), NewOperationRegistry(), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":           "owner",
+			"repo":            "repo",
+			"run_id":          float64(12345),
+			"operation_token": "wait-12345",
+		}))
+		require.NoError(t, err)
+		textContent := getTextResult(t, result)
+
+		var outcome WorkflowRunOutcome
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &outcome))
+		assert.Equal(t, WorkflowRunOutcome{
+			RunID:      12345,
+			Name:       "CI",
+			Status:     "completed",
+			Conclusion: "failure",
+			HTMLURL:    "https://github.com/owner/repo/actions/runs/12345",
+			Attempts:   3,
+			FailedJobs: []FailedJob{
+				{
+					ID:          2,
+					Name:        "test",
+					Conclusion:  "failure",
+					FailedSteps: []string{"Run tests"},
+					HTMLURL:     "https://github.com/owner/repo/actions/runs/12345/job/2",
+				},
+			},
+			Message:        "workflow run completed with conclusion failure",
+			OperationToken: "wait-12345",
+		}, outcome)
+	})
+
+	t.Run("timeout is reported separately from a failed run", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposActionsRunsByOwnerByRepoByRunId,
+				mockResponse(t, http.StatusOK, inProgressRun),
+			),
+		)
+		client := github.NewClient(mockedClient)
+		_, handler := WaitForWorkflowRun(stubGetClientFn(client), NewOperationRegistry(), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":           "owner",
+			"repo":            "repo",
+			"run_id":          float64(12345),
+			"operation_token": "wait-12345",
+			"timeout_seconds": float64(1),
+		}))
+		require.NoError(t, err)
+		textContent := getTextResult(t, result)
+
+		var outcome WorkflowRunOutcome
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &outcome))
+		assert.True(t, outcome.TimedOut)
+		assert.Equal(t, "in_progress", outcome.Status)
+		assert.Empty(t, outcome.Conclusion)
+		assert.Equal(t, "workflow run is still in_progress after waiting 1 seconds", outcome.Message)
+	})
+
+	t.Run("cancelled context stops polling", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposActionsRunsByOwnerByRepoByRunId,
+				mockResponse(t, http.StatusOK, inProgressRun),
+			),
+		)
+		client := github.NewClient(mockedClient)
+		_, handler := WaitForWorkflowRun(stubGetClientFn(client), NewOperationRegistry(), translations.NullTranslationHelper)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := handler(ctx, createMCPRequest(map[string]any{
+			"owner":           "owner",
+			"repo":            "repo",
+			"run_id":          float64(12345),
+			"operation_token": "wait-12345",
+		}))
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("cancel_operation stops a started wait", func(t *testing.T) {
+		// The second poll signals that the first one has been seen, so the run is known when the
+		// wait is cancelled.
+		polled := make(chan struct{}, 1)
+		var polls atomic.Int32
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposActionsRunsByOwnerByRepoByRunId,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if polls.Add(1) == 2 {
+						polled <- struct{}{}
+					}
+					mockResponse(t, http.StatusOK, inProgressRun)(w, r)
+				}),
+			),
+		)
+		client := github.NewClient(mockedClient)
+		operations := NewOperationRegistry()
+		_, handler := WaitForWorkflowRun(stubGetClientFn(client), operations, translations.NullTranslationHelper)
+		_, cancelHandler := CancelOperation(operations, translations.NullTranslationHelper)
+
+		results := make(chan *mcp.CallToolResult, 1)
+		go func() {
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"run_id":          float64(12345),
+				"operation_token": "wait-to-cancel",
+			}))
+			assert.NoError(t, err)
+			results <- result
+		}()
+		<-polled
+
+		cancelResult, err := cancelHandler(context.Background(), createMCPRequest(map[string]any{
+			"operation_token": "wait-to-cancel",
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, cancelResult).Text, `"cancelled":true`)
+
+		var outcome WorkflowRunOutcome
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, <-results).Text), &outcome))
+		assert.True(t, outcome.Cancelled)
+		assert.False(t, outcome.TimedOut)
+		assert.Equal(t, "in_progress", outcome.Status)
+		assert.Equal(t, "wait-to-cancel", outcome.OperationToken)
+		assert.Equal(t, "stopped waiting for the workflow run because the operation was cancelled", outcome.Message)
+		assert.Zero(t, operations.inFlight())
+	})
+
+	t.Run("run not found", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposActionsRunsByOwnerByRepoByRunId,
+				mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+			),
+		)
+		client := github.NewClient(mockedClient)
+		_, handler := WaitForWorkflowRun(stubGetClientFn(client), NewOperationRegistry(), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":           "owner",
+			"repo":            "repo",
+			"run_id":          float64(12345),
+			"operation_token": "wait-12345",
+		}))
+		require.NoError(t, err)
+		errorContent := getErrorResult(t, result)
+		assert.Contains(t, errorContent.Text, "failed to get workflow run")
+	})
+
+	t.Run("waits without an operation token", func(t *testing.T) {
+		completedRun := &github.WorkflowRun{
+			ID:         github.Ptr(int64(12345)),
+			Name:       github.Ptr("CI"),
+			Status:     github.Ptr("completed"),
+			Conclusion: github.Ptr("success"),
+		}
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(
+				mock.GetReposActionsRunsByOwnerByRepoByRunId,
+				completedRun,
+			),
+		)
+		operations := NewOperationRegistry()
+		_, handler := WaitForWorkflowRun(stubGetClientFn(github.NewClient(mockedClient)), operations, translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":  "owner",
+			"repo":   "repo",
+			"run_id": float64(12345),
+		}))
+		require.NoError(t, err)
+
+		var outcome WorkflowRunOutcome
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &outcome))
+		assert.Equal(t, "success", outcome.Conclusion)
+		assert.Empty(t, outcome.OperationToken)
+		assert.Zero(t, operations.inFlight())
+	})
+}
+
+func Test_GetWorkflowRunLogs(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetWorkflowRunLogs(stubGetClientFn(mockClient), translations.NullTranslationHelper, 5000)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_workflow_run_logs", tool.Name)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+
+	archive := zipArchive(t, map[string]string{
+		"0_build.txt": "compile\nbuild ok\n",
+		"1_test.txt":  "run tests\n--- FAIL: TestParse\nFAIL\n",
+	})
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(archive)
+	}))
+	defer testServer.Close()
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposActionsRunsLogsByOwnerByRepoByRunId,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Location", testServer.URL)
+				w.WriteHeader(http.StatusFound)
+			}),
+		),
+	)
+	_, handler := GetWorkflowRunLogs(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper, 5000)
+
+	t.Run("logs url by default", func(t *testing.T) {
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":  "owner",
+			"repo":   "repo",
+			"run_id": float64(456),
+		}))
+		require.NoError(t, err)
+
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, testServer.URL, response["logs_url"])
+	})
+
+	t.Run("job logs are extracted from the archive", func(t *testing.T) {
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":          "owner",
+			"repo":           "repo",
+			"run_id":         float64(456),
+			"return_content": true,
+			"job_name":       "test",
+			"tail_lines":     float64(2),
+		}))
+		require.NoError(t, err)
+
+		var logs WorkflowRunLogs
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &logs))
+		assert.Equal(t, int64(456), logs.RunID)
+		assert.Equal(t, []JobLog{{Name: "test", Content: "--- FAIL: TestParse\nFAIL", TotalLines: 3}}, logs.Jobs)
+		assert.Empty(t, logs.Notes)
+	})
+
+	t.Run("no job matches the name", func(t *testing.T) {
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":          "owner",
+			"repo":           "repo",
+			"run_id":         float64(456),
+			"return_content": true,
+			"job_name":       "deploy",
+		}))
+		require.NoError(t, err)
+
+		var logs WorkflowRunLogs
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &logs))
+		assert.Empty(t, logs.Jobs)
+		assert.Equal(t, []string{`no job name contains "deploy"`}, logs.Notes)
+	})
+}
+
 func Test_GetJobLogs(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -0,0 +1,169 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/toolsets"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// APIResponse is the raw result of a github_api_request call.
+type APIResponse struct {
+	Status int             `json:"status"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	Text   string          `json:"text,omitempty"`
+}
+
+// validateAPIPath checks that a user supplied path stays relative to the API base URL.
+// It returns the path without its leading slash, ready to be resolved against the base URL.
+func validateAPIPath(path string) (string, error) {
+	if !strings.HasPrefix(path, "/") || strings.HasPrefix(path, "//") {
+		return "", fmt.Errorf("path must be relative to the API base and start with a single '/', got %q", path)
+	}
+	if strings.Contains(path, "\\") {
+		return "", fmt.Errorf("path must not contain backslashes")
+	}
+
+	u, err := url.Parse(path)
+	if err != nil {
+		return "", fmt.Errorf("invalid path: %w", err)
+	}
+	if u.Scheme != "" || u.Host != "" {
+		return "", fmt.Errorf("path must not include a scheme or host")
+	}
+	// Check the decoded path too, so %2e%2e can't be used to sneak a traversal through.
+	for _, segment := range strings.Split(u.Path, "/") {
+		if segment == ".." || segment == "." {
+			return "", fmt.Errorf("path must not contain '.' or '..' segments")
+		}
+	}
+
+	return strings.TrimPrefix(path, "/"), nil
+}
+
+// GitHubAPIRequest creates a tool that sends an arbitrary request to the GitHub REST API using the
+// server's credentials. When readOnly is true, only GET and HEAD requests are allowed.
+func GitHubAPIRequest(getClient GetClientFn, readOnly bool, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	methods := []string{http.MethodGet, http.MethodHead}
+	if !readOnly {
+		methods = append(methods, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete)
+	}
+
+	return mcp.NewTool("github_api_request",
+			mcp.WithDescription(t("TOOL_GITHUB_API_REQUEST_DESCRIPTION", "Send a request to any GitHub REST API endpoint and return the raw response. Only use this when no other tool covers the endpoint you need.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GITHUB_API_REQUEST_USER_TITLE", "GitHub API request"),
+				ReadOnlyHint: ToBoolPtr(readOnly),
+			}),
+			mcp.WithString("method",
+				mcp.Required(),
+				mcp.Description("HTTP method"),
+				mcp.Enum(methods...),
+			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path relative to the API base URL, including any query string, e.g. /repos/octocat/hello-world/topics"),
+			),
+			mcp.WithObject("body",
+				mcp.Description("Optional JSON request body"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			method, err := RequiredParam[string](request, "method")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			method = strings.ToUpper(method)
+			path, err := RequiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			allowed := false
+			for _, m := range methods {
+				if m == method {
+					allowed = true
+					break
+				}
+			}
+			if !allowed {
+				if readOnly {
+					return mcp.NewToolResultError(fmt.Sprintf("method %s is not allowed in read-only mode", method)), nil
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("unsupported method %s", method)), nil
+			}
+
+			relPath, err := validateAPIPath(path)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			var body any
+			if b, ok := request.GetArguments()["body"]; ok && b != nil {
+				body = b
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			req, err := client.NewRequest(method, relPath, body)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create request: %v", err)), nil
+			}
+			// Belt and braces: the resolved URL must still point at the configured API.
+			if req.URL.Host != client.BaseURL.Host || !strings.HasPrefix(req.URL.Path, client.BaseURL.Path) {
+				return mcp.NewToolResultError("path must resolve to the configured GitHub API host"), nil
+			}
+
+			resp, err := client.BareDo(ctx, req)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("%s %s failed", method, path), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			data, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read response body: %w", err)
+			}
+
+			result := APIResponse{Status: resp.StatusCode}
+			if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 {
+				if json.Valid(trimmed) {
+					result.Body = trimmed
+				} else {
+					result.Text = string(data)
+				}
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// AddDangerousTools adds tools that are only offered when explicitly allowed with --allow-dangerous.
+// They are added to the experiments toolset, so that toolset must be enabled too.
+func AddDangerousTools(tsg *toolsets.ToolsetGroup, readOnly bool, getClient GetClientFn, t translations.TranslationHelperFunc) error {
+	experiments, err := tsg.GetToolset("experiments")
+	if err != nil {
+		return err
+	}
+
+	// In read-only mode the tool only allows GET and HEAD, so it is safe to offer as a read tool.
+	if readOnly {
+		experiments.AddReadTools(toolsets.NewServerTool(GitHubAPIRequest(getClient, true, t)))
+	} else {
+		experiments.AddWriteTools(toolsets.NewServerTool(GitHubAPIRequest(getClient, false, t)))
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GitHubAPIRequest(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GitHubAPIRequest(stubGetClientFn(mockClient), false, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "github_api_request", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "method")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "body")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method", "path"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		readOnly       bool
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "GET request succeeds",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTopicsByOwnerByRepo,
+					expectPath(t, "/repos/owner/repo/topics").andThen(
+						mockResponse(t, http.StatusOK, map[string]any{"names": []string{"go"}}),
+					),
+				),
+			),
+			readOnly: true,
+			requestArgs: map[string]interface{}{
+				"method": "get",
+				"path":   "/repos/owner/repo/topics",
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"names":["go"]}`,
+		},
+		{
+			name: "POST request sends body",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposLabelsByOwnerByRepo,
+					expectRequestBody(t, map[string]any{"name": "bug"}).andThen(
+						mockResponse(t, http.StatusCreated, map[string]any{"name": "bug"}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"method": "POST",
+				"path":   "/repos/owner/repo/labels",
+				"body":   map[string]any{"name": "bug"},
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"name":"bug"}`,
+		},
+		{
+			name:         "POST blocked in read-only mode",
+			mockedClient: mock.NewMockedHTTPClient(),
+			readOnly:     true,
+			requestArgs: map[string]interface{}{
+				"method": "POST",
+				"path":   "/repos/owner/repo/labels",
+			},
+			expectError:    true,
+			expectedErrMsg: "method POST is not allowed in read-only mode",
+		},
+		{
+			name:         "path traversal rejected",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"method": "GET",
+				"path":   "/repos/owner/repo/%2e%2e/%2e%2e/user",
+			},
+			expectError:    true,
+			expectedErrMsg: "must not contain '.' or '..' segments",
+		},
+		{
+			name:         "absolute URL rejected",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"method": "GET",
+				"path":   "https://evil.example.com/user",
+			},
+			expectError:    true,
+			expectedErrMsg: "must be relative to the API base",
+		},
+		{
+			name: "API error is reported",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTopicsByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"method": "GET",
+				"path":   "/repos/owner/repo/topics",
+			},
+			expectError:    true,
+			expectedErrMsg: "GET /repos/owner/repo/topics failed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GitHubAPIRequest(stubGetClientFn(client), tc.readOnly, translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+
+			var returned APIResponse
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, returned.Status)
+			assert.JSONEq(t, tc.expectedBody, string(returned.Body))
+		})
+	}
+}
+
+func Test_AddDangerousTools(t *testing.T) {
+	hasTool := func(names []string, name string) bool {
+		for _, n := range names {
+			if n == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, readOnly := range []bool{false, true} {
+		tsg := DefaultToolsetGroup(readOnly, stubGetClientFn(github.NewClient(nil)), nil, nil, translations.NullTranslationHelper, 5000)
+
+		// Not offered anywhere unless explicitly allowed
+		for name, ts := range tsg.Toolsets {
+			var names []string
+			for _, tool := range ts.GetAvailableTools() {
+				names = append(names, tool.Tool.Name)
+			}
+			assert.False(t, hasTool(names, "github_api_request"), "toolset %s should not offer github_api_request by default", name)
+		}
+
+		require.NoError(t, AddDangerousTools(tsg, readOnly, stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper))
+
+		// Only offered via the experiments toolset, including in read-only mode
+		for name, ts := range tsg.Toolsets {
+			var names []string
+			for _, tool := range ts.GetAvailableTools() {
+				names = append(names, tool.Tool.Name)
+			}
+			assert.Equal(t, name == "experiments", hasTool(names, "github_api_request"), "toolset %s, readOnly=%v", name, readOnly)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package github
+
+// Batch item statuses reported in a BatchResult.
+const (
+	BatchStatusSucceeded = "succeeded"
+	BatchStatusFailed    = "failed"
+)
+
+// BatchItemResult is the outcome of a single item in a batch operation.
+type BatchItemResult struct {
+	Item   string `json:"item"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// BatchResult reports the per-item outcome of a batch operation, so that a partial failure
+// doesn't hide which items were applied.
+type BatchResult struct {
+	Succeeded int               `json:"succeeded"`
+	Failed    int               `json:"failed"`
+	Results   []BatchItemResult `json:"results"`
+}
+
+func newBatchResult(size int) *BatchResult {
+	return &BatchResult{Results: make([]BatchItemResult, 0, size)}
+}
+
+func (b *BatchResult) addSuccess(item string) {
+	b.Succeeded++
+	b.Results = append(b.Results, BatchItemResult{Item: item, Status: BatchStatusSucceeded})
+}
+
+func (b *BatchResult) addFailure(item string, err error) {
+	b.Failed++
+	b.Results = append(b.Results, BatchItemResult{Item: item, Status: BatchStatusFailed, Error: err.Error()})
+}
@@ -0,0 +1,181 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+type blameQuery struct {
+	Repository struct {
+		Object struct {
+			Commit struct {
+				OID   githubv4.GitObjectID `graphql:"oid"`
+				Blame struct {
+					Ranges []struct {
+						StartingLine githubv4.Int
+						EndingLine   githubv4.Int
+						Age          githubv4.Int
+						Commit       struct {
+							OID             githubv4.GitObjectID `graphql:"oid"`
+							MessageHeadline githubv4.String
+							CommittedDate   githubv4.DateTime
+							URL             githubv4.String `graphql:"url"`
+							Author          struct {
+								Name githubv4.String
+								User struct {
+									Login githubv4.String
+								}
+							}
+						}
+					}
+				} `graphql:"blame(path: $path)"`
+			} `graphql:"... on Commit"`
+		} `graphql:"object(expression: $ref)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// BlameRange is a run of consecutive lines of a file that were last changed by the same commit.
+// Age ranks how recently the lines were changed, from 1 for the newest to 10 for the oldest
+// changes in the file.
+type BlameRange struct {
+	StartLine   int       `json:"start_line"`
+	EndLine     int       `json:"end_line"`
+	Age         int       `json:"age"`
+	SHA         string    `json:"sha"`
+	Message     string    `json:"message"`
+	Author      string    `json:"author"`
+	AuthorLogin string    `json:"author_login,omitempty"`
+	Date        time.Time `json:"date"`
+	URL         string    `json:"url"`
+}
+
+// FileBlame is the blame of a file, or of a range of its lines, at a commit.
+type FileBlame struct {
+	Path   string       `json:"path"`
+	Commit string       `json:"commit"`
+	Ranges []BlameRange `json:"ranges"`
+}
+
+// GetBlame creates a tool to get the blame of a file in a repository.
+func GetBlame(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_blame",
+			mcp.WithDescription(t("TOOL_GET_BLAME_DESCRIPTION", "Get the blame of a file in a GitHub repository: for each run of lines, the commit that last changed them, its author, date and message, and how recent the change is compared to the rest of the file. Use startLine and endLine to find out who last touched a piece of code and why.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_BLAME_USER_TITLE", "Get file blame"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path of the file"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit SHA to blame the file at (default: the default branch)"),
+			),
+			mcp.WithNumber("startLine",
+				mcp.Description("First line to blame (default: 1)"),
+				mcp.Min(1),
+			),
+			mcp.WithNumber("endLine",
+				mcp.Description("Last line to blame (default: the end of the file)"),
+				mcp.Min(1),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := RequiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			startLine, err := OptionalIntParam(request, "startLine")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			endLine, err := OptionalIntParam(request, "endLine")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if startLine > 0 && endLine > 0 && endLine < startLine {
+				return mcp.NewToolResultError("endLine must not be before startLine"), nil
+			}
+			if ref == "" {
+				ref = "HEAD"
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			var q blameQuery
+			vars := map[string]any{
+				"owner": githubv4.String(owner),
+				"repo":  githubv4.String(repo),
+				"ref":   githubv4.String(ref),
+				"path":  githubv4.String(path),
+			}
+			if err := client.Query(ctx, &q, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, fmt.Sprintf("failed to get blame of %s", path), err), nil
+			}
+			commit := q.Repository.Object.Commit
+			if commit.OID == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("%s is not a commit in %s/%s", ref, owner, repo)), nil
+			}
+
+			result := FileBlame{
+				Path:   path,
+				Commit: string(commit.OID),
+				Ranges: []BlameRange{},
+			}
+			for _, r := range commit.Blame.Ranges {
+				start, end := int(r.StartingLine), int(r.EndingLine)
+				if (startLine > 0 && end < startLine) || (endLine > 0 && start > endLine) {
+					continue
+				}
+				// Ranges overlapping the requested lines are clipped to them.
+				if startLine > start {
+					start = startLine
+				}
+				if endLine > 0 && endLine < end {
+					end = endLine
+				}
+				result.Ranges = append(result.Ranges, BlameRange{
+					StartLine:   start,
+					EndLine:     end,
+					Age:         int(r.Age),
+					SHA:         string(r.Commit.OID),
+					Message:     string(r.Commit.MessageHeadline),
+					Author:      string(r.Commit.Author.Name),
+					AuthorLogin: string(r.Commit.Author.User.Login),
+					Date:        r.Commit.CommittedDate.Time,
+					URL:         string(r.Commit.URL),
+				})
+			}
+			return MarshalledTextResult(result), nil
+		}
+}
@@ -0,0 +1,145 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetBlame(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := GetBlame(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_blame", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "path"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	vars := map[string]any{
+		"owner": githubv4.String("owner"),
+		"repo":  githubv4.String("repo"),
+		"ref":   githubv4.String("HEAD"),
+		"path":  githubv4.String("main.go"),
+	}
+	blameRange := func(start, end, age int, oid, message, login string) map[string]any {
+		return map[string]any{
+			"startingLine": start,
+			"endingLine":   end,
+			"age":          age,
+			"commit": map[string]any{
+				"oid":             oid,
+				"messageHeadline": message,
+				"committedDate":   "2025-05-01T10:00:00Z",
+				"url":             "https://github.com/owner/repo/commit/" + oid,
+				"author": map[string]any{
+					"name": "Mona Lisa",
+					"user": map[string]any{"login": login},
+				},
+			},
+		}
+	}
+	blame := githubv4mock.NewQueryMatcher(
+		blameQuery{},
+		vars,
+		githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{
+				"object": map[string]any{
+					"oid": "head123",
+					"blame": map[string]any{
+						"ranges": []any{
+							blameRange(1, 4, 10, "old111", "Initial commit", "monalisa"),
+							blameRange(5, 9, 1, "new222", "Handle empty input", "monalisa"),
+							blameRange(10, 20, 10, "old111", "Initial commit", "monalisa"),
+						},
+					},
+				},
+			},
+		}),
+	)
+	date := time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedRanges []BlameRange
+	}{
+		{
+			name:         "lines clipped to the requested range",
+			mockedClient: githubv4mock.NewMockedHTTPClient(blame),
+			requestArgs: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"path":      "main.go",
+				"startLine": float64(6),
+				"endLine":   float64(12),
+			},
+			expectedRanges: []BlameRange{
+				{StartLine: 6, EndLine: 9, Age: 1, SHA: "new222", Message: "Handle empty input", Author: "Mona Lisa", AuthorLogin: "monalisa", Date: date, URL: "https://github.com/owner/repo/commit/new222"},
+				{StartLine: 10, EndLine: 12, Age: 10, SHA: "old111", Message: "Initial commit", Author: "Mona Lisa", AuthorLogin: "monalisa", Date: date, URL: "https://github.com/owner/repo/commit/old111"},
+			},
+		},
+		{
+			name:         "end before start",
+			mockedClient: githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"path":      "main.go",
+				"startLine": float64(12),
+				"endLine":   float64(6),
+			},
+			expectError:    true,
+			expectedErrMsg: "endLine must not be before startLine",
+		},
+		{
+			name: "file not found",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					blameQuery{},
+					vars,
+					githubv4mock.ErrorResponse("Could not resolve file for path 'main.go'."),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "main.go",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get blame of main.go",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := githubv4.NewClient(tc.mockedClient)
+			_, handler := GetBlame(stubGetGQLClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned FileBlame
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, "main.go", returned.Path)
+			assert.Equal(t, "head123", returned.Commit)
+			assert.Equal(t, tc.expectedRanges, returned.Ranges)
+		})
+	}
+}
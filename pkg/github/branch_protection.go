@@ -0,0 +1,732 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func userLogins(users []*github.User) []string {
+	logins := make([]string, 0, len(users))
+	for _, u := range users {
+		logins = append(logins, u.GetLogin())
+	}
+	return logins
+}
+
+func teamSlugs(teams []*github.Team) []string {
+	slugs := make([]string, 0, len(teams))
+	for _, team := range teams {
+		slugs = append(slugs, team.GetSlug())
+	}
+	return slugs
+}
+
+func appSlugs(apps []*github.App) []string {
+	slugs := make([]string, 0, len(apps))
+	for _, app := range apps {
+		slugs = append(slugs, app.GetSlug())
+	}
+	return slugs
+}
+
+// protectionRequest turns the protection GitHub reports for a branch into the request that would
+// set it again. The update endpoint replaces the whole protection, so every setting that is not
+// being changed has to be sent back as it is.
+func protectionRequest(p *github.Protection) *github.ProtectionRequest {
+	req := &github.ProtectionRequest{}
+	if p == nil {
+		return req
+	}
+
+	if checks := p.RequiredStatusChecks; checks != nil {
+		req.RequiredStatusChecks = &github.RequiredStatusChecks{Strict: checks.Strict}
+		// GitHub rejects requests that set both, and checks carry the app that must report them.
+		if checks.Checks != nil {
+			req.RequiredStatusChecks.Checks = checks.Checks
+		} else {
+			req.RequiredStatusChecks.Contexts = checks.Contexts
+		}
+	}
+
+	if reviews := p.RequiredPullRequestReviews; reviews != nil {
+		req.RequiredPullRequestReviews = &github.PullRequestReviewsEnforcementRequest{
+			DismissStaleReviews:          reviews.DismissStaleReviews,
+			RequireCodeOwnerReviews:      reviews.RequireCodeOwnerReviews,
+			RequiredApprovingReviewCount: reviews.RequiredApprovingReviewCount,
+			RequireLastPushApproval:      github.Ptr(reviews.RequireLastPushApproval),
+		}
+		if r := reviews.DismissalRestrictions; r != nil {
+			users, teams, apps := userLogins(r.Users), teamSlugs(r.Teams), appSlugs(r.Apps)
+			req.RequiredPullRequestReviews.DismissalRestrictionsRequest = &github.DismissalRestrictionsRequest{
+				Users: &users,
+				Teams: &teams,
+				Apps:  &apps,
+			}
+		}
+		if r := reviews.BypassPullRequestAllowances; r != nil {
+			req.RequiredPullRequestReviews.BypassPullRequestAllowancesRequest = &github.BypassPullRequestAllowancesRequest{
+				Users: userLogins(r.Users),
+				Teams: teamSlugs(r.Teams),
+				Apps:  appSlugs(r.Apps),
+			}
+		}
+	}
+
+	if p.EnforceAdmins != nil {
+		req.EnforceAdmins = p.EnforceAdmins.Enabled
+	}
+
+	if r := p.Restrictions; r != nil {
+		req.Restrictions = &github.BranchRestrictionsRequest{
+			Users: userLogins(r.Users),
+			Teams: teamSlugs(r.Teams),
+			Apps:  appSlugs(r.Apps),
+		}
+	}
+
+	if p.RequireLinearHistory != nil {
+		req.RequireLinearHistory = github.Ptr(p.RequireLinearHistory.Enabled)
+	}
+	if p.AllowForcePushes != nil {
+		req.AllowForcePushes = github.Ptr(p.AllowForcePushes.Enabled)
+	}
+	if p.AllowDeletions != nil {
+		req.AllowDeletions = github.Ptr(p.AllowDeletions.Enabled)
+	}
+	if p.RequiredConversationResolution != nil {
+		req.RequiredConversationResolution = github.Ptr(p.RequiredConversationResolution.Enabled)
+	}
+	if p.BlockCreations != nil {
+		req.BlockCreations = p.BlockCreations.Enabled
+	}
+	if p.LockBranch != nil {
+		req.LockBranch = p.LockBranch.Enabled
+	}
+	if p.AllowForkSyncing != nil {
+		req.AllowForkSyncing = p.AllowForkSyncing.Enabled
+	}
+	return req
+}
+
+// GetBranchProtection creates a tool to get the protection rules of a branch.
+func GetBranchProtection(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_branch_protection",
+			mcp.WithDescription(t("TOOL_GET_BRANCH_PROTECTION_DESCRIPTION", "Get the branch protection rules of a branch in a GitHub repository: required status checks, required reviews, admin enforcement and push restrictions. Rules that come from rulesets are listed by list_rulesets instead.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_BRANCH_PROTECTION_USER_TITLE", "Get branch protection"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := RequiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			protection, resp, err := client.Repositories.GetBranchProtection(ctx, owner, repo, branch)
+			if errors.Is(err, github.ErrBranchNotProtected) {
+				return mcp.NewToolResultText(fmt.Sprintf("Branch %s in %s/%s is not protected", branch, owner, repo)), nil
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get protection of branch %s", branch), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(protection), nil
+		}
+}
+
+// UpdateBranchProtection creates a tool to change the protection rules of a branch.
+func UpdateBranchProtection(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_branch_protection",
+			mcp.WithDescription(t("TOOL_UPDATE_BRANCH_PROTECTION_DESCRIPTION", "Protect a branch in a GitHub repository or change its protection. Only the given settings are changed; everything else keeps its current value. Unprotected branches start with no rules.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_BRANCH_PROTECTION_USER_TITLE", "Update branch protection"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch name"),
+			),
+			mcp.WithArray("required_status_checks",
+				mcp.Description("Status checks that must pass before merging, replacing the current list. An empty list removes the requirement."),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+			mcp.WithBoolean("strict_status_checks",
+				mcp.Description("Require branches to be up to date with the base branch before merging"),
+			),
+			mcp.WithBoolean("require_pull_request_reviews",
+				mcp.Description("Require pull request reviews before merging; false removes the requirement"),
+			),
+			mcp.WithNumber("required_approving_review_count",
+				mcp.Description("Number of approving reviews required (0-6)"),
+				mcp.Min(0),
+				mcp.Max(6),
+			),
+			mcp.WithBoolean("dismiss_stale_reviews",
+				mcp.Description("Dismiss approving reviews when new commits are pushed"),
+			),
+			mcp.WithBoolean("require_code_owner_reviews",
+				mcp.Description("Require a review from a code owner"),
+			),
+			mcp.WithBoolean("enforce_admins",
+				mcp.Description("Apply the rules to repository administrators too"),
+			),
+			mcp.WithBoolean("restrict_pushes",
+				mcp.Description("Restrict who can push to the branch (organization repositories only); false removes the restriction"),
+			),
+			mcp.WithArray("push_restrictions_users",
+				mcp.Description("Logins of the users allowed to push"),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+			mcp.WithArray("push_restrictions_teams",
+				mcp.Description("Slugs of the teams allowed to push"),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+			mcp.WithArray("push_restrictions_apps",
+				mcp.Description("Slugs of the GitHub Apps allowed to push"),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+			mcp.WithBoolean("require_linear_history",
+				mcp.Description("Prevent merge commits from being pushed"),
+			),
+			mcp.WithBoolean("allow_force_pushes",
+				mcp.Description("Allow force pushes by anyone with push access"),
+			),
+			mcp.WithBoolean("allow_deletions",
+				mcp.Description("Allow the branch to be deleted"),
+			),
+			mcp.WithBoolean("required_conversation_resolution",
+				mcp.Description("Require all review conversations to be resolved before merging"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := RequiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// Collect the changes first, so nothing is fetched when the arguments are wrong.
+			var changes []func(*github.ProtectionRequest)
+
+			if _, ok := request.GetArguments()["required_status_checks"]; ok {
+				contexts, err := OptionalStringArrayParam(request, "required_status_checks")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				changes = append(changes, func(req *github.ProtectionRequest) {
+					if len(contexts) == 0 {
+						req.RequiredStatusChecks = nil
+						return
+					}
+					checks := make([]*github.RequiredStatusCheck, 0, len(contexts))
+					for _, c := range contexts {
+						checks = append(checks, &github.RequiredStatusCheck{Context: c})
+					}
+					if req.RequiredStatusChecks == nil {
+						req.RequiredStatusChecks = &github.RequiredStatusChecks{}
+					}
+					req.RequiredStatusChecks.Contexts = nil
+					req.RequiredStatusChecks.Checks = &checks
+				})
+			}
+			if v, ok, err := OptionalParamOK[bool](request, "strict_status_checks"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				changes = append(changes, func(req *github.ProtectionRequest) {
+					if req.RequiredStatusChecks == nil {
+						req.RequiredStatusChecks = &github.RequiredStatusChecks{Checks: &[]*github.RequiredStatusCheck{}}
+					}
+					req.RequiredStatusChecks.Strict = v
+				})
+			}
+
+			requireReviews, requireReviewsSet, err := OptionalParamOK[bool](request, "require_pull_request_reviews")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			reviewChanges := 0
+			reviews := func(req *github.ProtectionRequest) *github.PullRequestReviewsEnforcementRequest {
+				if req.RequiredPullRequestReviews == nil {
+					req.RequiredPullRequestReviews = &github.PullRequestReviewsEnforcementRequest{}
+				}
+				return req.RequiredPullRequestReviews
+			}
+			if v, ok, err := OptionalParamOK[float64](request, "required_approving_review_count"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				reviewChanges++
+				changes = append(changes, func(req *github.ProtectionRequest) { reviews(req).RequiredApprovingReviewCount = int(v) })
+			}
+			if v, ok, err := OptionalParamOK[bool](request, "dismiss_stale_reviews"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				reviewChanges++
+				changes = append(changes, func(req *github.ProtectionRequest) { reviews(req).DismissStaleReviews = v })
+			}
+			if v, ok, err := OptionalParamOK[bool](request, "require_code_owner_reviews"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				reviewChanges++
+				changes = append(changes, func(req *github.ProtectionRequest) { reviews(req).RequireCodeOwnerReviews = v })
+			}
+			if requireReviewsSet {
+				if !requireReviews && reviewChanges > 0 {
+					return mcp.NewToolResultError("review settings cannot be changed while require_pull_request_reviews is false"), nil
+				}
+				changes = append(changes, func(req *github.ProtectionRequest) {
+					if requireReviews {
+						reviews(req)
+					} else {
+						req.RequiredPullRequestReviews = nil
+					}
+				})
+			}
+
+			if v, ok, err := OptionalParamOK[bool](request, "enforce_admins"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				changes = append(changes, func(req *github.ProtectionRequest) { req.EnforceAdmins = v })
+			}
+
+			restrictPushes, restrictPushesSet, err := OptionalParamOK[bool](request, "restrict_pushes")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			restrictions := func(req *github.ProtectionRequest) *github.BranchRestrictionsRequest {
+				if req.Restrictions == nil {
+					req.Restrictions = &github.BranchRestrictionsRequest{Users: []string{}, Teams: []string{}, Apps: []string{}}
+				}
+				return req.Restrictions
+			}
+			restrictionChanges := 0
+			for _, name := range []string{"push_restrictions_users", "push_restrictions_teams", "push_restrictions_apps"} {
+				if _, ok := request.GetArguments()[name]; !ok {
+					continue
+				}
+				values, err := OptionalStringArrayParam(request, name)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if values == nil {
+					values = []string{}
+				}
+				restrictionChanges++
+				changes = append(changes, func(req *github.ProtectionRequest) {
+					r := restrictions(req)
+					switch name {
+					case "push_restrictions_users":
+						r.Users = values
+					case "push_restrictions_teams":
+						r.Teams = values
+					case "push_restrictions_apps":
+						r.Apps = values
+					}
+				})
+			}
+			if restrictPushesSet {
+				if !restrictPushes && restrictionChanges > 0 {
+					return mcp.NewToolResultError("push restrictions cannot be changed while restrict_pushes is false"), nil
+				}
+				changes = append(changes, func(req *github.ProtectionRequest) {
+					if restrictPushes {
+						restrictions(req)
+					} else {
+						req.Restrictions = nil
+					}
+				})
+			}
+
+			for name, field := range map[string]func(*github.ProtectionRequest) **bool{
+				"require_linear_history":           func(req *github.ProtectionRequest) **bool { return &req.RequireLinearHistory },
+				"allow_force_pushes":               func(req *github.ProtectionRequest) **bool { return &req.AllowForcePushes },
+				"allow_deletions":                  func(req *github.ProtectionRequest) **bool { return &req.AllowDeletions },
+				"required_conversation_resolution": func(req *github.ProtectionRequest) **bool { return &req.RequiredConversationResolution },
+			} {
+				if v, ok, err := OptionalParamOK[bool](request, name); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				} else if ok {
+					changes = append(changes, func(req *github.ProtectionRequest) { *field(req) = github.Ptr(v) })
+				}
+			}
+
+			if len(changes) == 0 {
+				return mcp.NewToolResultError("No update parameters provided."), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			current, resp, err := client.Repositories.GetBranchProtection(ctx, owner, repo, branch)
+			if err != nil && !errors.Is(err, github.ErrBranchNotProtected) {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get protection of branch %s", branch), resp, err), nil
+			}
+			if resp != nil {
+				_ = resp.Body.Close()
+			}
+
+			req := protectionRequest(current)
+			for _, change := range changes {
+				change(req)
+			}
+
+			protection, resp, err := client.Repositories.UpdateBranchProtection(ctx, owner, repo, branch, req)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to update protection of branch %s", branch), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(protection), nil
+		}
+}
+
+// ListRulesets creates a tool to list the rulesets of a repository.
+func ListRulesets(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_rulesets",
+			mcp.WithDescription(t("TOOL_LIST_RULESETS_DESCRIPTION", "List the rulesets of a GitHub repository. Use get_ruleset to see the rules of one.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_RULESETS_USER_TITLE", "List repository rulesets"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithBoolean("include_parents",
+				mcp.Description("Also list rulesets inherited from the organization"),
+				mcp.DefaultBool(true),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeParents, ok, err := OptionalParamOK[bool](request, "include_parents")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !ok {
+				includeParents = true
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			rulesets, resp, err := client.Repositories.GetAllRulesets(ctx, owner, repo, &github.RepositoryListRulesetsOptions{
+				IncludesParents: github.Ptr(includeParents),
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list rulesets", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledListResult(ctx, rulesets, "no rulesets found"), nil
+		}
+}
+
+// GetRuleset creates a tool to get a repository ruleset with its rules.
+func GetRuleset(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_ruleset",
+			mcp.WithDescription(t("TOOL_GET_RULESET_DESCRIPTION", "Get a ruleset of a GitHub repository with its conditions, bypass actors and rules")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_RULESET_USER_TITLE", "Get repository ruleset"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("ruleset_id",
+				mcp.Required(),
+				mcp.Description("Ruleset ID"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			rulesetID, err := RequiredInt(request, "ruleset_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Ask for inherited rulesets too, so IDs from list_rulesets always resolve.
+			ruleset, resp, err := client.Repositories.GetRuleset(ctx, owner, repo, int64(rulesetID), true)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get ruleset %d", rulesetID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(ruleset), nil
+		}
+}
+
+// UpdateRuleset creates a tool to change the status check and review rules of a repository ruleset.
+func UpdateRuleset(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_ruleset",
+			mcp.WithDescription(t("TOOL_UPDATE_RULESET_DESCRIPTION", "Update a ruleset of a GitHub repository: its name, enforcement, required status checks and required reviews. Only the given settings are changed. Rulesets inherited from an organization cannot be changed here.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_RULESET_USER_TITLE", "Update repository ruleset"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("ruleset_id",
+				mcp.Required(),
+				mcp.Description("Ruleset ID"),
+			),
+			mcp.WithString("name",
+				mcp.Description("New name of the ruleset"),
+			),
+			mcp.WithString("enforcement",
+				mcp.Description("Whether the ruleset is enforced, only evaluated, or disabled"),
+				mcp.Enum("active", "evaluate", "disabled"),
+			),
+			mcp.WithArray("required_status_checks",
+				mcp.Description("Status checks that must pass before merging, replacing the current list. An empty list removes the rule."),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+			mcp.WithBoolean("strict_required_status_checks_policy",
+				mcp.Description("Require branches to be up to date with the base branch before merging"),
+			),
+			mcp.WithNumber("required_approving_review_count",
+				mcp.Description("Number of approving reviews required (0-10). Adds a pull request rule if the ruleset has none."),
+				mcp.Min(0),
+				mcp.Max(10),
+			),
+			mcp.WithBoolean("dismiss_stale_reviews_on_push",
+				mcp.Description("Dismiss approving reviews when new commits are pushed"),
+			),
+			mcp.WithBoolean("require_code_owner_review",
+				mcp.Description("Require a review from a code owner"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			rulesetID, err := RequiredInt(request, "ruleset_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			var changes []func(*github.RepositoryRuleset)
+
+			if v, ok, err := OptionalParamOK[string](request, "name"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				changes = append(changes, func(r *github.RepositoryRuleset) { r.Name = v })
+			}
+			if v, ok, err := OptionalParamOK[string](request, "enforcement"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				enforcement := github.RulesetEnforcement(strings.ToLower(v))
+				switch enforcement {
+				case github.RulesetEnforcementActive, github.RulesetEnforcementEvaluate, github.RulesetEnforcementDisabled:
+				default:
+					return mcp.NewToolResultError(fmt.Sprintf("invalid enforcement %q: must be one of active, evaluate, disabled", v)), nil
+				}
+				changes = append(changes, func(r *github.RepositoryRuleset) { r.Enforcement = enforcement })
+			}
+
+			statusChecks := func(r *github.RepositoryRuleset) *github.RequiredStatusChecksRuleParameters {
+				if r.Rules.RequiredStatusChecks == nil {
+					r.Rules.RequiredStatusChecks = &github.RequiredStatusChecksRuleParameters{RequiredStatusChecks: []*github.RuleStatusCheck{}}
+				}
+				return r.Rules.RequiredStatusChecks
+			}
+			if _, ok := request.GetArguments()["required_status_checks"]; ok {
+				contexts, err := OptionalStringArrayParam(request, "required_status_checks")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				changes = append(changes, func(r *github.RepositoryRuleset) {
+					if len(contexts) == 0 {
+						r.Rules.RequiredStatusChecks = nil
+						return
+					}
+					// Keep the app that must report a check that stays required.
+					integrations := map[string]*int64{}
+					if r.Rules.RequiredStatusChecks != nil {
+						for _, check := range r.Rules.RequiredStatusChecks.RequiredStatusChecks {
+							integrations[check.Context] = check.IntegrationID
+						}
+					}
+					checks := make([]*github.RuleStatusCheck, 0, len(contexts))
+					for _, c := range contexts {
+						checks = append(checks, &github.RuleStatusCheck{Context: c, IntegrationID: integrations[c]})
+					}
+					statusChecks(r).RequiredStatusChecks = checks
+				})
+			}
+			if v, ok, err := OptionalParamOK[bool](request, "strict_required_status_checks_policy"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				changes = append(changes, func(r *github.RepositoryRuleset) { statusChecks(r).StrictRequiredStatusChecksPolicy = v })
+			}
+
+			pullRequest := func(r *github.RepositoryRuleset) *github.PullRequestRuleParameters {
+				if r.Rules.PullRequest == nil {
+					r.Rules.PullRequest = &github.PullRequestRuleParameters{
+						AllowedMergeMethods: []github.PullRequestMergeMethod{
+							github.PullRequestMergeMethodMerge,
+							github.PullRequestMergeMethodSquash,
+							github.PullRequestMergeMethodRebase,
+						},
+					}
+				}
+				return r.Rules.PullRequest
+			}
+			if v, ok, err := OptionalParamOK[float64](request, "required_approving_review_count"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				changes = append(changes, func(r *github.RepositoryRuleset) { pullRequest(r).RequiredApprovingReviewCount = int(v) })
+			}
+			if v, ok, err := OptionalParamOK[bool](request, "dismiss_stale_reviews_on_push"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				changes = append(changes, func(r *github.RepositoryRuleset) { pullRequest(r).DismissStaleReviewsOnPush = v })
+			}
+			if v, ok, err := OptionalParamOK[bool](request, "require_code_owner_review"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				changes = append(changes, func(r *github.RepositoryRuleset) { pullRequest(r).RequireCodeOwnerReview = v })
+			}
+
+			if len(changes) == 0 {
+				return mcp.NewToolResultError("No update parameters provided."), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			ruleset, resp, err := client.Repositories.GetRuleset(ctx, owner, repo, int64(rulesetID), true)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get ruleset %d", rulesetID), resp, err), nil
+			}
+			_ = resp.Body.Close()
+			if ruleset.SourceType != nil && *ruleset.SourceType != github.RulesetSourceTypeRepository {
+				return mcp.NewToolResultError(fmt.Sprintf("ruleset %d belongs to %s %s and must be changed there", rulesetID, strings.ToLower(string(*ruleset.SourceType)), ruleset.Source)), nil
+			}
+
+			if ruleset.Rules == nil {
+				ruleset.Rules = &github.RepositoryRulesetRules{}
+			}
+			for _, change := range changes {
+				change(ruleset)
+			}
+
+			updated, resp, err := client.Repositories.UpdateRuleset(ctx, owner, repo, int64(rulesetID), github.RepositoryRuleset{
+				Name:         ruleset.Name,
+				Target:       ruleset.Target,
+				Enforcement:  ruleset.Enforcement,
+				BypassActors: ruleset.BypassActors,
+				Conditions:   ruleset.Conditions,
+				Rules:        ruleset.Rules,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to update ruleset %d", rulesetID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(updated), nil
+		}
+}
@@ -0,0 +1,449 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mockProtection = &github.Protection{
+	RequiredStatusChecks: &github.RequiredStatusChecks{
+		Strict: true,
+		Checks: &[]*github.RequiredStatusCheck{
+			{Context: "build", AppID: github.Ptr(int64(15368))},
+		},
+	},
+	RequiredPullRequestReviews: &github.PullRequestReviewsEnforcement{
+		RequiredApprovingReviewCount: 1,
+		RequireCodeOwnerReviews:      true,
+	},
+	EnforceAdmins: &github.AdminEnforcement{Enabled: true},
+	Restrictions: &github.BranchRestrictions{
+		Users: []*github.User{{Login: github.Ptr("octocat")}},
+		Teams: []*github.Team{{Slug: github.Ptr("maintainers")}},
+		Apps:  []*github.App{},
+	},
+	AllowForcePushes: &github.AllowForcePushes{Enabled: false},
+}
+
+func Test_GetBranchProtection(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetBranchProtection(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_branch_protection", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "protected branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					expectPath(t, "/repos/owner/repo/branches/main/protection").andThen(
+						mockResponse(t, http.StatusOK, mockProtection),
+					),
+				),
+			),
+		},
+		{
+			name: "unprotected branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Branch not protected"}),
+				),
+			),
+			expectedText: "Branch main in owner/repo is not protected",
+		},
+		{
+			name: "branch not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Branch not found"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get protection of branch main",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetBranchProtection(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			textContent := getTextResult(t, result)
+			if tc.expectedText != "" {
+				assert.Equal(t, tc.expectedText, textContent.Text)
+				return
+			}
+
+			var returned github.Protection
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.True(t, returned.RequiredStatusChecks.Strict)
+			assert.Equal(t, 1, returned.RequiredPullRequestReviews.RequiredApprovingReviewCount)
+			assert.Equal(t, "octocat", returned.Restrictions.Users[0].GetLogin())
+		})
+	}
+}
+
+func Test_UpdateBranchProtection(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateBranchProtection(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_branch_protection", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		current        any
+		currentStatus  int
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedBody   map[string]any
+	}{
+		{
+			name:          "change the review count and keep everything else",
+			current:       mockProtection,
+			currentStatus: http.StatusOK,
+			requestArgs: map[string]any{
+				"required_approving_review_count": float64(2),
+			},
+			expectedBody: map[string]any{
+				"required_status_checks": map[string]any{
+					"strict": true,
+					"checks": []any{map[string]any{"context": "build", "app_id": float64(15368)}},
+				},
+				"required_pull_request_reviews": map[string]any{
+					"dismiss_stale_reviews":           false,
+					"require_code_owner_reviews":      true,
+					"required_approving_review_count": float64(2),
+					"require_last_push_approval":      false,
+				},
+				"enforce_admins": true,
+				"restrictions": map[string]any{
+					"users": []any{"octocat"},
+					"teams": []any{"maintainers"},
+					"apps":  []any{},
+				},
+				"allow_force_pushes": false,
+			},
+		},
+		{
+			name:          "protect a branch with required checks",
+			current:       map[string]string{"message": "Branch not protected"},
+			currentStatus: http.StatusNotFound,
+			requestArgs: map[string]any{
+				"required_status_checks": []any{"build", "test"},
+				"strict_status_checks":   true,
+			},
+			expectedBody: map[string]any{
+				"required_status_checks": map[string]any{
+					"strict": true,
+					"checks": []any{map[string]any{"context": "build"}, map[string]any{"context": "test"}},
+				},
+				"required_pull_request_reviews": nil,
+				"enforce_admins":                false,
+				"restrictions":                  nil,
+			},
+		},
+		{
+			name:          "lift push restrictions and reviews",
+			current:       mockProtection,
+			currentStatus: http.StatusOK,
+			requestArgs: map[string]any{
+				"restrict_pushes":              false,
+				"require_pull_request_reviews": false,
+				"enforce_admins":               false,
+			},
+			expectedBody: map[string]any{
+				"required_status_checks": map[string]any{
+					"strict": true,
+					"checks": []any{map[string]any{"context": "build", "app_id": float64(15368)}},
+				},
+				"required_pull_request_reviews": nil,
+				"enforce_admins":                false,
+				"restrictions":                  nil,
+				"allow_force_pushes":            false,
+			},
+		},
+		{
+			name: "conflicting review settings",
+			requestArgs: map[string]any{
+				"require_pull_request_reviews":    false,
+				"required_approving_review_count": float64(2),
+			},
+			expectError:    true,
+			expectedErrMsg: "review settings cannot be changed while require_pull_request_reviews is false",
+		},
+		{
+			name:           "nothing to update",
+			requestArgs:    map[string]any{},
+			expectError:    true,
+			expectedErrMsg: "No update parameters provided.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedClient := mock.NewMockedHTTPClient()
+			if tc.expectedBody != nil {
+				mockedClient = mock.NewMockedHTTPClient(
+					mock.WithRequestMatchHandler(
+						mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+						mockResponse(t, tc.currentStatus, tc.current),
+					),
+					mock.WithRequestMatchHandler(
+						mock.PutReposBranchesProtectionByOwnerByRepoByBranch,
+						expect(t, expectations{
+							path:        "/repos/owner/repo/branches/main/protection",
+							requestBody: tc.expectedBody,
+						}).andThen(
+							mockResponse(t, http.StatusOK, mockProtection),
+						),
+					),
+				)
+			}
+			client := github.NewClient(mockedClient)
+			_, handler := UpdateBranchProtection(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			args := map[string]any{"owner": "owner", "repo": "repo", "branch": "main"}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			getTextResult(t, result)
+		})
+	}
+}
+
+func Test_ListRulesets(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRulesets(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_rulesets", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	rulesets := []*github.RepositoryRuleset{
+		{ID: github.Ptr(int64(42)), Name: "main", Enforcement: github.RulesetEnforcementActive},
+		{ID: github.Ptr(int64(7)), Name: "org defaults", Enforcement: github.RulesetEnforcementEvaluate},
+	}
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposRulesetsByOwnerByRepo,
+			expectQueryParams(t, map[string]string{
+				"includes_parents": "false",
+				"page":             "1",
+				"per_page":         "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, rulesets),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := ListRulesets(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":           "owner",
+		"repo":            "repo",
+		"include_parents": false,
+	}))
+	require.NoError(t, err)
+
+	var returned []*github.RepositoryRuleset
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	require.Len(t, returned, 2)
+	assert.Equal(t, int64(42), returned[0].GetID())
+}
+
+func Test_GetRuleset(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRuleset(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_ruleset", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ruleset_id"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+			expect(t, expectations{
+				path:        "/repos/owner/repo/rulesets/42",
+				queryParams: map[string]string{"includes_parents": "true"},
+			}).andThen(
+				mockResponse(t, http.StatusOK, &github.RepositoryRuleset{
+					ID:          github.Ptr(int64(42)),
+					Name:        "main",
+					Enforcement: github.RulesetEnforcementActive,
+				}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := GetRuleset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":      "owner",
+		"repo":       "repo",
+		"ruleset_id": float64(42),
+	}))
+	require.NoError(t, err)
+
+	var returned github.RepositoryRuleset
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, "main", returned.Name)
+}
+
+func Test_UpdateRuleset(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateRuleset(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_ruleset", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ruleset_id"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	mockRuleset := &github.RepositoryRuleset{
+		ID:          github.Ptr(int64(42)),
+		Name:        "main",
+		Target:      github.Ptr(github.RulesetTargetBranch),
+		SourceType:  github.Ptr(github.RulesetSourceTypeRepository),
+		Source:      "owner/repo",
+		Enforcement: github.RulesetEnforcementActive,
+		Rules: &github.RepositoryRulesetRules{
+			Deletion: &github.EmptyRuleParameters{},
+			RequiredStatusChecks: &github.RequiredStatusChecksRuleParameters{
+				RequiredStatusChecks: []*github.RuleStatusCheck{
+					{Context: "build", IntegrationID: github.Ptr(int64(15368))},
+				},
+			},
+		},
+	}
+
+	t.Run("add a check and a review rule", func(t *testing.T) {
+		var sent github.RepositoryRuleset
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+				expectPath(t, "/repos/owner/repo/rulesets/42").andThen(
+					mockResponse(t, http.StatusOK, mockRuleset),
+				),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PutReposRulesetsByOwnerByRepoByRulesetId,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&sent))
+					mockResponse(t, http.StatusOK, sent)(w, r)
+				}),
+			),
+		)
+		client := github.NewClient(mockedClient)
+		_, handler := UpdateRuleset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":                           "owner",
+			"repo":                            "repo",
+			"ruleset_id":                      float64(42),
+			"required_status_checks":          []any{"build", "lint"},
+			"required_approving_review_count": float64(2),
+		}))
+		require.NoError(t, err)
+		getTextResult(t, result)
+
+		assert.Equal(t, "main", sent.Name)
+		assert.Equal(t, github.RulesetEnforcementActive, sent.Enforcement)
+		require.NotNil(t, sent.Rules)
+		assert.NotNil(t, sent.Rules.Deletion)
+		require.NotNil(t, sent.Rules.RequiredStatusChecks)
+		assert.Equal(t, []*github.RuleStatusCheck{
+			{Context: "build", IntegrationID: github.Ptr(int64(15368))},
+			{Context: "lint"},
+		}, sent.Rules.RequiredStatusChecks.RequiredStatusChecks)
+		require.NotNil(t, sent.Rules.PullRequest)
+		assert.Equal(t, 2, sent.Rules.PullRequest.RequiredApprovingReviewCount)
+		assert.Len(t, sent.Rules.PullRequest.AllowedMergeMethods, 3)
+	})
+
+	t.Run("inherited ruleset", func(t *testing.T) {
+		inherited := *mockRuleset
+		inherited.SourceType = github.Ptr(github.RulesetSourceTypeOrganization)
+		inherited.Source = "owner"
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(
+				mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+				inherited,
+			),
+		)
+		client := github.NewClient(mockedClient)
+		_, handler := UpdateRuleset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":       "owner",
+			"repo":        "repo",
+			"ruleset_id":  float64(42),
+			"enforcement": "disabled",
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "ruleset 42 belongs to organization owner and must be changed there", getErrorResult(t, result).Text)
+	})
+
+	t.Run("invalid enforcement", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient())
+		_, handler := UpdateRuleset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":       "owner",
+			"repo":        "repo",
+			"ruleset_id":  float64(42),
+			"enforcement": "sometimes",
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, `invalid enforcement "sometimes"`)
+	})
+}
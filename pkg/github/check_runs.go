@@ -0,0 +1,428 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/toolsets"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxCheckRunAnnotations is how many annotations the checks API accepts in one request. More are
+// sent in follow-up updates, which GitHub appends to the ones already on the check run.
+const maxCheckRunAnnotations = 50
+
+// UsesAppTokenFn reports whether the token a request is made with is a GitHub App installation
+// token. The token can differ per request, for example with HTTP sessions or a reloaded token file.
+type UsesAppTokenFn func(context.Context) (bool, error)
+
+// requireAppToken returns a tool error when the request is not made with a GitHub App installation
+// token, which is all the checks API accepts.
+func requireAppToken(ctx context.Context, usesAppToken UsesAppTokenFn) (*mcp.CallToolResult, error) {
+	ok, err := usesAppToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+	}
+	if !ok {
+		return mcp.NewToolResultError("the checks API only accepts GitHub App installation tokens (starting with ghs_); authenticate the server as a GitHub App to create or update check runs"), nil
+	}
+	return nil, nil
+}
+
+// CheckRunAnnotationSpec is an annotation passed to create_check_run or update_check_run.
+type CheckRunAnnotationSpec struct {
+	Path            string `mapstructure:"path"`
+	StartLine       int    `mapstructure:"start_line"`
+	EndLine         int    `mapstructure:"end_line"`
+	StartColumn     *int   `mapstructure:"start_column"`
+	EndColumn       *int   `mapstructure:"end_column"`
+	AnnotationLevel string `mapstructure:"annotation_level"`
+	Message         string `mapstructure:"message"`
+	Title           string `mapstructure:"title"`
+	RawDetails      string `mapstructure:"raw_details"`
+}
+
+// CheckRunResult identifies a check run after create_check_run or update_check_run.
+type CheckRunResult struct {
+	ID               int64  `json:"id"`
+	Name             string `json:"name"`
+	Status           string `json:"status"`
+	Conclusion       string `json:"conclusion,omitempty"`
+	URL              string `json:"url"`
+	AnnotationsAdded int    `json:"annotations_added"`
+	// Requests is how many create or update requests were needed to send all annotations.
+	Requests int `json:"requests"`
+}
+
+// withCheckRunParams adds the parameters create_check_run and update_check_run share.
+func withCheckRunParams() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("status",
+			mcp.Description("Current status of the check run"),
+			mcp.Enum("queued", "in_progress", "completed"),
+		)(tool)
+		mcp.WithString("conclusion",
+			mcp.Description("Final conclusion. Required when status is completed, and setting it marks the check run completed."),
+			mcp.Enum("action_required", "cancelled", "failure", "neutral", "success", "skipped", "timed_out"),
+		)(tool)
+		mcp.WithString("details_url",
+			mcp.Description("URL of the integrator's site with the full details of the check"),
+		)(tool)
+		mcp.WithString("external_id",
+			mcp.Description("Reference for the check run on the integrator's system"),
+		)(tool)
+		mcp.WithString("output_title",
+			mcp.Description("Title of the check run output. Required with output_summary when giving annotations."),
+		)(tool)
+		mcp.WithString("output_summary",
+			mcp.Description("Summary of the check run output, in Markdown"),
+		)(tool)
+		mcp.WithString("output_text",
+			mcp.Description("Details of the check run output, in Markdown"),
+		)(tool)
+		mcp.WithArray("annotations",
+			mcp.Description(fmt.Sprintf("Annotations on lines of code. Any number may be given; they are sent %d per request.", maxCheckRunAnnotations)),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"path":             map[string]any{"type": "string", "description": "Path of the file, relative to the repository root"},
+					"start_line":       map[string]any{"type": "number"},
+					"end_line":         map[string]any{"type": "number"},
+					"start_column":     map[string]any{"type": "number", "description": "Only allowed when start_line and end_line are the same"},
+					"end_column":       map[string]any{"type": "number", "description": "Only allowed when start_line and end_line are the same"},
+					"annotation_level": map[string]any{"type": "string", "enum": []string{"notice", "warning", "failure"}},
+					"message":          map[string]any{"type": "string"},
+					"title":            map[string]any{"type": "string"},
+					"raw_details":      map[string]any{"type": "string"},
+				},
+				"required": []string{"path", "start_line", "end_line", "annotation_level", "message"},
+			}),
+		)(tool)
+	}
+}
+
+// checkRunParams are the parsed parameters shared by create_check_run and update_check_run.
+type checkRunParams struct {
+	status, conclusion, detailsURL, externalID string
+	title, summary, text                       string
+	annotations                                []*github.CheckRunAnnotation
+}
+
+// output returns the check run output carrying the given annotations, or nil if there is nothing
+// to send.
+func (p checkRunParams) output(annotations []*github.CheckRunAnnotation) *github.CheckRunOutput {
+	if p.title == "" && p.summary == "" && p.text == "" && len(annotations) == 0 {
+		return nil
+	}
+	output := &github.CheckRunOutput{Annotations: annotations}
+	if p.title != "" {
+		output.Title = github.Ptr(p.title)
+	}
+	if p.summary != "" {
+		output.Summary = github.Ptr(p.summary)
+	}
+	if p.text != "" {
+		output.Text = github.Ptr(p.text)
+	}
+	return output
+}
+
+func optionalStringPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return github.Ptr(s)
+}
+
+// parseCheckRunParams reads and checks the parameters added by withCheckRunParams.
+func parseCheckRunParams(request mcp.CallToolRequest) (checkRunParams, error) {
+	var p checkRunParams
+	for name, dst := range map[string]*string{
+		"status":         &p.status,
+		"conclusion":     &p.conclusion,
+		"details_url":    &p.detailsURL,
+		"external_id":    &p.externalID,
+		"output_title":   &p.title,
+		"output_summary": &p.summary,
+		"output_text":    &p.text,
+	} {
+		v, err := OptionalParam[string](request, name)
+		if err != nil {
+			return checkRunParams{}, err
+		}
+		*dst = v
+	}
+	if p.status == "completed" && p.conclusion == "" {
+		return checkRunParams{}, fmt.Errorf("conclusion is required when status is completed")
+	}
+
+	raw, err := OptionalParam[[]any](request, "annotations")
+	if err != nil {
+		return checkRunParams{}, err
+	}
+	if len(raw) > 0 && (p.title == "" || p.summary == "") {
+		return checkRunParams{}, fmt.Errorf("output_title and output_summary are required when giving annotations")
+	}
+	for i, item := range raw {
+		var spec CheckRunAnnotationSpec
+		if err := mapstructure.Decode(item, &spec); err != nil {
+			return checkRunParams{}, fmt.Errorf("annotations[%d]: %w", i, err)
+		}
+		switch {
+		case spec.Path == "" || spec.Message == "":
+			return checkRunParams{}, fmt.Errorf("annotations[%d]: path and message are required", i)
+		case spec.StartLine < 1 || spec.EndLine < spec.StartLine:
+			return checkRunParams{}, fmt.Errorf("annotations[%d]: start_line must be at least 1 and end_line must not be before it", i)
+		case spec.AnnotationLevel != "notice" && spec.AnnotationLevel != "warning" && spec.AnnotationLevel != "failure":
+			return checkRunParams{}, fmt.Errorf("annotations[%d]: annotation_level must be notice, warning or failure", i)
+		case (spec.StartColumn != nil || spec.EndColumn != nil) && spec.StartLine != spec.EndLine:
+			return checkRunParams{}, fmt.Errorf("annotations[%d]: columns can only be given when start_line and end_line are the same", i)
+		}
+		p.annotations = append(p.annotations, &github.CheckRunAnnotation{
+			Path:            github.Ptr(spec.Path),
+			StartLine:       github.Ptr(spec.StartLine),
+			EndLine:         github.Ptr(spec.EndLine),
+			StartColumn:     spec.StartColumn,
+			EndColumn:       spec.EndColumn,
+			AnnotationLevel: github.Ptr(spec.AnnotationLevel),
+			Message:         github.Ptr(spec.Message),
+			Title:           optionalStringPtr(spec.Title),
+			RawDetails:      optionalStringPtr(spec.RawDetails),
+		})
+	}
+	return p, nil
+}
+
+// chunkAnnotations splits annotations into batches the checks API accepts in one request.
+func chunkAnnotations(annotations []*github.CheckRunAnnotation) [][]*github.CheckRunAnnotation {
+	var chunks [][]*github.CheckRunAnnotation
+	for len(annotations) > maxCheckRunAnnotations {
+		chunks = append(chunks, annotations[:maxCheckRunAnnotations])
+		annotations = annotations[maxCheckRunAnnotations:]
+	}
+	return append(chunks, annotations)
+}
+
+// addRemainingAnnotations sends the annotation batches after the first as updates to the check
+// run, and returns the check run after the last update.
+func addRemainingAnnotations(ctx context.Context, client *github.Client, owner, repo string, run *github.CheckRun, p checkRunParams, batches [][]*github.CheckRunAnnotation) (*github.CheckRun, *mcp.CallToolResult) {
+	for i, batch := range batches {
+		updated, resp, err := client.Checks.UpdateCheckRun(ctx, owner, repo, run.GetID(), github.UpdateCheckRunOptions{
+			// The API needs the name on every update, and the output title and summary whenever
+			// annotations are sent.
+			Name:   run.GetName(),
+			Output: &github.CheckRunOutput{Title: github.Ptr(p.title), Summary: github.Ptr(p.summary), Annotations: batch},
+		})
+		if err != nil {
+			sent := (i + 1) * maxCheckRunAnnotations
+			return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("check run %d was saved with its first %d of %d annotations, but adding the rest failed", run.GetID(), sent, len(p.annotations)), resp, err)
+		}
+		_ = resp.Body.Close()
+		run = updated
+	}
+	return run, nil
+}
+
+func newCheckRunResult(run *github.CheckRun, p checkRunParams, requests int) CheckRunResult {
+	return CheckRunResult{
+		ID:               run.GetID(),
+		Name:             run.GetName(),
+		Status:           run.GetStatus(),
+		Conclusion:       run.GetConclusion(),
+		URL:              run.GetHTMLURL(),
+		AnnotationsAdded: len(p.annotations),
+		Requests:         requests,
+	}
+}
+
+// CreateCheckRun creates a tool to create a check run on a commit. The checks API only accepts
+// GitHub App installation tokens.
+func CreateCheckRun(getClient GetClientFn, usesAppToken UsesAppTokenFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_check_run",
+			mcp.WithDescription(t("TOOL_CREATE_CHECK_RUN_DESCRIPTION", "Create a check run on a commit, optionally with output and line annotations. Only works when the server authenticates as a GitHub App.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_CHECK_RUN_USER_TITLE", "Create check run"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Name of the check, e.g. code-coverage"),
+			),
+			mcp.WithString("head_sha",
+				mcp.Required(),
+				mcp.Description("SHA of the commit to check"),
+			),
+			withCheckRunParams(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := RequiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			headSHA, err := RequiredParam[string](request, "head_sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			p, err := parseCheckRunParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if result, err := requireAppToken(ctx, usesAppToken); result != nil || err != nil {
+				return result, err
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			batches := chunkAnnotations(p.annotations)
+			run, resp, err := client.Checks.CreateCheckRun(ctx, owner, repo, github.CreateCheckRunOptions{
+				Name:       name,
+				HeadSHA:    headSHA,
+				DetailsURL: optionalStringPtr(p.detailsURL),
+				ExternalID: optionalStringPtr(p.externalID),
+				Status:     optionalStringPtr(p.status),
+				Conclusion: optionalStringPtr(p.conclusion),
+				Output:     p.output(batches[0]),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create check run", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			run, errResult := addRemainingAnnotations(ctx, client, owner, repo, run, p, batches[1:])
+			if errResult != nil {
+				return errResult, nil
+			}
+
+			return MarshalledTextResult(newCheckRunResult(run, p, len(batches))), nil
+		}
+}
+
+// UpdateCheckRun creates a tool to update a check run. The checks API only accepts GitHub App
+// installation tokens.
+func UpdateCheckRun(getClient GetClientFn, usesAppToken UsesAppTokenFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_check_run",
+			mcp.WithDescription(t("TOOL_UPDATE_CHECK_RUN_DESCRIPTION", "Update the status, conclusion or output of a check run, or add line annotations to it. Annotations are added to the ones already on the check run. Only works when the server authenticates as a GitHub App.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_CHECK_RUN_USER_TITLE", "Update check run"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("check_run_id",
+				mcp.Required(),
+				mcp.Description("ID of the check run"),
+			),
+			mcp.WithString("name",
+				mcp.Description("New name of the check"),
+			),
+			withCheckRunParams(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			checkRunID, err := RequiredInt(request, "check_run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := OptionalParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			p, err := parseCheckRunParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if result, err := requireAppToken(ctx, usesAppToken); result != nil || err != nil {
+				return result, err
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// go-github always sends the name, so keep the current one unless a new one is given.
+			if strings.TrimSpace(name) == "" {
+				current, resp, err := client.Checks.GetCheckRun(ctx, owner, repo, int64(checkRunID))
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get check run", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				name = current.GetName()
+			}
+
+			batches := chunkAnnotations(p.annotations)
+			run, resp, err := client.Checks.UpdateCheckRun(ctx, owner, repo, int64(checkRunID), github.UpdateCheckRunOptions{
+				Name:       name,
+				DetailsURL: optionalStringPtr(p.detailsURL),
+				ExternalID: optionalStringPtr(p.externalID),
+				Status:     optionalStringPtr(p.status),
+				Conclusion: optionalStringPtr(p.conclusion),
+				Output:     p.output(batches[0]),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to update check run", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			run, errResult := addRemainingAnnotations(ctx, client, owner, repo, run, p, batches[1:])
+			if errResult != nil {
+				return errResult, nil
+			}
+
+			return MarshalledTextResult(newCheckRunResult(run, p, len(batches))), nil
+		}
+}
+
+// AddCheckRunTools adds the check run tools to the actions toolset. The checks API only accepts
+// GitHub App installation tokens, so the tools check usesAppToken on every call.
+func AddCheckRunTools(tsg *toolsets.ToolsetGroup, getClient GetClientFn, usesAppToken UsesAppTokenFn, t translations.TranslationHelperFunc) error {
+	actions, err := tsg.GetToolset("actions")
+	if err != nil {
+		return err
+	}
+	actions.AddWriteTools(
+		toolsets.NewServerTool(CreateCheckRun(getClient, usesAppToken, t)),
+		toolsets.NewServerTool(UpdateCheckRun(getClient, usesAppToken, t)),
+	)
+	return nil
+}
@@ -0,0 +1,270 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testAnnotations returns n valid annotation arguments.
+func testAnnotations(n int) []any {
+	annotations := make([]any, n)
+	for i := range annotations {
+		annotations[i] = map[string]any{
+			"path":             "main.go",
+			"start_line":       float64(i + 1),
+			"end_line":         float64(i + 1),
+			"annotation_level": "warning",
+			"message":          fmt.Sprintf("problem %d", i+1),
+		}
+	}
+	return annotations
+}
+
+// recordCheckRunRequest decodes a check run request body, records how many annotations it carried
+// and responds with the check run.
+func recordCheckRunRequest(t *testing.T, batches *[]int, names *[]string, run *github.CheckRun) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Name   string `json:"name"`
+			Output *struct {
+				Title       string `json:"title"`
+				Summary     string `json:"summary"`
+				Annotations []any  `json:"annotations"`
+			} `json:"output"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.NotNil(t, body.Output)
+		assert.Equal(t, "Lint", body.Output.Title)
+		assert.Equal(t, "Found problems", body.Output.Summary)
+		*batches = append(*batches, len(body.Output.Annotations))
+		*names = append(*names, body.Name)
+		mockResponse(t, http.StatusOK, run)(w, r)
+	}
+}
+
+func stubUsesAppToken(ok bool) UsesAppTokenFn {
+	return func(context.Context) (bool, error) {
+		return ok, nil
+	}
+}
+
+func Test_CreateCheckRun(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateCheckRun(stubGetClientFn(mockClient), stubUsesAppToken(true), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_check_run", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.Properties, "annotations")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "name", "head_sha"})
+
+	run := &github.CheckRun{
+		ID:         github.Ptr(int64(4)),
+		Name:       github.Ptr("lint"),
+		Status:     github.Ptr("completed"),
+		Conclusion: github.Ptr("failure"),
+		HTMLURL:    github.Ptr("https://github.com/owner/repo/runs/4"),
+	}
+
+	t.Run("annotations are sent in batches of 50", func(t *testing.T) {
+		var batches []int
+		var names []string
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.PostReposCheckRunsByOwnerByRepo,
+				recordCheckRunRequest(t, &batches, &names, run),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PatchReposCheckRunsByOwnerByRepoByCheckRunId,
+				expectPath(t, "/repos/owner/repo/check-runs/4").andThen(
+					recordCheckRunRequest(t, &batches, &names, run),
+				),
+			),
+		)
+		client := github.NewClient(mockedClient)
+		_, handler := CreateCheckRun(stubGetClientFn(client), stubUsesAppToken(true), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":          "owner",
+			"repo":           "repo",
+			"name":           "lint",
+			"head_sha":       "abc123",
+			"conclusion":     "failure",
+			"output_title":   "Lint",
+			"output_summary": "Found problems",
+			"annotations":    testAnnotations(120),
+		}))
+		require.NoError(t, err)
+		textContent := getTextResult(t, result)
+
+		assert.Equal(t, []int{50, 50, 20}, batches)
+		assert.Equal(t, []string{"lint", "lint", "lint"}, names)
+
+		var created CheckRunResult
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &created))
+		assert.Equal(t, CheckRunResult{
+			ID:               4,
+			Name:             "lint",
+			Status:           "completed",
+			Conclusion:       "failure",
+			URL:              "https://github.com/owner/repo/runs/4",
+			AnnotationsAdded: 120,
+			Requests:         3,
+		}, created)
+	})
+
+	t.Run("without annotations only one request is sent", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.PostReposCheckRunsByOwnerByRepo,
+				expectRequestBody(t, map[string]any{
+					"name":     "lint",
+					"head_sha": "abc123",
+					"status":   "in_progress",
+				}).andThen(
+					mockResponse(t, http.StatusCreated, run),
+				),
+			),
+		)
+		client := github.NewClient(mockedClient)
+		_, handler := CreateCheckRun(stubGetClientFn(client), stubUsesAppToken(true), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":    "owner",
+			"repo":     "repo",
+			"name":     "lint",
+			"head_sha": "abc123",
+			"status":   "in_progress",
+		}))
+		require.NoError(t, err)
+		textContent := getTextResult(t, result)
+
+		var created CheckRunResult
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &created))
+		assert.Equal(t, 1, created.Requests)
+	})
+
+	errorTests := []struct {
+		name           string
+		args           map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "annotations without an output summary",
+			args: map[string]any{
+				"output_title": "Lint",
+				"annotations":  testAnnotations(1),
+			},
+			expectedErrMsg: "output_title and output_summary are required when giving annotations",
+		},
+		{
+			name: "invalid annotation level",
+			args: map[string]any{
+				"output_title":   "Lint",
+				"output_summary": "Found problems",
+				"annotations": []any{map[string]any{
+					"path": "main.go", "start_line": float64(1), "end_line": float64(1), "annotation_level": "error", "message": "bad",
+				}},
+			},
+			expectedErrMsg: "annotations[0]: annotation_level must be notice, warning or failure",
+		},
+		{
+			name:           "completed without a conclusion",
+			args:           map[string]any{"status": "completed"},
+			expectedErrMsg: "conclusion is required when status is completed",
+		},
+	}
+
+	for _, tc := range errorTests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient())
+			_, handler := CreateCheckRun(stubGetClientFn(client), stubUsesAppToken(true), translations.NullTranslationHelper)
+
+			args := map[string]any{"owner": "owner", "repo": "repo", "name": "lint", "head_sha": "abc123"}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+			errorContent := getErrorResult(t, result)
+			assert.Equal(t, tc.expectedErrMsg, errorContent.Text)
+		})
+	}
+
+	t.Run("token that is not an installation token", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient())
+		_, handler := CreateCheckRun(stubGetClientFn(client), stubUsesAppToken(false), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner": "owner", "repo": "repo", "name": "lint", "head_sha": "abc123",
+		}))
+		require.NoError(t, err)
+		errorContent := getErrorResult(t, result)
+		assert.Contains(t, errorContent.Text, "only accepts GitHub App installation tokens")
+	})
+}
+
+func Test_UpdateCheckRun(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateCheckRun(stubGetClientFn(mockClient), stubUsesAppToken(true), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_check_run", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "check_run_id"})
+
+	run := &github.CheckRun{
+		ID:     github.Ptr(int64(4)),
+		Name:   github.Ptr("lint"),
+		Status: github.Ptr("in_progress"),
+	}
+
+	var batches []int
+	var names []string
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposCheckRunsByOwnerByRepoByCheckRunId,
+			run,
+		),
+		mock.WithRequestMatchHandler(
+			mock.PatchReposCheckRunsByOwnerByRepoByCheckRunId,
+			recordCheckRunRequest(t, &batches, &names, run),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := UpdateCheckRun(stubGetClientFn(client), stubUsesAppToken(true), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":          "owner",
+		"repo":           "repo",
+		"check_run_id":   float64(4),
+		"output_title":   "Lint",
+		"output_summary": "Found problems",
+		"annotations":    testAnnotations(51),
+	}))
+	require.NoError(t, err)
+	textContent := getTextResult(t, result)
+
+	// The current name is looked up, because the API needs it on every update.
+	assert.Equal(t, []string{"lint", "lint"}, names)
+	assert.Equal(t, []int{50, 1}, batches)
+
+	var updated CheckRunResult
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &updated))
+	assert.Equal(t, 51, updated.AnnotationsAdded)
+	assert.Equal(t, 2, updated.Requests)
+}
@@ -247,3 +247,129 @@ func Test_ListCodeScanningAlerts(t *testing.T) {
 		})
 	}
 }
+
+func Test_DismissCodeScanningAlert(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DismissCodeScanningAlert(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "dismiss_code_scanning_alert", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "alertNumber", "dismissedReason"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "dismiss with a comment",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposCodeScanningAlertsByOwnerByRepoByAlertNumber,
+					expect(t, expectations{
+						path: "/repos/owner/repo/code-scanning/alerts/42",
+						requestBody: map[string]any{
+							"state":             "dismissed",
+							"dismissed_reason":  "false positive",
+							"dismissed_comment": "Input is validated by the caller",
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Alert{
+							Number:          github.Ptr(42),
+							State:           github.Ptr("dismissed"),
+							DismissedReason: github.Ptr("false positive"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"alertNumber":      float64(42),
+				"dismissedReason":  "false positive",
+				"dismissedComment": "Input is validated by the caller",
+			},
+		},
+		{
+			name: "dismiss fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposCodeScanningAlertsByOwnerByRepoByAlertNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"alertNumber":     float64(42),
+				"dismissedReason": "won't fix",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to dismiss alert",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DismissCodeScanningAlert(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returnedAlert github.Alert
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returnedAlert))
+			assert.Equal(t, "dismissed", returnedAlert.GetState())
+			assert.Equal(t, "false positive", returnedAlert.GetDismissedReason())
+		})
+	}
+}
+
+func Test_ReopenCodeScanningAlert(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ReopenCodeScanningAlert(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "reopen_code_scanning_alert", tool.Name)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "alertNumber"})
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PatchReposCodeScanningAlertsByOwnerByRepoByAlertNumber,
+			expectRequestBody(t, map[string]any{"state": "open"}).andThen(
+				mockResponse(t, http.StatusOK, &github.Alert{
+					Number: github.Ptr(42),
+					State:  github.Ptr("open"),
+				}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := ReopenCodeScanningAlert(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":       "owner",
+		"repo":        "repo",
+		"alertNumber": float64(42),
+	}))
+	require.NoError(t, err)
+
+	var returnedAlert github.Alert
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returnedAlert))
+	assert.Equal(t, "open", returnedAlert.GetState())
+}
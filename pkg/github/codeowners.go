@@ -0,0 +1,289 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultCodeownersMaxFiles = 5000
+	maxCodeownersMaxFiles     = 20000
+	// maxOrphanedPathsReported limits how many orphaned paths are listed in the result. The
+	// counts always cover every file examined.
+	maxOrphanedPathsReported = 200
+)
+
+// codeownersPaths are the locations GitHub looks for a CODEOWNERS file, in the order it checks them.
+var codeownersPaths = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// codeownersRule is a single pattern line of a CODEOWNERS file.
+type codeownersRule struct {
+	Pattern string
+	Owners  []string
+	Line    int
+	re      *regexp.Regexp
+}
+
+// codeownersPatternToRegexp converts a CODEOWNERS pattern, which follows gitignore rules, into a
+// regular expression matching repository paths. A pattern that matches a directory also matches
+// everything below it.
+func codeownersPatternToRegexp(pattern string) (*regexp.Regexp, error) {
+	trimmed := strings.TrimSuffix(pattern, "/")
+	dirOnly := trimmed != pattern
+	// Patterns with a slash anywhere but the end are relative to the repository root, the others
+	// match at any depth.
+	anchored := strings.Contains(trimmed, "/")
+	trimmed = strings.TrimPrefix(trimmed, "/")
+	if trimmed == "" {
+		return nil, fmt.Errorf("empty pattern")
+	}
+
+	var b strings.Builder
+	if anchored {
+		b.WriteString("^")
+	} else {
+		b.WriteString("^(?:.*/)?")
+	}
+	for i := 0; i < len(trimmed); i++ {
+		switch c := trimmed[i]; {
+		case strings.HasPrefix(trimmed[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(trimmed[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		case c == '\\' && i+1 < len(trimmed):
+			i++
+			b.WriteString(regexp.QuoteMeta(string(trimmed[i])))
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	switch {
+	case dirOnly:
+		b.WriteString("/.*$")
+	case strings.HasSuffix(trimmed, "/*"):
+		// docs/* owns the files directly in docs, but not those in its subdirectories.
+		b.WriteString("$")
+	default:
+		b.WriteString("(?:/.*)?$")
+	}
+	return regexp.Compile(b.String())
+}
+
+// parseCodeowners reads the rules of a CODEOWNERS file. Lines that cannot be parsed are reported
+// as errors and skipped, as GitHub does.
+func parseCodeowners(content string) ([]codeownersRule, []string) {
+	var rules []codeownersRule
+	var errs []string
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if idx := strings.Index(line, " #"); idx >= 0 {
+			line = strings.TrimSpace(line[:idx])
+		}
+		fields := strings.Fields(line)
+		re, err := codeownersPatternToRegexp(fields[0])
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("line %d: invalid pattern %q: %v", i+1, fields[0], err))
+			continue
+		}
+		// A pattern without owners is valid and leaves the matching paths without an owner.
+		rules = append(rules, codeownersRule{Pattern: fields[0], Owners: fields[1:], Line: i + 1, re: re})
+	}
+	return rules, errs
+}
+
+// matchCodeowners returns the rule that applies to path. The last matching rule in the file wins.
+func matchCodeowners(rules []codeownersRule, path string) (codeownersRule, bool) {
+	for i := len(rules) - 1; i >= 0; i-- {
+		if rules[i].re.MatchString(path) {
+			return rules[i], true
+		}
+	}
+	return codeownersRule{}, false
+}
+
+// CodeownersCoverage reports how much of a repository is owned through CODEOWNERS.
+type CodeownersCoverage struct {
+	Path            string         `json:"codeowners_path,omitempty"`
+	Ref             string         `json:"ref,omitempty"`
+	Rules           int            `json:"rules"`
+	FilesExamined   int            `json:"files_examined"`
+	Covered         int            `json:"covered"`
+	Orphaned        int            `json:"orphaned"`
+	CoveragePercent float64        `json:"coverage_percent"`
+	FilesPerOwner   map[string]int `json:"files_per_owner,omitempty"`
+	OrphanedPaths   []string       `json:"orphaned_paths,omitempty"`
+	RuleErrors      []string       `json:"rule_errors,omitempty"`
+	Truncated       bool           `json:"truncated"`
+	Notes           []string       `json:"notes,omitempty"`
+}
+
+// analyzeCodeowners works out which of paths are covered by rules.
+func analyzeCodeowners(rules []codeownersRule, paths []string) CodeownersCoverage {
+	coverage := CodeownersCoverage{
+		Rules:         len(rules),
+		FilesExamined: len(paths),
+		FilesPerOwner: map[string]int{},
+	}
+	for _, path := range paths {
+		rule, ok := matchCodeowners(rules, path)
+		if !ok || len(rule.Owners) == 0 {
+			coverage.Orphaned++
+			if len(coverage.OrphanedPaths) < maxOrphanedPathsReported {
+				coverage.OrphanedPaths = append(coverage.OrphanedPaths, path)
+			}
+			continue
+		}
+		coverage.Covered++
+		for _, owner := range rule.Owners {
+			coverage.FilesPerOwner[owner]++
+		}
+	}
+	if len(paths) > 0 {
+		coverage.CoveragePercent = float64(coverage.Covered*10000/len(paths)) / 100
+	}
+	if coverage.Orphaned > len(coverage.OrphanedPaths) {
+		coverage.Notes = append(coverage.Notes, fmt.Sprintf("only the first %d of %d orphaned paths are listed", len(coverage.OrphanedPaths), coverage.Orphaned))
+	}
+	return coverage
+}
+
+// AnalyzeCodeownersCoverage creates a tool to report which files of a repository have a code owner.
+func AnalyzeCodeownersCoverage(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("analyze_codeowners_coverage",
+			mcp.WithDescription(t("TOOL_ANALYZE_CODEOWNERS_COVERAGE_DESCRIPTION", "Report which files of a GitHub repository are covered by a CODEOWNERS rule and which are orphaned, with no owner. Follows CODEOWNERS precedence, where the last matching rule wins and a rule without owners removes ownership.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ANALYZE_CODEOWNERS_COVERAGE_USER_TITLE", "Analyze CODEOWNERS coverage"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit to analyze. Defaults to the default branch."),
+			),
+			mcp.WithNumber("max_files",
+				mcp.Description(fmt.Sprintf("Maximum number of files to examine (default %d, max %d)", defaultCodeownersMaxFiles, maxCodeownersMaxFiles)),
+				mcp.Min(1),
+				mcp.Max(maxCodeownersMaxFiles),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxFiles, err := OptionalIntParamWithDefault(request, "max_files", defaultCodeownersMaxFiles)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxFiles < 1 || maxFiles > maxCodeownersMaxFiles {
+				return mcp.NewToolResultError(fmt.Sprintf("max_files must be between 1 and %d", maxCodeownersMaxFiles)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var codeownersPath, content string
+			for _, path := range codeownersPaths {
+				file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: ref})
+				if resp != nil {
+					_ = resp.Body.Close()
+				}
+				if err != nil {
+					if resp != nil && resp.StatusCode == http.StatusNotFound {
+						continue
+					}
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get CODEOWNERS file", resp, err), nil
+				}
+				if file == nil {
+					continue
+				}
+				content, err = file.GetContent()
+				if err != nil {
+					return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+				}
+				codeownersPath = path
+				break
+			}
+
+			treeRef := ref
+			if treeRef == "" {
+				treeRef = "HEAD"
+			}
+			tree, resp, err := client.Git.GetTree(ctx, owner, repo, treeRef, true)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get git tree", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			var paths []string
+			for _, entry := range tree.Entries {
+				if entry.GetType() == "blob" {
+					paths = append(paths, entry.GetPath())
+				}
+			}
+			sort.Strings(paths)
+
+			var notes []string
+			truncated := tree.GetTruncated()
+			if truncated {
+				notes = append(notes, "the repository tree is too large for GitHub to return in full, so some files were not examined")
+			}
+			if len(paths) > maxFiles {
+				notes = append(notes, fmt.Sprintf("only the first %d of %d files were examined; raise max_files to examine more", maxFiles, len(paths)))
+				paths = paths[:maxFiles]
+				truncated = true
+			}
+
+			var rules []codeownersRule
+			var ruleErrors []string
+			if codeownersPath == "" {
+				notes = append(notes, "no CODEOWNERS file was found in .github/, the repository root or docs/, so every file is orphaned")
+			} else {
+				rules, ruleErrors = parseCodeowners(content)
+			}
+
+			coverage := analyzeCodeowners(rules, paths)
+			coverage.Path = codeownersPath
+			coverage.Ref = ref
+			coverage.RuleErrors = ruleErrors
+			coverage.Truncated = truncated
+			coverage.Notes = append(notes, coverage.Notes...)
+
+			return MarshalledTextResult(coverage), nil
+		}
+}
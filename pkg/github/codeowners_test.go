@@ -0,0 +1,243 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CodeownersPatternToRegexp(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		matches  []string
+		excludes []string
+	}{
+		{
+			pattern: "*",
+			matches: []string{"README.md", "src/main.go"},
+		},
+		{
+			pattern:  "*.js",
+			matches:  []string{"app.js", "src/lib/app.js"},
+			excludes: []string{"app.jsx", "src/app.ts"},
+		},
+		{
+			pattern:  "/build/logs/",
+			matches:  []string{"build/logs/today.log", "build/logs/old/1.log"},
+			excludes: []string{"src/build/logs/today.log", "build/logs"},
+		},
+		{
+			pattern:  "docs/*",
+			matches:  []string{"docs/index.md"},
+			excludes: []string{"docs/guides/setup.md", "src/docs/index.md"},
+		},
+		{
+			pattern:  "apps/",
+			matches:  []string{"apps/web/index.ts", "packages/apps/cli.go"},
+			excludes: []string{"apps.go"},
+		},
+		{
+			pattern:  "**/logs",
+			matches:  []string{"logs/a.log", "deep/nested/logs/a.log"},
+			excludes: []string{"catalogs/a.log"},
+		},
+		{
+			pattern:  "/scripts",
+			matches:  []string{"scripts/release.sh"},
+			excludes: []string{"tools/scripts/release.sh"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.pattern, func(t *testing.T) {
+			re, err := codeownersPatternToRegexp(tc.pattern)
+			require.NoError(t, err)
+			for _, path := range tc.matches {
+				assert.True(t, re.MatchString(path), "%s should match %s", tc.pattern, path)
+			}
+			for _, path := range tc.excludes {
+				assert.False(t, re.MatchString(path), "%s should not match %s", tc.pattern, path)
+			}
+		})
+	}
+}
+
+func Test_MatchCodeownersLastMatchWins(t *testing.T) {
+	rules, errs := parseCodeowners(`# Default owners
+*          @org/everyone
+*.go       @org/go-team   # Go code
+/docs/     @org/docs
+/docs/api/ @org/api-team
+/vendor/
+`)
+	require.Empty(t, errs)
+	require.Len(t, rules, 5)
+
+	tests := map[string][]string{
+		"README.md":             {"@org/everyone"},
+		"cmd/server/main.go":    {"@org/go-team"},
+		"docs/index.md":         {"@org/docs"},
+		"docs/api/reference.md": {"@org/api-team"},
+		// A later rule without owners takes ownership away again.
+		"vendor/lib/lib.go": {},
+	}
+	for path, expected := range tests {
+		rule, ok := matchCodeowners(rules, path)
+		require.True(t, ok, path)
+		assert.ElementsMatch(t, expected, rule.Owners, path)
+	}
+}
+
+func Test_AnalyzeCodeownersCoverage(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AnalyzeCodeownersCoverage(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "analyze_codeowners_coverage", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.Properties, "max_files")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockTree := &github.Tree{
+		SHA: github.Ptr("abc123"),
+		Entries: []*github.TreeEntry{
+			{Path: github.Ptr("README.md"), Type: github.Ptr("blob")},
+			{Path: github.Ptr("src"), Type: github.Ptr("tree")},
+			{Path: github.Ptr("src/main.go"), Type: github.Ptr("blob")},
+			{Path: github.Ptr("src/util.go"), Type: github.Ptr("blob")},
+			{Path: github.Ptr("vendor/lib.go"), Type: github.Ptr("blob")},
+			{Path: github.Ptr("web/app.ts"), Type: github.Ptr("blob")},
+		},
+	}
+	codeowners := &github.RepositoryContent{
+		Type:    github.Ptr("file"),
+		Path:    github.Ptr("CODEOWNERS"),
+		Content: github.Ptr("*.go @org/go-team\n/vendor/\n/README.md @octocat\n"),
+	}
+	notFound := mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"})
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectedCoverage CodeownersCoverage
+	}{
+		{
+			name: "orphaned paths are reported",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						// The file lives at the root, so the .github/ location is checked first and missed.
+						if r.URL.Path != "/repos/owner/repo/contents/CODEOWNERS" {
+							notFound(w, r)
+							return
+						}
+						mockResponse(t, http.StatusOK, codeowners)(w, r)
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitTreesByOwnerByRepoByTreeSha,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/git/trees/HEAD",
+						queryParams: map[string]string{"recursive": "1"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockTree),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedCoverage: CodeownersCoverage{
+				Path:            "CODEOWNERS",
+				Rules:           3,
+				FilesExamined:   5,
+				Covered:         3,
+				Orphaned:        2,
+				CoveragePercent: 60,
+				FilesPerOwner:   map[string]int{"@org/go-team": 2, "@octocat": 1},
+				OrphanedPaths:   []string{"vendor/lib.go", "web/app.ts"},
+			},
+		},
+		{
+			name: "file cap truncates with a note",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusOK, codeowners),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposGitTreesByOwnerByRepoByTreeSha,
+					mockTree,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"ref":       "main",
+				"max_files": float64(2),
+			},
+			expectedCoverage: CodeownersCoverage{
+				Path:            ".github/CODEOWNERS",
+				Ref:             "main",
+				Rules:           3,
+				FilesExamined:   2,
+				Covered:         2,
+				CoveragePercent: 100,
+				FilesPerOwner:   map[string]int{"@org/go-team": 1, "@octocat": 1},
+				Truncated:       true,
+				Notes:           []string{"only the first 2 of 5 files were examined; raise max_files to examine more"},
+			},
+		},
+		{
+			name: "missing CODEOWNERS leaves everything orphaned",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					notFound,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposGitTreesByOwnerByRepoByTreeSha,
+					&github.Tree{Entries: mockTree.Entries[:1]},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedCoverage: CodeownersCoverage{
+				FilesExamined: 1,
+				Orphaned:      1,
+				OrphanedPaths: []string{"README.md"},
+				Notes:         []string{"no CODEOWNERS file was found in .github/, the repository root or docs/, so every file is orphaned"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AnalyzeCodeownersCoverage(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			var coverage CodeownersCoverage
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &coverage))
+			assert.Equal(t, tc.expectedCoverage, coverage)
+		})
+	}
+}
@@ -0,0 +1,429 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Codespace is a codespace of the authenticated user.
+type Codespace struct {
+	Name               string              `json:"name"`
+	DisplayName        string              `json:"display_name,omitempty"`
+	State              string              `json:"state"`
+	Repository         string              `json:"repository"`
+	Machine            string              `json:"machine,omitempty"`
+	GitStatus          *CodespaceGitStatus `json:"git_status,omitempty"`
+	IdleTimeoutMinutes int                 `json:"idle_timeout_minutes,omitempty"`
+	WebURL             string              `json:"web_url"`
+	CreatedAt          *time.Time          `json:"created_at,omitempty"`
+	LastUsedAt         *time.Time          `json:"last_used_at,omitempty"`
+}
+
+// CodespaceGitStatus is the state of the branch checked out in a codespace.
+type CodespaceGitStatus struct {
+	Ref                   string `json:"ref"`
+	Ahead                 int    `json:"ahead,omitempty"`
+	Behind                int    `json:"behind,omitempty"`
+	HasUnpushedChanges    bool   `json:"has_unpushed_changes,omitempty"`
+	HasUncommittedChanges bool   `json:"has_uncommitted_changes,omitempty"`
+}
+
+// CodespacesPage is a page of the codespaces of the authenticated user.
+type CodespacesPage struct {
+	TotalCount int         `json:"total_count"`
+	Codespaces []Codespace `json:"codespaces"`
+}
+
+// CodespaceMachine is a machine type a codespace can run on.
+type CodespaceMachine struct {
+	Name                 string `json:"name"`
+	DisplayName          string `json:"display_name"`
+	OperatingSystem      string `json:"operating_system"`
+	CPUs                 int    `json:"cpus"`
+	MemoryInBytes        int64  `json:"memory_in_bytes"`
+	StorageInBytes       int64  `json:"storage_in_bytes"`
+	PrebuildAvailability string `json:"prebuild_availability,omitempty"`
+}
+
+func newCodespace(c *github.Codespace) Codespace {
+	codespace := Codespace{
+		Name:               c.GetName(),
+		DisplayName:        c.GetDisplayName(),
+		State:              c.GetState(),
+		Repository:         c.GetRepository().GetFullName(),
+		Machine:            c.GetMachine().GetName(),
+		IdleTimeoutMinutes: c.GetIdleTimeoutMinutes(),
+		WebURL:             c.GetWebURL(),
+	}
+	if c.GitStatus != nil {
+		codespace.GitStatus = &CodespaceGitStatus{
+			Ref:                   c.GitStatus.GetRef(),
+			Ahead:                 c.GitStatus.GetAhead(),
+			Behind:                c.GitStatus.GetBehind(),
+			HasUnpushedChanges:    c.GitStatus.GetHasUnpushedChanges(),
+			HasUncommittedChanges: c.GitStatus.GetHasUncommittedChanges(),
+		}
+	}
+	if c.CreatedAt != nil {
+		codespace.CreatedAt = &c.CreatedAt.Time
+	}
+	if c.LastUsedAt != nil {
+		codespace.LastUsedAt = &c.LastUsedAt.Time
+	}
+	return codespace
+}
+
+// ListCodespaces creates a tool to list the codespaces of the authenticated user.
+func ListCodespaces(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_codespaces",
+			mcp.WithDescription(t("TOOL_LIST_CODESPACES_DESCRIPTION", "List the codespaces of the authenticated user, with their state, repository, branch and machine type. Give owner and repo to only list the codespaces of one repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_CODESPACES_USER_TITLE", "List codespaces"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Description("Repository owner, to only list the codespaces of a repository"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Repository name, to only list the codespaces of a repository"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := OptionalParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (owner == "") != (repo == "") {
+				return mcp.NewToolResultError("owner and repo must be given together"), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts := github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var codespaces *github.ListCodespaces
+			var resp *github.Response
+			if repo != "" {
+				codespaces, resp, err = client.Codespaces.ListInRepo(ctx, owner, repo, &opts)
+			} else {
+				codespaces, resp, err = client.Codespaces.List(ctx, &github.ListCodespacesOptions{ListOptions: opts})
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list codespaces", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := CodespacesPage{
+				TotalCount: codespaces.GetTotalCount(),
+				Codespaces: make([]Codespace, 0, len(codespaces.Codespaces)),
+			}
+			for _, c := range codespaces.Codespaces {
+				result.Codespaces = append(result.Codespaces, newCodespace(c))
+			}
+			info := ListPageInfo{TotalCount: github.Ptr(result.TotalCount)}
+			return MarshalledListPageResult(ctx, result, result.Codespaces, info, "no codespaces found"), nil
+		}
+}
+
+// ListCodespaceMachines creates a tool to list the machine types available for codespaces of a repository.
+func ListCodespaceMachines(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_codespace_machines",
+			mcp.WithDescription(t("TOOL_LIST_CODESPACE_MACHINES_DESCRIPTION", "List the machine types a codespace of a GitHub repository can run on, with their CPUs, memory and storage. Pass the name of one as machine to create_codespace.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_CODESPACE_MACHINES_USER_TITLE", "List codespace machine types"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch or commit to check prebuild availability for"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// go-github has no wrapper for this endpoint yet.
+			u := fmt.Sprintf("repos/%s/%s/codespaces/machines", owner, repo)
+			if ref != "" {
+				u += "?" + url.Values{"ref": {ref}}.Encode()
+			}
+			req, err := client.NewRequest(http.MethodGet, u, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			var machines struct {
+				Machines []*github.CodespacesMachine `json:"machines"`
+			}
+			resp, err := client.Do(ctx, req, &machines)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list codespace machine types", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]CodespaceMachine, 0, len(machines.Machines))
+			for _, m := range machines.Machines {
+				result = append(result, CodespaceMachine{
+					Name:                 m.GetName(),
+					DisplayName:          m.GetDisplayName(),
+					OperatingSystem:      m.GetOperatingSystem(),
+					CPUs:                 m.GetCPUs(),
+					MemoryInBytes:        m.GetMemoryInBytes(),
+					StorageInBytes:       m.GetStorageInBytes(),
+					PrebuildAvailability: m.GetPrebuildAvailability(),
+				})
+			}
+			return MarshalledListResult(ctx, result, "no machine types available"), nil
+		}
+}
+
+// CreateCodespace creates a tool to create a codespace for a repository.
+func CreateCodespace(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_codespace",
+			mcp.WithDescription(t("TOOL_CREATE_CODESPACE_DESCRIPTION", "Create a codespace for the authenticated user on a branch of a GitHub repository. Codespaces are billed while they run and for their storage, so stop or delete them when they are no longer needed.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_CODESPACE_USER_TITLE", "Create codespace"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch to check out in the codespace (default: the default branch)"),
+			),
+			mcp.WithString("machine",
+				mcp.Description("Machine type, as listed by list_codespace_machines (default: the repository's default)"),
+			),
+			mcp.WithString("devcontainer_path",
+				mcp.Description("Path of the devcontainer.json to use, when the repository has several"),
+			),
+			mcp.WithString("display_name",
+				mcp.Description("Display name of the codespace"),
+			),
+			mcp.WithString("geo",
+				mcp.Description("Geographic area to create the codespace in (default: based on your IP)"),
+				mcp.Enum("EuropeWest", "SoutheastAsia", "UsEast", "UsWest"),
+			),
+			mcp.WithNumber("idle_timeout_minutes",
+				mcp.Description("Minutes of inactivity after which the codespace stops"),
+				mcp.Min(5),
+				mcp.Max(240),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			machine, err := OptionalParam[string](request, "machine")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			devcontainerPath, err := OptionalParam[string](request, "devcontainer_path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			displayName, err := OptionalParam[string](request, "display_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			geo, err := OptionalParam[string](request, "geo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			idleTimeout, err := OptionalIntParam(request, "idle_timeout_minutes")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.CreateCodespaceOptions{
+				Ref:              optionalStringPtr(ref),
+				Machine:          optionalStringPtr(machine),
+				DevcontainerPath: optionalStringPtr(devcontainerPath),
+				DisplayName:      optionalStringPtr(displayName),
+				Geo:              optionalStringPtr(geo),
+			}
+			if idleTimeout > 0 {
+				opts.IdleTimeoutMinutes = github.Ptr(idleTimeout)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			codespace, resp, err := client.Codespaces.CreateInRepo(ctx, owner, repo, opts)
+			if err != nil {
+				// GitHub answers 202 when it finishes creating the codespace in the background.
+				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
+					_ = resp.Body.Close()
+					return mcp.NewToolResultText(fmt.Sprintf("GitHub is still creating a codespace for %s/%s; check list_codespaces in a few minutes.", owner, repo)), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to create codespace for %s/%s", owner, repo), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newCodespace(codespace)), nil
+		}
+}
+
+// StartCodespace creates a tool to start a codespace.
+func StartCodespace(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("start_codespace",
+			mcp.WithDescription(t("TOOL_START_CODESPACE_DESCRIPTION", "Start a stopped codespace of the authenticated user")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_START_CODESPACE_USER_TITLE", "Start codespace"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("codespace_name",
+				mcp.Required(),
+				mcp.Description("Name of the codespace, as listed by list_codespaces"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			name, err := RequiredParam[string](request, "codespace_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			codespace, resp, err := client.Codespaces.Start(ctx, name)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to start codespace %s", name), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newCodespace(codespace)), nil
+		}
+}
+
+// StopCodespace creates a tool to stop a codespace.
+func StopCodespace(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("stop_codespace",
+			mcp.WithDescription(t("TOOL_STOP_CODESPACE_DESCRIPTION", "Stop a running codespace of the authenticated user. Its files are kept, and it can be started again.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_STOP_CODESPACE_USER_TITLE", "Stop codespace"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("codespace_name",
+				mcp.Required(),
+				mcp.Description("Name of the codespace, as listed by list_codespaces"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			name, err := RequiredParam[string](request, "codespace_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			codespace, resp, err := client.Codespaces.Stop(ctx, name)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to stop codespace %s", name), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newCodespace(codespace)), nil
+		}
+}
+
+// DeleteCodespace creates a tool to delete a codespace.
+func DeleteCodespace(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_codespace",
+			mcp.WithDescription(t("TOOL_DELETE_CODESPACE_DESCRIPTION", "Delete a codespace of the authenticated user. Changes that were not pushed are lost.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_DELETE_CODESPACE_USER_TITLE", "Delete codespace"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("codespace_name",
+				mcp.Required(),
+				mcp.Description("Name of the codespace, as listed by list_codespaces"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			name, err := RequiredParam[string](request, "codespace_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Codespaces.Delete(ctx, name)
+			// GitHub answers 202 because the codespace is deleted in the background.
+			if err != nil && !(resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err)) {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to delete codespace %s", name), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Successfully deleted codespace %s", name)), nil
+		}
+}
@@ -0,0 +1,341 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mockCodespace = &github.Codespace{
+	Name:       github.Ptr("monalisa-fluffy-spoon-abc123"),
+	State:      github.Ptr("Available"),
+	Repository: &github.Repository{FullName: github.Ptr("owner/repo")},
+	Machine:    &github.CodespacesMachine{Name: github.Ptr("standardLinux32gb")},
+	GitStatus:  &github.CodespacesGitStatus{Ref: github.Ptr("main"), Ahead: github.Ptr(1), HasUnpushedChanges: github.Ptr(true)},
+	WebURL:     github.Ptr("https://monalisa-fluffy-spoon-abc123.github.dev"),
+}
+
+var expectedCodespace = Codespace{
+	Name:       "monalisa-fluffy-spoon-abc123",
+	State:      "Available",
+	Repository: "owner/repo",
+	Machine:    "standardLinux32gb",
+	GitStatus:  &CodespaceGitStatus{Ref: "main", Ahead: 1, HasUnpushedChanges: true},
+	WebURL:     "https://monalisa-fluffy-spoon-abc123.github.dev",
+}
+
+func Test_ListCodespaces(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListCodespaces(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_codespaces", tool.Name)
+	assert.Empty(t, tool.InputSchema.Required)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	codespaces := &github.ListCodespaces{TotalCount: github.Ptr(1), Codespaces: []*github.Codespace{mockCodespace}}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "codespaces of the user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUserCodespaces,
+					expectQueryParams(t, map[string]string{"page": "1", "per_page": "30"}).andThen(
+						mockResponse(t, http.StatusOK, codespaces),
+					),
+				),
+			),
+			requestArgs: map[string]any{},
+		},
+		{
+			name: "codespaces of a repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCodespacesByOwnerByRepo,
+					expectPath(t, "/repos/owner/repo/codespaces").andThen(
+						mockResponse(t, http.StatusOK, codespaces),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+		},
+		{
+			name:         "owner without repo",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner": "owner",
+			},
+			expectError:    true,
+			expectedErrMsg: "owner and repo must be given together",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListCodespaces(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned CodespacesPage
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, CodespacesPage{TotalCount: 1, Codespaces: []Codespace{expectedCodespace}}, returned)
+		})
+	}
+}
+
+func Test_ListCodespaceMachines(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListCodespaceMachines(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_codespace_machines", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposCodespacesMachinesByOwnerByRepo,
+			expect(t, expectations{
+				path:        "/repos/owner/repo/codespaces/machines",
+				queryParams: map[string]string{"ref": "feature"},
+			}).andThen(
+				mockResponse(t, http.StatusOK, map[string]any{
+					"total_count": 1,
+					"machines": []*github.CodespacesMachine{
+						{
+							Name:                 github.Ptr("standardLinux32gb"),
+							DisplayName:          github.Ptr("4 cores, 16 GB RAM, 32 GB storage"),
+							OperatingSystem:      github.Ptr("linux"),
+							CPUs:                 github.Ptr(4),
+							MemoryInBytes:        github.Ptr(int64(17179869184)),
+							StorageInBytes:       github.Ptr(int64(34359738368)),
+							PrebuildAvailability: github.Ptr("ready"),
+						},
+					},
+				}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := ListCodespaceMachines(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner": "owner",
+		"repo":  "repo",
+		"ref":   "feature",
+	}))
+	require.NoError(t, err)
+
+	var returned []CodespaceMachine
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, []CodespaceMachine{{
+		Name:                 "standardLinux32gb",
+		DisplayName:          "4 cores, 16 GB RAM, 32 GB storage",
+		OperatingSystem:      "linux",
+		CPUs:                 4,
+		MemoryInBytes:        17179869184,
+		StorageInBytes:       34359738368,
+		PrebuildAvailability: "ready",
+	}}, returned)
+}
+
+func Test_CreateCodespace(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateCodespace(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_codespace", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	requestArgs := map[string]any{
+		"owner":                "owner",
+		"repo":                 "repo",
+		"ref":                  "main",
+		"machine":              "standardLinux32gb",
+		"idle_timeout_minutes": float64(30),
+	}
+	expectedBody := map[string]any{
+		"ref":                  "main",
+		"machine":              "standardLinux32gb",
+		"idle_timeout_minutes": float64(30),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "codespace created",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposCodespacesByOwnerByRepo,
+					expectRequestBody(t, expectedBody).andThen(
+						mockResponse(t, http.StatusCreated, mockCodespace),
+					),
+				),
+			),
+		},
+		{
+			name: "creation finishing in the background",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposCodespacesByOwnerByRepo,
+					mockResponse(t, http.StatusAccepted, mockCodespace),
+				),
+			),
+			expectedText: "GitHub is still creating a codespace for owner/repo; check list_codespaces in a few minutes.",
+		},
+		{
+			name: "codespaces not allowed",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposCodespacesByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "You are not allowed to create codespaces for this repository"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to create codespace for owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateCodespace(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			if tc.expectedText != "" {
+				assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+				return
+			}
+
+			var returned Codespace
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, expectedCodespace, returned)
+		})
+	}
+}
+
+func Test_StartCodespace(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := StartCodespace(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "start_codespace", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"codespace_name"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostUserCodespacesStartByCodespaceName,
+			expectPath(t, "/user/codespaces/monalisa-fluffy-spoon-abc123/start").andThen(
+				mockResponse(t, http.StatusOK, mockCodespace),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := StartCodespace(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"codespace_name": "monalisa-fluffy-spoon-abc123",
+	}))
+	require.NoError(t, err)
+
+	var returned Codespace
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, expectedCodespace, returned)
+}
+
+func Test_StopCodespace(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := StopCodespace(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "stop_codespace", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"codespace_name"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostUserCodespacesStopByCodespaceName,
+			mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := StopCodespace(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"codespace_name": "missing",
+	}))
+	require.NoError(t, err)
+	assert.Contains(t, getErrorResult(t, result).Text, "failed to stop codespace missing")
+}
+
+func Test_DeleteCodespace(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteCodespace(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_codespace", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"codespace_name"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteUserCodespacesByCodespaceName,
+			expectPath(t, "/user/codespaces/monalisa-fluffy-spoon-abc123").andThen(
+				mockResponse(t, http.StatusAccepted, map[string]any{}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := DeleteCodespace(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"codespace_name": "monalisa-fluffy-spoon-abc123",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "Successfully deleted codespace monalisa-fluffy-spoon-abc123", getTextResult(t, result).Text)
+}
@@ -0,0 +1,236 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Collaborator is a user with access to a repository, with the role they have on it.
+type Collaborator struct {
+	Login string `json:"login"`
+	// Role is the user's repository role: one of the built-in roles (read, triage, write, maintain,
+	// admin) or the name of a custom role.
+	Role string `json:"role"`
+	// Permission is the highest base permission the role grants.
+	Permission string `json:"permission"`
+	ProfileURL string `json:"profile_url,omitempty"`
+}
+
+// CollaboratorInvitationResult is the outcome of add_collaborator.
+type CollaboratorInvitationResult struct {
+	Username   string `json:"username"`
+	Permission string `json:"permission"`
+	// Invited is false when the user already had access and only their permission was changed.
+	Invited      bool   `json:"invited"`
+	InvitationID int64  `json:"invitation_id,omitempty"`
+	URL          string `json:"url,omitempty"`
+}
+
+// ListCollaborators creates a tool to list the collaborators of a repository with their permissions.
+func ListCollaborators(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_collaborators",
+			mcp.WithDescription(t("TOOL_LIST_COLLABORATORS_DESCRIPTION", "List the users with access to a GitHub repository and the role each one has, for access reviews. Organization members with access through teams or base permissions are included unless affiliation is set.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_COLLABORATORS_USER_TITLE", "List repository collaborators"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("affiliation",
+				mcp.Description("outside lists only outside collaborators, direct only users with direct access, all (the default) everyone"),
+				mcp.Enum("outside", "direct", "all"),
+			),
+			mcp.WithString("permission",
+				mcp.Description("Only list users with this permission"),
+				mcp.Enum("pull", "triage", "push", "maintain", "admin"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			affiliation, err := OptionalParam[string](request, "affiliation")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			permission, err := OptionalParam[string](request, "permission")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			users, resp, err := client.Repositories.ListCollaborators(ctx, owner, repo, &github.ListCollaboratorsOptions{
+				Affiliation: affiliation,
+				Permission:  permission,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list collaborators", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]Collaborator, 0, len(users))
+			for _, u := range users {
+				result = append(result, Collaborator{
+					Login:      u.GetLogin(),
+					Role:       u.GetRoleName(),
+					Permission: highestPermission(u.Permissions),
+					ProfileURL: u.GetHTMLURL(),
+				})
+			}
+			return MarshalledListResult(ctx, result, "no collaborators found"), nil
+		}
+}
+
+// AddCollaborator creates a tool to invite a user to a repository or change their permission.
+func AddCollaborator(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_collaborator",
+			mcp.WithDescription(t("TOOL_ADD_COLLABORATOR_DESCRIPTION", "Invite a user to collaborate on a GitHub repository with the given permission. If the user already has access, their permission is changed instead and no invitation is sent.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ADD_COLLABORATOR_USER_TITLE", "Add repository collaborator"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("GitHub username of the collaborator"),
+			),
+			mcp.WithString("permission",
+				mcp.Description("Permission to grant: pull, triage, push, maintain, admin, or the name of a custom repository role in the organization. Defaults to push."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			permission, err := OptionalParam[string](request, "permission")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if permission == "" {
+				permission = "push"
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			invitation, resp, err := client.Repositories.AddCollaborator(ctx, owner, repo, username, &github.RepositoryAddCollaboratorOptions{
+				Permission: permission,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to add collaborator %s", username), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := CollaboratorInvitationResult{
+				Username:   username,
+				Permission: permission,
+			}
+			// GitHub answers 204 without an invitation when the user already has access.
+			if resp.StatusCode != http.StatusNoContent {
+				result.Invited = true
+				result.InvitationID = invitation.GetID()
+				result.URL = invitation.GetHTMLURL()
+			}
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// RemoveCollaborator creates a tool to remove a collaborator from a repository.
+func RemoveCollaborator(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_collaborator",
+			mcp.WithDescription(t("TOOL_REMOVE_COLLABORATOR_DESCRIPTION", "Remove a collaborator from a GitHub repository. Access the user has through an organization team or base permission is not affected.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_REMOVE_COLLABORATOR_USER_TITLE", "Remove repository collaborator"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("GitHub username of the collaborator"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Repositories.RemoveCollaborator(ctx, owner, repo, username)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to remove collaborator %s", username), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Successfully removed %s from %s/%s", username, owner, repo)), nil
+		}
+}
@@ -0,0 +1,193 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListCollaborators(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListCollaborators(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_collaborators", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposCollaboratorsByOwnerByRepo,
+			expectQueryParams(t, map[string]string{
+				"affiliation": "outside",
+				"page":        "1",
+				"per_page":    "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, []*github.User{
+					{
+						Login:       github.Ptr("contractor"),
+						RoleName:    github.Ptr("write"),
+						Permissions: map[string]bool{"admin": false, "maintain": false, "push": true, "triage": true, "pull": true},
+					},
+					{
+						Login:       github.Ptr("auditor"),
+						RoleName:    github.Ptr("security-reviewer"),
+						Permissions: map[string]bool{"pull": true},
+					},
+				}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := ListCollaborators(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":       "owner",
+		"repo":        "repo",
+		"affiliation": "outside",
+	}))
+	require.NoError(t, err)
+
+	var returned []Collaborator
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, []Collaborator{
+		{Login: "contractor", Role: "write", Permission: "push"},
+		{Login: "auditor", Role: "security-reviewer", Permission: "pull"},
+	}, returned)
+}
+
+func Test_AddCollaborator(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AddCollaborator(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "add_collaborator", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "username"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       CollaboratorInvitationResult
+	}{
+		{
+			name: "invite a new collaborator",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposCollaboratorsByOwnerByRepoByUsername,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/collaborators/newcomer",
+						requestBody: map[string]any{"permission": "triage"},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.CollaboratorInvitation{
+							ID:      github.Ptr(int64(77)),
+							HTMLURL: github.Ptr("https://github.com/owner/repo/invitations"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]any{"username": "newcomer", "permission": "triage"},
+			expected: CollaboratorInvitationResult{
+				Username:     "newcomer",
+				Permission:   "triage",
+				Invited:      true,
+				InvitationID: 77,
+				URL:          "https://github.com/owner/repo/invitations",
+			},
+		},
+		{
+			name: "change the permission of an existing collaborator",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposCollaboratorsByOwnerByRepoByUsername,
+					expectRequestBody(t, map[string]any{"permission": "push"}).andThen(
+						mockResponse(t, http.StatusNoContent, nil),
+					),
+				),
+			),
+			requestArgs: map[string]any{"username": "contractor"},
+			expected: CollaboratorInvitationResult{
+				Username:   "contractor",
+				Permission: "push",
+			},
+		},
+		{
+			name: "unknown role",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposCollaboratorsByOwnerByRepoByUsername,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Validation Failed"}),
+				),
+			),
+			requestArgs:    map[string]any{"username": "newcomer", "permission": "owner"},
+			expectError:    true,
+			expectedErrMsg: "failed to add collaborator newcomer",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AddCollaborator(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			args := map[string]any{"owner": "owner", "repo": "repo"}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned CollaboratorInvitationResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
+
+func Test_RemoveCollaborator(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RemoveCollaborator(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "remove_collaborator", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "username"})
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteReposCollaboratorsByOwnerByRepoByUsername,
+			expectPath(t, "/repos/owner/repo/collaborators/contractor").andThen(
+				mockResponse(t, http.StatusNoContent, nil),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := RemoveCollaborator(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":    "owner",
+		"repo":     "repo",
+		"username": "contractor",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "Successfully removed contractor from owner/repo", getTextResult(t, result).Text)
+}
@@ -0,0 +1,434 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// CommitStatus is one status reported on a commit by an external system.
+type CommitStatus struct {
+	Context     string     `json:"context"`
+	State       string     `json:"state"`
+	Description string     `json:"description,omitempty"`
+	TargetURL   string     `json:"target_url,omitempty"`
+	Creator     string     `json:"creator,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// CombinedCommitStatus is the overall state of the statuses on a commit, with the latest status
+// for each context.
+type CombinedCommitStatus struct {
+	SHA        string         `json:"sha"`
+	State      string         `json:"state"`
+	TotalCount int            `json:"total_count"`
+	Statuses   []CommitStatus `json:"statuses"`
+}
+
+// CommitCheckRun is a check run on a commit.
+type CommitCheckRun struct {
+	ID          int64      `json:"id"`
+	Name        string     `json:"name"`
+	Status      string     `json:"status"`
+	Conclusion  string     `json:"conclusion,omitempty"`
+	App         string     `json:"app,omitempty"`
+	URL         string     `json:"url"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// CommitCheckRuns is a page of the check runs on a commit.
+type CommitCheckRuns struct {
+	TotalCount int              `json:"total_count"`
+	CheckRuns  []CommitCheckRun `json:"check_runs"`
+}
+
+// CommitCheckSuite is the check suite one GitHub App created for a commit.
+type CommitCheckSuite struct {
+	ID         int64      `json:"id"`
+	App        string     `json:"app,omitempty"`
+	HeadBranch string     `json:"head_branch,omitempty"`
+	Status     string     `json:"status"`
+	Conclusion string     `json:"conclusion,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+// CommitCheckSuites is a page of the check suites on a commit.
+type CommitCheckSuites struct {
+	TotalCount  int                `json:"total_count"`
+	CheckSuites []CommitCheckSuite `json:"check_suites"`
+}
+
+func newCommitStatus(s *github.RepoStatus) CommitStatus {
+	status := CommitStatus{
+		Context:     s.GetContext(),
+		State:       s.GetState(),
+		Description: s.GetDescription(),
+		TargetURL:   s.GetTargetURL(),
+		Creator:     s.GetCreator().GetLogin(),
+	}
+	if s.UpdatedAt != nil {
+		status.UpdatedAt = &s.UpdatedAt.Time
+	}
+	return status
+}
+
+func newCommitCheckRun(r *github.CheckRun) CommitCheckRun {
+	run := CommitCheckRun{
+		ID:         r.GetID(),
+		Name:       r.GetName(),
+		Status:     r.GetStatus(),
+		Conclusion: r.GetConclusion(),
+		App:        r.GetApp().GetSlug(),
+		URL:        r.GetHTMLURL(),
+	}
+	if r.StartedAt != nil {
+		run.StartedAt = &r.StartedAt.Time
+	}
+	if r.CompletedAt != nil {
+		run.CompletedAt = &r.CompletedAt.Time
+	}
+	return run
+}
+
+func newCommitCheckSuite(s *github.CheckSuite) CommitCheckSuite {
+	suite := CommitCheckSuite{
+		ID:         s.GetID(),
+		App:        s.GetApp().GetSlug(),
+		HeadBranch: s.GetHeadBranch(),
+		Status:     s.GetStatus(),
+		Conclusion: s.GetConclusion(),
+	}
+	if s.UpdatedAt != nil {
+		suite.UpdatedAt = &s.UpdatedAt.Time
+	}
+	return suite
+}
+
+// GetCombinedCommitStatus creates a tool to get the combined commit status of a ref.
+func GetCombinedCommitStatus(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_combined_commit_status",
+			mcp.WithDescription(t("TOOL_GET_COMBINED_COMMIT_STATUS_DESCRIPTION", "Get the combined state of the commit statuses on a branch, tag or commit SHA, with the latest status for each context. The state is failure if any context failed, pending if any is still pending or there are none, and success otherwise. Check runs, which GitHub Actions reports, are not included; use list_commit_check_runs for those.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_COMBINED_COMMIT_STATUS_USER_TITLE", "Get combined commit status"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Required(),
+				mcp.Description("Branch name, tag name or commit SHA"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := RequiredParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			combined, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, ref, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get combined status of %s", ref), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := CombinedCommitStatus{
+				SHA:        combined.GetSHA(),
+				State:      combined.GetState(),
+				TotalCount: combined.GetTotalCount(),
+				Statuses:   make([]CommitStatus, 0, len(combined.Statuses)),
+			}
+			for _, s := range combined.Statuses {
+				result.Statuses = append(result.Statuses, newCommitStatus(s))
+			}
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// ListCommitCheckRuns creates a tool to list the check runs on a ref.
+func ListCommitCheckRuns(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_commit_check_runs",
+			mcp.WithDescription(t("TOOL_LIST_COMMIT_CHECK_RUNS_DESCRIPTION", "List the check runs on a branch, tag or commit SHA, such as GitHub Actions jobs and checks reported by other GitHub Apps.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_COMMIT_CHECK_RUNS_USER_TITLE", "List commit check runs"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Required(),
+				mcp.Description("Branch name, tag name or commit SHA"),
+			),
+			mcp.WithString("check_name",
+				mcp.Description("Only list check runs with this name"),
+			),
+			mcp.WithString("status",
+				mcp.Description("Only list check runs with this status"),
+				mcp.Enum("queued", "in_progress", "completed"),
+			),
+			mcp.WithString("filter",
+				mcp.Description("List only the latest run of each check, or every attempt (default: latest)"),
+				mcp.Enum("latest", "all"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := RequiredParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			checkName, err := OptionalParam[string](request, "check_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			status, err := OptionalParam[string](request, "status")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			filter, err := OptionalParam[string](request, "filter")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			runs, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, ref, &github.ListCheckRunsOptions{
+				CheckName: optionalStringPtr(checkName),
+				Status:    optionalStringPtr(status),
+				Filter:    optionalStringPtr(filter),
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list check runs of %s", ref), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := CommitCheckRuns{
+				TotalCount: runs.GetTotal(),
+				CheckRuns:  make([]CommitCheckRun, 0, len(runs.CheckRuns)),
+			}
+			for _, r := range runs.CheckRuns {
+				result.CheckRuns = append(result.CheckRuns, newCommitCheckRun(r))
+			}
+			info := ListPageInfo{TotalCount: github.Ptr(result.TotalCount)}
+			return MarshalledListPageResult(ctx, result, result.CheckRuns, info, fmt.Sprintf("no check runs matched for %s", ref)), nil
+		}
+}
+
+// ListCommitCheckSuites creates a tool to list the check suites on a ref.
+func ListCommitCheckSuites(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_commit_check_suites",
+			mcp.WithDescription(t("TOOL_LIST_COMMIT_CHECK_SUITES_DESCRIPTION", "List the check suites on a branch, tag or commit SHA. Each GitHub App that checks the commit has one suite, whose conclusion summarises its check runs.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_COMMIT_CHECK_SUITES_USER_TITLE", "List commit check suites"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Required(),
+				mcp.Description("Branch name, tag name or commit SHA"),
+			),
+			mcp.WithString("check_name",
+				mcp.Description("Only list check suites containing a check run with this name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := RequiredParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			checkName, err := OptionalParam[string](request, "check_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			suites, resp, err := client.Checks.ListCheckSuitesForRef(ctx, owner, repo, ref, &github.ListCheckSuiteOptions{
+				CheckName: optionalStringPtr(checkName),
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list check suites of %s", ref), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := CommitCheckSuites{
+				TotalCount:  suites.GetTotal(),
+				CheckSuites: make([]CommitCheckSuite, 0, len(suites.CheckSuites)),
+			}
+			for _, s := range suites.CheckSuites {
+				result.CheckSuites = append(result.CheckSuites, newCommitCheckSuite(s))
+			}
+			info := ListPageInfo{TotalCount: github.Ptr(result.TotalCount)}
+			return MarshalledListPageResult(ctx, result, result.CheckSuites, info, fmt.Sprintf("no check suites matched for %s", ref)), nil
+		}
+}
+
+// CreateCommitStatus creates a tool to report a status on a commit.
+func CreateCommitStatus(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_commit_status",
+			mcp.WithDescription(t("TOOL_CREATE_COMMIT_STATUS_DESCRIPTION", "Report a status on a commit, shown on pull requests and usable as a required status check. To update a status, create another with the same context; the latest one for each context wins.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_COMMIT_STATUS_USER_TITLE", "Create commit status"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("sha",
+				mcp.Required(),
+				mcp.Description("SHA of the commit"),
+			),
+			mcp.WithString("state",
+				mcp.Required(),
+				mcp.Description("State of the status"),
+				mcp.Enum("error", "failure", "pending", "success"),
+			),
+			mcp.WithString("context",
+				mcp.Description("Label that tells this status apart from those of other systems (default: default)"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Short description of the status"),
+			),
+			mcp.WithString("target_url",
+				mcp.Description("URL with the details of the status, linked from the status on GitHub"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := RequiredParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := RequiredParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			statusContext, err := OptionalParam[string](request, "context")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			description, err := OptionalParam[string](request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			targetURL, err := OptionalParam[string](request, "target_url")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			status, resp, err := client.Repositories.CreateStatus(ctx, owner, repo, sha, &github.RepoStatus{
+				State:       github.Ptr(state),
+				Context:     optionalStringPtr(statusContext),
+				Description: optionalStringPtr(description),
+				TargetURL:   optionalStringPtr(targetURL),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to create status on %s", sha), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newCommitStatus(status)), nil
+		}
+}
@@ -0,0 +1,263 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetCombinedCommitStatus(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCombinedCommitStatus(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_combined_commit_status", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ref"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "combined status",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/commits/main/status",
+						queryParams: map[string]string{"page": "1", "per_page": "30"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.CombinedStatus{
+							SHA:        github.Ptr("abc123"),
+							State:      github.Ptr("failure"),
+							TotalCount: github.Ptr(2),
+							Statuses: []*github.RepoStatus{
+								{
+									Context:   github.Ptr("ci/build"),
+									State:     github.Ptr("success"),
+									TargetURL: github.Ptr("https://ci.example.com/builds/1"),
+									Creator:   &github.User{Login: github.Ptr("ci-bot")},
+								},
+								{
+									Context:     github.Ptr("ci/deploy-preview"),
+									State:       github.Ptr("failure"),
+									Description: github.Ptr("Preview failed to start"),
+								},
+							},
+						}),
+					),
+				),
+			),
+		},
+		{
+			name: "ref not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "No commit found for SHA: main"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get combined status of main",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetCombinedCommitStatus(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "main",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned CombinedCommitStatus
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, CombinedCommitStatus{
+				SHA:        "abc123",
+				State:      "failure",
+				TotalCount: 2,
+				Statuses: []CommitStatus{
+					{Context: "ci/build", State: "success", TargetURL: "https://ci.example.com/builds/1", Creator: "ci-bot"},
+					{Context: "ci/deploy-preview", State: "failure", Description: "Preview failed to start"},
+				},
+			}, returned)
+		})
+	}
+}
+
+func Test_ListCommitCheckRuns(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListCommitCheckRuns(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_commit_check_runs", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ref"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+			expect(t, expectations{
+				path: "/repos/owner/repo/commits/abc123/check-runs",
+				queryParams: map[string]string{
+					"status":   "completed",
+					"page":     "1",
+					"per_page": "30",
+				},
+			}).andThen(
+				mockResponse(t, http.StatusOK, &github.ListCheckRunsResults{
+					Total: github.Ptr(1),
+					CheckRuns: []*github.CheckRun{
+						{
+							ID:         github.Ptr(int64(7)),
+							Name:       github.Ptr("test (ubuntu-latest)"),
+							Status:     github.Ptr("completed"),
+							Conclusion: github.Ptr("failure"),
+							App:        &github.App{Slug: github.Ptr("github-actions")},
+							HTMLURL:    github.Ptr("https://github.com/owner/repo/runs/7"),
+						},
+					},
+				}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := ListCommitCheckRuns(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":  "owner",
+		"repo":   "repo",
+		"ref":    "abc123",
+		"status": "completed",
+	}))
+	require.NoError(t, err)
+
+	var returned CommitCheckRuns
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, CommitCheckRuns{
+		TotalCount: 1,
+		CheckRuns: []CommitCheckRun{{
+			ID:         7,
+			Name:       "test (ubuntu-latest)",
+			Status:     "completed",
+			Conclusion: "failure",
+			App:        "github-actions",
+			URL:        "https://github.com/owner/repo/runs/7",
+		}},
+	}, returned)
+}
+
+func Test_ListCommitCheckSuites(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListCommitCheckSuites(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_commit_check_suites", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ref"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposCommitsCheckSuitesByOwnerByRepoByRef,
+			expectPath(t, "/repos/owner/repo/commits/main/check-suites").andThen(
+				mockResponse(t, http.StatusOK, &github.ListCheckSuiteResults{
+					Total: github.Ptr(1),
+					CheckSuites: []*github.CheckSuite{
+						{
+							ID:         github.Ptr(int64(3)),
+							App:        &github.App{Slug: github.Ptr("github-actions")},
+							HeadBranch: github.Ptr("main"),
+							Status:     github.Ptr("in_progress"),
+						},
+					},
+				}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := ListCommitCheckSuites(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner": "owner",
+		"repo":  "repo",
+		"ref":   "main",
+	}))
+	require.NoError(t, err)
+
+	var returned CommitCheckSuites
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, CommitCheckSuites{
+		TotalCount:  1,
+		CheckSuites: []CommitCheckSuite{{ID: 3, App: "github-actions", HeadBranch: "main", Status: "in_progress"}},
+	}, returned)
+}
+
+func Test_CreateCommitStatus(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateCommitStatus(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_commit_status", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "sha", "state"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposStatusesByOwnerByRepoBySha,
+			expect(t, expectations{
+				path: "/repos/owner/repo/statuses/abc123",
+				requestBody: map[string]any{
+					"state":       "pending",
+					"context":     "agent/review",
+					"description": "Review in progress",
+				},
+			}).andThen(
+				mockResponse(t, http.StatusCreated, &github.RepoStatus{
+					Context:     github.Ptr("agent/review"),
+					State:       github.Ptr("pending"),
+					Description: github.Ptr("Review in progress"),
+				}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := CreateCommitStatus(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":       "owner",
+		"repo":        "repo",
+		"sha":         "abc123",
+		"state":       "pending",
+		"context":     "agent/review",
+		"description": "Review in progress",
+	}))
+	require.NoError(t, err)
+
+	var returned CommitStatus
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, CommitStatus{Context: "agent/review", State: "pending", Description: "Review in progress"}, returned)
+}
@@ -0,0 +1,272 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// CopilotSeat is a Copilot seat assigned to a member of an organization.
+type CopilotSeat struct {
+	Assignee                string     `json:"assignee"`
+	AssigneeType            string     `json:"assignee_type"`
+	AssigningTeam           string     `json:"assigning_team,omitempty"`
+	PlanType                string     `json:"plan_type,omitempty"`
+	PendingCancellationDate string     `json:"pending_cancellation_date,omitempty"`
+	LastActivityAt          *time.Time `json:"last_activity_at,omitempty"`
+	LastActivityEditor      string     `json:"last_activity_editor,omitempty"`
+	CreatedAt               *time.Time `json:"created_at,omitempty"`
+}
+
+// CopilotSeatsPage is a page of the Copilot seats of an organization.
+type CopilotSeatsPage struct {
+	TotalSeats int64         `json:"total_seats"`
+	Seats      []CopilotSeat `json:"seats"`
+}
+
+func newCopilotSeat(s *github.CopilotSeatDetails) CopilotSeat {
+	seat := CopilotSeat{
+		AssigningTeam:           s.GetAssigningTeam().GetSlug(),
+		PlanType:                s.GetPlanType(),
+		PendingCancellationDate: s.GetPendingCancellationDate(),
+		LastActivityEditor:      s.GetLastActivityEditor(),
+	}
+	if user, ok := s.GetUser(); ok {
+		seat.Assignee, seat.AssigneeType = user.GetLogin(), "User"
+	} else if team, ok := s.GetTeam(); ok {
+		seat.Assignee, seat.AssigneeType = team.GetSlug(), "Team"
+	} else if org, ok := s.GetOrganization(); ok {
+		seat.Assignee, seat.AssigneeType = org.GetLogin(), "Organization"
+	}
+	if s.LastActivityAt != nil {
+		seat.LastActivityAt = &s.LastActivityAt.Time
+	}
+	if s.CreatedAt != nil {
+		seat.CreatedAt = &s.CreatedAt.Time
+	}
+	return seat
+}
+
+// copilotSeatAssignees reads the users and teams a Copilot seat tool works on, at least one of
+// which must be given.
+func copilotSeatAssignees(request mcp.CallToolRequest) (users, teams []string, err error) {
+	users, err = OptionalStringArrayParam(request, "users")
+	if err != nil {
+		return nil, nil, err
+	}
+	teams, err = OptionalStringArrayParam(request, "teams")
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(users) == 0 && len(teams) == 0 {
+		return nil, nil, fmt.Errorf("at least one of users or teams must be given")
+	}
+	return users, teams, nil
+}
+
+// GetCopilotBilling creates a tool to get the Copilot seat breakdown and settings of an organization.
+func GetCopilotBilling(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_copilot_billing",
+			mcp.WithDescription(t("TOOL_GET_COPILOT_BILLING_DESCRIPTION", "Get the Copilot subscription of an organization: how many seats are assigned, added, active, inactive or pending cancellation in the current billing cycle, and how seats are managed. Requires organization owner access.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_COPILOT_BILLING_USER_TITLE", "Get Copilot billing"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			billing, resp, err := client.Copilot.GetCopilotBilling(ctx, org)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get Copilot billing of %s", org), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(billing), nil
+		}
+}
+
+// ListCopilotSeats creates a tool to list the Copilot seats of an organization.
+func ListCopilotSeats(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_copilot_seats",
+			mcp.WithDescription(t("TOOL_LIST_COPILOT_SEATS_DESCRIPTION", "List the Copilot seats of an organization: who has a seat, through which team, and when they last used Copilot. Useful to find unused seats. Requires organization owner access.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_COPILOT_SEATS_USER_TITLE", "List Copilot seats"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			seats, resp, err := client.Copilot.ListCopilotSeats(ctx, org, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list Copilot seats of %s", org), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := CopilotSeatsPage{
+				TotalSeats: seats.TotalSeats,
+				Seats:      make([]CopilotSeat, 0, len(seats.Seats)),
+			}
+			for _, s := range seats.Seats {
+				result.Seats = append(result.Seats, newCopilotSeat(s))
+			}
+			info := ListPageInfo{TotalCount: github.Ptr(int(seats.TotalSeats))}
+			return MarshalledListPageResult(ctx, result, result.Seats, info, fmt.Sprintf("%s has no Copilot seats", org)), nil
+		}
+}
+
+// AddCopilotSeats creates a tool to give users or teams of an organization access to Copilot.
+func AddCopilotSeats(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_copilot_seats",
+			mcp.WithDescription(t("TOOL_ADD_COPILOT_SEATS_DESCRIPTION", "Give users or teams of an organization a Copilot seat. Each new seat is billed to the organization. Only possible when the organization assigns seats to selected members. Requires organization owner access.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ADD_COPILOT_SEATS_USER_TITLE", "Add Copilot seats"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithArray("users",
+				mcp.Description("Logins of the organization members to give a seat"),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+			mcp.WithArray("teams",
+				mcp.Description("Slugs of the teams whose members get a seat"),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			users, teams, err := copilotSeatAssignees(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			created := 0
+			if len(users) > 0 {
+				assigned, resp, err := client.Copilot.AddCopilotUsers(ctx, org, users)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to add Copilot seats for users", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				created += assigned.SeatsCreated
+			}
+			if len(teams) > 0 {
+				assigned, resp, err := client.Copilot.AddCopilotTeams(ctx, org, teams)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to add Copilot seats for teams", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				created += assigned.SeatsCreated
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Created %d Copilot seats in %s", created, org)), nil
+		}
+}
+
+// RemoveCopilotSeats creates a tool to take Copilot access away from users or teams of an organization.
+func RemoveCopilotSeats(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_copilot_seats",
+			mcp.WithDescription(t("TOOL_REMOVE_COPILOT_SEATS_DESCRIPTION", "Cancel the Copilot seats of users or teams of an organization. The seats stay usable until the end of the billing cycle. A user who also gets a seat through a team keeps it. Requires organization owner access.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_REMOVE_COPILOT_SEATS_USER_TITLE", "Remove Copilot seats"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithArray("users",
+				mcp.Description("Logins of the organization members whose seat to cancel"),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+			mcp.WithArray("teams",
+				mcp.Description("Slugs of the teams whose seats to cancel"),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			users, teams, err := copilotSeatAssignees(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			cancelled := 0
+			if len(users) > 0 {
+				cancellations, resp, err := client.Copilot.RemoveCopilotUsers(ctx, org, users)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to remove Copilot seats of users", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				cancelled += cancellations.SeatsCancelled
+			}
+			if len(teams) > 0 {
+				cancellations, resp, err := client.Copilot.RemoveCopilotTeams(ctx, org, teams)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to remove Copilot seats of teams", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				cancelled += cancellations.SeatsCancelled
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Cancelled %d Copilot seats in %s; they stay usable until the end of the billing cycle", cancelled, org)), nil
+		}
+}
@@ -0,0 +1,307 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetCopilotBilling(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCopilotBilling(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_copilot_billing", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "billing fetched",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsCopilotBillingByOrg,
+					mockResponse(t, http.StatusOK, map[string]any{
+						"seat_breakdown": map[string]any{
+							"total":               12,
+							"added_this_cycle":    2,
+							"active_this_cycle":   9,
+							"inactive_this_cycle": 3,
+						},
+						"public_code_suggestions": "block",
+						"seat_management_setting": "assign_selected",
+					}),
+				),
+			),
+		},
+		{
+			name: "not an organization owner",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsCopilotBillingByOrg,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must have admin rights"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get Copilot billing of octo-org",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetCopilotBilling(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{"org": "octo-org"}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned github.CopilotOrganizationDetails
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, 12, returned.SeatBreakdown.Total)
+			assert.Equal(t, 3, returned.SeatBreakdown.InactiveThisCycle)
+			assert.Equal(t, "assign_selected", returned.SeatManagementSetting)
+		})
+	}
+}
+
+func Test_ListCopilotSeats(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListCopilotSeats(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_copilot_seats", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	lastActivity := time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC)
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetOrgsCopilotBillingSeatsByOrg,
+			expectQueryParams(t, map[string]string{"page": "2", "per_page": "10"}).andThen(
+				mockResponse(t, http.StatusOK, map[string]any{
+					"total_seats": 2,
+					"seats": []map[string]any{
+						{
+							"assignee":             map[string]any{"login": "octocat", "type": "User"},
+							"assigning_team":       map[string]any{"slug": "developers"},
+							"last_activity_at":     lastActivity,
+							"last_activity_editor": "vscode/1.90.0",
+							"plan_type":            "business",
+						},
+						{
+							"assignee":                  map[string]any{"login": "hubot", "type": "User"},
+							"pending_cancellation_date": "2026-10-31",
+							"plan_type":                 "business",
+						},
+					},
+				}),
+			),
+		),
+	)
+
+	client := github.NewClient(mockedClient)
+	_, handler := ListCopilotSeats(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"org":     "octo-org",
+		"page":    float64(2),
+		"perPage": float64(10),
+	}))
+	require.NoError(t, err)
+
+	var returned CopilotSeatsPage
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, CopilotSeatsPage{
+		TotalSeats: 2,
+		Seats: []CopilotSeat{
+			{
+				Assignee:           "octocat",
+				AssigneeType:       "User",
+				AssigningTeam:      "developers",
+				PlanType:           "business",
+				LastActivityAt:     &lastActivity,
+				LastActivityEditor: "vscode/1.90.0",
+			},
+			{
+				Assignee:                "hubot",
+				AssigneeType:            "User",
+				PlanType:                "business",
+				PendingCancellationDate: "2026-10-31",
+			},
+		},
+	}, returned)
+}
+
+func Test_AddCopilotSeats(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AddCopilotSeats(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "add_copilot_seats", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "users and teams added",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsCopilotBillingSelectedUsersByOrg,
+					expectRequestBody(t, map[string]any{"selected_usernames": []any{"octocat"}}).andThen(
+						mockResponse(t, http.StatusCreated, map[string]int{"seats_created": 1}),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsCopilotBillingSelectedTeamsByOrg,
+					expectRequestBody(t, map[string]any{"selected_teams": []any{"developers"}}).andThen(
+						mockResponse(t, http.StatusCreated, map[string]int{"seats_created": 4}),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"org":   "octo-org",
+				"users": []any{"octocat"},
+				"teams": []any{"developers"},
+			},
+			expectedText: "Created 5 Copilot seats in octo-org",
+		},
+		{
+			name:         "no users or teams",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"org": "octo-org",
+			},
+			expectError:    true,
+			expectedErrMsg: "at least one of users or teams must be given",
+		},
+		{
+			name: "seats assigned to all members",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsCopilotBillingSelectedUsersByOrg,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Copilot seat management is set to assign all"}),
+				),
+			),
+			requestArgs: map[string]any{
+				"org":   "octo-org",
+				"users": []any{"octocat"},
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to add Copilot seats for users",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AddCopilotSeats(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_RemoveCopilotSeats(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RemoveCopilotSeats(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "remove_copilot_seats", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "user seats cancelled",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteOrgsCopilotBillingSelectedUsersByOrg,
+					expectRequestBody(t, map[string]any{"selected_usernames": []any{"octocat", "hubot"}}).andThen(
+						mockResponse(t, http.StatusOK, map[string]int{"seats_cancelled": 2}),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"org":   "octo-org",
+				"users": []any{"octocat", "hubot"},
+			},
+			expectedText: "Cancelled 2 Copilot seats in octo-org; they stay usable until the end of the billing cycle",
+		},
+		{
+			name: "team seats cancellation fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteOrgsCopilotBillingSelectedTeamsByOrg,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]any{
+				"org":   "octo-org",
+				"teams": []any{"ghosts"},
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to remove Copilot seats of teams",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RemoveCopilotSeats(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/google/go-github/v74/github"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	"gopkg.in/yaml.v3"
 )
 
 func GetDependabotAlert(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
@@ -151,11 +152,179 @@ func ListDependabotAlerts(getClient GetClientFn, t translations.TranslationHelpe
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list alerts: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(alerts)
+			return MarshalledListResult(ctx, alerts, "no Dependabot alerts matched"), nil
+		}
+}
+
+// dependabotConfigPaths are the locations GitHub reads the Dependabot configuration from, in order.
+var dependabotConfigPaths = []string{".github/dependabot.yml", ".github/dependabot.yaml"}
+
+// validDependabotIntervals are the schedule intervals Dependabot accepts.
+var validDependabotIntervals = map[string]bool{
+	"daily":        true,
+	"weekly":       true,
+	"monthly":      true,
+	"quarterly":    true,
+	"semiannually": true,
+	"yearly":       true,
+	"cron":         true,
+}
+
+type dependabotFile struct {
+	Version int `yaml:"version"`
+	Updates []struct {
+		PackageEcosystem      string             `yaml:"package-ecosystem"`
+		Directory             string             `yaml:"directory"`
+		Directories           []string           `yaml:"directories"`
+		Schedule              DependabotSchedule `yaml:"schedule"`
+		TargetBranch          string             `yaml:"target-branch"`
+		OpenPullRequestsLimit *int               `yaml:"open-pull-requests-limit"`
+	} `yaml:"updates"`
+}
+
+// DependabotSchedule is how often Dependabot checks for updates.
+type DependabotSchedule struct {
+	Interval string `yaml:"interval" json:"interval"`
+	Day      string `yaml:"day" json:"day,omitempty"`
+	Time     string `yaml:"time" json:"time,omitempty"`
+	Timezone string `yaml:"timezone" json:"timezone,omitempty"`
+	Cronjob  string `yaml:"cronjob" json:"cronjob,omitempty"`
+}
+
+// DependabotUpdate is a single entry of the updates list in a Dependabot configuration.
+type DependabotUpdate struct {
+	Ecosystem             string             `json:"ecosystem"`
+	Directories           []string           `json:"directories"`
+	Schedule              DependabotSchedule `json:"schedule"`
+	TargetBranch          string             `json:"target_branch,omitempty"`
+	OpenPullRequestsLimit *int               `json:"open_pull_requests_limit,omitempty"`
+}
+
+// DependabotConfig is the parsed Dependabot configuration of a repository.
+type DependabotConfig struct {
+	Path    string             `json:"path,omitempty"`
+	Found   bool               `json:"found"`
+	Version int                `json:"version,omitempty"`
+	Updates []DependabotUpdate `json:"updates,omitempty"`
+	Errors  []string           `json:"errors,omitempty"`
+}
+
+// parseDependabotConfig parses a dependabot.yml file. Problems are reported in the Errors field
+// rather than as an error, so agents can audit a broken configuration.
+func parseDependabotConfig(path string, content []byte) DependabotConfig {
+	config := DependabotConfig{Path: path, Found: true}
+
+	var file dependabotFile
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		config.Errors = append(config.Errors, fmt.Sprintf("invalid YAML: %v", err))
+		return config
+	}
+
+	config.Version = file.Version
+	if file.Version != 2 {
+		config.Errors = append(config.Errors, fmt.Sprintf("version must be 2, got %d", file.Version))
+	}
+	if len(file.Updates) == 0 {
+		config.Errors = append(config.Errors, "no updates are configured")
+	}
+
+	for i, u := range file.Updates {
+		update := DependabotUpdate{
+			Ecosystem:             u.PackageEcosystem,
+			Directories:           u.Directories,
+			Schedule:              u.Schedule,
+			TargetBranch:          u.TargetBranch,
+			OpenPullRequestsLimit: u.OpenPullRequestsLimit,
+		}
+		if u.Directory != "" {
+			update.Directories = append([]string{u.Directory}, update.Directories...)
+		}
+		config.Updates = append(config.Updates, update)
+
+		if u.PackageEcosystem == "" {
+			config.Errors = append(config.Errors, fmt.Sprintf("updates[%d]: package-ecosystem is required", i))
+		}
+		if len(update.Directories) == 0 {
+			config.Errors = append(config.Errors, fmt.Sprintf("updates[%d]: directory or directories is required", i))
+		}
+		switch {
+		case u.Schedule.Interval == "":
+			config.Errors = append(config.Errors, fmt.Sprintf("updates[%d]: schedule.interval is required", i))
+		case !validDependabotIntervals[u.Schedule.Interval]:
+			config.Errors = append(config.Errors, fmt.Sprintf("updates[%d]: unknown schedule.interval %q", i, u.Schedule.Interval))
+		case u.Schedule.Interval == "cron" && u.Schedule.Cronjob == "":
+			config.Errors = append(config.Errors, fmt.Sprintf("updates[%d]: schedule.cronjob is required when interval is cron", i))
+		}
+	}
+
+	return config
+}
+
+func GetDependabotConfig(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"get_dependabot_config",
+			mcp.WithDescription(t("TOOL_GET_DEPENDABOT_CONFIG_DESCRIPTION", "Get and validate a repository's Dependabot version updates configuration (.github/dependabot.yml), returning the configured ecosystems, directories and schedules, plus any problems found in the file.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_DEPENDABOT_CONFIG_USER_TITLE", "Get Dependabot configuration"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("The owner of the repository."),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository."),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit to read the configuration from. Defaults to the default branch."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal alerts: %w", err)
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			for _, path := range dependabotConfigPaths {
+				file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: ref})
+				if resp != nil {
+					_ = resp.Body.Close()
+				}
+				if err != nil {
+					if resp != nil && resp.StatusCode == http.StatusNotFound {
+						continue
+					}
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get Dependabot configuration", resp, err), nil
+				}
+				if file == nil {
+					return mcp.NewToolResultError(fmt.Sprintf("%s is not a file", path)), nil
+				}
+
+				content, err := file.GetContent()
+				if err != nil {
+					return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+				}
+
+				return MarshalledTextResult(parseDependabotConfig(path, []byte(content))), nil
+			}
+
+			return MarshalledTextResult(DependabotConfig{
+				Found:  false,
+				Errors: []string{"no Dependabot configuration found at .github/dependabot.yml or .github/dependabot.yaml"},
+			}), nil
 		}
 }
@@ -274,3 +274,224 @@ func Test_ListDependabotAlerts(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetDependabotConfig(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := GetDependabotConfig(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_dependabot_config", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	fileContent := func(path, content string) *github.RepositoryContent {
+		return &github.RepositoryContent{
+			Type:     github.Ptr("file"),
+			Path:     github.Ptr(path),
+			Encoding: github.Ptr(""),
+			Content:  github.Ptr(content),
+		}
+	}
+
+	sampleConfig := `version: 2
+updates:
+  - package-ecosystem: "npm"
+    directory: "/"
+    schedule:
+      interval: "weekly"
+      day: "monday"
+    open-pull-requests-limit: 5
+  - package-ecosystem: "github-actions"
+    directories: ["/", "/.github/actions/setup"]
+    schedule:
+      interval: "daily"
+    target-branch: "develop"
+`
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedConfig DependabotConfig
+	}{
+		{
+			name: "parses sample configuration",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					expectPath(t, "/repos/owner/repo/contents/.github/dependabot.yml").andThen(
+						mockResponse(t, http.StatusOK, fileContent(".github/dependabot.yml", sampleConfig)),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedConfig: DependabotConfig{
+				Path:    ".github/dependabot.yml",
+				Found:   true,
+				Version: 2,
+				Updates: []DependabotUpdate{
+					{
+						Ecosystem:             "npm",
+						Directories:           []string{"/"},
+						Schedule:              DependabotSchedule{Interval: "weekly", Day: "monday"},
+						OpenPullRequestsLimit: github.Ptr(5),
+					},
+					{
+						Ecosystem:    "github-actions",
+						Directories:  []string{"/", "/.github/actions/setup"},
+						Schedule:     DependabotSchedule{Interval: "daily"},
+						TargetBranch: "develop",
+					},
+				},
+			},
+		},
+		{
+			name: "reports problems in a malformed configuration",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					fileContent(".github/dependabot.yml", "version: 1\nupdates:\n  - package-ecosystem: pip\n    schedule:\n      interval: hourly\n"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedConfig: DependabotConfig{
+				Path:    ".github/dependabot.yml",
+				Found:   true,
+				Version: 1,
+				Updates: []DependabotUpdate{
+					{Ecosystem: "pip", Schedule: DependabotSchedule{Interval: "hourly"}},
+				},
+				Errors: []string{
+					"version must be 2, got 1",
+					"updates[0]: directory or directories is required",
+					`updates[0]: unknown schedule.interval "hourly"`,
+				},
+			},
+		},
+		{
+			name: "reports invalid YAML",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					fileContent(".github/dependabot.yml", "version: 2\nupdates: [\n"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedConfig: DependabotConfig{
+				Path:   ".github/dependabot.yml",
+				Found:  true,
+				Errors: []string{"invalid YAML: yaml: line 2: did not find expected node content"},
+			},
+		},
+		{
+			name: "falls back to dependabot.yaml",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.URL.Path == "/repos/owner/repo/contents/.github/dependabot.yaml" {
+							mockResponse(t, http.StatusOK, fileContent(".github/dependabot.yaml", sampleConfig))(w, r)
+							return
+						}
+						mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"})(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedConfig: DependabotConfig{
+				Path:    ".github/dependabot.yaml",
+				Found:   true,
+				Version: 2,
+				Updates: []DependabotUpdate{
+					{
+						Ecosystem:             "npm",
+						Directories:           []string{"/"},
+						Schedule:              DependabotSchedule{Interval: "weekly", Day: "monday"},
+						OpenPullRequestsLimit: github.Ptr(5),
+					},
+					{
+						Ecosystem:    "github-actions",
+						Directories:  []string{"/", "/.github/actions/setup"},
+						Schedule:     DependabotSchedule{Interval: "daily"},
+						TargetBranch: "develop",
+					},
+				},
+			},
+		},
+		{
+			name: "no configuration file",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedConfig: DependabotConfig{
+				Found:  false,
+				Errors: []string{"no Dependabot configuration found at .github/dependabot.yml or .github/dependabot.yaml"},
+			},
+		},
+		{
+			name: "repository access fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Forbidden"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get Dependabot configuration",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetDependabotConfig(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+
+			var returned DependabotConfig
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedConfig, returned)
+		})
+	}
+}
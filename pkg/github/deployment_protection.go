@@ -0,0 +1,305 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Deployment branch policies of an environment.
+const (
+	branchPolicyAll       = "all"
+	branchPolicyProtected = "protected_branches"
+	branchPolicyCustom    = "custom_branch_policies"
+)
+
+// CustomProtectionRule is a GitHub App that gates deployments to an environment.
+type CustomProtectionRule struct {
+	ID      int64  `json:"id"`
+	App     string `json:"app"`
+	Enabled bool   `json:"enabled"`
+}
+
+// DeploymentProtection describes the gates a deployment to an environment has to pass.
+type DeploymentProtection struct {
+	Environment       string                 `json:"environment"`
+	Protected         bool                   `json:"protected"`
+	RequiredReviewers []string               `json:"required_reviewers"`
+	PreventSelfReview bool                   `json:"prevent_self_review"`
+	WaitTimerMinutes  int                    `json:"wait_timer_minutes"`
+	BranchPolicy      string                 `json:"branch_policy"`
+	CanAdminsBypass   bool                   `json:"can_admins_bypass"`
+	CustomRules       []CustomProtectionRule `json:"custom_rules"`
+}
+
+// ProtectionDifference is a setting that is not the same in every compared environment.
+type ProtectionDifference struct {
+	Setting string         `json:"setting"`
+	Values  map[string]any `json:"values"`
+}
+
+// DeploymentProtectionComparison is the result of comparing the protection of several environments.
+type DeploymentProtectionComparison struct {
+	Consistent   bool                   `json:"consistent"`
+	Differences  []ProtectionDifference `json:"differences"`
+	Environments []DeploymentProtection `json:"environments"`
+}
+
+// newDeploymentProtection flattens the protection rules of env and its custom rule apps.
+func newDeploymentProtection(env *github.Environment, customRules []*github.CustomDeploymentProtectionRule) DeploymentProtection {
+	protection := DeploymentProtection{
+		Environment:       env.GetName(),
+		RequiredReviewers: []string{},
+		BranchPolicy:      branchPolicyAll,
+		CanAdminsBypass:   env.GetCanAdminsBypass(),
+		CustomRules:       []CustomProtectionRule{},
+	}
+
+	for _, rule := range env.ProtectionRules {
+		switch rule.GetType() {
+		case "required_reviewers":
+			protection.PreventSelfReview = rule.GetPreventSelfReview()
+			for _, reviewer := range rule.Reviewers {
+				switch r := reviewer.Reviewer.(type) {
+				case *github.User:
+					protection.RequiredReviewers = append(protection.RequiredReviewers, r.GetLogin())
+				case *github.Team:
+					protection.RequiredReviewers = append(protection.RequiredReviewers, "team:"+r.GetSlug())
+				}
+			}
+		case "wait_timer":
+			protection.WaitTimerMinutes = rule.GetWaitTimer()
+		}
+	}
+	slices.Sort(protection.RequiredReviewers)
+
+	if policy := env.DeploymentBranchPolicy; policy != nil {
+		switch {
+		case policy.GetProtectedBranches():
+			protection.BranchPolicy = branchPolicyProtected
+		case policy.GetCustomBranchPolicies():
+			protection.BranchPolicy = branchPolicyCustom
+		}
+	}
+
+	for _, rule := range customRules {
+		protection.CustomRules = append(protection.CustomRules, CustomProtectionRule{
+			ID:      rule.GetID(),
+			App:     rule.GetApp().GetSlug(),
+			Enabled: rule.GetEnabled(),
+		})
+	}
+	slices.SortFunc(protection.CustomRules, func(a, b CustomProtectionRule) int {
+		return strings.Compare(a.App, b.App)
+	})
+
+	protection.Protected = len(protection.RequiredReviewers) > 0 ||
+		protection.WaitTimerMinutes > 0 ||
+		protection.BranchPolicy != branchPolicyAll ||
+		slices.ContainsFunc(protection.CustomRules, func(r CustomProtectionRule) bool { return r.Enabled })
+	return protection
+}
+
+// enabledCustomRuleApps returns the apps of the enabled custom rules, which is what decides
+// whether two environments are gated alike.
+func (p DeploymentProtection) enabledCustomRuleApps() []string {
+	apps := []string{}
+	for _, rule := range p.CustomRules {
+		if rule.Enabled {
+			apps = append(apps, rule.App)
+		}
+	}
+	return apps
+}
+
+// getDeploymentProtection fetches an environment and its custom deployment protection rules.
+func getDeploymentProtection(ctx context.Context, client *github.Client, owner, repo, environment string) (*DeploymentProtection, *github.Response, error) {
+	env, resp, err := client.Repositories.GetEnvironment(ctx, owner, repo, environment)
+	if err != nil {
+		return nil, resp, err
+	}
+	_ = resp.Body.Close()
+
+	rules, resp, err := client.Repositories.GetAllDeploymentProtectionRules(ctx, owner, repo, environment)
+	if err != nil {
+		return nil, resp, err
+	}
+	_ = resp.Body.Close()
+
+	protection := newDeploymentProtection(env, rules.ProtectionRules)
+	return &protection, resp, nil
+}
+
+// compareDeploymentProtection lists the settings that differ between environments.
+func compareDeploymentProtection(environments []DeploymentProtection) []ProtectionDifference {
+	settings := []struct {
+		name  string
+		value func(DeploymentProtection) any
+	}{
+		{"protected", func(p DeploymentProtection) any { return p.Protected }},
+		{"required_reviewers", func(p DeploymentProtection) any { return p.RequiredReviewers }},
+		{"prevent_self_review", func(p DeploymentProtection) any { return p.PreventSelfReview }},
+		{"wait_timer_minutes", func(p DeploymentProtection) any { return p.WaitTimerMinutes }},
+		{"branch_policy", func(p DeploymentProtection) any { return p.BranchPolicy }},
+		{"can_admins_bypass", func(p DeploymentProtection) any { return p.CanAdminsBypass }},
+		{"custom_rules", func(p DeploymentProtection) any { return p.enabledCustomRuleApps() }},
+	}
+
+	differences := []ProtectionDifference{}
+	for _, setting := range settings {
+		values := make(map[string]any, len(environments))
+		distinct := map[string]bool{}
+		for _, env := range environments {
+			value := setting.value(env)
+			values[env.Environment] = value
+			distinct[fmt.Sprint(value)] = true
+		}
+		if len(distinct) > 1 {
+			differences = append(differences, ProtectionDifference{Setting: setting.name, Values: values})
+		}
+	}
+	return differences
+}
+
+// GetDeploymentProtectionRules creates a tool to get the deployment protection rules of an environment.
+func GetDeploymentProtectionRules(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_deployment_protection_rules",
+			mcp.WithDescription(t("TOOL_GET_DEPLOYMENT_PROTECTION_RULES_DESCRIPTION", "Get the deployment protection rules of a repository environment: required reviewers, wait timer, deployment branch policy, admin bypass and custom protection rule apps.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_DEPLOYMENT_PROTECTION_RULES_USER_TITLE", "Get deployment protection rules"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("environment",
+				mcp.Required(),
+				mcp.Description("Environment name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			environment, err := RequiredParam[string](request, "environment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			protection, resp, err := getDeploymentProtection(ctx, client, owner, repo, environment)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("environment %s not found in %s/%s", environment, owner, repo)), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get deployment protection rules", resp, err), nil
+			}
+
+			return MarshalledTextResult(protection), nil
+		}
+}
+
+// CompareDeploymentProtection creates a tool to compare deployment protection across environments.
+func CompareDeploymentProtection(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("compare_deployment_protection",
+			mcp.WithDescription(t("TOOL_COMPARE_DEPLOYMENT_PROTECTION_DESCRIPTION", "Compare the deployment protection rules of a repository's environments and list every setting that is not the same in all of them, to spot environments that are gated inconsistently.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_COMPARE_DEPLOYMENT_PROTECTION_USER_TITLE", "Compare deployment protection"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithArray("environments",
+				mcp.Description("Environments to compare. Defaults to all environments of the repository"),
+				mcp.Items(
+					map[string]any{
+						"type": "string",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			environments, err := OptionalStringArrayParam(request, "environments")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if len(environments) == 0 {
+				opts := &github.EnvironmentListOptions{ListOptions: github.ListOptions{PerPage: 100}}
+				for {
+					list, resp, err := client.Repositories.ListEnvironments(ctx, owner, repo, opts)
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list environments", resp, err), nil
+					}
+					_ = resp.Body.Close()
+					for _, env := range list.Environments {
+						environments = append(environments, env.GetName())
+					}
+					if resp.NextPage == 0 {
+						break
+					}
+					opts.Page = resp.NextPage
+				}
+			}
+			if len(environments) < 2 {
+				return mcp.NewToolResultError(fmt.Sprintf("at least two environments are needed for a comparison, got %d", len(environments))), nil
+			}
+
+			comparison := DeploymentProtectionComparison{Environments: make([]DeploymentProtection, 0, len(environments))}
+			for _, environment := range environments {
+				protection, resp, err := getDeploymentProtection(ctx, client, owner, repo, environment)
+				if err != nil {
+					if resp != nil && resp.StatusCode == http.StatusNotFound {
+						return mcp.NewToolResultError(fmt.Sprintf("environment %s not found in %s/%s", environment, owner, repo)), nil
+					}
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get deployment protection rules of %s", environment), resp, err), nil
+				}
+				comparison.Environments = append(comparison.Environments, *protection)
+			}
+			comparison.Differences = compareDeploymentProtection(comparison.Environments)
+			comparison.Consistent = len(comparison.Differences) == 0
+
+			return MarshalledTextResult(comparison), nil
+		}
+}
@@ -0,0 +1,250 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockEnvironmentsHandler(t *testing.T, environments map[string]*github.Environment) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/repos/owner/repo/environments/")
+		env, ok := environments[name]
+		if !ok {
+			mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"})(w, r)
+			return
+		}
+		mockResponse(t, http.StatusOK, env)(w, r)
+	}
+}
+
+func mockCustomRulesHandler(t *testing.T, rules map[string][]*github.CustomDeploymentProtectionRule) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/repos/owner/repo/environments/"), "/deployment_protection_rules")
+		mockResponse(t, http.StatusOK, &github.ListDeploymentProtectionRuleResponse{
+			TotalCount:      github.Ptr(len(rules[name])),
+			ProtectionRules: rules[name],
+		})(w, r)
+	}
+}
+
+var (
+	mockProductionEnvironment = &github.Environment{
+		Name:            github.Ptr("production"),
+		CanAdminsBypass: github.Ptr(false),
+		DeploymentBranchPolicy: &github.BranchPolicy{
+			ProtectedBranches:    github.Ptr(true),
+			CustomBranchPolicies: github.Ptr(false),
+		},
+		ProtectionRules: []*github.ProtectionRule{
+			{
+				Type:              github.Ptr("required_reviewers"),
+				PreventSelfReview: github.Ptr(true),
+				Reviewers: []*github.RequiredReviewer{
+					{Type: github.Ptr("User"), Reviewer: &github.User{Login: github.Ptr("octocat")}},
+					{Type: github.Ptr("Team"), Reviewer: &github.Team{Slug: github.Ptr("release")}},
+				},
+			},
+			{Type: github.Ptr("wait_timer"), WaitTimer: github.Ptr(30)},
+			{Type: github.Ptr("branch_policy")},
+		},
+	}
+	mockProductionCustomRules = []*github.CustomDeploymentProtectionRule{
+		{ID: github.Ptr(int64(7)), Enabled: github.Ptr(true), App: &github.CustomDeploymentProtectionRuleApp{Slug: github.Ptr("datadog-gate")}},
+	}
+)
+
+func Test_GetDeploymentProtectionRules(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetDeploymentProtectionRules(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_deployment_protection_rules", tool.Name)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "environment"})
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposEnvironmentsByOwnerByRepoByEnvironmentName,
+			mockEnvironmentsHandler(t, map[string]*github.Environment{
+				"production": mockProductionEnvironment,
+				"preview":    {Name: github.Ptr("preview"), CanAdminsBypass: github.Ptr(true)},
+			}),
+		),
+		mock.WithRequestMatchHandler(
+			mock.GetReposEnvironmentsDeploymentProtectionRulesByOwnerByRepoByEnvironmentName,
+			mockCustomRulesHandler(t, map[string][]*github.CustomDeploymentProtectionRule{
+				"production": mockProductionCustomRules,
+			}),
+		),
+	)
+	_, handler := GetDeploymentProtectionRules(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+	tests := []struct {
+		name           string
+		environment    string
+		expected       DeploymentProtection
+		expectedErrMsg string
+	}{
+		{
+			name:        "protected environment",
+			environment: "production",
+			expected: DeploymentProtection{
+				Environment:       "production",
+				Protected:         true,
+				RequiredReviewers: []string{"octocat", "team:release"},
+				PreventSelfReview: true,
+				WaitTimerMinutes:  30,
+				BranchPolicy:      "protected_branches",
+				CustomRules:       []CustomProtectionRule{{ID: 7, App: "datadog-gate", Enabled: true}},
+			},
+		},
+		{
+			name:        "environment without protection rules",
+			environment: "preview",
+			expected: DeploymentProtection{
+				Environment:       "preview",
+				RequiredReviewers: []string{},
+				BranchPolicy:      "all",
+				CanAdminsBypass:   true,
+				CustomRules:       []CustomProtectionRule{},
+			},
+		},
+		{
+			name:           "missing environment",
+			environment:    "staging",
+			expectedErrMsg: "environment staging not found in owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"environment": tc.environment,
+			}))
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				assert.Equal(t, tc.expectedErrMsg, getErrorResult(t, result).Text)
+				return
+			}
+
+			var protection DeploymentProtection
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &protection))
+			assert.Equal(t, tc.expected, protection)
+		})
+	}
+}
+
+func Test_CompareDeploymentProtection(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CompareDeploymentProtection(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "compare_deployment_protection", tool.Name)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	stagingEnvironment := &github.Environment{
+		Name:            github.Ptr("staging"),
+		CanAdminsBypass: github.Ptr(false),
+		DeploymentBranchPolicy: &github.BranchPolicy{
+			ProtectedBranches:    github.Ptr(true),
+			CustomBranchPolicies: github.Ptr(false),
+		},
+		ProtectionRules: []*github.ProtectionRule{
+			{
+				Type:              github.Ptr("required_reviewers"),
+				PreventSelfReview: github.Ptr(true),
+				Reviewers: []*github.RequiredReviewer{
+					{Type: github.Ptr("Team"), Reviewer: &github.Team{Slug: github.Ptr("release")}},
+					{Type: github.Ptr("User"), Reviewer: &github.User{Login: github.Ptr("octocat")}},
+				},
+			},
+			{Type: github.Ptr("wait_timer"), WaitTimer: github.Ptr(5)},
+		},
+	}
+	stagingCustomRules := []*github.CustomDeploymentProtectionRule{
+		{ID: github.Ptr(int64(8)), Enabled: github.Ptr(false), App: &github.CustomDeploymentProtectionRuleApp{Slug: github.Ptr("datadog-gate")}},
+	}
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposEnvironmentsByOwnerByRepo,
+			mockResponse(t, http.StatusOK, &github.EnvResponse{
+				TotalCount:   github.Ptr(2),
+				Environments: []*github.Environment{mockProductionEnvironment, stagingEnvironment},
+			}),
+		),
+		mock.WithRequestMatchHandler(
+			mock.GetReposEnvironmentsByOwnerByRepoByEnvironmentName,
+			mockEnvironmentsHandler(t, map[string]*github.Environment{
+				"production": mockProductionEnvironment,
+				"staging":    stagingEnvironment,
+				"qa":         stagingEnvironment,
+			}),
+		),
+		mock.WithRequestMatchHandler(
+			mock.GetReposEnvironmentsDeploymentProtectionRulesByOwnerByRepoByEnvironmentName,
+			mockCustomRulesHandler(t, map[string][]*github.CustomDeploymentProtectionRule{
+				"production": mockProductionCustomRules,
+				"staging":    stagingCustomRules,
+				"qa":         stagingCustomRules,
+			}),
+		),
+	)
+	_, handler := CompareDeploymentProtection(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+	t.Run("all environments are compared by default", func(t *testing.T) {
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{"owner": "owner", "repo": "repo"}))
+		require.NoError(t, err)
+
+		var comparison DeploymentProtectionComparison
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &comparison))
+		assert.False(t, comparison.Consistent)
+		require.Len(t, comparison.Environments, 2)
+		assert.Equal(t, "production", comparison.Environments[0].Environment)
+		assert.Equal(t, "staging", comparison.Environments[1].Environment)
+		assert.Equal(t, []ProtectionDifference{
+			{Setting: "wait_timer_minutes", Values: map[string]any{"production": float64(30), "staging": float64(5)}},
+			{Setting: "custom_rules", Values: map[string]any{"production": []any{"datadog-gate"}, "staging": []any{}}},
+		}, comparison.Differences)
+	})
+
+	t.Run("identical environments are consistent", func(t *testing.T) {
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"environments": []any{"staging", "qa"},
+		}))
+		require.NoError(t, err)
+
+		var comparison DeploymentProtectionComparison
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &comparison))
+		assert.True(t, comparison.Consistent)
+		assert.Empty(t, comparison.Differences)
+	})
+
+	t.Run("a single environment cannot be compared", func(t *testing.T) {
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"environments": []any{"staging"},
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "at least two environments are needed for a comparison, got 1", getErrorResult(t, result).Text)
+	})
+}
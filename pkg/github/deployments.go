@@ -0,0 +1,710 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Deployment is a request to deploy a ref of a repository to an environment.
+type Deployment struct {
+	ID          int64           `json:"id"`
+	Ref         string          `json:"ref"`
+	SHA         string          `json:"sha"`
+	Task        string          `json:"task"`
+	Environment string          `json:"environment"`
+	Description string          `json:"description,omitempty"`
+	Payload     json.RawMessage `json:"payload,omitempty"`
+	Creator     string          `json:"creator,omitempty"`
+	CreatedAt   *time.Time      `json:"created_at,omitempty"`
+}
+
+// DeploymentStatus is one step in the progress of a deployment.
+type DeploymentStatus struct {
+	ID             int64      `json:"id"`
+	State          string     `json:"state"`
+	Description    string     `json:"description,omitempty"`
+	Environment    string     `json:"environment,omitempty"`
+	EnvironmentURL string     `json:"environment_url,omitempty"`
+	LogURL         string     `json:"log_url,omitempty"`
+	Creator        string     `json:"creator,omitempty"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+}
+
+// EnvironmentSummary is a deployment environment with its built-in protection rules. Custom
+// protection rule apps are only reported by get_deployment_protection_rules.
+type EnvironmentSummary struct {
+	Name              string     `json:"name"`
+	URL               string     `json:"url"`
+	RequiredReviewers []string   `json:"required_reviewers"`
+	PreventSelfReview bool       `json:"prevent_self_review"`
+	WaitTimerMinutes  int        `json:"wait_timer_minutes"`
+	BranchPolicy      string     `json:"branch_policy"`
+	CanAdminsBypass   bool       `json:"can_admins_bypass"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+}
+
+func newDeployment(d *github.Deployment) Deployment {
+	deployment := Deployment{
+		ID:          d.GetID(),
+		Ref:         d.GetRef(),
+		SHA:         d.GetSHA(),
+		Task:        d.GetTask(),
+		Environment: d.GetEnvironment(),
+		Description: d.GetDescription(),
+		Creator:     d.GetCreator().GetLogin(),
+	}
+	// An empty payload is sent back as "" rather than omitted.
+	if len(d.Payload) > 0 && string(d.Payload) != `""` && string(d.Payload) != "{}" {
+		deployment.Payload = d.Payload
+	}
+	if d.CreatedAt != nil {
+		deployment.CreatedAt = &d.CreatedAt.Time
+	}
+	return deployment
+}
+
+func newDeploymentStatus(s *github.DeploymentStatus) DeploymentStatus {
+	status := DeploymentStatus{
+		ID:             s.GetID(),
+		State:          s.GetState(),
+		Description:    s.GetDescription(),
+		Environment:    s.GetEnvironment(),
+		EnvironmentURL: s.GetEnvironmentURL(),
+		LogURL:         s.GetLogURL(),
+		Creator:        s.GetCreator().GetLogin(),
+	}
+	if s.CreatedAt != nil {
+		status.CreatedAt = &s.CreatedAt.Time
+	}
+	return status
+}
+
+func newEnvironmentSummary(env *github.Environment) EnvironmentSummary {
+	protection := newDeploymentProtection(env, nil)
+	summary := EnvironmentSummary{
+		Name:              env.GetName(),
+		URL:               env.GetHTMLURL(),
+		RequiredReviewers: protection.RequiredReviewers,
+		PreventSelfReview: protection.PreventSelfReview,
+		WaitTimerMinutes:  protection.WaitTimerMinutes,
+		BranchPolicy:      protection.BranchPolicy,
+		CanAdminsBypass:   protection.CanAdminsBypass,
+	}
+	if env.UpdatedAt != nil {
+		summary.UpdatedAt = &env.UpdatedAt.Time
+	}
+	return summary
+}
+
+// environmentRequest carries the protection rules of env over to an update request. The update
+// endpoint replaces every setting, so anything left out of the request would be cleared. A nil env
+// gives the settings GitHub uses for a new environment.
+func environmentRequest(env *github.Environment) *github.CreateUpdateEnvironment {
+	req := &github.CreateUpdateEnvironment{
+		WaitTimer:       github.Ptr(0),
+		Reviewers:       []*github.EnvReviewers{},
+		CanAdminsBypass: github.Ptr(true),
+	}
+	if env == nil {
+		return req
+	}
+
+	req.CanAdminsBypass = github.Ptr(env.GetCanAdminsBypass())
+	req.DeploymentBranchPolicy = env.DeploymentBranchPolicy
+	for _, rule := range env.ProtectionRules {
+		switch rule.GetType() {
+		case "required_reviewers":
+			req.PreventSelfReview = github.Ptr(rule.GetPreventSelfReview())
+			for _, reviewer := range rule.Reviewers {
+				switch r := reviewer.Reviewer.(type) {
+				case *github.User:
+					req.Reviewers = append(req.Reviewers, &github.EnvReviewers{Type: github.Ptr("User"), ID: r.ID})
+				case *github.Team:
+					req.Reviewers = append(req.Reviewers, &github.EnvReviewers{Type: github.Ptr("Team"), ID: r.ID})
+				}
+			}
+		case "wait_timer":
+			req.WaitTimer = github.Ptr(rule.GetWaitTimer())
+		}
+	}
+	return req
+}
+
+// resolveEnvironmentReviewers looks up the IDs of reviewers given as logins, or as "team:slug" for
+// teams of the repository owner.
+func resolveEnvironmentReviewers(ctx context.Context, client *github.Client, owner string, reviewers []string) ([]*github.EnvReviewers, *mcp.CallToolResult) {
+	resolved := make([]*github.EnvReviewers, 0, len(reviewers))
+	for _, reviewer := range reviewers {
+		if slug, ok := strings.CutPrefix(reviewer, "team:"); ok {
+			team, resp, err := client.Teams.GetTeamBySlug(ctx, owner, slug)
+			if err != nil {
+				return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get team %s", slug), resp, err)
+			}
+			_ = resp.Body.Close()
+			resolved = append(resolved, &github.EnvReviewers{Type: github.Ptr("Team"), ID: team.ID})
+			continue
+		}
+		user, resp, err := client.Users.Get(ctx, reviewer)
+		if err != nil {
+			return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get user %s", reviewer), resp, err)
+		}
+		_ = resp.Body.Close()
+		resolved = append(resolved, &github.EnvReviewers{Type: github.Ptr("User"), ID: user.ID})
+	}
+	return resolved, nil
+}
+
+// ListDeployments creates a tool to list the deployments of a repository.
+func ListDeployments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_deployments",
+			mcp.WithDescription(t("TOOL_LIST_DEPLOYMENTS_DESCRIPTION", "List the deployments of a GitHub repository, newest first. Use list_deployment_statuses to see how a deployment went.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_DEPLOYMENTS_USER_TITLE", "List deployments"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("environment",
+				mcp.Description("Only list deployments to this environment"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Only list deployments of this branch, tag or SHA"),
+			),
+			mcp.WithString("sha",
+				mcp.Description("Only list deployments of this commit SHA"),
+			),
+			mcp.WithString("task",
+				mcp.Description("Only list deployments for this task, such as deploy or deploy:migrations"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts := &github.DeploymentsListOptions{}
+			for name, field := range map[string]*string{
+				"environment": &opts.Environment,
+				"ref":         &opts.Ref,
+				"sha":         &opts.SHA,
+				"task":        &opts.Task,
+			} {
+				if *field, err = OptionalParam[string](request, name); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts.ListOptions = github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			deployments, resp, err := client.Repositories.ListDeployments(ctx, owner, repo, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list deployments", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]Deployment, 0, len(deployments))
+			for _, d := range deployments {
+				result = append(result, newDeployment(d))
+			}
+			return MarshalledListResult(ctx, result, "no deployments found"), nil
+		}
+}
+
+// CreateDeployment creates a tool to deploy a ref of a repository to an environment.
+func CreateDeployment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_deployment",
+			mcp.WithDescription(t("TOOL_CREATE_DEPLOYMENT_DESCRIPTION", "Create a deployment of a branch, tag or SHA of a GitHub repository to an environment. GitHub only records the deployment and notifies integrations; the deployment itself is done by whatever listens for deployment events. By default the commit status checks of the ref must pass.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_DEPLOYMENT_USER_TITLE", "Create deployment"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Required(),
+				mcp.Description("Branch, tag or SHA to deploy"),
+			),
+			mcp.WithString("environment",
+				mcp.Description("Environment to deploy to. Defaults to production"),
+			),
+			mcp.WithString("task",
+				mcp.Description("Task to run, such as deploy or deploy:migrations. Defaults to deploy"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Short description of the deployment"),
+			),
+			mcp.WithObject("payload",
+				mcp.Description("JSON data passed on to the deployment integrations"),
+			),
+			mcp.WithBoolean("auto_merge",
+				mcp.Description("Merge the default branch into ref first when ref is behind it. Defaults to true"),
+			),
+			mcp.WithArray("required_contexts",
+				mcp.Description("Status check contexts that must pass before deploying. Defaults to all of them; an empty list deploys without checking"),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+			mcp.WithBoolean("transient_environment",
+				mcp.Description("Whether the environment is temporary and will go away, such as a review app"),
+			),
+			mcp.WithBoolean("production_environment",
+				mcp.Description("Whether the environment is one end users interact with. Defaults to true for production"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := RequiredParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			req := &github.DeploymentRequest{Ref: github.Ptr(ref)}
+			for name, field := range map[string]**string{
+				"environment": &req.Environment,
+				"task":        &req.Task,
+				"description": &req.Description,
+			} {
+				v, err := OptionalParam[string](request, name)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if v != "" {
+					*field = github.Ptr(v)
+				}
+			}
+			for name, field := range map[string]**bool{
+				"auto_merge":             &req.AutoMerge,
+				"transient_environment":  &req.TransientEnvironment,
+				"production_environment": &req.ProductionEnvironment,
+			} {
+				v, ok, err := OptionalParamOK[bool](request, name)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if ok {
+					*field = github.Ptr(v)
+				}
+			}
+			if payload, ok, err := OptionalParamOK[map[string]any](request, "payload"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				req.Payload = payload
+			}
+			// An empty list is meaningful here, so the parameter's presence has to be checked.
+			if _, ok := request.GetArguments()["required_contexts"]; ok {
+				contexts, err := OptionalStringArrayParam(request, "required_contexts")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				req.RequiredContexts = &contexts
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			deployment, resp, err := client.Repositories.CreateDeployment(ctx, owner, repo, req)
+			if err != nil {
+				// GitHub answers 202 when it merged the default branch into ref instead of deploying.
+				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
+					return mcp.NewToolResultText(fmt.Sprintf("GitHub merged the default branch into %s because it was behind, and created no deployment. Create the deployment again once the checks of the merge commit have passed.", ref)), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to create deployment of %s", ref), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newDeployment(deployment)), nil
+		}
+}
+
+// ListDeploymentStatuses creates a tool to list the statuses of a deployment.
+func ListDeploymentStatuses(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_deployment_statuses",
+			mcp.WithDescription(t("TOOL_LIST_DEPLOYMENT_STATUSES_DESCRIPTION", "List the statuses of a deployment in a GitHub repository, newest first. The first one is the current state of the deployment.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_DEPLOYMENT_STATUSES_USER_TITLE", "List deployment statuses"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("deployment_id",
+				mcp.Required(),
+				mcp.Description("ID of the deployment"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			deploymentID, err := RequiredInt(request, "deployment_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			statuses, resp, err := client.Repositories.ListDeploymentStatuses(ctx, owner, repo, int64(deploymentID), &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list statuses of deployment %d", deploymentID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]DeploymentStatus, 0, len(statuses))
+			for _, s := range statuses {
+				result = append(result, newDeploymentStatus(s))
+			}
+			return MarshalledListResult(ctx, result, "no deployment statuses found"), nil
+		}
+}
+
+// CreateDeploymentStatus creates a tool to report the progress of a deployment.
+func CreateDeploymentStatus(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_deployment_status",
+			mcp.WithDescription(t("TOOL_CREATE_DEPLOYMENT_STATUS_DESCRIPTION", "Set the status of a deployment in a GitHub repository, for example to mark it in_progress while deploying and success or failure when done.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_DEPLOYMENT_STATUS_USER_TITLE", "Create deployment status"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("deployment_id",
+				mcp.Required(),
+				mcp.Description("ID of the deployment"),
+			),
+			mcp.WithString("state",
+				mcp.Required(),
+				mcp.Description("New state of the deployment"),
+				mcp.Enum("queued", "pending", "in_progress", "success", "failure", "error", "inactive"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Short description of the status, up to 140 characters"),
+			),
+			mcp.WithString("log_url",
+				mcp.Description("URL of the deployment output"),
+			),
+			mcp.WithString("environment_url",
+				mcp.Description("URL the deployed environment can be reached at"),
+			),
+			mcp.WithString("environment",
+				mcp.Description("Move the deployment to this environment"),
+			),
+			mcp.WithBoolean("auto_inactive",
+				mcp.Description("Whether a success status marks the earlier successful deployments to the same environment inactive. Defaults to true"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			deploymentID, err := RequiredInt(request, "deployment_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := RequiredParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			req := &github.DeploymentStatusRequest{State: github.Ptr(state)}
+			for name, field := range map[string]**string{
+				"description":     &req.Description,
+				"log_url":         &req.LogURL,
+				"environment_url": &req.EnvironmentURL,
+				"environment":     &req.Environment,
+			} {
+				v, err := OptionalParam[string](request, name)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if v != "" {
+					*field = github.Ptr(v)
+				}
+			}
+			if v, ok, err := OptionalParamOK[bool](request, "auto_inactive"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				req.AutoInactive = github.Ptr(v)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			status, resp, err := client.Repositories.CreateDeploymentStatus(ctx, owner, repo, int64(deploymentID), req)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to create status for deployment %d", deploymentID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newDeploymentStatus(status)), nil
+		}
+}
+
+// ListEnvironments creates a tool to list the deployment environments of a repository.
+func ListEnvironments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_environments",
+			mcp.WithDescription(t("TOOL_LIST_ENVIRONMENTS_DESCRIPTION", "List the deployment environments of a GitHub repository with their required reviewers, wait timer and deployment branch policy. Use get_deployment_protection_rules to also see custom protection rule apps.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ENVIRONMENTS_USER_TITLE", "List environments"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			list, resp, err := client.Repositories.ListEnvironments(ctx, owner, repo, &github.EnvironmentListOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list environments", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]EnvironmentSummary, 0, len(list.Environments))
+			for _, env := range list.Environments {
+				result = append(result, newEnvironmentSummary(env))
+			}
+			return MarshalledListResult(ctx, result, "no environments found"), nil
+		}
+}
+
+// CreateOrUpdateEnvironment creates a tool to create a deployment environment or change its protection rules.
+func CreateOrUpdateEnvironment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_or_update_environment",
+			mcp.WithDescription(t("TOOL_CREATE_OR_UPDATE_ENVIRONMENT_DESCRIPTION", "Create a deployment environment in a GitHub repository, or change the protection rules of an existing one. Only the given settings are changed. Required reviewers and wait timers need a public repository or GitHub Enterprise.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:          t("TOOL_CREATE_OR_UPDATE_ENVIRONMENT_USER_TITLE", "Create or update environment"),
+				ReadOnlyHint:   ToBoolPtr(false),
+				IdempotentHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("environment",
+				mcp.Required(),
+				mcp.Description("Environment name"),
+			),
+			mcp.WithArray("required_reviewers",
+				mcp.Description(`Users, or teams as "team:<slug>", of whom one must approve each deployment. Replaces the current reviewers; an empty list removes the requirement. At most 6`),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+			mcp.WithBoolean("prevent_self_review",
+				mcp.Description("Whether the user who triggered a deployment is barred from approving it"),
+			),
+			mcp.WithNumber("wait_timer_minutes",
+				mcp.Description("Minutes to wait before a deployment may proceed, 0 to 43200"),
+				mcp.Min(0),
+				mcp.Max(43200),
+			),
+			mcp.WithString("branch_policy",
+				mcp.Description("Branches that may deploy: all, protected_branches only, or custom_branch_policies (name patterns configured in the repository settings)"),
+				mcp.Enum(branchPolicyAll, branchPolicyProtected, branchPolicyCustom),
+			),
+			mcp.WithBoolean("can_admins_bypass",
+				mcp.Description("Whether repository admins may deploy without passing the protection rules"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			environment, err := RequiredParam[string](request, "environment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			_, setReviewers := request.GetArguments()["required_reviewers"]
+			reviewers, err := OptionalStringArrayParam(request, "required_reviewers")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			preventSelfReview, setPreventSelfReview, err := OptionalParamOK[bool](request, "prevent_self_review")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			waitTimer, setWaitTimer, err := OptionalParamOK[float64](request, "wait_timer_minutes")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branchPolicy, err := OptionalParam[string](request, "branch_policy")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			canAdminsBypass, setCanAdminsBypass, err := OptionalParamOK[bool](request, "can_admins_bypass")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			env, resp, err := client.Repositories.GetEnvironment(ctx, owner, repo, environment)
+			if err != nil {
+				if resp == nil || resp.StatusCode != http.StatusNotFound {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get environment %s", environment), resp, err), nil
+				}
+				// The environment does not exist yet and is created with the given settings.
+				env = nil
+			}
+			if resp != nil {
+				_ = resp.Body.Close()
+			}
+
+			req := environmentRequest(env)
+			if setReviewers {
+				resolved, result := resolveEnvironmentReviewers(ctx, client, owner, reviewers)
+				if result != nil {
+					return result, nil
+				}
+				req.Reviewers = resolved
+			}
+			if setPreventSelfReview {
+				req.PreventSelfReview = github.Ptr(preventSelfReview)
+			}
+			if setWaitTimer {
+				req.WaitTimer = github.Ptr(int(waitTimer))
+			}
+			switch branchPolicy {
+			case branchPolicyAll:
+				req.DeploymentBranchPolicy = nil
+			case branchPolicyProtected:
+				req.DeploymentBranchPolicy = &github.BranchPolicy{ProtectedBranches: github.Ptr(true), CustomBranchPolicies: github.Ptr(false)}
+			case branchPolicyCustom:
+				req.DeploymentBranchPolicy = &github.BranchPolicy{ProtectedBranches: github.Ptr(false), CustomBranchPolicies: github.Ptr(true)}
+			}
+			if setCanAdminsBypass {
+				req.CanAdminsBypass = github.Ptr(canAdminsBypass)
+			}
+
+			_, resp, err = client.Repositories.CreateUpdateEnvironment(ctx, owner, repo, environment, req)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to update environment %s", environment), resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			protection, resp, err := getDeploymentProtection(ctx, client, owner, repo, environment)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get deployment protection rules", resp, err), nil
+			}
+
+			return MarshalledTextResult(protection), nil
+		}
+}
@@ -0,0 +1,478 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mockDeployment = &github.Deployment{
+	ID:          github.Ptr(int64(42)),
+	Ref:         github.Ptr("main"),
+	SHA:         github.Ptr("a1b2c3"),
+	Task:        github.Ptr("deploy"),
+	Environment: github.Ptr("staging"),
+	Payload:     json.RawMessage(`{"migrate":true}`),
+	Creator:     &github.User{Login: github.Ptr("octocat")},
+}
+
+func Test_ListDeployments(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListDeployments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_deployments", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposDeploymentsByOwnerByRepo,
+			expectQueryParams(t, map[string]string{
+				"environment": "staging",
+				"ref":         "main",
+				"page":        "1",
+				"per_page":    "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, []*github.Deployment{mockDeployment}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := ListDeployments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":       "owner",
+		"repo":        "repo",
+		"environment": "staging",
+		"ref":         "main",
+	}))
+	require.NoError(t, err)
+
+	var returned []Deployment
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	require.Len(t, returned, 1)
+	assert.Equal(t, int64(42), returned[0].ID)
+	assert.Equal(t, "octocat", returned[0].Creator)
+	assert.JSONEq(t, `{"migrate":true}`, string(returned[0].Payload))
+}
+
+func Test_CreateDeployment(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateDeployment(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_deployment", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ref"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "deploy without waiting for checks",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposDeploymentsByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"ref":               "main",
+						"environment":       "staging",
+						"payload":           map[string]any{"migrate": true},
+						"auto_merge":        false,
+						"required_contexts": []any{},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockDeployment),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"environment":       "staging",
+				"payload":           map[string]any{"migrate": true},
+				"auto_merge":        false,
+				"required_contexts": []any{},
+			},
+		},
+		{
+			name: "default branch merged into ref",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposDeploymentsByOwnerByRepo,
+					mockResponse(t, http.StatusAccepted, map[string]string{"message": "Auto-merged main into topic on deployment."}),
+				),
+			),
+			expectedText: "GitHub merged the default branch into main because it was behind, and created no deployment. Create the deployment again once the checks of the merge commit have passed.",
+		},
+		{
+			name: "checks failing",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposDeploymentsByOwnerByRepo,
+					mockResponse(t, http.StatusConflict, map[string]string{"message": "Conflict: Commit status checks failed for main."}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to create deployment of main",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateDeployment(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			args := map[string]any{"owner": "owner", "repo": "repo", "ref": "main"}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			if tc.expectedText != "" {
+				assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+				return
+			}
+
+			var returned Deployment
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, int64(42), returned.ID)
+		})
+	}
+}
+
+func Test_ListDeploymentStatuses(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListDeploymentStatuses(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_deployment_statuses", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "deployment_id"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposDeploymentsStatusesByOwnerByRepoByDeploymentId,
+			expectPath(t, "/repos/owner/repo/deployments/42/statuses").andThen(
+				mockResponse(t, http.StatusOK, []*github.DeploymentStatus{
+					{ID: github.Ptr(int64(2)), State: github.Ptr("failure"), LogURL: github.Ptr("https://ci.example.com/42")},
+					{ID: github.Ptr(int64(1)), State: github.Ptr("in_progress")},
+				}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := ListDeploymentStatuses(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":         "owner",
+		"repo":          "repo",
+		"deployment_id": float64(42),
+	}))
+	require.NoError(t, err)
+
+	var returned []DeploymentStatus
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, []DeploymentStatus{
+		{ID: 2, State: "failure", LogURL: "https://ci.example.com/42"},
+		{ID: 1, State: "in_progress"},
+	}, returned)
+}
+
+func Test_CreateDeploymentStatus(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateDeploymentStatus(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_deployment_status", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "deployment_id", "state"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposDeploymentsStatusesByOwnerByRepoByDeploymentId,
+			expect(t, expectations{
+				path: "/repos/owner/repo/deployments/42/statuses",
+				requestBody: map[string]any{
+					"state":           "success",
+					"environment_url": "https://staging.example.com",
+					"auto_inactive":   false,
+				},
+			}).andThen(
+				mockResponse(t, http.StatusCreated, &github.DeploymentStatus{
+					ID:             github.Ptr(int64(3)),
+					State:          github.Ptr("success"),
+					EnvironmentURL: github.Ptr("https://staging.example.com"),
+				}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := CreateDeploymentStatus(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":           "owner",
+		"repo":            "repo",
+		"deployment_id":   float64(42),
+		"state":           "success",
+		"environment_url": "https://staging.example.com",
+		"auto_inactive":   false,
+	}))
+	require.NoError(t, err)
+
+	var returned DeploymentStatus
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, "success", returned.State)
+	assert.Equal(t, "https://staging.example.com", returned.EnvironmentURL)
+}
+
+func Test_ListEnvironments(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListEnvironments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_environments", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposEnvironmentsByOwnerByRepo,
+			&github.EnvResponse{
+				TotalCount: github.Ptr(2),
+				Environments: []*github.Environment{
+					mockProductionEnvironment,
+					{Name: github.Ptr("preview"), CanAdminsBypass: github.Ptr(true)},
+				},
+			},
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := ListEnvironments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+
+	var returned []EnvironmentSummary
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, []EnvironmentSummary{
+		{
+			Name:              "production",
+			RequiredReviewers: []string{"octocat", "team:release"},
+			PreventSelfReview: true,
+			WaitTimerMinutes:  30,
+			BranchPolicy:      branchPolicyProtected,
+		},
+		{
+			Name:              "preview",
+			RequiredReviewers: []string{},
+			BranchPolicy:      branchPolicyAll,
+			CanAdminsBypass:   true,
+		},
+	}, returned)
+}
+
+func Test_CreateOrUpdateEnvironment(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateOrUpdateEnvironment(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_or_update_environment", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "environment"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	production := &github.Environment{
+		Name:            github.Ptr("production"),
+		CanAdminsBypass: github.Ptr(false),
+		DeploymentBranchPolicy: &github.BranchPolicy{
+			ProtectedBranches:    github.Ptr(true),
+			CustomBranchPolicies: github.Ptr(false),
+		},
+		ProtectionRules: []*github.ProtectionRule{
+			{
+				Type:              github.Ptr("required_reviewers"),
+				PreventSelfReview: github.Ptr(true),
+				Reviewers: []*github.RequiredReviewer{
+					{Type: github.Ptr("User"), Reviewer: &github.User{ID: github.Ptr(int64(1)), Login: github.Ptr("octocat")}},
+				},
+			},
+			{Type: github.Ptr("wait_timer"), WaitTimer: github.Ptr(30)},
+		},
+	}
+	staging := &github.Environment{
+		Name:            github.Ptr("staging"),
+		CanAdminsBypass: github.Ptr(true),
+		ProtectionRules: []*github.ProtectionRule{
+			{
+				Type: github.Ptr("required_reviewers"),
+				Reviewers: []*github.RequiredReviewer{
+					{Type: github.Ptr("Team"), Reviewer: &github.Team{ID: github.Ptr(int64(9)), Slug: github.Ptr("release")}},
+				},
+			},
+		},
+	}
+
+	// createdOnPut serves a 404 for the environment until it has been created.
+	createdOnPut := func(env *github.Environment) (http.HandlerFunc, http.HandlerFunc) {
+		created := false
+		get := func(w http.ResponseWriter, r *http.Request) {
+			if !created {
+				mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"})(w, r)
+				return
+			}
+			mockResponse(t, http.StatusOK, env)(w, r)
+		}
+		put := expectRequestBody(t, map[string]any{
+			"wait_timer":               float64(0),
+			"reviewers":                []any{map[string]any{"type": "Team", "id": float64(9)}},
+			"can_admins_bypass":        true,
+			"deployment_branch_policy": nil,
+		}).andThen(func(w http.ResponseWriter, r *http.Request) {
+			created = true
+			mockResponse(t, http.StatusOK, env)(w, r)
+		})
+		return get, put
+	}
+	getStaging, putStaging := createdOnPut(staging)
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]any
+		expectError      bool
+		expectedErrMsg   string
+		expectedReviewer []string
+		expectedTimer    int
+	}{
+		{
+			name: "change the wait timer and keep the other rules",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposEnvironmentsByOwnerByRepoByEnvironmentName,
+					production,
+					production,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutReposEnvironmentsByOwnerByRepoByEnvironmentName,
+					expectRequestBody(t, map[string]any{
+						"wait_timer":          float64(60),
+						"reviewers":           []any{map[string]any{"type": "User", "id": float64(1)}},
+						"can_admins_bypass":   false,
+						"prevent_self_review": true,
+						"deployment_branch_policy": map[string]any{
+							"protected_branches":     true,
+							"custom_branch_policies": false,
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, production),
+					),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposEnvironmentsDeploymentProtectionRulesByOwnerByRepoByEnvironmentName,
+					&github.ListDeploymentProtectionRuleResponse{TotalCount: github.Ptr(0)},
+				),
+			),
+			requestArgs: map[string]any{
+				"environment":        "production",
+				"wait_timer_minutes": float64(60),
+			},
+			expectedReviewer: []string{"octocat"},
+			expectedTimer:    30,
+		},
+		{
+			name: "create an environment reviewed by a team",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposEnvironmentsByOwnerByRepoByEnvironmentName,
+					getStaging,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsTeamsByOrgByTeamSlug,
+					expectPath(t, "/orgs/owner/teams/release").andThen(
+						mockResponse(t, http.StatusOK, &github.Team{ID: github.Ptr(int64(9)), Slug: github.Ptr("release")}),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutReposEnvironmentsByOwnerByRepoByEnvironmentName,
+					putStaging,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposEnvironmentsDeploymentProtectionRulesByOwnerByRepoByEnvironmentName,
+					&github.ListDeploymentProtectionRuleResponse{TotalCount: github.Ptr(0)},
+				),
+			),
+			requestArgs: map[string]any{
+				"environment":        "staging",
+				"required_reviewers": []any{"team:release"},
+			},
+			expectedReviewer: []string{"team:release"},
+		},
+		{
+			name: "unknown reviewer",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposEnvironmentsByOwnerByRepoByEnvironmentName,
+					production,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetUsersByUsername,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]any{
+				"environment":        "production",
+				"required_reviewers": []any{"nobody"},
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get user nobody",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateOrUpdateEnvironment(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			args := map[string]any{"owner": "owner", "repo": "repo"}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned DeploymentProtection
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expectedReviewer, returned.RequiredReviewers)
+			assert.Equal(t, tc.expectedTimer, returned.WaitTimerMinutes)
+		})
+	}
+}
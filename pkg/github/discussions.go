@@ -4,7 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/google/go-github/v74/github"
@@ -248,11 +251,12 @@ func ListDiscussions(getGQLClient GetGQLClientFn, t translations.TranslationHelp
 				"totalCount": totalCount,
 			}
 
-			out, err := json.Marshal(response)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal discussions: %w", err)
+			info := ListPageInfo{
+				TotalCount:  github.Ptr(int(totalCount)),
+				HasNextPage: bool(pageInfo.HasNextPage),
+				NextCursor:  string(pageInfo.EndCursor),
 			}
-			return mcp.NewToolResultText(string(out)), nil
+			return MarshalledListPageResult(ctx, response, discussions, info, "no discussions matched"), nil
 		}
 }
 
@@ -333,9 +337,44 @@ func GetDiscussion(getGQLClient GetGQLClientFn, t translations.TranslationHelper
 		}
 }
 
+// discussionCommentFragment is the part of a discussion comment that top-level comments and
+// replies share.
+type discussionCommentFragment struct {
+	ID        githubv4.ID
+	Body      githubv4.String
+	CreatedAt githubv4.DateTime
+	URL       githubv4.String `graphql:"url"`
+	Author    struct {
+		Login githubv4.String
+	}
+}
+
+func (c discussionCommentFragment) toComment() DiscussionComment {
+	return DiscussionComment{
+		ID:        fmt.Sprint(c.ID),
+		Author:    string(c.Author.Login),
+		Body:      string(c.Body),
+		CreatedAt: c.CreatedAt.Time,
+		HTMLURL:   string(c.URL),
+	}
+}
+
+// DiscussionComment is a comment on a discussion. Top-level comments carry their replies, which
+// cannot be replied to in turn; ReplyCount counts all replies, also those that were left out.
+type DiscussionComment struct {
+	ID         string              `json:"id"`
+	Author     string              `json:"author,omitempty"`
+	Body       string              `json:"body"`
+	CreatedAt  time.Time           `json:"created_at"`
+	HTMLURL    string              `json:"html_url,omitempty"`
+	IsAnswer   bool                `json:"is_answer,omitempty"`
+	ReplyCount int                 `json:"reply_count,omitempty"`
+	Replies    []DiscussionComment `json:"replies,omitempty"`
+}
+
 func GetDiscussionComments(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_discussion_comments",
-			mcp.WithDescription(t("TOOL_GET_DISCUSSION_COMMENTS_DESCRIPTION", "Get comments from a discussion")),
+			mcp.WithDescription(t("TOOL_GET_DISCUSSION_COMMENTS_DESCRIPTION", "Get comments from a discussion, each with its first 20 replies. The IDs can be used to reply to a comment or mark it as the answer")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_DISCUSSION_COMMENTS_USER_TITLE", "Get discussion comments"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -387,7 +426,12 @@ func GetDiscussionComments(getGQLClient GetGQLClientFn, t translations.Translati
 					Discussion struct {
 						Comments struct {
 							Nodes []struct {
-								Body githubv4.String
+								discussionCommentFragment
+								IsAnswer githubv4.Boolean
+								Replies  struct {
+									Nodes      []discussionCommentFragment
+									TotalCount int
+								} `graphql:"replies(first: 20)"`
 							}
 							PageInfo struct {
 								HasNextPage     githubv4.Boolean
@@ -415,9 +459,15 @@ func GetDiscussionComments(getGQLClient GetGQLClientFn, t translations.Translati
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			var comments []*github.IssueComment
+			var comments []DiscussionComment
 			for _, c := range q.Repository.Discussion.Comments.Nodes {
-				comments = append(comments, &github.IssueComment{Body: github.Ptr(string(c.Body))})
+				comment := c.toComment()
+				comment.IsAnswer = bool(c.IsAnswer)
+				comment.ReplyCount = c.Replies.TotalCount
+				for _, r := range c.Replies.Nodes {
+					comment.Replies = append(comment.Replies, r.toComment())
+				}
+				comments = append(comments, comment)
 			}
 
 			// Create response with pagination info
@@ -522,10 +572,293 @@ func ListDiscussionCategories(getGQLClient GetGQLClientFn, t translations.Transl
 				"totalCount": q.Repository.DiscussionCategories.TotalCount,
 			}
 
-			out, err := json.Marshal(response)
+			info := ListPageInfo{
+				TotalCount:  &q.Repository.DiscussionCategories.TotalCount,
+				HasNextPage: bool(q.Repository.DiscussionCategories.PageInfo.HasNextPage),
+				NextCursor:  string(q.Repository.DiscussionCategories.PageInfo.EndCursor),
+			}
+			return MarshalledListPageResult(ctx, response, categories, info, "the repository has no discussion categories"), nil
+		}
+}
+
+// CreateDiscussion creates a tool to start a discussion in a repository.
+func CreateDiscussion(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_discussion",
+			mcp.WithDescription(t("TOOL_CREATE_DISCUSSION_DESCRIPTION", "Start a discussion in a repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_DISCUSSION_USER_TITLE", "Create discussion"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("category",
+				mcp.Required(),
+				mcp.Description("Name or ID of the discussion category, see list_discussion_categories"),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Discussion title"),
+			),
+			mcp.WithString("body",
+				mcp.Required(),
+				mcp.Description("Discussion body in markdown"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal discussion categories: %w", err)
+				return mcp.NewToolResultError(err.Error()), nil
 			}
-			return mcp.NewToolResultText(string(out)), nil
+			category, err := RequiredParam[string](request, "category")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := RequiredParam[string](request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := RequiredParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			var q struct {
+				Repository struct {
+					ID                   githubv4.ID
+					DiscussionCategories struct {
+						Nodes []struct {
+							ID   githubv4.ID
+							Name githubv4.String
+						}
+					} `graphql:"discussionCategories(first: 100)"`
+				} `graphql:"repository(owner: $owner, name: $repo)"`
+			}
+			if err := client.Query(ctx, &q, map[string]any{
+				"owner": githubv4.String(owner),
+				"repo":  githubv4.String(repo),
+			}); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get discussion categories", err), nil
+			}
+
+			var categoryID githubv4.ID
+			names := make([]string, 0, len(q.Repository.DiscussionCategories.Nodes))
+			for _, c := range q.Repository.DiscussionCategories.Nodes {
+				if fmt.Sprint(c.ID) == category || strings.EqualFold(string(c.Name), category) {
+					categoryID = c.ID
+					break
+				}
+				names = append(names, string(c.Name))
+			}
+			if categoryID == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("category %q does not exist in %s/%s, available categories: %s", category, owner, repo, strings.Join(names, ", "))), nil
+			}
+
+			var mutation struct {
+				CreateDiscussion struct {
+					Discussion struct {
+						ID     githubv4.ID
+						Number githubv4.Int
+						URL    githubv4.String `graphql:"url"`
+					}
+				} `graphql:"createDiscussion(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, githubv4.CreateDiscussionInput{
+				RepositoryID: q.Repository.ID,
+				CategoryID:   categoryID,
+				Title:        githubv4.String(title),
+				Body:         githubv4.String(body),
+			}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to create discussion", err), nil
+			}
+
+			d := mutation.CreateDiscussion.Discussion
+			return MarshalledTextResult(map[string]any{
+				"id":       fmt.Sprint(d.ID),
+				"number":   int(d.Number),
+				"html_url": string(d.URL),
+			}), nil
+		}
+}
+
+// AddDiscussionComment creates a tool to comment on a discussion or reply to one of its comments.
+func AddDiscussionComment(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_discussion_comment",
+			mcp.WithDescription(t("TOOL_ADD_DISCUSSION_COMMENT_DESCRIPTION", "Comment on a discussion, or reply to one of its top-level comments")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ADD_DISCUSSION_COMMENT_USER_TITLE", "Add discussion comment"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("discussionNumber",
+				mcp.Required(),
+				mcp.Description("Discussion Number"),
+			),
+			mcp.WithString("body",
+				mcp.Required(),
+				mcp.Description("Comment body in markdown"),
+			),
+			mcp.WithString("reply_to_id",
+				mcp.Description("ID of the top-level comment to reply to, as returned by get_discussion_comments"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			discussionNumber, err := RequiredInt(request, "discussionNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := RequiredParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			replyToID, err := OptionalParam[string](request, "reply_to_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			var q struct {
+				Repository struct {
+					Discussion struct {
+						ID githubv4.ID
+					} `graphql:"discussion(number: $discussionNumber)"`
+				} `graphql:"repository(owner: $owner, name: $repo)"`
+			}
+			if err := client.Query(ctx, &q, map[string]any{
+				"owner":            githubv4.String(owner),
+				"repo":             githubv4.String(repo),
+				"discussionNumber": githubv4.Int(discussionNumber), // #nosec G115 - discussion numbers are positive integers well below the int32 limit
+			}); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get discussion", err), nil
+			}
+
+			input := githubv4.AddDiscussionCommentInput{
+				DiscussionID: q.Repository.Discussion.ID,
+				Body:         githubv4.String(body),
+			}
+			if replyToID != "" {
+				input.ReplyToID = githubv4.NewID(githubv4.ID(replyToID))
+			}
+			var mutation struct {
+				AddDiscussionComment struct {
+					Comment struct {
+						ID  githubv4.ID
+						URL githubv4.String `graphql:"url"`
+					}
+				} `graphql:"addDiscussionComment(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to add discussion comment", err), nil
+			}
+
+			c := mutation.AddDiscussionComment.Comment
+			return MarshalledTextResult(map[string]any{
+				"id":       fmt.Sprint(c.ID),
+				"html_url": string(c.URL),
+			}), nil
+		}
+}
+
+// MarkDiscussionCommentAsAnswer creates a tool to mark a comment as the answer of a discussion in a
+// category that accepts answers, or to unmark it again.
+func MarkDiscussionCommentAsAnswer(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("mark_discussion_comment_as_answer",
+			mcp.WithDescription(t("TOOL_MARK_DISCUSSION_COMMENT_AS_ANSWER_DESCRIPTION", "Mark a comment as the answer of a discussion, or unmark it. Only discussions in categories that accept answers, such as Q&A, have answers")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_MARK_DISCUSSION_COMMENT_AS_ANSWER_USER_TITLE", "Mark discussion comment as answer"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("comment_id",
+				mcp.Required(),
+				mcp.Description("ID of the comment, as returned by get_discussion_comments"),
+			),
+			mcp.WithBoolean("unmark",
+				mcp.Description("Unmark the comment as the answer instead"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			commentID, err := RequiredParam[string](request, "comment_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			unmark, err := OptionalParam[bool](request, "unmark")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			var discussionURL githubv4.String
+			if unmark {
+				var mutation struct {
+					UnmarkDiscussionCommentAsAnswer struct {
+						Discussion struct {
+							URL githubv4.String `graphql:"url"`
+						}
+					} `graphql:"unmarkDiscussionCommentAsAnswer(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &mutation, githubv4.UnmarkDiscussionCommentAsAnswerInput{
+					ID: githubv4.ID(commentID),
+				}, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to unmark discussion comment as answer", err), nil
+				}
+				discussionURL = mutation.UnmarkDiscussionCommentAsAnswer.Discussion.URL
+			} else {
+				var mutation struct {
+					MarkDiscussionCommentAsAnswer struct {
+						Discussion struct {
+							URL githubv4.String `graphql:"url"`
+						}
+					} `graphql:"markDiscussionCommentAsAnswer(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &mutation, githubv4.MarkDiscussionCommentAsAnswerInput{
+					ID: githubv4.ID(commentID),
+				}, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to mark discussion comment as answer", err), nil
+				}
+				discussionURL = mutation.MarkDiscussionCommentAsAnswer.Discussion.URL
+			}
+
+			return MarshalledTextResult(map[string]any{
+				"comment_id":     commentID,
+				"is_answer":      !unmark,
+				"discussion_url": string(discussionURL),
+			}), nil
 		}
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v74/github"
 	"github.com/shurcooL/githubv4"
@@ -570,7 +571,7 @@ func Test_GetDiscussionComments(t *testing.T) {
 	assert.ElementsMatch(t, toolDef.InputSchema.Required, []string{"owner", "repo", "discussionNumber"})
 
 	// Use exact string query that matches implementation output
-	qGetComments := "query($after:String$discussionNumber:Int!$first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussion(number: $discussionNumber){comments(first: $first, after: $after){nodes{body},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}}"
+	qGetComments := "query($after:String$discussionNumber:Int!$first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussion(number: $discussionNumber){comments(first: $first, after: $after){nodes{id,body,createdAt,url,author{login},isAnswer,replies(first: 20){nodes{id,body,createdAt,url,author{login}},totalCount}},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}}"
 
 	// Variables matching what GraphQL receives after JSON marshaling/unmarshaling
 	vars := map[string]interface{}{
@@ -586,8 +587,38 @@ func Test_GetDiscussionComments(t *testing.T) {
 			"discussion": map[string]any{
 				"comments": map[string]any{
 					"nodes": []map[string]any{
-						{"body": "This is the first comment"},
-						{"body": "This is the second comment"},
+						{
+							"id":        "DC_1",
+							"body":      "This is the first comment",
+							"createdAt": "2025-04-25T12:00:00Z",
+							"url":       "https://github.com/owner/repo/discussions/1#discussioncomment-1",
+							"author":    map[string]any{"login": "octocat"},
+							"isAnswer":  true,
+							"replies": map[string]any{
+								"nodes": []map[string]any{
+									{
+										"id":        "DC_3",
+										"body":      "Thanks, that worked",
+										"createdAt": "2025-04-25T13:00:00Z",
+										"url":       "https://github.com/owner/repo/discussions/1#discussioncomment-3",
+										"author":    map[string]any{"login": "hubot"},
+									},
+								},
+								"totalCount": 1,
+							},
+						},
+						{
+							"id":        "DC_2",
+							"body":      "This is the second comment",
+							"createdAt": "2025-04-25T12:30:00Z",
+							"url":       "https://github.com/owner/repo/discussions/1#discussioncomment-2",
+							"author":    map[string]any{"login": "monalisa"},
+							"isAnswer":  false,
+							"replies": map[string]any{
+								"nodes":      []map[string]any{},
+								"totalCount": 0,
+							},
+						},
 					},
 					"pageInfo": map[string]any{
 						"hasNextPage":     false,
@@ -619,7 +650,7 @@ func Test_GetDiscussionComments(t *testing.T) {
 	// (Lines removed)
 
 	var response struct {
-		Comments []*github.IssueComment `json:"comments"`
+		Comments []DiscussionComment `json:"comments"`
 		PageInfo struct {
 			HasNextPage     bool   `json:"hasNextPage"`
 			HasPreviousPage bool   `json:"hasPreviousPage"`
@@ -633,8 +664,17 @@ func Test_GetDiscussionComments(t *testing.T) {
 	assert.Len(t, response.Comments, 2)
 	expectedBodies := []string{"This is the first comment", "This is the second comment"}
 	for i, comment := range response.Comments {
-		assert.Equal(t, expectedBodies[i], *comment.Body)
+		assert.Equal(t, expectedBodies[i], comment.Body)
 	}
+	assert.Equal(t, "DC_1", response.Comments[0].ID)
+	assert.Equal(t, "octocat", response.Comments[0].Author)
+	assert.True(t, response.Comments[0].IsAnswer)
+	assert.Equal(t, 1, response.Comments[0].ReplyCount)
+	require.Len(t, response.Comments[0].Replies, 1)
+	assert.Equal(t, "DC_3", response.Comments[0].Replies[0].ID)
+	assert.Equal(t, "Thanks, that worked", response.Comments[0].Replies[0].Body)
+	assert.False(t, response.Comments[1].IsAnswer)
+	assert.Empty(t, response.Comments[1].Replies)
 }
 
 func Test_ListDiscussionCategories(t *testing.T) {
@@ -776,3 +816,320 @@ func Test_ListDiscussionCategories(t *testing.T) {
 		})
 	}
 }
+
+func Test_CreateDiscussion(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := CreateDiscussion(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_discussion", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "category", "title", "body"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	categoriesQuery := githubv4mock.NewQueryMatcher(
+		struct {
+			Repository struct {
+				ID                   githubv4.ID
+				DiscussionCategories struct {
+					Nodes []struct {
+						ID   githubv4.ID
+						Name githubv4.String
+					}
+				} `graphql:"discussionCategories(first: 100)"`
+			} `graphql:"repository(owner: $owner, name: $repo)"`
+		}{},
+		map[string]any{
+			"owner": githubv4.String("owner"),
+			"repo":  githubv4.String("repo"),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{
+				"id": "R_repo",
+				"discussionCategories": map[string]any{
+					"nodes": []any{
+						map[string]any{"id": "DIC_general", "name": "General"},
+						map[string]any{"id": "DIC_qa", "name": "Q&A"},
+					},
+				},
+			},
+		}),
+	)
+	createMutation := githubv4mock.NewMutationMatcher(
+		struct {
+			CreateDiscussion struct {
+				Discussion struct {
+					ID     githubv4.ID
+					Number githubv4.Int
+					URL    githubv4.String `graphql:"url"`
+				}
+			} `graphql:"createDiscussion(input: $input)"`
+		}{},
+		githubv4.CreateDiscussionInput{
+			RepositoryID: githubv4.ID("R_repo"),
+			CategoryID:   githubv4.ID("DIC_qa"),
+			Title:        githubv4.String("How do I configure the cache?"),
+			Body:         githubv4.String("The docs do not say."),
+		},
+		nil,
+		githubv4mock.DataResponse(map[string]any{
+			"createDiscussion": map[string]any{
+				"discussion": map[string]any{
+					"id":     "D_7",
+					"number": 7,
+					"url":    "https://github.com/owner/repo/discussions/7",
+				},
+			},
+		}),
+	)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		category       string
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name:         "category by name",
+			mockedClient: githubv4mock.NewMockedHTTPClient(categoriesQuery, createMutation),
+			category:     "q&a",
+		},
+		{
+			name:         "category by ID",
+			mockedClient: githubv4mock.NewMockedHTTPClient(categoriesQuery, createMutation),
+			category:     "DIC_qa",
+		},
+		{
+			name:           "unknown category",
+			mockedClient:   githubv4mock.NewMockedHTTPClient(categoriesQuery),
+			category:       "Ideas",
+			expectError:    true,
+			expectedErrMsg: `category "Ideas" does not exist in owner/repo, available categories: General, Q&A`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := CreateDiscussion(stubGetGQLClientFn(githubv4.NewClient(tc.mockedClient)), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"category": tc.category,
+				"title":    "How do I configure the cache?",
+				"body":     "The docs do not say.",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned map[string]any
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, "D_7", returned["id"])
+			assert.Equal(t, float64(7), returned["number"])
+			assert.Equal(t, "https://github.com/owner/repo/discussions/7", returned["html_url"])
+		})
+	}
+}
+
+func Test_AddDiscussionComment(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := AddDiscussionComment(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "add_discussion_comment", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "discussionNumber", "body"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	discussionQuery := githubv4mock.NewQueryMatcher(
+		struct {
+			Repository struct {
+				Discussion struct {
+					ID githubv4.ID
+				} `graphql:"discussion(number: $discussionNumber)"`
+			} `graphql:"repository(owner: $owner, name: $repo)"`
+		}{},
+		map[string]any{
+			"owner":            githubv4.String("owner"),
+			"repo":             githubv4.String("repo"),
+			"discussionNumber": githubv4.Int(7),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{
+				"discussion": map[string]any{"id": "D_7"},
+			},
+		}),
+	)
+	addMutation := func(input githubv4.AddDiscussionCommentInput) githubv4mock.Matcher {
+		return githubv4mock.NewMutationMatcher(
+			struct {
+				AddDiscussionComment struct {
+					Comment struct {
+						ID  githubv4.ID
+						URL githubv4.String `graphql:"url"`
+					}
+				} `graphql:"addDiscussionComment(input: $input)"`
+			}{},
+			input,
+			nil,
+			githubv4mock.DataResponse(map[string]any{
+				"addDiscussionComment": map[string]any{
+					"comment": map[string]any{
+						"id":  "DC_9",
+						"url": "https://github.com/owner/repo/discussions/7#discussioncomment-9",
+					},
+				},
+			}),
+		)
+	}
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		requestArgs  map[string]any
+	}{
+		{
+			name: "comment on the discussion",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				discussionQuery,
+				addMutation(githubv4.AddDiscussionCommentInput{
+					DiscussionID: githubv4.ID("D_7"),
+					Body:         githubv4.String("Set cache.size in the config file."),
+				}),
+			),
+			requestArgs: map[string]any{
+				"body": "Set cache.size in the config file.",
+			},
+		},
+		{
+			name: "reply to a comment",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				discussionQuery,
+				addMutation(githubv4.AddDiscussionCommentInput{
+					DiscussionID: githubv4.ID("D_7"),
+					Body:         githubv4.String("Set cache.size in the config file."),
+					ReplyToID:    githubv4.NewID(githubv4.ID("DC_1")),
+				}),
+			),
+			requestArgs: map[string]any{
+				"body":        "Set cache.size in the config file.",
+				"reply_to_id": "DC_1",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := AddDiscussionComment(stubGetGQLClientFn(githubv4.NewClient(tc.mockedClient)), translations.NullTranslationHelper)
+
+			args := map[string]any{
+				"owner":            "owner",
+				"repo":             "repo",
+				"discussionNumber": float64(7),
+			}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			var returned map[string]string
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, "DC_9", returned["id"])
+		})
+	}
+}
+
+func Test_MarkDiscussionCommentAsAnswer(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := MarkDiscussionCommentAsAnswer(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "mark_discussion_comment_as_answer", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"comment_id"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	t.Run("mark as answer", func(t *testing.T) {
+		mockedClient := githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewMutationMatcher(
+				struct {
+					MarkDiscussionCommentAsAnswer struct {
+						Discussion struct {
+							URL githubv4.String `graphql:"url"`
+						}
+					} `graphql:"markDiscussionCommentAsAnswer(input: $input)"`
+				}{},
+				githubv4.MarkDiscussionCommentAsAnswerInput{ID: githubv4.ID("DC_9")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"markDiscussionCommentAsAnswer": map[string]any{
+						"discussion": map[string]any{"url": "https://github.com/owner/repo/discussions/7"},
+					},
+				}),
+			),
+		)
+		_, handler := MarkDiscussionCommentAsAnswer(stubGetGQLClientFn(githubv4.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{"comment_id": "DC_9"}))
+		require.NoError(t, err)
+
+		var returned map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		assert.Equal(t, true, returned["is_answer"])
+		assert.Equal(t, "https://github.com/owner/repo/discussions/7", returned["discussion_url"])
+	})
+
+	t.Run("unmark", func(t *testing.T) {
+		mockedClient := githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewMutationMatcher(
+				struct {
+					UnmarkDiscussionCommentAsAnswer struct {
+						Discussion struct {
+							URL githubv4.String `graphql:"url"`
+						}
+					} `graphql:"unmarkDiscussionCommentAsAnswer(input: $input)"`
+				}{},
+				githubv4.UnmarkDiscussionCommentAsAnswerInput{ID: githubv4.ID("DC_9")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"unmarkDiscussionCommentAsAnswer": map[string]any{
+						"discussion": map[string]any{"url": "https://github.com/owner/repo/discussions/7"},
+					},
+				}),
+			),
+		)
+		_, handler := MarkDiscussionCommentAsAnswer(stubGetGQLClientFn(githubv4.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{"comment_id": "DC_9", "unmark": true}))
+		require.NoError(t, err)
+
+		var returned map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		assert.Equal(t, false, returned["is_answer"])
+	})
+
+	t.Run("category without answers", func(t *testing.T) {
+		mockedClient := githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewMutationMatcher(
+				struct {
+					MarkDiscussionCommentAsAnswer struct {
+						Discussion struct {
+							URL githubv4.String `graphql:"url"`
+						}
+					} `graphql:"markDiscussionCommentAsAnswer(input: $input)"`
+				}{},
+				githubv4.MarkDiscussionCommentAsAnswerInput{ID: githubv4.ID("DC_9")},
+				nil,
+				githubv4mock.ErrorResponse("Discussion category does not accept answers"),
+			),
+		)
+		_, handler := MarkDiscussionCommentAsAnswer(stubGetGQLClientFn(githubv4.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{"comment_id": "DC_9"}))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to mark discussion comment as answer")
+	})
+}
@@ -12,11 +12,7 @@ import (
 )
 
 func ToolsetEnum(toolsetGroup *toolsets.ToolsetGroup) mcp.PropertyOption {
-	toolsetNames := make([]string, 0, len(toolsetGroup.Toolsets))
-	for name := range toolsetGroup.Toolsets {
-		toolsetNames = append(toolsetNames, name)
-	}
-	return mcp.Enum(toolsetNames...)
+	return mcp.Enum(toolsetGroup.ToolsetNames()...)
 }
 
 func EnableToolset(s *server.MCPServer, toolsetGroup *toolsets.ToolsetGroup, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
@@ -67,12 +63,13 @@ func ListAvailableToolsets(toolsetGroup *toolsets.ToolsetGroup, t translations.T
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
 		),
-		func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			// We need to convert the toolsetGroup back to a map for JSON serialization
 
 			payload := []map[string]string{}
 
-			for name, ts := range toolsetGroup.Toolsets {
+			for _, name := range toolsetGroup.ToolsetNames() {
+				ts := toolsetGroup.Toolsets[name]
 				{
 					t := map[string]string{
 						"name":              name,
@@ -84,12 +81,7 @@ func ListAvailableToolsets(toolsetGroup *toolsets.ToolsetGroup, t translations.T
 				}
 			}
 
-			r, err := json.Marshal(payload)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal features: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledListResult(ctx, payload, "no toolsets are available"), nil
 		}
 }
 
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v74/github"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -80,19 +82,88 @@ func ListGists(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list gists: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(gists)
+			return MarshalledListResult(ctx, gists, "no gists found"), nil
+		}
+}
+
+// GetGist creates a tool to get a gist with the contents of its files
+func GetGist(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_gist",
+			mcp.WithDescription(t("TOOL_GET_GIST_DESCRIPTION", "Get a gist with the contents of its files. Files larger than one megabyte are truncated; their raw_url has the full content")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_GIST", "Get Gist"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("gist_id",
+				mcp.Required(),
+				mcp.Description("ID of the gist"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			gistID, err := RequiredParam[string](request, "gist_id")
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			gist, resp, err := client.Gists.Get(ctx, gistID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get gist %s", gistID),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(gist), nil
 		}
 }
 
+// gistFiles returns the files of a create or update request, mapping filenames to contents. They
+// come from the files object and from filename and content, which must be given together. Unless
+// optional is set, at least one file is required.
+func gistFiles(request mcp.CallToolRequest, optional bool) (map[string]string, error) {
+	files := make(map[string]string)
+	if raw, ok := request.GetArguments()["files"]; ok && raw != nil {
+		m, ok := raw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("files must be an object mapping filenames to contents")
+		}
+		for filename, v := range m {
+			content, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("content of file %s must be a string", filename)
+			}
+			files[filename] = content
+		}
+	}
+
+	args := request.GetArguments()
+	_, hasFilename := args["filename"]
+	_, hasContent := args["content"]
+	if (len(files) == 0 && !optional) || hasFilename || hasContent {
+		filename, err := RequiredParam[string](request, "filename")
+		if err != nil {
+			return nil, err
+		}
+		content, err := RequiredParam[string](request, "content")
+		if err != nil {
+			return nil, err
+		}
+		files[filename] = content
+	}
+	return files, nil
+}
+
 // CreateGist creates a tool to create a new gist
 func CreateGist(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("create_gist",
-			mcp.WithDescription(t("TOOL_CREATE_GIST_DESCRIPTION", "Create a new gist")),
+			mcp.WithDescription(t("TOOL_CREATE_GIST_DESCRIPTION", "Create a new gist. Give a single file with filename and content, or several with files")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_CREATE_GIST", "Create Gist"),
 				ReadOnlyHint: ToBoolPtr(false),
@@ -101,13 +172,14 @@ func CreateGist(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				mcp.Description("Description of the gist"),
 			),
 			mcp.WithString("filename",
-				mcp.Required(),
 				mcp.Description("Filename for simple single-file gist creation"),
 			),
 			mcp.WithString("content",
-				mcp.Required(),
 				mcp.Description("Content for simple single-file gist creation"),
 			),
+			mcp.WithObject("files",
+				mcp.Description("Files of the gist, mapping filenames to contents, e.g. {\"main.go\": \"package main\", \"README.md\": \"# Notes\"}"),
+			),
 			mcp.WithBoolean("public",
 				mcp.Description("Whether the gist is public"),
 				mcp.DefaultBool(false),
@@ -119,12 +191,7 @@ func CreateGist(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			filename, err := RequiredParam[string](request, "filename")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-
-			content, err := RequiredParam[string](request, "content")
+			contents, err := gistFiles(request, false)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -134,10 +201,12 @@ func CreateGist(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			files := make(map[github.GistFilename]github.GistFile)
-			files[github.GistFilename(filename)] = github.GistFile{
-				Filename: github.Ptr(filename),
-				Content:  github.Ptr(content),
+			files := make(map[github.GistFilename]github.GistFile, len(contents))
+			for filename, content := range contents {
+				files[github.GistFilename(filename)] = github.GistFile{
+					Filename: github.Ptr(filename),
+					Content:  github.Ptr(content),
+				}
 			}
 
 			gist := &github.Gist{
@@ -182,7 +251,7 @@ func CreateGist(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 // UpdateGist creates a tool to edit an existing gist
 func UpdateGist(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("update_gist",
-			mcp.WithDescription(t("TOOL_UPDATE_GIST_DESCRIPTION", "Update an existing gist")),
+			mcp.WithDescription(t("TOOL_UPDATE_GIST_DESCRIPTION", "Update an existing gist. Change a single file with filename and content, or several with files, and remove files with delete_files")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_UPDATE_GIST", "Update Gist"),
 				ReadOnlyHint: ToBoolPtr(false),
@@ -195,13 +264,20 @@ func UpdateGist(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				mcp.Description("Updated description of the gist"),
 			),
 			mcp.WithString("filename",
-				mcp.Required(),
 				mcp.Description("Filename to update or create"),
 			),
 			mcp.WithString("content",
-				mcp.Required(),
 				mcp.Description("Content for the file"),
 			),
+			mcp.WithObject("files",
+				mcp.Description("Files to update or create, mapping filenames to contents"),
+			),
+			mcp.WithArray("delete_files",
+				mcp.Description("Filenames to remove from the gist"),
+				mcp.Items(map[string]any{
+					"type": "string",
+				}),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			gistID, err := RequiredParam[string](request, "gist_id")
@@ -209,30 +285,36 @@ func UpdateGist(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			description, err := OptionalParam[string](request, "description")
+			description, hasDescription, err := OptionalParamOK[string](request, "description")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			filename, err := RequiredParam[string](request, "filename")
+			deleteFiles, err := OptionalStringArrayParam(request, "delete_files")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			content, err := RequiredParam[string](request, "content")
+			contents, err := gistFiles(request, len(deleteFiles) > 0)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			files := make(map[github.GistFilename]github.GistFile)
-			files[github.GistFilename(filename)] = github.GistFile{
-				Filename: github.Ptr(filename),
-				Content:  github.Ptr(content),
+			// The gist API removes a file that is set to null, which github.GistFile cannot express,
+			// so the request body is built by hand.
+			files := make(map[string]any, len(contents)+len(deleteFiles))
+			for filename, content := range contents {
+				files[filename] = map[string]string{"content": content}
 			}
-
-			gist := &github.Gist{
-				Files:       files,
-				Description: github.Ptr(description),
+			for _, filename := range deleteFiles {
+				if _, ok := contents[filename]; ok {
+					return mcp.NewToolResultError(fmt.Sprintf("file %s is both updated and deleted", filename)), nil
+				}
+				files[filename] = nil
+			}
+			body := map[string]any{"files": files}
+			if hasDescription {
+				body["description"] = description
 			}
 
 			client, err := getClient(ctx)
@@ -240,7 +322,12 @@ func UpdateGist(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
-			updatedGist, resp, err := client.Gists.Edit(ctx, gistID, gist)
+			req, err := client.NewRequest(http.MethodPatch, "gists/"+url.PathEscape(gistID), body)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			updatedGist := new(github.Gist)
+			resp, err := client.Do(ctx, req, updatedGist)
 			if err != nil {
 				return nil, fmt.Errorf("failed to update gist: %w", err)
 			}
@@ -267,3 +354,215 @@ func UpdateGist(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
+
+// DeleteGist creates a tool to delete a gist
+func DeleteGist(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_gist",
+			mcp.WithDescription(t("TOOL_DELETE_GIST_DESCRIPTION", "Delete a gist of the authenticated user")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_DELETE_GIST", "Delete Gist"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("gist_id",
+				mcp.Required(),
+				mcp.Description("ID of the gist to delete"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			gistID, err := RequiredParam[string](request, "gist_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Gists.Delete(ctx, gistID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to delete gist %s", gistID),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Successfully deleted gist %s", gistID)), nil
+		}
+}
+
+// StarGist creates a tool to star a gist
+func StarGist(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("star_gist",
+			mcp.WithDescription(t("TOOL_STAR_GIST_DESCRIPTION", "Star a gist")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_STAR_GIST", "Star Gist"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("gist_id",
+				mcp.Required(),
+				mcp.Description("ID of the gist to star"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			gistID, err := RequiredParam[string](request, "gist_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Gists.Star(ctx, gistID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to star gist %s", gistID),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Successfully starred gist %s", gistID)), nil
+		}
+}
+
+// UnstarGist creates a tool to unstar a gist
+func UnstarGist(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("unstar_gist",
+			mcp.WithDescription(t("TOOL_UNSTAR_GIST_DESCRIPTION", "Unstar a gist")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UNSTAR_GIST", "Unstar Gist"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("gist_id",
+				mcp.Required(),
+				mcp.Description("ID of the gist to unstar"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			gistID, err := RequiredParam[string](request, "gist_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Gists.Unstar(ctx, gistID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to unstar gist %s", gistID),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Successfully unstarred gist %s", gistID)), nil
+		}
+}
+
+// IsGistStarred creates a tool to check whether the authenticated user has starred a gist
+func IsGistStarred(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("is_gist_starred",
+			mcp.WithDescription(t("TOOL_IS_GIST_STARRED_DESCRIPTION", "Check whether the authenticated user has starred a gist")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_IS_GIST_STARRED", "Check if Gist is starred"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("gist_id",
+				mcp.Required(),
+				mcp.Description("ID of the gist to check"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			gistID, err := RequiredParam[string](request, "gist_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			starred, resp, err := client.Gists.IsStarred(ctx, gistID)
+			// The API answers 404 when the gist is not starred.
+			if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to check if gist %s is starred", gistID),
+					resp,
+					err,
+				), nil
+			}
+			if resp != nil {
+				defer func() { _ = resp.Body.Close() }()
+			}
+
+			r, err := json.Marshal(map[string]any{
+				"gist_id": gistID,
+				"starred": starred,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ForkGist creates a tool to fork a gist
+func ForkGist(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("fork_gist",
+			mcp.WithDescription(t("TOOL_FORK_GIST_DESCRIPTION", "Fork a gist to the authenticated user's account")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_FORK_GIST", "Fork Gist"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("gist_id",
+				mcp.Required(),
+				mcp.Description("ID of the gist to fork"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			gistID, err := RequiredParam[string](request, "gist_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			forkedGist, resp, err := client.Gists.Fork(ctx, gistID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to fork gist %s", gistID),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			minimalResponse := MinimalResponse{
+				ID:  forkedGist.GetID(),
+				URL: forkedGist.GetHTMLURL(),
+			}
+
+			r, err := json.Marshal(minimalResponse)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/github/github-mcp-server/internal/toolsnaps"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v74/github"
 	"github.com/migueleliasweb/go-github-mock/src/mock"
@@ -192,6 +193,94 @@ func Test_ListGists(t *testing.T) {
 	}
 }
 
+func Test_GetGist(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := GetGist(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_gist", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"gist_id"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	gist := &github.Gist{
+		ID:          github.Ptr("gist1"),
+		Description: github.Ptr("Scratch files"),
+		HTMLURL:     github.Ptr("https://gist.github.com/user/gist1"),
+		Files: map[github.GistFilename]github.GistFile{
+			"main.go": {
+				Filename: github.Ptr("main.go"),
+				Content:  github.Ptr("package main"),
+			},
+			"README.md": {
+				Filename: github.Ptr("README.md"),
+				Content:  github.Ptr("# Notes"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "get gist",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetGistsByGistId,
+					expectPath(t, "/gists/gist1").andThen(
+						mockResponse(t, http.StatusOK, gist),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"gist_id": "gist1",
+			},
+		},
+		{
+			name: "gist not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetGistsByGistId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"gist_id": "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get gist missing",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetGist(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned github.Gist
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, "gist1", returned.GetID())
+			require.Len(t, returned.Files, 2)
+			readme := returned.Files["README.md"]
+			assert.Equal(t, "# Notes", readme.GetContent())
+		})
+	}
+}
+
 func Test_CreateGist(t *testing.T) {
 	// Verify tool definition
 	mockClient := github.NewClient(nil)
@@ -203,10 +292,10 @@ func Test_CreateGist(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "filename")
 	assert.Contains(t, tool.InputSchema.Properties, "content")
 	assert.Contains(t, tool.InputSchema.Properties, "public")
+	assert.Contains(t, tool.InputSchema.Properties, "files")
 
-	// Verify required parameters
-	assert.Contains(t, tool.InputSchema.Required, "filename")
-	assert.Contains(t, tool.InputSchema.Required, "content")
+	// A single file or several can be given, so no parameter is required
+	assert.Empty(t, tool.InputSchema.Required)
 
 	// Setup mock data for test cases
 	createdGist := &github.Gist{
@@ -249,6 +338,33 @@ func Test_CreateGist(t *testing.T) {
 			expectError:  false,
 			expectedGist: createdGist,
 		},
+		{
+			name: "create gist with several files",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostGists,
+					expectRequestBody(t, map[string]any{
+						"description": "Test Gist",
+						"public":      false,
+						"files": map[string]any{
+							"main.go":   map[string]any{"filename": "main.go", "content": "package main"},
+							"README.md": map[string]any{"filename": "README.md", "content": "# Notes"},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, createdGist),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"files": map[string]any{
+					"main.go":   "package main",
+					"README.md": "# Notes",
+				},
+				"description": "Test Gist",
+			},
+			expectError:  false,
+			expectedGist: createdGist,
+		},
 		{
 			name:         "missing required filename",
 			mockedClient: mock.NewMockedHTTPClient(),
@@ -342,11 +458,11 @@ func Test_UpdateGist(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "description")
 	assert.Contains(t, tool.InputSchema.Properties, "filename")
 	assert.Contains(t, tool.InputSchema.Properties, "content")
+	assert.Contains(t, tool.InputSchema.Properties, "files")
+	assert.Contains(t, tool.InputSchema.Properties, "delete_files")
 
 	// Verify required parameters
-	assert.Contains(t, tool.InputSchema.Required, "gist_id")
-	assert.Contains(t, tool.InputSchema.Required, "filename")
-	assert.Contains(t, tool.InputSchema.Required, "content")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"gist_id"})
 
 	// Setup mock data for test cases
 	updatedGist := &github.Gist{
@@ -389,6 +505,64 @@ func Test_UpdateGist(t *testing.T) {
 			expectError:  false,
 			expectedGist: updatedGist,
 		},
+		{
+			name: "update and delete several files",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchGistsByGistId,
+					expectRequestBody(t, map[string]any{
+						"files": map[string]any{
+							"updated.go": map[string]any{"content": "package main"},
+							"notes.md":   map[string]any{"content": "# Notes"},
+							"old.txt":    nil,
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, updatedGist),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"gist_id": "existing-gist-id",
+				"files": map[string]any{
+					"updated.go": "package main",
+					"notes.md":   "# Notes",
+				},
+				"delete_files": []any{"old.txt"},
+			},
+			expectError:  false,
+			expectedGist: updatedGist,
+		},
+		{
+			name: "only delete a file",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchGistsByGistId,
+					expectRequestBody(t, map[string]any{
+						"files": map[string]any{"old.txt": nil},
+					}).andThen(
+						mockResponse(t, http.StatusOK, updatedGist),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"gist_id":      "existing-gist-id",
+				"delete_files": []any{"old.txt"},
+			},
+			expectError:  false,
+			expectedGist: updatedGist,
+		},
+		{
+			name:         "file both updated and deleted",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"gist_id":      "existing-gist-id",
+				"filename":     "old.txt",
+				"content":      "still here",
+				"delete_files": []any{"old.txt"},
+			},
+			expectError:    true,
+			expectedErrMsg: "file old.txt is both updated and deleted",
+		},
 		{
 			name:         "missing required gist_id",
 			mockedClient: mock.NewMockedHTTPClient(),
@@ -484,3 +658,314 @@ func Test_UpdateGist(t *testing.T) {
 		})
 	}
 }
+
+func Test_DeleteGist(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteGist(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_gist", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"gist_id"})
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	t.Run("delete gist", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.DeleteGistsByGistId,
+				expectPath(t, "/gists/gist1").andThen(
+					mockResponse(t, http.StatusNoContent, nil),
+				),
+			),
+		))
+		_, handler := DeleteGist(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{"gist_id": "gist1"}))
+		require.NoError(t, err)
+		assert.Equal(t, "Successfully deleted gist gist1", getTextResult(t, result).Text)
+	})
+
+	t.Run("gist of another user", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.DeleteGistsByGistId,
+				mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+			),
+		))
+		_, handler := DeleteGist(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{"gist_id": "gist1"}))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to delete gist gist1")
+	})
+}
+
+func Test_StarGist(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := StarGist(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "star_gist", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "gist_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"gist_id"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "star gist",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutGistsStarByGistId,
+					expectPath(t, "/gists/gist1/star").andThen(
+						mockResponse(t, http.StatusNoContent, ""),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"gist_id": "gist1",
+			},
+		},
+		{
+			name: "gist not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutGistsStarByGistId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"gist_id": "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to star gist missing",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := StarGist(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			assert.Equal(t, "Successfully starred gist gist1", textContent.Text)
+		})
+	}
+}
+
+func Test_UnstarGist(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := UnstarGist(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "unstar_gist", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "gist_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"gist_id"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteGistsStarByGistId,
+			expectPath(t, "/gists/gist1/star").andThen(
+				mockResponse(t, http.StatusNoContent, ""),
+			),
+		),
+	))
+	_, handler := UnstarGist(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"gist_id": "gist1",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	assert.Equal(t, "Successfully unstarred gist gist1", textContent.Text)
+}
+
+func Test_IsGistStarred(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := IsGistStarred(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "is_gist_starred", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "gist_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"gist_id"})
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedErrMsg  string
+		expectedStarred bool
+	}{
+		{
+			name: "gist is starred",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetGistsStarByGistId,
+					mockResponse(t, http.StatusNoContent, ""),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"gist_id": "gist1",
+			},
+			expectedStarred: true,
+		},
+		{
+			name: "404 means not starred",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetGistsStarByGistId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"gist_id": "gist1",
+			},
+			expectedStarred: false,
+		},
+		{
+			name: "unexpected error",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetGistsStarByGistId,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Forbidden"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"gist_id": "gist1",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to check if gist gist1 is starred",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := IsGistStarred(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+
+			var returned struct {
+				GistID  string `json:"gist_id"`
+				Starred bool   `json:"starred"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, "gist1", returned.GistID)
+			assert.Equal(t, tc.expectedStarred, returned.Starred)
+		})
+	}
+}
+
+func Test_ForkGist(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := ForkGist(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "fork_gist", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "gist_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"gist_id"})
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedErrMsg   string
+		expectedResponse MinimalResponse
+	}{
+		{
+			name: "fork gist",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostGistsForksByGistId,
+					expectPath(t, "/gists/gist1/forks").andThen(
+						mockResponse(t, http.StatusCreated, &github.Gist{
+							ID:      github.Ptr("fork1"),
+							HTMLURL: github.Ptr("https://gist.github.com/user/fork1"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"gist_id": "gist1",
+			},
+			expectedResponse: MinimalResponse{
+				ID:  "fork1",
+				URL: "https://gist.github.com/user/fork1",
+			},
+		},
+		{
+			name: "cannot fork own gist",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostGistsForksByGistId,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "You cannot fork your own gist"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"gist_id": "gist1",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to fork gist gist1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ForkGist(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+
+			var returned MinimalResponse
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResponse, returned)
+		})
+	}
+}
@@ -0,0 +1,474 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// GitRef is a Git reference and the object it points to.
+type GitRef struct {
+	Ref        string `json:"ref"`
+	SHA        string `json:"sha"`
+	ObjectType string `json:"object_type"`
+}
+
+// GitTreeEntry is one entry of a Git tree.
+type GitTreeEntry struct {
+	Path string `json:"path"`
+	Mode string `json:"mode"`
+	Type string `json:"type"`
+	SHA  string `json:"sha"`
+	Size int    `json:"size,omitempty"`
+}
+
+// GitTree is a Git tree. Truncated is set when GitHub left out entries of a recursive tree
+// because it was too large.
+type GitTree struct {
+	SHA       string         `json:"sha"`
+	Truncated bool           `json:"truncated,omitempty"`
+	Entries   []GitTreeEntry `json:"entries"`
+}
+
+// GitBlob is a Git blob. Content is the text of the blob when Encoding is "utf-8", or its
+// base64 encoded bytes when Encoding is "base64".
+type GitBlob struct {
+	SHA      string `json:"sha"`
+	Size     int    `json:"size"`
+	Encoding string `json:"encoding"`
+	Content  string `json:"content"`
+}
+
+// GitCommit is a commit created from a tree.
+type GitCommit struct {
+	SHA     string   `json:"sha"`
+	Tree    string   `json:"tree"`
+	Parents []string `json:"parents"`
+	Message string   `json:"message"`
+	URL     string   `json:"url,omitempty"`
+}
+
+func newGitRef(ref *github.Reference) GitRef {
+	return GitRef{
+		Ref:        ref.GetRef(),
+		SHA:        ref.GetObject().GetSHA(),
+		ObjectType: ref.GetObject().GetType(),
+	}
+}
+
+// fullRefName returns ref with the refs/ prefix, so that both heads/main and refs/heads/main
+// name the same reference.
+func fullRefName(ref string) string {
+	return "refs/" + strings.TrimPrefix(ref, "refs/")
+}
+
+// GetGitRef creates a tool to get a Git reference of a repository.
+func GetGitRef(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_git_ref",
+			mcp.WithDescription(t("TOOL_GET_GIT_REF_DESCRIPTION", "Get a Git reference of a GitHub repository, such as a branch or tag, and the SHA of the object it points to.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_GIT_REF_USER_TITLE", "Get Git reference"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Required(),
+				mcp.Description("Fully qualified reference, e.g. 'heads/main' or 'refs/tags/v1.0.0'"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := RequiredParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			reference, resp, err := client.Git.GetRef(ctx, owner, repo, fullRefName(ref))
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get reference %s", ref), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newGitRef(reference)), nil
+		}
+}
+
+// CreateGitRef creates a tool to create a Git reference in a repository.
+func CreateGitRef(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_git_ref",
+			mcp.WithDescription(t("TOOL_CREATE_GIT_REF_DESCRIPTION", "Create a Git reference in a GitHub repository pointing at an existing object, e.g. a branch at a commit made with create_git_commit.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_GIT_REF_USER_TITLE", "Create Git reference"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Required(),
+				mcp.Description("Fully qualified reference to create, e.g. 'refs/heads/feature' or 'heads/feature'"),
+			),
+			mcp.WithString("sha",
+				mcp.Required(),
+				mcp.Description("SHA of the object the reference points to"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := RequiredParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := RequiredParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			reference, resp, err := client.Git.CreateRef(ctx, owner, repo, &github.Reference{
+				Ref:    github.Ptr(fullRefName(ref)),
+				Object: &github.GitObject{SHA: github.Ptr(sha)},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to create reference %s", ref), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newGitRef(reference)), nil
+		}
+}
+
+// UpdateGitRef creates a tool to point a Git reference of a repository at another object.
+func UpdateGitRef(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_git_ref",
+			mcp.WithDescription(t("TOOL_UPDATE_GIT_REF_DESCRIPTION", "Point an existing Git reference of a GitHub repository at another commit. Without force, the update must be a fast-forward.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_UPDATE_GIT_REF_USER_TITLE", "Update Git reference"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Required(),
+				mcp.Description("Fully qualified reference to update, e.g. 'heads/main'"),
+			),
+			mcp.WithString("sha",
+				mcp.Required(),
+				mcp.Description("SHA of the commit the reference should point to"),
+			),
+			mcp.WithBoolean("force",
+				mcp.Description("Allow an update that is not a fast-forward, discarding commits only reachable from the old value (default: false)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := RequiredParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := RequiredParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			force, err := OptionalParam[bool](request, "force")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			reference, resp, err := client.Git.UpdateRef(ctx, owner, repo, &github.Reference{
+				Ref:    github.Ptr(fullRefName(ref)),
+				Object: &github.GitObject{SHA: github.Ptr(sha)},
+			}, force)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to update reference %s", ref), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newGitRef(reference)), nil
+		}
+}
+
+// GetGitTree creates a tool to get a Git tree of a repository.
+func GetGitTree(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_git_tree",
+			mcp.WithDescription(t("TOOL_GET_GIT_TREE_DESCRIPTION", "Get a Git tree of a GitHub repository: the path, mode, type and SHA of its entries. With recursive, the entries of all subtrees are listed too, which gives the full file listing of a commit in one call.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_GIT_TREE_USER_TITLE", "Get Git tree"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("tree_sha",
+				mcp.Required(),
+				mcp.Description("SHA of the tree, or a commit SHA, branch or tag name whose root tree to get"),
+			),
+			mcp.WithBoolean("recursive",
+				mcp.Description("Also list the entries of all subtrees (default: false)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			treeSHA, err := RequiredParam[string](request, "tree_sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			recursive, err := OptionalParam[bool](request, "recursive")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			tree, resp, err := client.Git.GetTree(ctx, owner, repo, treeSHA, recursive)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get tree %s", treeSHA), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := GitTree{
+				SHA:       tree.GetSHA(),
+				Truncated: tree.GetTruncated(),
+				Entries:   make([]GitTreeEntry, 0, len(tree.Entries)),
+			}
+			for _, entry := range tree.Entries {
+				result.Entries = append(result.Entries, GitTreeEntry{
+					Path: entry.GetPath(),
+					Mode: entry.GetMode(),
+					Type: entry.GetType(),
+					SHA:  entry.GetSHA(),
+					Size: entry.GetSize(),
+				})
+			}
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// GetGitBlob creates a tool to read a Git blob of a repository.
+func GetGitBlob(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_git_blob",
+			mcp.WithDescription(t("TOOL_GET_GIT_BLOB_DESCRIPTION", "Read a Git blob of a GitHub repository by its SHA, e.g. one listed by get_git_tree. Text is returned as is and binary content base64 encoded.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_GIT_BLOB_USER_TITLE", "Get Git blob"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("sha",
+				mcp.Required(),
+				mcp.Description("SHA of the blob"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := RequiredParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			blob, resp, err := client.Git.GetBlob(ctx, owner, repo, sha)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get blob %s", sha), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := GitBlob{
+				SHA:      blob.GetSHA(),
+				Size:     blob.GetSize(),
+				Encoding: blob.GetEncoding(),
+				Content:  blob.GetContent(),
+			}
+			if blob.GetEncoding() == "base64" {
+				// GitHub wraps base64 content in lines of 60 characters.
+				content, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(blob.GetContent(), "\n", ""))
+				if err != nil {
+					return nil, fmt.Errorf("failed to decode blob %s: %w", sha, err)
+				}
+				if isText(content) {
+					result.Encoding = "utf-8"
+					result.Content = string(content)
+				} else {
+					result.Content = base64.StdEncoding.EncodeToString(content)
+				}
+			}
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// CreateGitCommit creates a tool to create a commit from a tree.
+func CreateGitCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_git_commit",
+			mcp.WithDescription(t("TOOL_CREATE_GIT_COMMIT_DESCRIPTION", "Create a commit in a GitHub repository from an existing tree and parent commits. No branch is moved: use update_git_ref or create_git_ref to point a branch at the new commit.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_GIT_COMMIT_USER_TITLE", "Create Git commit"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("message",
+				mcp.Required(),
+				mcp.Description("Commit message"),
+			),
+			mcp.WithString("tree",
+				mcp.Required(),
+				mcp.Description("SHA of the tree of the commit"),
+			),
+			mcp.WithArray("parents",
+				mcp.Description("SHAs of the parent commits: none for a root commit, one for a regular commit, several for a merge commit"),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			message, err := RequiredParam[string](request, "message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tree, err := RequiredParam[string](request, "tree")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			parents, err := OptionalStringArrayParam(request, "parents")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			commit := &github.Commit{
+				Message: github.Ptr(message),
+				Tree:    &github.Tree{SHA: github.Ptr(tree)},
+				Parents: make([]*github.Commit, 0, len(parents)),
+			}
+			for _, parent := range parents {
+				commit.Parents = append(commit.Parents, &github.Commit{SHA: github.Ptr(parent)})
+			}
+			created, resp, err := client.Git.CreateCommit(ctx, owner, repo, commit, nil)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create commit", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := GitCommit{
+				SHA:     created.GetSHA(),
+				Tree:    created.GetTree().GetSHA(),
+				Parents: make([]string, 0, len(created.Parents)),
+				Message: created.GetMessage(),
+				URL:     created.GetHTMLURL(),
+			}
+			for _, parent := range created.Parents {
+				result.Parents = append(result.Parents, parent.GetSHA())
+			}
+			return MarshalledTextResult(result), nil
+		}
+}
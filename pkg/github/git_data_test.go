@@ -0,0 +1,405 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetGitRef(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetGitRef(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_git_ref", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ref"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mainRef := &github.Reference{
+		Ref:    github.Ptr("refs/heads/main"),
+		Object: &github.GitObject{Type: github.Ptr("commit"), SHA: github.Ptr("abc123")},
+	}
+
+	tests := []struct {
+		name           string
+		ref            string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "short reference",
+			ref:  "heads/main",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					expectPath(t, "/repos/owner/repo/git/ref/heads/main").andThen(
+						mockResponse(t, http.StatusOK, mainRef),
+					),
+				),
+			),
+		},
+		{
+			name: "fully qualified reference",
+			ref:  "refs/heads/main",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					expectPath(t, "/repos/owner/repo/git/ref/heads/main").andThen(
+						mockResponse(t, http.StatusOK, mainRef),
+					),
+				),
+			),
+		},
+		{
+			name: "reference not found",
+			ref:  "heads/missing",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get reference heads/missing",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetGitRef(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   tc.ref,
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned GitRef
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, GitRef{Ref: "refs/heads/main", SHA: "abc123", ObjectType: "commit"}, returned)
+		})
+	}
+}
+
+func Test_CreateGitRef(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateGitRef(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_git_ref", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ref", "sha"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposGitRefsByOwnerByRepo,
+			expectRequestBody(t, map[string]any{
+				"ref": "refs/heads/feature",
+				"sha": "def456",
+			}).andThen(
+				mockResponse(t, http.StatusCreated, &github.Reference{
+					Ref:    github.Ptr("refs/heads/feature"),
+					Object: &github.GitObject{Type: github.Ptr("commit"), SHA: github.Ptr("def456")},
+				}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := CreateGitRef(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner": "owner",
+		"repo":  "repo",
+		"ref":   "heads/feature",
+		"sha":   "def456",
+	}))
+	require.NoError(t, err)
+
+	var returned GitRef
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, GitRef{Ref: "refs/heads/feature", SHA: "def456", ObjectType: "commit"}, returned)
+}
+
+func Test_UpdateGitRef(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateGitRef(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_git_ref", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ref", "sha"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "forced update",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposGitRefsByOwnerByRepoByRef,
+					expect(t, expectations{
+						path: "/repos/owner/repo/git/refs/heads/main",
+						requestBody: map[string]any{
+							"sha":   "def456",
+							"force": true,
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Reference{
+							Ref:    github.Ptr("refs/heads/main"),
+							Object: &github.GitObject{Type: github.Ptr("commit"), SHA: github.Ptr("def456")},
+						}),
+					),
+				),
+			),
+		},
+		{
+			name: "not a fast-forward",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposGitRefsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Update is not a fast forward"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to update reference heads/main",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateGitRef(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "heads/main",
+				"sha":   "def456",
+				"force": true,
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned GitRef
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, GitRef{Ref: "refs/heads/main", SHA: "def456", ObjectType: "commit"}, returned)
+		})
+	}
+}
+
+func Test_GetGitTree(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetGitTree(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_git_tree", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "tree_sha"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposGitTreesByOwnerByRepoByTreeSha,
+			expect(t, expectations{
+				path:        "/repos/owner/repo/git/trees/main",
+				queryParams: map[string]string{"recursive": "1"},
+			}).andThen(
+				mockResponse(t, http.StatusOK, &github.Tree{
+					SHA: github.Ptr("tree123"),
+					Entries: []*github.TreeEntry{
+						{Path: github.Ptr("cmd"), Mode: github.Ptr("040000"), Type: github.Ptr("tree"), SHA: github.Ptr("tree456")},
+						{Path: github.Ptr("cmd/main.go"), Mode: github.Ptr("100644"), Type: github.Ptr("blob"), SHA: github.Ptr("blob789"), Size: github.Ptr(120)},
+					},
+					Truncated: github.Ptr(false),
+				}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := GetGitTree(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":     "owner",
+		"repo":      "repo",
+		"tree_sha":  "main",
+		"recursive": true,
+	}))
+	require.NoError(t, err)
+
+	var returned GitTree
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, GitTree{
+		SHA: "tree123",
+		Entries: []GitTreeEntry{
+			{Path: "cmd", Mode: "040000", Type: "tree", SHA: "tree456"},
+			{Path: "cmd/main.go", Mode: "100644", Type: "blob", SHA: "blob789", Size: 120},
+		},
+	}, returned)
+}
+
+func Test_GetGitBlob(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetGitBlob(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_git_blob", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "sha"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	binary := []byte{0x89, 'P', 'N', 'G', 0x00, 0x01}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+		expectedBlob   GitBlob
+	}{
+		{
+			name: "text blob",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitBlobsByOwnerByRepoByFileSha,
+					expectPath(t, "/repos/owner/repo/git/blobs/blob789").andThen(
+						mockResponse(t, http.StatusOK, &github.Blob{
+							SHA:      github.Ptr("blob789"),
+							Size:     github.Ptr(12),
+							Encoding: github.Ptr("base64"),
+							Content:  github.Ptr("cGFja2FnZSBt\nYWluCg==\n"),
+						}),
+					),
+				),
+			),
+			expectedBlob: GitBlob{SHA: "blob789", Size: 12, Encoding: "utf-8", Content: "package main\n"},
+		},
+		{
+			name: "binary blob",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitBlobsByOwnerByRepoByFileSha,
+					&github.Blob{
+						SHA:      github.Ptr("blob789"),
+						Size:     github.Ptr(len(binary)),
+						Encoding: github.Ptr("base64"),
+						Content:  github.Ptr(base64.StdEncoding.EncodeToString(binary) + "\n"),
+					},
+				),
+			),
+			expectedBlob: GitBlob{SHA: "blob789", Size: len(binary), Encoding: "base64", Content: base64.StdEncoding.EncodeToString(binary)},
+		},
+		{
+			name: "blob not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitBlobsByOwnerByRepoByFileSha,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get blob blob789",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetGitBlob(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "blob789",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned GitBlob
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expectedBlob, returned)
+		})
+	}
+}
+
+func Test_CreateGitCommit(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateGitCommit(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_git_commit", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "message", "tree"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposGitCommitsByOwnerByRepo,
+			expectRequestBody(t, map[string]any{
+				"message": "Merge feature into main",
+				"tree":    "tree123",
+				"parents": []any{"abc123", "def456"},
+			}).andThen(
+				mockResponse(t, http.StatusCreated, &github.Commit{
+					SHA:     github.Ptr("fed789"),
+					Message: github.Ptr("Merge feature into main"),
+					Tree:    &github.Tree{SHA: github.Ptr("tree123")},
+					Parents: []*github.Commit{{SHA: github.Ptr("abc123")}, {SHA: github.Ptr("def456")}},
+					HTMLURL: github.Ptr("https://github.com/owner/repo/commit/fed789"),
+				}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := CreateGitCommit(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":   "owner",
+		"repo":    "repo",
+		"message": "Merge feature into main",
+		"tree":    "tree123",
+		"parents": []any{"abc123", "def456"},
+	}))
+	require.NoError(t, err)
+
+	var returned GitCommit
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, GitCommit{
+		SHA:     "fed789",
+		Tree:    "tree123",
+		Parents: []string{"abc123", "def456"},
+		Message: "Merge feature into main",
+		URL:     "https://github.com/owner/repo/commit/fed789",
+	}, returned)
+}
@@ -0,0 +1,84 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// GitignoreTemplate is a .gitignore template GitHub offers when creating a repository.
+type GitignoreTemplate struct {
+	Name   string `json:"name"`
+	Source string `json:"source"`
+}
+
+// ListGitignoreTemplates creates a tool to list the names of the .gitignore templates GitHub offers.
+func ListGitignoreTemplates(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_gitignore_templates",
+			mcp.WithDescription(t("TOOL_LIST_GITIGNORE_TEMPLATES_DESCRIPTION", "List the names of the .gitignore templates GitHub offers, such as Go, Node or Python. Pass a name to get_gitignore_template to get its contents.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_GITIGNORE_TEMPLATES_USER_TITLE", "List .gitignore templates"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+		),
+		func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			names, resp, err := client.Gitignores.List(ctx)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list .gitignore templates", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledListResult(ctx, names, "no .gitignore templates found"), nil
+		}
+}
+
+// GetGitignoreTemplate creates a tool to get the contents of a .gitignore template.
+func GetGitignoreTemplate(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_gitignore_template",
+			mcp.WithDescription(t("TOOL_GET_GITIGNORE_TEMPLATE_DESCRIPTION", "Get the contents of a .gitignore template GitHub offers, ready to be pushed to a repository as its .gitignore file.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_GITIGNORE_TEMPLATE_USER_TITLE", "Get .gitignore template"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Name of the template, as returned by list_gitignore_templates, such as Go"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			name, err := RequiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			template, resp, err := client.Gitignores.Get(ctx, name)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					_ = resp.Body.Close()
+					return mcp.NewToolResultError(fmt.Sprintf(".gitignore template %s not found; template names are case sensitive, use list_gitignore_templates to find it", name)), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get .gitignore template %s", name), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(GitignoreTemplate{
+				Name:   template.GetName(),
+				Source: template.GetSource(),
+			}), nil
+		}
+}
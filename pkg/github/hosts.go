@@ -0,0 +1,96 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// hostParameter is the tool argument that sends a single call to another configured GitHub host.
+const hostParameter = "host"
+
+type hostKey struct{}
+
+// ContextWithHost returns a context that makes GitHub clients target the given host rather than
+// the server default.
+func ContextWithHost(ctx context.Context, host string) context.Context {
+	return context.WithValue(ctx, hostKey{}, host)
+}
+
+// HostFromContext returns the GitHub host a tool call asked for, or "" for the server default.
+func HostFromContext(ctx context.Context) string {
+	host, _ := ctx.Value(hostKey{}).(string)
+	return host
+}
+
+// NormalizeHost reduces a GitHub host given as a hostname or URL, such as github.com or
+// https://ghes.example.com/, to its lowercase hostname.
+func NormalizeHost(host string) string {
+	host = strings.TrimSpace(host)
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+	u, err := url.Parse(host)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(u.Hostname(), "www."))
+}
+
+// AddHostParameter adds the optional host parameter to a tool's input schema.
+func AddHostParameter(tool mcp.Tool) mcp.Tool {
+	mcp.WithString(hostParameter,
+		mcp.Description("GitHub host to send this call to instead of the server default, e.g. github.com. Only hosts the server has credentials for are accepted."),
+	)(&tool)
+	return tool
+}
+
+// WithHostOverride is a server option that lets a tool call target one of hosts through its host
+// argument. The argument is removed before the tool runs and the host is put on the context, where
+// the client getters pick it up. Calls naming any other host are rejected.
+func WithHostOverride(hosts []string) server.ServerOption {
+	return server.WithToolHandlerMiddleware(hostOverrideMiddleware(hosts))
+}
+
+func hostOverrideMiddleware(hosts []string) server.ToolHandlerMiddleware {
+	allowed := make(map[string]bool, len(hosts))
+	for _, host := range hosts {
+		allowed[NormalizeHost(host)] = true
+	}
+	names := make([]string, 0, len(allowed))
+	for host := range allowed {
+		names = append(names, host)
+	}
+	sort.Strings(names)
+
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			raw, ok := request.GetArguments()[hostParameter]
+			if !ok || raw == nil || raw == "" {
+				return next(ctx, request)
+			}
+			host, ok := raw.(string)
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("parameter %s is not of type string, is %T", hostParameter, raw)), nil
+			}
+			normalized := NormalizeHost(host)
+			if !allowed[normalized] {
+				return mcp.NewToolResultError(fmt.Sprintf("host %q is not configured on this server; configured hosts: %s", host, strings.Join(names, ", "))), nil
+			}
+
+			args := make(map[string]any, len(request.GetArguments()))
+			for k, v := range request.GetArguments() {
+				if k != hostParameter {
+					args[k] = v
+				}
+			}
+			request.Params.Arguments = args
+			return next(ContextWithHost(ctx, normalized), request)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package github
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NormalizeHost(t *testing.T) {
+	tests := map[string]string{
+		"github.com":                 "github.com",
+		"https://github.com/":        "github.com",
+		"https://www.github.com":     "github.com",
+		"GHES.example.com":           "ghes.example.com",
+		"https://octocorp.ghe.com":   "octocorp.ghe.com",
+		"http://ghes.example.com:80": "ghes.example.com",
+	}
+	for host, expected := range tests {
+		assert.Equal(t, expected, NormalizeHost(host), host)
+	}
+}
+
+func Test_AddHostParameter(t *testing.T) {
+	tool := AddHostParameter(mcp.NewTool("get_me"))
+	assert.Contains(t, tool.InputSchema.Properties, "host")
+	assert.NotContains(t, tool.InputSchema.Required, "host")
+}
+
+func Test_HostOverrideMiddleware(t *testing.T) {
+	var gotHost string
+	var gotArgs map[string]any
+	handler := hostOverrideMiddleware([]string{"ghes.example.com", "github.com"})(
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			gotHost = HostFromContext(ctx)
+			gotArgs = request.GetArguments()
+			return mcp.NewToolResultText("ok"), nil
+		},
+	)
+
+	t.Run("configured host is put on the context", func(t *testing.T) {
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner": "octocat",
+			"host":  "https://github.com/",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.Equal(t, "github.com", gotHost)
+		assert.Equal(t, map[string]any{"owner": "octocat"}, gotArgs)
+	})
+
+	t.Run("no host uses the default", func(t *testing.T) {
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner": "octocat",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.Empty(t, gotHost)
+	})
+
+	t.Run("unconfigured host is rejected", func(t *testing.T) {
+		gotHost = "unchanged"
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner": "octocat",
+			"host":  "evil.example.com",
+		}))
+		require.NoError(t, err)
+		errorContent := getErrorResult(t, result)
+		assert.Equal(t, `host "evil.example.com" is not configured on this server; configured hosts: ghes.example.com, github.com`, errorContent.Text)
+		assert.Equal(t, "unchanged", gotHost, "the tool should not run")
+	})
+}
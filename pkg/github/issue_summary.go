@@ -0,0 +1,294 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultSummaryMaxComments = 30
+	maxSummaryMaxComments     = 100
+	// summaryCommentBodyLimit is how much of each comment is kept. Long comments are usually logs
+	// or stack traces, whose start is the useful part.
+	summaryCommentBodyLimit = 2000
+	// summaryMaxPages caps how many pages of comments and timeline events are read, at 100 each.
+	summaryMaxPages = 10
+)
+
+// IssueSummaryComment is a comment in an issue summary.
+type IssueSummaryComment struct {
+	Author    string    `json:"author"`
+	CreatedAt time.Time `json:"created_at"`
+	Body      string    `json:"body"`
+	Truncated bool      `json:"truncated,omitempty"`
+}
+
+// IssueLinkedPullRequest is a pull request that references an issue.
+type IssueLinkedPullRequest struct {
+	Repository string `json:"repository"`
+	Number     int    `json:"number"`
+	Title      string `json:"title"`
+	State      string `json:"state"`
+	Merged     bool   `json:"merged"`
+	URL        string `json:"url"`
+}
+
+// IssueSummary is an issue together with its discussion and related pull requests.
+type IssueSummary struct {
+	Number             int                      `json:"number"`
+	Title              string                   `json:"title"`
+	State              string                   `json:"state"`
+	StateReason        string                   `json:"state_reason,omitempty"`
+	Author             string                   `json:"author"`
+	URL                string                   `json:"url"`
+	Body               string                   `json:"body"`
+	Labels             []string                 `json:"labels"`
+	Assignees          []string                 `json:"assignees"`
+	Milestone          string                   `json:"milestone,omitempty"`
+	CreatedAt          time.Time                `json:"created_at"`
+	UpdatedAt          time.Time                `json:"updated_at"`
+	ClosedAt           *time.Time               `json:"closed_at,omitempty"`
+	TotalComments      int                      `json:"total_comments"`
+	OmittedComments    int                      `json:"omitted_comments"`
+	Comments           []IssueSummaryComment    `json:"comments"`
+	LinkedPullRequests []IssueLinkedPullRequest `json:"linked_pull_requests"`
+	Notes              []string                 `json:"notes,omitempty"`
+}
+
+// listAllIssueComments reads the comments of an issue, oldest first, up to summaryMaxPages pages.
+// It reports whether there were more.
+func listAllIssueComments(ctx context.Context, client *github.Client, owner, repo string, number int) ([]*github.IssueComment, bool, error) {
+	var all []*github.IssueComment
+	opts := &github.IssueListCommentsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for page := 0; page < summaryMaxPages; page++ {
+		comments, resp, err := client.Issues.ListComments(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, false, err
+		}
+		_ = resp.Body.Close()
+		all = append(all, comments...)
+		if resp.NextPage == 0 {
+			return all, false, nil
+		}
+		opts.Page = resp.NextPage
+	}
+	return all, true, nil
+}
+
+// linkedPullRequests finds the pull requests that reference an issue in its timeline.
+func linkedPullRequests(ctx context.Context, client *github.Client, owner, repo string, number int) ([]IssueLinkedPullRequest, error) {
+	linked := []IssueLinkedPullRequest{}
+	seen := map[string]bool{}
+	opts := &github.ListOptions{PerPage: 100}
+	for page := 0; page < summaryMaxPages; page++ {
+		events, resp, err := client.Issues.ListIssueTimeline(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, err
+		}
+		_ = resp.Body.Close()
+		for _, event := range events {
+			if event.GetEvent() != "cross-referenced" {
+				continue
+			}
+			source := event.GetSource().GetIssue()
+			if source == nil || !source.IsPullRequest() {
+				continue
+			}
+			repository := source.GetRepository().GetFullName()
+			if repository == "" {
+				repository = owner + "/" + repo
+			}
+			key := fmt.Sprintf("%s#%d", repository, source.GetNumber())
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			linked = append(linked, IssueLinkedPullRequest{
+				Repository: repository,
+				Number:     source.GetNumber(),
+				Title:      source.GetTitle(),
+				State:      source.GetState(),
+				Merged:     source.GetPullRequestLinks().GetMergedAt() != github.Timestamp{},
+				URL:        source.GetHTMLURL(),
+			})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return linked, nil
+}
+
+// newSummaryComments keeps the latest maxComments comments, shortening long ones. It returns the
+// comments kept and how many earlier ones were left out.
+func newSummaryComments(comments []*github.IssueComment, maxComments int) ([]IssueSummaryComment, int) {
+	omitted := 0
+	if len(comments) > maxComments {
+		omitted = len(comments) - maxComments
+		comments = comments[omitted:]
+	}
+	result := make([]IssueSummaryComment, 0, len(comments))
+	for _, c := range comments {
+		comment := IssueSummaryComment{
+			Author:    c.GetUser().GetLogin(),
+			CreatedAt: c.GetCreatedAt().Time,
+			Body:      c.GetBody(),
+		}
+		if runes := []rune(comment.Body); len(runes) > summaryCommentBodyLimit {
+			comment.Body = string(runes[:summaryCommentBodyLimit])
+			comment.Truncated = true
+		}
+		result = append(result, comment)
+	}
+	return result, omitted
+}
+
+// SummarizeIssue creates a tool to gather an issue, its comments and the pull requests referencing it in one call.
+func SummarizeIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("summarize_issue",
+			mcp.WithDescription(t("TOOL_SUMMARIZE_ISSUE_DESCRIPTION", "Get everything needed to catch up on an issue in one call: its body, state, labels, assignees and milestone, the latest comments, and the pull requests that reference it. Long comment threads keep only the latest comments and report how many earlier ones were omitted.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SUMMARIZE_ISSUE_USER_TITLE", "Summarize issue"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number"),
+			),
+			mcp.WithNumber("max_comments",
+				mcp.Description(fmt.Sprintf("Maximum number of comments to include, keeping the latest (default %d, max %d)", defaultSummaryMaxComments, maxSummaryMaxComments)),
+				mcp.Min(0),
+				mcp.Max(maxSummaryMaxComments),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxComments, err := OptionalIntParamWithDefault(request, "max_comments", defaultSummaryMaxComments)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxComments < 0 || maxComments > maxSummaryMaxComments {
+				return mcp.NewToolResultError(fmt.Sprintf("max_comments must be between 0 and %d", maxSummaryMaxComments)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var (
+				wg           sync.WaitGroup
+				issue        *github.Issue
+				issueResp    *github.Response
+				issueErr     error
+				comments     []*github.IssueComment
+				moreComments bool
+				commentsErr  error
+				linked       []IssueLinkedPullRequest
+				linkedErr    error
+			)
+			wg.Add(3)
+			go func() {
+				defer wg.Done()
+				issue, issueResp, issueErr = client.Issues.Get(ctx, owner, repo, issueNumber)
+				if issueErr == nil {
+					_ = issueResp.Body.Close()
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				comments, moreComments, commentsErr = listAllIssueComments(ctx, client, owner, repo, issueNumber)
+			}()
+			go func() {
+				defer wg.Done()
+				linked, linkedErr = linkedPullRequests(ctx, client, owner, repo, issueNumber)
+			}()
+			wg.Wait()
+
+			// Without the issue itself there is nothing to summarize. The other parts are optional.
+			if issueErr != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get issue", issueResp, issueErr), nil
+			}
+
+			summary := IssueSummary{
+				Number:             issue.GetNumber(),
+				Title:              issue.GetTitle(),
+				State:              issue.GetState(),
+				StateReason:        issue.GetStateReason(),
+				Author:             issue.GetUser().GetLogin(),
+				URL:                issue.GetHTMLURL(),
+				Body:               issue.GetBody(),
+				Labels:             []string{},
+				Assignees:          []string{},
+				Milestone:          issue.GetMilestone().GetTitle(),
+				CreatedAt:          issue.GetCreatedAt().Time,
+				UpdatedAt:          issue.GetUpdatedAt().Time,
+				TotalComments:      issue.GetComments(),
+				Comments:           []IssueSummaryComment{},
+				LinkedPullRequests: []IssueLinkedPullRequest{},
+			}
+			if issue.ClosedAt != nil {
+				summary.ClosedAt = &issue.ClosedAt.Time
+			}
+			for _, label := range issue.Labels {
+				summary.Labels = append(summary.Labels, label.GetName())
+			}
+			for _, assignee := range issue.Assignees {
+				summary.Assignees = append(summary.Assignees, assignee.GetLogin())
+			}
+			if issue.IsPullRequest() {
+				summary.Notes = append(summary.Notes, "this is a pull request; its review comments and commits are not included")
+			}
+
+			if commentsErr != nil {
+				summary.OmittedComments = summary.TotalComments
+				summary.Notes = append(summary.Notes, fmt.Sprintf("comments could not be fetched: %v", commentsErr))
+			} else {
+				summary.Comments, summary.OmittedComments = newSummaryComments(comments, maxComments)
+				if moreComments {
+					// Comments past the pages read were never fetched, so count them as omitted too.
+					summary.OmittedComments = summary.TotalComments - len(summary.Comments)
+					summary.Notes = append(summary.Notes, fmt.Sprintf("only the first %d comments were read, so the latest ones may be missing", len(comments)))
+				}
+				if summary.OmittedComments > 0 {
+					summary.Notes = append(summary.Notes, fmt.Sprintf("%d earlier comments were omitted; raise max_comments or use get_issue_comments to read them", summary.OmittedComments))
+				}
+			}
+
+			if linkedErr != nil {
+				summary.Notes = append(summary.Notes, fmt.Sprintf("linked pull requests could not be fetched: %v", linkedErr))
+			} else {
+				summary.LinkedPullRequests = linked
+			}
+
+			return MarshalledTextResult(summary), nil
+		}
+}
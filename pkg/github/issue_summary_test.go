@@ -0,0 +1,193 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SummarizeIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SummarizeIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "summarize_issue", tool.Name)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.Properties, "max_comments")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	created := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	issue := &github.Issue{
+		Number:    github.Ptr(42),
+		Title:     github.Ptr("Crash on startup"),
+		State:     github.Ptr("open"),
+		Body:      github.Ptr("The app crashes when started without a config file."),
+		HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/42"),
+		User:      &github.User{Login: github.Ptr("reporter")},
+		Labels:    []*github.Label{{Name: github.Ptr("bug")}, {Name: github.Ptr("p1")}},
+		Assignees: []*github.User{{Login: github.Ptr("maintainer")}},
+		Milestone: &github.Milestone{Title: github.Ptr("v2.0")},
+		Comments:  github.Ptr(3),
+		CreatedAt: &github.Timestamp{Time: created},
+		UpdatedAt: &github.Timestamp{Time: created},
+	}
+	comments := []*github.IssueComment{
+		{User: &github.User{Login: github.Ptr("alice")}, Body: github.Ptr("I can reproduce this."), CreatedAt: &github.Timestamp{Time: created}},
+		{User: &github.User{Login: github.Ptr("bob")}, Body: github.Ptr(strings.Repeat("x", summaryCommentBodyLimit+10)), CreatedAt: &github.Timestamp{Time: created}},
+		{User: &github.User{Login: github.Ptr("maintainer")}, Body: github.Ptr("Fix is up."), CreatedAt: &github.Timestamp{Time: created}},
+	}
+	timeline := []*github.Timeline{
+		{Event: github.Ptr("labeled")},
+		{
+			Event: github.Ptr("cross-referenced"),
+			Source: &github.Source{Issue: &github.Issue{
+				Number:           github.Ptr(43),
+				Title:            github.Ptr("Handle missing config file"),
+				State:            github.Ptr("closed"),
+				HTMLURL:          github.Ptr("https://github.com/owner/repo/pull/43"),
+				Repository:       &github.Repository{FullName: github.Ptr("owner/repo")},
+				PullRequestLinks: &github.PullRequestLinks{MergedAt: &github.Timestamp{Time: created}},
+			}},
+		},
+		{
+			// A plain issue mentioning this one is not a linked pull request.
+			Event:  github.Ptr("cross-referenced"),
+			Source: &github.Source{Issue: &github.Issue{Number: github.Ptr(7)}},
+		},
+	}
+
+	t.Run("aggregates issue, comments and linked pull requests", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetReposIssuesByOwnerByRepoByIssueNumber, issue),
+			mock.WithRequestMatch(mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber, comments),
+			mock.WithRequestMatch(mock.GetReposIssuesTimelineByOwnerByRepoByIssueNumber, timeline),
+		)
+		_, handler := SummarizeIssue(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(42),
+		}))
+		require.NoError(t, err)
+
+		var summary IssueSummary
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &summary))
+		assert.Equal(t, 42, summary.Number)
+		assert.Equal(t, "open", summary.State)
+		assert.Equal(t, "reporter", summary.Author)
+		assert.Equal(t, []string{"bug", "p1"}, summary.Labels)
+		assert.Equal(t, []string{"maintainer"}, summary.Assignees)
+		assert.Equal(t, "v2.0", summary.Milestone)
+		assert.Equal(t, 3, summary.TotalComments)
+		assert.Equal(t, 0, summary.OmittedComments)
+		require.Len(t, summary.Comments, 3)
+		assert.Equal(t, "alice", summary.Comments[0].Author)
+		assert.True(t, summary.Comments[1].Truncated)
+		assert.Len(t, summary.Comments[1].Body, summaryCommentBodyLimit)
+		assert.False(t, summary.Comments[2].Truncated)
+		assert.Equal(t, []IssueLinkedPullRequest{{
+			Repository: "owner/repo",
+			Number:     43,
+			Title:      "Handle missing config file",
+			State:      "closed",
+			Merged:     true,
+			URL:        "https://github.com/owner/repo/pull/43",
+		}}, summary.LinkedPullRequests)
+		assert.Empty(t, summary.Notes)
+	})
+
+	t.Run("long threads keep the latest comments", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetReposIssuesByOwnerByRepoByIssueNumber, issue),
+			mock.WithRequestMatch(mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber, comments),
+			mock.WithRequestMatch(mock.GetReposIssuesTimelineByOwnerByRepoByIssueNumber, []*github.Timeline{}),
+		)
+		_, handler := SummarizeIssue(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(42),
+			"max_comments": float64(1),
+		}))
+		require.NoError(t, err)
+
+		var summary IssueSummary
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &summary))
+		require.Len(t, summary.Comments, 1)
+		assert.Equal(t, "maintainer", summary.Comments[0].Author)
+		assert.Equal(t, 2, summary.OmittedComments)
+		assert.Contains(t, summary.Notes, "2 earlier comments were omitted; raise max_comments or use get_issue_comments to read them")
+		assert.Empty(t, summary.LinkedPullRequests)
+	})
+
+	t.Run("failed sub-calls are noted", func(t *testing.T) {
+		serverError := mockResponse(t, http.StatusInternalServerError, map[string]string{"message": "boom"})
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetReposIssuesByOwnerByRepoByIssueNumber, issue),
+			mock.WithRequestMatchHandler(mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber, serverError),
+			mock.WithRequestMatchHandler(mock.GetReposIssuesTimelineByOwnerByRepoByIssueNumber, serverError),
+		)
+		_, handler := SummarizeIssue(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(42),
+		}))
+		require.NoError(t, err)
+
+		var summary IssueSummary
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &summary))
+		assert.Equal(t, "Crash on startup", summary.Title)
+		assert.Empty(t, summary.Comments)
+		assert.Equal(t, 3, summary.OmittedComments)
+		require.Len(t, summary.Notes, 2)
+		assert.Contains(t, summary.Notes[0], "comments could not be fetched")
+		assert.Contains(t, summary.Notes[1], "linked pull requests could not be fetched")
+	})
+
+	t.Run("missing issue is an error", func(t *testing.T) {
+		notFound := mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"})
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(mock.GetReposIssuesByOwnerByRepoByIssueNumber, notFound),
+			mock.WithRequestMatchHandler(mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber, notFound),
+			mock.WithRequestMatchHandler(mock.GetReposIssuesTimelineByOwnerByRepoByIssueNumber, notFound),
+		)
+		_, handler := SummarizeIssue(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(42),
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to get issue")
+	})
+
+	t.Run("max_comments out of range", func(t *testing.T) {
+		_, handler := SummarizeIssue(stubGetClientFn(github.NewClient(mock.NewMockedHTTPClient())), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(42),
+			"max_comments": float64(maxSummaryMaxComments + 1),
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("max_comments must be between 0 and %d", maxSummaryMaxComments), getErrorResult(t, result).Text)
+	})
+}
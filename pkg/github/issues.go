@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
@@ -154,17 +156,19 @@ type ListIssuesQueryTypeWithLabels struct {
 	} `graphql:"repository(owner: $owner, name: $repo)"`
 }
 
-// ListIssuesQueryWithSince is the query structure for fetching issues without label filtering but with since filtering.
-type ListIssuesQueryWithSince struct {
+// ListIssuesQueryWithFilters is the query structure for fetching issues without label filtering but with
+// since or milestone filtering.
+type ListIssuesQueryWithFilters struct {
 	Repository struct {
-		Issues IssueQueryFragment `graphql:"issues(first: $first, after: $after, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: {since: $since})"`
+		Issues IssueQueryFragment `graphql:"issues(first: $first, after: $after, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: $filterBy)"`
 	} `graphql:"repository(owner: $owner, name: $repo)"`
 }
 
-// ListIssuesQueryTypeWithLabelsWithSince is the query structure for fetching issues with both label and since filtering.
-type ListIssuesQueryTypeWithLabelsWithSince struct {
+// ListIssuesQueryTypeWithLabelsWithFilters is the query structure for fetching issues with label filtering
+// and since or milestone filtering.
+type ListIssuesQueryTypeWithLabelsWithFilters struct {
 	Repository struct {
-		Issues IssueQueryFragment `graphql:"issues(first: $first, after: $after, labels: $labels, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: {since: $since})"`
+		Issues IssueQueryFragment `graphql:"issues(first: $first, after: $after, labels: $labels, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: $filterBy)"`
 	} `graphql:"repository(owner: $owner, name: $repo)"`
 }
 
@@ -177,22 +181,22 @@ func (q *ListIssuesQuery) GetIssueFragment() IssueQueryFragment {
 	return q.Repository.Issues
 }
 
-func (q *ListIssuesQueryWithSince) GetIssueFragment() IssueQueryFragment {
+func (q *ListIssuesQueryWithFilters) GetIssueFragment() IssueQueryFragment {
 	return q.Repository.Issues
 }
 
-func (q *ListIssuesQueryTypeWithLabelsWithSince) GetIssueFragment() IssueQueryFragment {
+func (q *ListIssuesQueryTypeWithLabelsWithFilters) GetIssueFragment() IssueQueryFragment {
 	return q.Repository.Issues
 }
 
-func getIssueQueryType(hasLabels bool, hasSince bool) any {
+func getIssueQueryType(hasLabels bool, hasFilters bool) any {
 	switch {
-	case hasLabels && hasSince:
-		return &ListIssuesQueryTypeWithLabelsWithSince{}
+	case hasLabels && hasFilters:
+		return &ListIssuesQueryTypeWithLabelsWithFilters{}
 	case hasLabels:
 		return &ListIssuesQueryTypeWithLabels{}
-	case hasSince:
-		return &ListIssuesQueryWithSince{}
+	case hasFilters:
+		return &ListIssuesQueryWithFilters{}
 	default:
 		return &ListIssuesQuery{}
 	}
@@ -325,12 +329,7 @@ func ListIssueTypes(getClient GetClientFn, t translations.TranslationHelperFunc)
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list issue types: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(issueTypes)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal issue types: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledListResult(ctx, issueTypes, "the organization has no issue types"), nil
 		}
 }
 
@@ -576,12 +575,7 @@ func ListSubIssues(getClient GetClientFn, t translations.TranslationHelperFunc)
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list sub-issues: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(subIssues)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledListResult(ctx, subIssues, "the issue has no sub-issues"), nil
 		}
 
 }
@@ -800,6 +794,9 @@ func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (
 			mcp.WithString("repo",
 				mcp.Description("Optional repository name. If provided with owner, only issues for this repository are listed."),
 			),
+			mcp.WithString("milestone",
+				mcp.Description("Optional milestone title. Only issues in this milestone are listed; use \"none\" for issues without a milestone."),
+			),
 			mcp.WithString("sort",
 				mcp.Description("Sort field by number of matches of categories, defaults to best match"),
 				mcp.Enum(
@@ -1007,6 +1004,9 @@ func ListIssues(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 			mcp.WithString("since",
 				mcp.Description("Filter by date (ISO 8601 timestamp)"),
 			),
+			mcp.WithString("milestone",
+				mcp.Description("Filter by milestone number. Use \"*\" for issues in any milestone and \"none\" for issues without one."),
+			),
 			WithCursorPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -1064,15 +1064,35 @@ func ListIssues(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			// There are two optional parameters: since and labels.
-			var sinceTime time.Time
-			var hasSince bool
+			milestone, err := OptionalParam[string](request, "milestone")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// Labels are a separate argument; since and milestone go into filterBy.
+			var filters githubv4.IssueFilters
+			var hasFilters bool
 			if since != "" {
-				sinceTime, err = parseISOTimestamp(since)
+				sinceTime, err := parseISOTimestamp(since)
 				if err != nil {
 					return mcp.NewToolResultError(fmt.Sprintf("failed to list issues: %s", err.Error())), nil
 				}
-				hasSince = true
+				filters.Since = &githubv4.DateTime{Time: sinceTime}
+				hasFilters = true
+			}
+			if milestone != "" {
+				switch milestone {
+				case "*":
+				case "none":
+					// The API takes the string "null" for issues without a milestone.
+					milestone = "null"
+				default:
+					if _, err := strconv.Atoi(milestone); err != nil {
+						return mcp.NewToolResultError(fmt.Sprintf("invalid milestone %q: must be a milestone number, \"*\" or \"none\"", milestone)), nil
+					}
+				}
+				filters.MilestoneNumber = githubv4.NewString(githubv4.String(milestone))
+				hasFilters = true
 			}
 			hasLabels := len(labels) > 0
 
@@ -1133,11 +1153,11 @@ func ListIssues(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 				vars["labels"] = labelStrings
 			}
 
-			if hasSince {
-				vars["since"] = githubv4.DateTime{Time: sinceTime}
+			if hasFilters {
+				vars["filterBy"] = filters
 			}
 
-			issueQuery := getIssueQueryType(hasLabels, hasSince)
+			issueQuery := getIssueQueryType(hasLabels, hasFilters)
 			if err := client.Query(ctx, issueQuery, vars); err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -1172,11 +1192,12 @@ func ListIssues(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 				},
 				"totalCount": totalCount,
 			}
-			out, err := json.Marshal(response)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal issues: %w", err)
+			info := ListPageInfo{
+				TotalCount:  &totalCount,
+				HasNextPage: bool(pageInfo.HasNextPage),
+				NextCursor:  string(pageInfo.EndCursor),
 			}
-			return mcp.NewToolResultText(string(out)), nil
+			return MarshalledListPageResult(ctx, response, issues, info, "no issues matched"), nil
 		}
 }
 
@@ -1437,6 +1458,605 @@ func UpdateIssue(getClient GetClientFn, getGQLClient GetGQLClientFn, t translati
 		}
 }
 
+// MilestoneAssignmentResult is the outcome of assigning a milestone to a batch of issues.
+type MilestoneAssignmentResult struct {
+	MilestoneNumber int    `json:"milestone_number"`
+	MilestoneTitle  string `json:"milestone_title"`
+	*BatchResult
+}
+
+// resolveMilestone finds a milestone by number, or by exact title when the value is not a number.
+func resolveMilestone(ctx context.Context, client *github.Client, owner, repo, milestone string) (*github.Milestone, error) {
+	if number, err := strconv.Atoi(milestone); err == nil {
+		m, resp, err := client.Issues.GetMilestone(ctx, owner, repo, number)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, fmt.Errorf("milestone #%d does not exist in %s/%s", number, owner, repo)
+			}
+			return nil, fmt.Errorf("failed to get milestone #%d: %w", number, err)
+		}
+		_ = resp.Body.Close()
+		return m, nil
+	}
+
+	opts := &github.MilestoneListOptions{
+		State:       "all",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	for {
+		milestones, resp, err := client.Issues.ListMilestones(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list milestones: %w", err)
+		}
+		_ = resp.Body.Close()
+		for _, m := range milestones {
+			if m.GetTitle() == milestone {
+				return m, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return nil, fmt.Errorf("milestone %q does not exist in %s/%s", milestone, owner, repo)
+}
+
+// SetMilestoneForIssues creates a tool to assign a milestone to several issues or pull requests at once.
+func SetMilestoneForIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("set_milestone_for_issues",
+			mcp.WithDescription(t("TOOL_SET_MILESTONE_FOR_ISSUES_DESCRIPTION", "Assign a milestone to a batch of issues or pull requests, reporting success or failure for each one")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SET_MILESTONE_FOR_ISSUES_USER_TITLE", "Set milestone for issues"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("milestone",
+				mcp.Required(),
+				mcp.Description("Milestone number or exact title"),
+			),
+			mcp.WithArray("issue_numbers",
+				mcp.Required(),
+				mcp.Description("Numbers of the issues or pull requests to assign to the milestone"),
+				mcp.Items(map[string]any{
+					"type": "number",
+				}),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			milestone, err := RequiredParam[string](request, "milestone")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumbers, err := OptionalIntArrayParam(request, "issue_numbers")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(issueNumbers) == 0 {
+				return mcp.NewToolResultError("missing required parameter: issue_numbers"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			m, err := resolveMilestone(ctx, client, owner, repo, milestone)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			milestoneNumber := m.GetNumber()
+
+			result := newBatchResult(len(issueNumbers))
+			for _, issueNumber := range issueNumbers {
+				item := fmt.Sprintf("#%d", issueNumber)
+				_, resp, err := client.Issues.Edit(ctx, owner, repo, issueNumber, &github.IssueRequest{Milestone: &milestoneNumber})
+				if err != nil {
+					result.addFailure(item, err)
+					continue
+				}
+				_ = resp.Body.Close()
+				result.addSuccess(item)
+			}
+
+			return MarshalledTextResult(MilestoneAssignmentResult{
+				MilestoneNumber: milestoneNumber,
+				MilestoneTitle:  m.GetTitle(),
+				BatchResult:     result,
+			}), nil
+		}
+}
+
+// IssueLabelsResult lists the labels of an issue after set_issue_labels.
+type IssueLabelsResult struct {
+	IssueNumber int      `json:"issue_number"`
+	Labels      []string `json:"labels"`
+}
+
+// SetIssueLabels creates a tool to replace all labels of an issue or pull request.
+func SetIssueLabels(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("set_issue_labels",
+			mcp.WithDescription(t("TOOL_SET_ISSUE_LABELS_DESCRIPTION", "Set the labels of an issue or pull request to exactly the given list, removing any other labels. Pass an empty list to remove all labels. Labels that do not exist in the repository are created.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:          t("TOOL_SET_ISSUE_LABELS_USER_TITLE", "Set issue labels"),
+				ReadOnlyHint:   ToBoolPtr(false),
+				IdempotentHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue or pull request number"),
+			),
+			mcp.WithArray("labels",
+				mcp.Required(),
+				mcp.Description("The complete list of labels the issue should have. An empty list removes all labels."),
+				mcp.Items(map[string]any{
+					"type": "string",
+				}),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// An empty list is meaningful here, so only a missing parameter is rejected.
+			if _, ok := request.GetArguments()["labels"]; !ok {
+				return mcp.NewToolResultError("missing required parameter: labels"), nil
+			}
+			labels, err := OptionalStringArrayParam(request, "labels")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			updated, resp, err := client.Issues.ReplaceLabelsForIssue(ctx, owner, repo, issueNumber, labels)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to set issue labels", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := IssueLabelsResult{
+				IssueNumber: issueNumber,
+				Labels:      make([]string, 0, len(updated)),
+			}
+			for _, label := range updated {
+				result.Labels = append(result.Labels, label.GetName())
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+const (
+	// maxCreateIssues limits how many issues a single create_issues call may create.
+	maxCreateIssues = 50
+	// createIssuesConcurrency limits how many issues are created at the same time, to stay clear
+	// of GitHub's secondary rate limits on content creation.
+	createIssuesConcurrency = 4
+)
+
+// IssueSpec describes an issue to create with create_issues.
+type IssueSpec struct {
+	Title     string   `json:"title" mapstructure:"title"`
+	Body      string   `json:"body,omitempty" mapstructure:"body"`
+	Labels    []string `json:"labels,omitempty" mapstructure:"labels"`
+	Assignees []string `json:"assignees,omitempty" mapstructure:"assignees"`
+}
+
+// CreatedIssue identifies an issue created by create_issues.
+type CreatedIssue struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	URL    string `json:"url"`
+}
+
+// CreateIssuesResult is the outcome of create_issues. In a dry run, Preview lists the issues
+// that would have been created and nothing else is set.
+type CreateIssuesResult struct {
+	DryRun  bool           `json:"dry_run"`
+	Preview []IssueSpec    `json:"preview,omitempty"`
+	Created []CreatedIssue `json:"created,omitempty"`
+	*BatchResult
+}
+
+// parseIssueSpecs decodes and checks the issues parameter of create_issues.
+func parseIssueSpecs(request mcp.CallToolRequest) ([]IssueSpec, error) {
+	raw, err := OptionalParam[[]any](request, "issues")
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("missing required parameter: issues")
+	}
+	if len(raw) > maxCreateIssues {
+		return nil, fmt.Errorf("at most %d issues can be created at once, got %d", maxCreateIssues, len(raw))
+	}
+
+	specs := make([]IssueSpec, len(raw))
+	for i, item := range raw {
+		if err := mapstructure.Decode(item, &specs[i]); err != nil {
+			return nil, fmt.Errorf("issues[%d]: %w", i, err)
+		}
+		specs[i].Title = strings.TrimSpace(specs[i].Title)
+		if specs[i].Title == "" {
+			return nil, fmt.Errorf("issues[%d]: title is required", i)
+		}
+	}
+	return specs, nil
+}
+
+// CreateIssues creates a tool to create several issues at once.
+func CreateIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_issues",
+			mcp.WithDescription(t("TOOL_CREATE_ISSUES_DESCRIPTION", fmt.Sprintf("Create up to %d issues in a GitHub repository from a list, for example to turn a plan into tracked work. Reports the created issue numbers and a per-issue error for any that failed. Use dry_run to preview the issues without creating them.", maxCreateIssues))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_ISSUES_USER_TITLE", "Create issues"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithArray("issues",
+				mcp.Required(),
+				mcp.Description("Issues to create"),
+				mcp.Items(map[string]any{
+					"type":     "object",
+					"required": []string{"title"},
+					"properties": map[string]any{
+						"title": map[string]any{
+							"type":        "string",
+							"description": "Issue title",
+						},
+						"body": map[string]any{
+							"type":        "string",
+							"description": "Issue body content",
+						},
+						"labels": map[string]any{
+							"type":        "array",
+							"description": "Labels to apply to the issue",
+							"items":       map[string]any{"type": "string"},
+						},
+						"assignees": map[string]any{
+							"type":        "array",
+							"description": "Usernames to assign to the issue",
+							"items":       map[string]any{"type": "string"},
+						},
+					},
+				}),
+			),
+			mcp.WithBoolean("dry_run",
+				mcp.Description("Validate and preview the issues without creating them"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			specs, err := parseIssueSpecs(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dryRun, err := OptionalParam[bool](request, "dry_run")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if dryRun {
+				return MarshalledTextResult(CreateIssuesResult{DryRun: true, Preview: specs}), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			issues := make([]*github.Issue, len(specs))
+			errs := make([]error, len(specs))
+			sem := make(chan struct{}, createIssuesConcurrency)
+			var wg sync.WaitGroup
+			for i, spec := range specs {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					select {
+					case sem <- struct{}{}:
+						defer func() { <-sem }()
+					case <-ctx.Done():
+						errs[i] = ctx.Err()
+						return
+					}
+
+					issueRequest := &github.IssueRequest{
+						Title: github.Ptr(spec.Title),
+					}
+					if spec.Body != "" {
+						issueRequest.Body = github.Ptr(spec.Body)
+					}
+					if len(spec.Labels) > 0 {
+						issueRequest.Labels = &spec.Labels
+					}
+					if len(spec.Assignees) > 0 {
+						issueRequest.Assignees = &spec.Assignees
+					}
+
+					issue, resp, err := client.Issues.Create(ctx, owner, repo, issueRequest)
+					if err != nil {
+						errs[i] = err
+						return
+					}
+					_ = resp.Body.Close()
+					issues[i] = issue
+				}()
+			}
+			wg.Wait()
+
+			// Results are reported in the order the issues were given, not the order they were created.
+			result := CreateIssuesResult{BatchResult: newBatchResult(len(specs))}
+			for i, spec := range specs {
+				if errs[i] != nil {
+					result.addFailure(spec.Title, errs[i])
+					continue
+				}
+				result.addSuccess(spec.Title)
+				result.Created = append(result.Created, CreatedIssue{
+					Number: issues[i].GetNumber(),
+					Title:  issues[i].GetTitle(),
+					URL:    issues[i].GetHTMLURL(),
+				})
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+const (
+	// defaultBulkLabelMaxIssues and maxBulkLabelMaxIssues limit how many issues one
+	// bulk_label_by_query call may change.
+	defaultBulkLabelMaxIssues = 100
+	maxBulkLabelMaxIssues     = 500
+	// bulkLabelConfirmThreshold is how many issues may be changed without passing confirm.
+	bulkLabelConfirmThreshold = 20
+)
+
+// BulkLabelIssue is an issue that bulk_label_by_query would change.
+type BulkLabelIssue struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+}
+
+// BulkLabelResult is the outcome of bulk_label_by_query. Matched counts every issue the query
+// found, Unchanged those that already had (or lacked) the label. In a dry run, Preview lists the
+// issues that would be changed and nothing else is set.
+type BulkLabelResult struct {
+	Query     string           `json:"query"`
+	Label     string           `json:"label"`
+	Action    string           `json:"action"`
+	DryRun    bool             `json:"dry_run"`
+	Matched   int              `json:"matched"`
+	Unchanged int              `json:"unchanged"`
+	Preview   []BulkLabelIssue `json:"preview,omitempty"`
+	*BatchResult
+}
+
+// hasLabel reports whether issue has the label called name. Label names are case-insensitive.
+func hasLabel(issue *github.Issue, name string) bool {
+	for _, label := range issue.Labels {
+		if strings.EqualFold(label.GetName(), name) {
+			return true
+		}
+	}
+	return false
+}
+
+// BulkLabelByQuery creates a tool to add or remove a label on every issue matching a search query.
+func BulkLabelByQuery(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("bulk_label_by_query",
+			mcp.WithDescription(t("TOOL_BULK_LABEL_BY_QUERY_DESCRIPTION", fmt.Sprintf("Add or remove a label on every issue in a repository that matches an issue search query, reporting success or failure for each one. Use dry_run to preview the affected issues first. Changing more than %d issues requires confirm, and queries matching more than max_issues issues are refused.", bulkLabelConfirmThreshold))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_BULK_LABEL_BY_QUERY_USER_TITLE", "Bulk label issues by query"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("query",
+				mcp.Required(),
+				mcp.Description("Issue search query, e.g. 'is:open no:assignee crash in:title'. It is always limited to the repository, so it must not contain a repo: filter. Only issues are matched unless the query says is:pr."),
+			),
+			mcp.WithString("label",
+				mcp.Required(),
+				mcp.Description("Label to add or remove"),
+			),
+			mcp.WithString("action",
+				mcp.Description("Whether to add or remove the label"),
+				mcp.Enum("add", "remove"),
+				mcp.DefaultString("add"),
+			),
+			mcp.WithBoolean("dry_run",
+				mcp.Description("Preview the issues that would change without changing them"),
+			),
+			mcp.WithBoolean("confirm",
+				mcp.Description(fmt.Sprintf("Required to change more than %d issues", bulkLabelConfirmThreshold)),
+			),
+			mcp.WithNumber("max_issues",
+				mcp.Description(fmt.Sprintf("Refuse to run when the query matches more issues than this (default %d, max %d)", defaultBulkLabelMaxIssues, maxBulkLabelMaxIssues)),
+				mcp.Min(1),
+				mcp.Max(maxBulkLabelMaxIssues),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			query, err := RequiredParam[string](request, "query")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			label, err := RequiredParam[string](request, "label")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			action, err := OptionalParam[string](request, "action")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if action == "" {
+				action = "add"
+			}
+			if action != "add" && action != "remove" {
+				return mcp.NewToolResultError(fmt.Sprintf("action must be add or remove, got %q", action)), nil
+			}
+			dryRun, err := OptionalParam[bool](request, "dry_run")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			confirm, err := OptionalParam[bool](request, "confirm")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxIssues, err := OptionalIntParamWithDefault(request, "max_issues", defaultBulkLabelMaxIssues)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxIssues < 1 || maxIssues > maxBulkLabelMaxIssues {
+				return mcp.NewToolResultError(fmt.Sprintf("max_issues must be between 1 and %d", maxBulkLabelMaxIssues)), nil
+			}
+
+			// Labels belong to a repository, so the search must not reach outside it.
+			if hasRepoFilter(query) {
+				return mcp.NewToolResultError("query must not contain a repo: filter, it is always limited to owner/repo"), nil
+			}
+			if !hasSpecificFilter(query, "is", "issue") && !hasSpecificFilter(query, "is", "pr") {
+				query = "is:issue " + query
+			}
+			query = fmt.Sprintf("repo:%s/%s %s", owner, repo, query)
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var matched []*github.Issue
+			opts := &github.SearchOptions{ListOptions: github.ListOptions{PerPage: 100}}
+			for {
+				found, resp, err := client.Search.Issues(ctx, query, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to search issues", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				if found.GetTotal() > maxIssues {
+					return mcp.NewToolResultError(fmt.Sprintf("the query matches %d issues, more than max_issues (%d); narrow the query or raise max_issues", found.GetTotal(), maxIssues)), nil
+				}
+				matched = append(matched, found.Issues...)
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			result := BulkLabelResult{
+				Query:   query,
+				Label:   label,
+				Action:  action,
+				DryRun:  dryRun,
+				Matched: len(matched),
+			}
+			var targets []*github.Issue
+			for _, issue := range matched {
+				if hasLabel(issue, label) == (action == "add") {
+					result.Unchanged++
+					continue
+				}
+				targets = append(targets, issue)
+			}
+
+			if dryRun {
+				result.Preview = make([]BulkLabelIssue, 0, len(targets))
+				for _, issue := range targets {
+					result.Preview = append(result.Preview, BulkLabelIssue{Number: issue.GetNumber(), Title: issue.GetTitle()})
+				}
+				return MarshalledTextResult(result), nil
+			}
+			if len(targets) > bulkLabelConfirmThreshold && !confirm {
+				return mcp.NewToolResultError(fmt.Sprintf("%d issues would change, more than %d; check them with dry_run and pass confirm to go ahead", len(targets), bulkLabelConfirmThreshold)), nil
+			}
+
+			result.BatchResult = newBatchResult(len(targets))
+			for _, issue := range targets {
+				item := fmt.Sprintf("#%d", issue.GetNumber())
+				var resp *github.Response
+				if action == "add" {
+					_, resp, err = client.Issues.AddLabelsToIssue(ctx, owner, repo, issue.GetNumber(), []string{label})
+				} else {
+					resp, err = client.Issues.RemoveLabelForIssue(ctx, owner, repo, issue.GetNumber(), label)
+				}
+				if err != nil {
+					result.addFailure(item, err)
+					continue
+				}
+				_ = resp.Body.Close()
+				result.addSuccess(item)
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
 // GetIssueComments creates a tool to get comments for a GitHub issue.
 func GetIssueComments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_issue_comments",
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -349,6 +350,56 @@ func Test_SearchIssues(t *testing.T) {
 			expectError:    false,
 			expectedResult: mockSearchResult,
 		},
+		{
+			name: "issues search with milestone parameter",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					expectQueryParams(
+						t,
+						map[string]string{
+							"q":        `repo:test-owner/test-repo is:issue is:open milestone:"v1.2 beta"`,
+							"page":     "1",
+							"per_page": "30",
+						},
+					).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query":     "is:open",
+				"owner":     "test-owner",
+				"repo":      "test-repo",
+				"milestone": "v1.2 beta",
+			},
+			expectError:    false,
+			expectedResult: mockSearchResult,
+		},
+		{
+			name: "issues search without a milestone",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					expectQueryParams(
+						t,
+						map[string]string{
+							"q":        "is:issue is:open no:milestone",
+							"page":     "1",
+							"per_page": "30",
+						},
+					).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query":     "is:open",
+				"milestone": "none",
+			},
+			expectError:    false,
+			expectedResult: mockSearchResult,
+		},
 		{
 			name: "issues search with only owner parameter (should ignore it)",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -891,6 +942,17 @@ func Test_ListIssues(t *testing.T) {
 		"after":     (*string)(nil),
 	}
 
+	varsWithMilestone := map[string]interface{}{
+		"owner":     "owner",
+		"repo":      "repo",
+		"states":    []interface{}{"OPEN", "CLOSED"},
+		"filterBy":  map[string]interface{}{"milestoneNumber": "3"},
+		"orderBy":   "CREATED_AT",
+		"direction": "DESC",
+		"first":     float64(30),
+		"after":     (*string)(nil),
+	}
+
 	varsRepoNotFound := map[string]interface{}{
 		"owner":     "owner",
 		"repo":      "nonexistent-repo",
@@ -948,6 +1010,26 @@ func Test_ListIssues(t *testing.T) {
 			expectError:   false,
 			expectedCount: 2,
 		},
+		{
+			name: "filter by milestone",
+			reqParams: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"milestone": "3",
+			},
+			expectError:   false,
+			expectedCount: 2,
+		},
+		{
+			name: "invalid milestone",
+			reqParams: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"milestone": "v1.0",
+			},
+			expectError: true,
+			errContains: `invalid milestone "v1.0"`,
+		},
 		{
 			name: "repository not found error",
 			reqParams: map[string]interface{}{
@@ -962,6 +1044,7 @@ func Test_ListIssues(t *testing.T) {
 	// Define the actual query strings that match the implementation
 	qBasicNoLabels := "query($after:String$direction:OrderDirection!$first:Int!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, states: $states, orderBy: {field: $orderBy, direction: $direction}){nodes{number,title,body,state,databaseId,author{login},createdAt,updatedAt,labels(first: 100){nodes{name,id,description}},comments{totalCount}},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
 	qWithLabels := "query($after:String$direction:OrderDirection!$first:Int!$labels:[String!]!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, labels: $labels, states: $states, orderBy: {field: $orderBy, direction: $direction}){nodes{number,title,body,state,databaseId,author{login},createdAt,updatedAt,labels(first: 100){nodes{name,id,description}},comments{totalCount}},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
+	qWithFilters := "query($after:String$direction:OrderDirection!$filterBy:IssueFilters!$first:Int!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: $filterBy){nodes{number,title,body,state,databaseId,author{login},createdAt,updatedAt,labels(first: 100){nodes{name,id,description}},comments{totalCount}},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -983,6 +1066,11 @@ func Test_ListIssues(t *testing.T) {
 			case "repository not found error":
 				matcher := githubv4mock.NewQueryMatcher(qBasicNoLabels, varsRepoNotFound, mockErrorRepoNotFound)
 				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
+			case "filter by milestone":
+				matcher := githubv4mock.NewQueryMatcher(qWithFilters, varsWithMilestone, mockResponseListAll)
+				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
+			case "invalid milestone":
+				httpClient = githubv4mock.NewMockedHTTPClient()
 			}
 
 			gqlClient := githubv4.NewClient(httpClient)
@@ -1511,6 +1599,654 @@ func Test_UpdateIssue(t *testing.T) {
 	}
 }
 
+func Test_SetMilestoneForIssues(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SetMilestoneForIssues(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "set_milestone_for_issues", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "milestone")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_numbers")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "milestone", "issue_numbers"})
+
+	mockMilestones := []*github.Milestone{
+		{Number: github.Ptr(1), Title: github.Ptr("v1.0")},
+		{Number: github.Ptr(2), Title: github.Ptr("v2.0")},
+	}
+
+	// Issue #404 does not exist, the others are updated
+	editIssueHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/issues/404") {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+			return
+		}
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["milestone"] != float64(2) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"number": 1}`))
+	})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedResult MilestoneAssignmentResult
+	}{
+		{
+			name: "resolve milestone by title with mixed outcomes",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposMilestonesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"state":    "all",
+						"per_page": "100",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockMilestones),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					editIssueHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"milestone":     "v2.0",
+				"issue_numbers": []any{float64(10), float64(404), float64(11)},
+			},
+			expectedResult: MilestoneAssignmentResult{
+				MilestoneNumber: 2,
+				MilestoneTitle:  "v2.0",
+				BatchResult: &BatchResult{
+					Succeeded: 2,
+					Failed:    1,
+					Results: []BatchItemResult{
+						{Item: "#10", Status: BatchStatusSucceeded},
+						{Item: "#404", Status: BatchStatusFailed},
+						{Item: "#11", Status: BatchStatusSucceeded},
+					},
+				},
+			},
+		},
+		{
+			name: "resolve milestone by number",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposMilestonesByOwnerByRepoByMilestoneNumber,
+					expectPath(t, "/repos/owner/repo/milestones/2").andThen(
+						mockResponse(t, http.StatusOK, mockMilestones[1]),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					editIssueHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"milestone":     "2",
+				"issue_numbers": []any{float64(10)},
+			},
+			expectedResult: MilestoneAssignmentResult{
+				MilestoneNumber: 2,
+				MilestoneTitle:  "v2.0",
+				BatchResult: &BatchResult{
+					Succeeded: 1,
+					Results: []BatchItemResult{
+						{Item: "#10", Status: BatchStatusSucceeded},
+					},
+				},
+			},
+		},
+		{
+			name: "milestone title does not exist",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposMilestonesByOwnerByRepo,
+					mockMilestones,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"milestone":     "v3.0",
+				"issue_numbers": []any{float64(10)},
+			},
+			expectError:    true,
+			expectedErrMsg: `milestone "v3.0" does not exist in owner/repo`,
+		},
+		{
+			name: "milestone number does not exist",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposMilestonesByOwnerByRepoByMilestoneNumber,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"milestone":     "9",
+				"issue_numbers": []any{float64(10)},
+			},
+			expectError:    true,
+			expectedErrMsg: "milestone #9 does not exist in owner/repo",
+		},
+		{
+			name:         "no issues given",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"milestone":     "v1.0",
+				"issue_numbers": []any{},
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: issue_numbers",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SetMilestoneForIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+
+			var returned MilestoneAssignmentResult
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			// Only check that failures carry an error, not its exact text
+			for i := range returned.Results {
+				if returned.Results[i].Status == BatchStatusFailed {
+					assert.NotEmpty(t, returned.Results[i].Error)
+					returned.Results[i].Error = ""
+				}
+			}
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_SetIssueLabels(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SetIssueLabels(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "set_issue_labels", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.Properties, "labels")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "labels"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedResult IssueLabelsResult
+	}{
+		{
+			name: "labels are replaced with the given list",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposIssuesLabelsByOwnerByRepoByIssueNumber,
+					expectPath(t, "/repos/owner/repo/issues/42/labels").andThen(
+						expectRequestBody(t, []any{"bug", "triaged"}).andThen(
+							mockResponse(t, http.StatusOK, []*github.Label{
+								{Name: github.Ptr("bug")},
+								{Name: github.Ptr("triaged")},
+							}),
+						),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"labels":       []any{"bug", "triaged"},
+			},
+			expectedResult: IssueLabelsResult{
+				IssueNumber: 42,
+				Labels:      []string{"bug", "triaged"},
+			},
+		},
+		{
+			name: "empty list clears all labels",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposIssuesLabelsByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, []any{}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Label{}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"labels":       []any{},
+			},
+			expectedResult: IssueLabelsResult{
+				IssueNumber: 42,
+				Labels:      []string{},
+			},
+		},
+		{
+			name:         "missing labels is rejected",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: labels",
+		},
+		{
+			name: "issue not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposIssuesLabelsByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(999),
+				"labels":       []any{"bug"},
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to set issue labels",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SetIssueLabels(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var labels IssueLabelsResult
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &labels))
+			assert.Equal(t, tc.expectedResult, labels)
+		})
+	}
+}
+
+func Test_CreateIssues(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateIssues(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_issues", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.Properties, "issues")
+	assert.Contains(t, tool.InputSchema.Properties, "dry_run")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issues"})
+
+	// createIssueHandler creates issues numbered from 100 in request order, failing those whose
+	// title starts with "fail", and records the highest number of requests handled at once.
+	createIssueHandler := func(maxInFlight *atomic.Int32) http.HandlerFunc {
+		var inFlight, next atomic.Int32
+		return func(w http.ResponseWriter, r *http.Request) {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				seen := maxInFlight.Load()
+				if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+					break
+				}
+			}
+			// Hold the request briefly so that concurrent requests overlap.
+			time.Sleep(10 * time.Millisecond)
+
+			var issueRequest github.IssueRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&issueRequest))
+			if strings.HasPrefix(issueRequest.GetTitle(), "fail") {
+				mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Validation Failed"})(w, r)
+				return
+			}
+			number := int(100 + next.Add(1))
+			mockResponse(t, http.StatusCreated, &github.Issue{
+				Number:  github.Ptr(number),
+				Title:   issueRequest.Title,
+				HTMLURL: github.Ptr(fmt.Sprintf("https://github.com/owner/repo/issues/%d", number)),
+			})(w, r)
+		}
+	}
+
+	t.Run("dry run previews without creating", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesByOwnerByRepo,
+				http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+					t.Error("no issue should be created in a dry run")
+				}),
+			),
+		)
+		client := github.NewClient(mockedClient)
+		_, handler := CreateIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner": "owner",
+			"repo":  "repo",
+			"issues": []any{
+				map[string]any{"title": "Set up CI", "labels": []any{"infra"}},
+				map[string]any{"title": "Write docs", "body": "Cover the install steps", "assignees": []any{"octocat"}},
+			},
+			"dry_run": true,
+		}))
+		require.NoError(t, err)
+		textContent := getTextResult(t, result)
+
+		var returned CreateIssuesResult
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+		assert.True(t, returned.DryRun)
+		assert.Equal(t, []IssueSpec{
+			{Title: "Set up CI", Labels: []string{"infra"}},
+			{Title: "Write docs", Body: "Cover the install steps", Assignees: []string{"octocat"}},
+		}, returned.Preview)
+		assert.Empty(t, returned.Created)
+		assert.Nil(t, returned.BatchResult)
+	})
+
+	t.Run("issues are created with bounded concurrency", func(t *testing.T) {
+		var maxInFlight atomic.Int32
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesByOwnerByRepo,
+				createIssueHandler(&maxInFlight),
+			),
+		)
+		client := github.NewClient(mockedClient)
+		_, handler := CreateIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		issues := make([]any, 10)
+		for i := range issues {
+			issues[i] = map[string]any{"title": fmt.Sprintf("Task %d", i+1)}
+		}
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":  "owner",
+			"repo":   "repo",
+			"issues": issues,
+		}))
+		require.NoError(t, err)
+		textContent := getTextResult(t, result)
+
+		var returned CreateIssuesResult
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+		assert.Equal(t, 10, returned.Succeeded)
+		assert.Equal(t, 0, returned.Failed)
+		require.Len(t, returned.Created, 10)
+		for i, created := range returned.Created {
+			assert.Equal(t, fmt.Sprintf("Task %d", i+1), created.Title, "results keep the input order")
+			assert.Equal(t, fmt.Sprintf("Task %d", i+1), returned.Results[i].Item)
+		}
+		assert.LessOrEqual(t, maxInFlight.Load(), int32(createIssuesConcurrency))
+		assert.Greater(t, maxInFlight.Load(), int32(1), "issues should be created concurrently")
+	})
+
+	t.Run("partial failure is reported per issue", func(t *testing.T) {
+		var maxInFlight atomic.Int32
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesByOwnerByRepo,
+				createIssueHandler(&maxInFlight),
+			),
+		)
+		client := github.NewClient(mockedClient)
+		_, handler := CreateIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner": "owner",
+			"repo":  "repo",
+			"issues": []any{
+				map[string]any{"title": "Set up CI"},
+				map[string]any{"title": "fail on purpose"},
+			},
+		}))
+		require.NoError(t, err)
+		textContent := getTextResult(t, result)
+
+		var returned CreateIssuesResult
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+		assert.Equal(t, 1, returned.Succeeded)
+		assert.Equal(t, 1, returned.Failed)
+		require.Len(t, returned.Created, 1)
+		assert.Equal(t, "Set up CI", returned.Created[0].Title)
+		require.Len(t, returned.Results, 2)
+		assert.Equal(t, BatchItemResult{Item: "Set up CI", Status: BatchStatusSucceeded}, returned.Results[0])
+		assert.Equal(t, "fail on purpose", returned.Results[1].Item)
+		assert.Equal(t, BatchStatusFailed, returned.Results[1].Status)
+		assert.Contains(t, returned.Results[1].Error, "Validation Failed")
+	})
+
+	validationTests := []struct {
+		name           string
+		issues         []any
+		expectedErrMsg string
+	}{
+		{
+			name:           "no issues",
+			issues:         []any{},
+			expectedErrMsg: "missing required parameter: issues",
+		},
+		{
+			name:           "issue without a title",
+			issues:         []any{map[string]any{"title": "ok"}, map[string]any{"body": "no title"}},
+			expectedErrMsg: "issues[1]: title is required",
+		},
+		{
+			name:           "too many issues",
+			issues:         make([]any, maxCreateIssues+1),
+			expectedErrMsg: fmt.Sprintf("at most %d issues can be created at once", maxCreateIssues),
+		},
+	}
+
+	for _, tc := range validationTests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient())
+			_, handler := CreateIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"issues": tc.issues,
+			}))
+			require.NoError(t, err)
+			errorContent := getErrorResult(t, result)
+			assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+		})
+	}
+}
+
+func Test_BulkLabelByQuery(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := BulkLabelByQuery(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "bulk_label_by_query", tool.Name)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.Properties, "dry_run")
+	assert.Contains(t, tool.InputSchema.Properties, "confirm")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "query", "label"})
+
+	// searchResult returns count issues numbered from 1, the first of which already has the
+	// triage label.
+	searchResult := func(count int) *github.IssuesSearchResult {
+		issues := make([]*github.Issue, count)
+		for i := range issues {
+			issues[i] = &github.Issue{Number: github.Ptr(i + 1), Title: github.Ptr(fmt.Sprintf("Issue %d", i+1))}
+		}
+		issues[0].Labels = []*github.Label{{Name: github.Ptr("Triage")}}
+		return &github.IssuesSearchResult{Total: github.Ptr(count), Issues: issues}
+	}
+	searchHandler := func(count int) mock.MockBackendOption {
+		return mock.WithRequestMatchHandler(
+			mock.GetSearchIssues,
+			expectQueryParams(t, map[string]string{
+				"q":        "repo:owner/repo is:issue is:open crash",
+				"per_page": "100",
+			}).andThen(
+				mockResponse(t, http.StatusOK, searchResult(count)),
+			),
+		)
+	}
+	noLabelChanges := mock.WithRequestMatchHandler(
+		mock.PostReposIssuesLabelsByOwnerByRepoByIssueNumber,
+		http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+			t.Error("no label should be added")
+		}),
+	)
+	args := func(extra map[string]any) map[string]any {
+		a := map[string]any{"owner": "owner", "repo": "repo", "query": "is:open crash", "label": "triage"}
+		for k, v := range extra {
+			a[k] = v
+		}
+		return a
+	}
+
+	t.Run("dry run previews without labeling", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(searchHandler(bulkLabelConfirmThreshold+5), noLabelChanges)
+		_, handler := BulkLabelByQuery(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args(map[string]any{"dry_run": true})))
+		require.NoError(t, err)
+
+		var response BulkLabelResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.True(t, response.DryRun)
+		assert.Equal(t, bulkLabelConfirmThreshold+5, response.Matched)
+		assert.Equal(t, 1, response.Unchanged)
+		require.Len(t, response.Preview, bulkLabelConfirmThreshold+4)
+		assert.Equal(t, BulkLabelIssue{Number: 2, Title: "Issue 2"}, response.Preview[0])
+		assert.Nil(t, response.BatchResult)
+	})
+
+	t.Run("above the threshold requires confirm", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(searchHandler(bulkLabelConfirmThreshold+2), noLabelChanges)
+		_, handler := BulkLabelByQuery(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args(nil)))
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("%d issues would change, more than %d; check them with dry_run and pass confirm to go ahead", bulkLabelConfirmThreshold+1, bulkLabelConfirmThreshold), getErrorResult(t, result).Text)
+	})
+
+	t.Run("confirmed run labels each issue", func(t *testing.T) {
+		var labeled []string
+		mockedClient := mock.NewMockedHTTPClient(
+			searchHandler(bulkLabelConfirmThreshold+2),
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesLabelsByOwnerByRepoByIssueNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					labeled = append(labeled, r.URL.Path)
+					if strings.HasSuffix(r.URL.Path, "/issues/3/labels") {
+						mockResponse(t, http.StatusForbidden, map[string]string{"message": "Forbidden"})(w, r)
+						return
+					}
+					mockResponse(t, http.StatusOK, []*github.Label{{Name: github.Ptr("triage")}})(w, r)
+				}),
+			),
+		)
+		_, handler := BulkLabelByQuery(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args(map[string]any{"confirm": true})))
+		require.NoError(t, err)
+
+		var response BulkLabelResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Len(t, labeled, bulkLabelConfirmThreshold+1)
+		require.NotNil(t, response.BatchResult)
+		assert.Equal(t, bulkLabelConfirmThreshold, response.Succeeded)
+		assert.Equal(t, 1, response.Failed)
+		assert.Equal(t, "#2", response.Results[0].Item)
+		assert.Equal(t, BatchStatusFailed, response.Results[1].Status)
+	})
+
+	t.Run("remove below the threshold", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			searchHandler(3),
+			mock.WithRequestMatchHandler(
+				mock.DeleteReposIssuesLabelsByOwnerByRepoByIssueNumberByName,
+				expectPath(t, "/repos/owner/repo/issues/1/labels/triage").andThen(
+					mockResponse(t, http.StatusOK, []*github.Label{}),
+				),
+			),
+		)
+		_, handler := BulkLabelByQuery(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args(map[string]any{"action": "remove"})))
+		require.NoError(t, err)
+
+		var response BulkLabelResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, 3, response.Matched)
+		assert.Equal(t, 2, response.Unchanged)
+		assert.Equal(t, 1, response.Succeeded)
+	})
+
+	t.Run("too many matches are refused", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(searchHandler(6), noLabelChanges)
+		_, handler := BulkLabelByQuery(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args(map[string]any{"max_issues": float64(5), "confirm": true})))
+		require.NoError(t, err)
+		assert.Equal(t, "the query matches 6 issues, more than max_issues (5); narrow the query or raise max_issues", getErrorResult(t, result).Text)
+	})
+
+	t.Run("repo filter is rejected", func(t *testing.T) {
+		_, handler := BulkLabelByQuery(stubGetClientFn(github.NewClient(mock.NewMockedHTTPClient())), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args(map[string]any{"query": "repo:other/repo crash"})))
+		require.NoError(t, err)
+		assert.Equal(t, "query must not contain a repo: filter, it is always limited to owner/repo", getErrorResult(t, result).Text)
+	})
+}
+
 func Test_ParseISOTimestamp(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -0,0 +1,130 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// LicenseSummary is a license known to GitHub, as listed by list_licenses.
+type LicenseSummary struct {
+	Key    string `json:"key"`
+	SPDXID string `json:"spdx_id"`
+	Name   string `json:"name"`
+}
+
+// License is a license known to GitHub, with what it permits, requires and limits and its full
+// text.
+type License struct {
+	Key            string   `json:"key"`
+	SPDXID         string   `json:"spdx_id"`
+	Name           string   `json:"name"`
+	HTMLURL        string   `json:"html_url,omitempty"`
+	Description    string   `json:"description,omitempty"`
+	Implementation string   `json:"implementation,omitempty"`
+	Permissions    []string `json:"permissions"`
+	Conditions     []string `json:"conditions"`
+	Limitations    []string `json:"limitations"`
+	Body           string   `json:"body"`
+}
+
+// ListLicenses creates a tool to list the commonly used licenses GitHub knows.
+func ListLicenses(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_licenses",
+			mcp.WithDescription(t("TOOL_LIST_LICENSES_DESCRIPTION", "List the commonly used open source licenses GitHub knows, with the key to pass to get_license and their SPDX identifier.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_LICENSES_USER_TITLE", "List licenses"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+		),
+		func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			licenses, resp, err := client.Licenses.List(ctx)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list licenses", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]LicenseSummary, 0, len(licenses))
+			for _, l := range licenses {
+				result = append(result, LicenseSummary{
+					Key:    l.GetKey(),
+					SPDXID: l.GetSPDXID(),
+					Name:   l.GetName(),
+				})
+			}
+			return MarshalledListResult(ctx, result, "no licenses found"), nil
+		}
+}
+
+// GetLicense creates a tool to get a license by its key, with its terms and full text.
+func GetLicense(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_license",
+			mcp.WithDescription(t("TOOL_GET_LICENSE_DESCRIPTION", "Get a license by its key, with what it permits, what it requires, what it limits and its full text. Use it to answer questions about the terms of a license instead of relying on memory. The key is the key returned by list_licenses or get_repository_license, such as mit or apache-2.0.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_LICENSE_USER_TITLE", "Get license"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("license",
+				mcp.Required(),
+				mcp.Description("Key of the license, such as mit or apache-2.0"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			key, err := RequiredParam[string](request, "license")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			license, resp, err := client.Licenses.Get(ctx, key)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					_ = resp.Body.Close()
+					return mcp.NewToolResultError(fmt.Sprintf("license %s not found; use list_licenses to find its key", key)), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get license %s", key), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newLicense(license)), nil
+		}
+}
+
+func newLicense(l *github.License) License {
+	return License{
+		Key:            l.GetKey(),
+		SPDXID:         l.GetSPDXID(),
+		Name:           l.GetName(),
+		HTMLURL:        l.GetHTMLURL(),
+		Description:    l.GetDescription(),
+		Implementation: l.GetImplementation(),
+		Permissions:    nonNilStrings(l.Permissions),
+		Conditions:     nonNilStrings(l.Conditions),
+		Limitations:    nonNilStrings(l.Limitations),
+		Body:           l.GetBody(),
+	}
+}
+
+// nonNilStrings returns the strings s points to, or an empty slice, so that they marshal as a
+// JSON array.
+func nonNilStrings(s *[]string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return *s
+}
@@ -0,0 +1,172 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListLicenses(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListLicenses(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_licenses", tool.Name)
+	assert.Empty(t, tool.InputSchema.Required)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+		expected       []LicenseSummary
+	}{
+		{
+			name: "licenses listed",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetLicenses,
+					mockResponse(t, http.StatusOK, []*github.License{
+						{Key: github.Ptr("mit"), SPDXID: github.Ptr("MIT"), Name: github.Ptr("MIT License")},
+						{Key: github.Ptr("apache-2.0"), SPDXID: github.Ptr("Apache-2.0"), Name: github.Ptr("Apache License 2.0")},
+					}),
+				),
+			),
+			expected: []LicenseSummary{
+				{Key: "mit", SPDXID: "MIT", Name: "MIT License"},
+				{Key: "apache-2.0", SPDXID: "Apache-2.0", Name: "Apache License 2.0"},
+			},
+		},
+		{
+			name: "listing fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetLicenses,
+					mockResponse(t, http.StatusInternalServerError, map[string]string{"message": "Server Error"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to list licenses",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListLicenses(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned []LicenseSummary
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
+
+func Test_GetLicense(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetLicense(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_license", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"license"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       License
+	}{
+		{
+			name: "license fetched",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetLicensesByLicense,
+					expectPath(t, "/licenses/mit").andThen(
+						mockResponse(t, http.StatusOK, &github.License{
+							Key:            github.Ptr("mit"),
+							SPDXID:         github.Ptr("MIT"),
+							Name:           github.Ptr("MIT License"),
+							HTMLURL:        github.Ptr("https://choosealicense.com/licenses/mit/"),
+							Implementation: github.Ptr("Create a text file called LICENSE"),
+							Permissions:    &[]string{"commercial-use", "modifications"},
+							Conditions:     &[]string{"include-copyright"},
+							Body:           github.Ptr("MIT License\n\nCopyright (c) [year] [fullname]"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]any{"license": "mit"},
+			expected: License{
+				Key:            "mit",
+				SPDXID:         "MIT",
+				Name:           "MIT License",
+				HTMLURL:        "https://choosealicense.com/licenses/mit/",
+				Implementation: "Create a text file called LICENSE",
+				Permissions:    []string{"commercial-use", "modifications"},
+				Conditions:     []string{"include-copyright"},
+				Limitations:    []string{},
+				Body:           "MIT License\n\nCopyright (c) [year] [fullname]",
+			},
+		},
+		{
+			name: "unknown license",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetLicensesByLicense,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs:    map[string]any{"license": "wtfpl-3"},
+			expectError:    true,
+			expectedErrMsg: "license wtfpl-3 not found; use list_licenses to find its key",
+		},
+		{
+			name:           "missing license",
+			mockedClient:   mock.NewMockedHTTPClient(),
+			requestArgs:    map[string]any{},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: license",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetLicense(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned License
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// RenderMarkdown creates a tool to render Markdown to HTML the way GitHub renders it.
+func RenderMarkdown(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("render_markdown",
+			mcp.WithDescription(t("TOOL_RENDER_MARKDOWN_DESCRIPTION", "Render Markdown to HTML the way GitHub renders it, to preview an issue, pull request or comment body before posting it. In gfm mode, task lists, mentions and references such as #123 or a commit SHA are rendered as on GitHub; give owner and repo to resolve references against that repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_RENDER_MARKDOWN_USER_TITLE", "Render Markdown"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("text",
+				mcp.Required(),
+				mcp.Description("Markdown to render"),
+			),
+			mcp.WithString("mode",
+				mcp.Description("gfm renders like issue and pull request bodies and comments, markdown renders like README files"),
+				mcp.Enum("gfm", "markdown"),
+				mcp.DefaultString("gfm"),
+			),
+			mcp.WithString("owner",
+				mcp.Description("Owner of the repository to resolve references against. Only used in gfm mode"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Name of the repository to resolve references against. Only used in gfm mode"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			text, err := RequiredParam[string](request, "text")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			mode, err := OptionalParam[string](request, "mode")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if mode == "" {
+				mode = "gfm"
+			}
+			if mode != "gfm" && mode != "markdown" {
+				return mcp.NewToolResultError(fmt.Sprintf("mode must be gfm or markdown, got %q", mode)), nil
+			}
+			owner, err := OptionalParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (owner == "") != (repo == "") {
+				return mcp.NewToolResultError("owner and repo must be given together"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.MarkdownOptions{Mode: mode}
+			if owner != "" && mode == "gfm" {
+				opts.Context = fmt.Sprintf("%s/%s", owner, repo)
+			}
+			html, resp, err := client.Markdown.Render(ctx, text, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to render Markdown", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(html), nil
+		}
+}
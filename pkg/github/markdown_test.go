@@ -0,0 +1,115 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RenderMarkdown(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RenderMarkdown(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "render_markdown", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"text"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	rendered := `<ul class="contains-task-list"><li class="task-list-item"><input type="checkbox" disabled> fix <a href="https://github.com/owner/repo/issues/42">#42</a></li></ul>`
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "gfm with repository context",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostMarkdown,
+					expectRequestBody(t, map[string]any{
+						"text":    "- [ ] fix #42",
+						"mode":    "gfm",
+						"context": "owner/repo",
+					}).andThen(
+						mockResponse(t, http.StatusOK, rendered),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"text":  "- [ ] fix #42",
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedText: rendered,
+		},
+		{
+			name: "markdown mode ignores repository context",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostMarkdown,
+					expectRequestBody(t, map[string]any{
+						"text": "# Title",
+						"mode": "markdown",
+					}).andThen(
+						mockResponse(t, http.StatusOK, "<h1>Title</h1>"),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"text":  "# Title",
+				"mode":  "markdown",
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedText: "<h1>Title</h1>",
+		},
+		{
+			name:         "owner without repo",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"text":  "fix #42",
+				"owner": "owner",
+			},
+			expectError:    true,
+			expectedErrMsg: "owner and repo must be given together",
+		},
+		{
+			name:         "unknown mode",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"text": "fix #42",
+				"mode": "html",
+			},
+			expectError:    true,
+			expectedErrMsg: `mode must be gfm or markdown, got "html"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RenderMarkdown(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
@@ -0,0 +1,619 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// mergeQueueLimit describes the range GitHub accepts for one of the numeric merge queue settings.
+type mergeQueueLimit struct {
+	name     string
+	min, max int
+	value    func(p *github.MergeQueueRuleParameters) *int
+}
+
+var mergeQueueLimits = []mergeQueueLimit{
+	{"max_entries_to_build", 0, 100, func(p *github.MergeQueueRuleParameters) *int { return &p.MaxEntriesToBuild }},
+	{"max_entries_to_merge", 0, 100, func(p *github.MergeQueueRuleParameters) *int { return &p.MaxEntriesToMerge }},
+	{"min_entries_to_merge", 0, 100, func(p *github.MergeQueueRuleParameters) *int { return &p.MinEntriesToMerge }},
+	{"min_entries_to_merge_wait_minutes", 0, 360, func(p *github.MergeQueueRuleParameters) *int { return &p.MinEntriesToMergeWaitMinutes }},
+	{"check_response_timeout_minutes", 1, 360, func(p *github.MergeQueueRuleParameters) *int { return &p.CheckResponseTimeoutMinutes }},
+}
+
+// MergeQueueConfig is the merge queue configuration that applies to a branch, along with the
+// ruleset that defines it.
+type MergeQueueConfig struct {
+	Branch                       string `json:"branch"`
+	RulesetID                    int64  `json:"ruleset_id"`
+	RulesetSource                string `json:"ruleset_source"`
+	RulesetSourceType            string `json:"ruleset_source_type"`
+	MergeMethod                  string `json:"merge_method"`
+	GroupingStrategy             string `json:"grouping_strategy"`
+	MaxEntriesToBuild            int    `json:"max_entries_to_build"`
+	MaxEntriesToMerge            int    `json:"max_entries_to_merge"`
+	MinEntriesToMerge            int    `json:"min_entries_to_merge"`
+	MinEntriesToMergeWaitMinutes int    `json:"min_entries_to_merge_wait_minutes"`
+	CheckResponseTimeoutMinutes  int    `json:"check_response_timeout_minutes"`
+}
+
+func newMergeQueueConfig(branch string, metadata github.BranchRuleMetadata, p github.MergeQueueRuleParameters) MergeQueueConfig {
+	return MergeQueueConfig{
+		Branch:                       branch,
+		RulesetID:                    metadata.RulesetID,
+		RulesetSource:                metadata.RulesetSource,
+		RulesetSourceType:            string(metadata.RulesetSourceType),
+		MergeMethod:                  string(p.MergeMethod),
+		GroupingStrategy:             string(p.GroupingStrategy),
+		MaxEntriesToBuild:            p.MaxEntriesToBuild,
+		MaxEntriesToMerge:            p.MaxEntriesToMerge,
+		MinEntriesToMerge:            p.MinEntriesToMerge,
+		MinEntriesToMergeWaitMinutes: p.MinEntriesToMergeWaitMinutes,
+		CheckResponseTimeoutMinutes:  p.CheckResponseTimeoutMinutes,
+	}
+}
+
+// validateMergeQueueParameters checks the settings against the values GitHub accepts, so a bad
+// update is rejected before the ruleset is touched.
+func validateMergeQueueParameters(p *github.MergeQueueRuleParameters) error {
+	switch p.MergeMethod {
+	case github.MergeQueueMergeMethodMerge, github.MergeQueueMergeMethodSquash, github.MergeQueueMergeMethodRebase:
+	default:
+		return fmt.Errorf("invalid merge_method %q: must be one of MERGE, SQUASH, REBASE", p.MergeMethod)
+	}
+	switch p.GroupingStrategy {
+	case github.MergeGroupingStrategyAllGreen, github.MergeGroupingStrategyHeadGreen:
+	default:
+		return fmt.Errorf("invalid grouping_strategy %q: must be one of ALLGREEN, HEADGREEN", p.GroupingStrategy)
+	}
+	for _, limit := range mergeQueueLimits {
+		if v := *limit.value(p); v < limit.min || v > limit.max {
+			return fmt.Errorf("%s must be between %d and %d, got %d", limit.name, limit.min, limit.max, v)
+		}
+	}
+	if p.MinEntriesToMerge > p.MaxEntriesToMerge {
+		return fmt.Errorf("min_entries_to_merge (%d) must not be greater than max_entries_to_merge (%d)", p.MinEntriesToMerge, p.MaxEntriesToMerge)
+	}
+	return nil
+}
+
+// getBranchMergeQueue returns the merge queue rule that applies to branch, or nil if the branch
+// has no merge queue.
+func getBranchMergeQueue(ctx context.Context, client *github.Client, owner, repo, branch string) (*github.MergeQueueBranchRule, *github.Response, error) {
+	rules, resp, err := client.Repositories.GetRulesForBranch(ctx, owner, repo, branch, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(rules.MergeQueue) == 0 {
+		return nil, resp, nil
+	}
+	return rules.MergeQueue[0], resp, nil
+}
+
+// GetMergeQueue creates a tool to get the merge queue configuration of a branch.
+func GetMergeQueue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_merge_queue",
+			mcp.WithDescription(t("TOOL_GET_MERGE_QUEUE_DESCRIPTION", "Get the merge queue configuration of a branch in a GitHub repository, including the merge method, batch sizes and wait timers, and the ruleset that defines it")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_MERGE_QUEUE_USER_TITLE", "Get merge queue configuration"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch the merge queue targets, usually the default branch"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := RequiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			rule, resp, err := getBranchMergeQueue(ctx, client, owner, repo, branch)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get branch rules", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if rule == nil {
+				return mcp.NewToolResultText(fmt.Sprintf("No merge queue is configured for branch %s in %s/%s", branch, owner, repo)), nil
+			}
+
+			return MarshalledTextResult(newMergeQueueConfig(branch, rule.BranchRuleMetadata, rule.Parameters)), nil
+		}
+}
+
+// UpdateMergeQueue creates a tool to change the merge queue configuration of a branch.
+func UpdateMergeQueue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_merge_queue",
+			mcp.WithDescription(t("TOOL_UPDATE_MERGE_QUEUE_DESCRIPTION", "Update the merge queue configuration of a branch in a GitHub repository. Only the given settings are changed. The merge queue must already be enabled by a repository ruleset; queues inherited from organization rulesets cannot be changed here.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_MERGE_QUEUE_USER_TITLE", "Update merge queue configuration"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch the merge queue targets, usually the default branch"),
+			),
+			mcp.WithString("merge_method",
+				mcp.Description("Method used to merge pull requests from the queue"),
+				mcp.Enum("MERGE", "SQUASH", "REBASE"),
+			),
+			mcp.WithString("grouping_strategy",
+				mcp.Description("ALLGREEN requires every pull request in a group to pass checks, HEADGREEN only the head of the group"),
+				mcp.Enum("ALLGREEN", "HEADGREEN"),
+			),
+			mcp.WithNumber("max_entries_to_build",
+				mcp.Description("Maximum number of queued pull requests to build at once (0-100)"),
+				mcp.Min(0),
+				mcp.Max(100),
+			),
+			mcp.WithNumber("max_entries_to_merge",
+				mcp.Description("Maximum number of pull requests to merge together in one group (0-100)"),
+				mcp.Min(0),
+				mcp.Max(100),
+			),
+			mcp.WithNumber("min_entries_to_merge",
+				mcp.Description("Minimum number of pull requests to merge together in one group (0-100)"),
+				mcp.Min(0),
+				mcp.Max(100),
+			),
+			mcp.WithNumber("min_entries_to_merge_wait_minutes",
+				mcp.Description("Minutes to wait for min_entries_to_merge to be reached before merging a smaller group (0-360)"),
+				mcp.Min(0),
+				mcp.Max(360),
+			),
+			mcp.WithNumber("check_response_timeout_minutes",
+				mcp.Description("Minutes a required status check may take before it is treated as failed (1-360)"),
+				mcp.Min(1),
+				mcp.Max(360),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := RequiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			mergeMethod, err := OptionalParam[string](request, "merge_method")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			groupingStrategy, err := OptionalParam[string](request, "grouping_strategy")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// Zero is a meaningful value for most settings, so track which ones were given.
+			updates := map[string]int{}
+			for _, limit := range mergeQueueLimits {
+				v, ok, err := OptionalParamOK[float64](request, limit.name)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if ok {
+					updates[limit.name] = int(v)
+				}
+			}
+			if mergeMethod == "" && groupingStrategy == "" && len(updates) == 0 {
+				return mcp.NewToolResultError("at least one merge queue setting must be provided"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			rule, resp, err := getBranchMergeQueue(ctx, client, owner, repo, branch)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get branch rules", resp, err), nil
+			}
+			_ = resp.Body.Close()
+			if rule == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("no merge queue is configured for branch %s in %s/%s; enable it in a repository ruleset first", branch, owner, repo)), nil
+			}
+			if rule.RulesetSourceType != github.RulesetSourceTypeRepository {
+				return mcp.NewToolResultError(fmt.Sprintf("the merge queue for branch %s is defined by ruleset %d of %s %s and must be changed there", branch, rule.RulesetID, strings.ToLower(string(rule.RulesetSourceType)), rule.RulesetSource)), nil
+			}
+
+			ruleset, resp, err := client.Repositories.GetRuleset(ctx, owner, repo, rule.RulesetID, false)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get ruleset %d", rule.RulesetID), resp, err), nil
+			}
+			_ = resp.Body.Close()
+			if ruleset.Rules == nil || ruleset.Rules.MergeQueue == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("ruleset %d no longer has a merge queue rule", rule.RulesetID)), nil
+			}
+
+			params := *ruleset.Rules.MergeQueue
+			if mergeMethod != "" {
+				params.MergeMethod = github.MergeQueueMergeMethod(strings.ToUpper(mergeMethod))
+			}
+			if groupingStrategy != "" {
+				params.GroupingStrategy = github.MergeGroupingStrategy(strings.ToUpper(groupingStrategy))
+			}
+			for _, limit := range mergeQueueLimits {
+				if v, ok := updates[limit.name]; ok {
+					*limit.value(&params) = v
+				}
+			}
+			if err := validateMergeQueueParameters(&params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ruleset.Rules.MergeQueue = &params
+
+			updated, resp, err := client.Repositories.UpdateRuleset(ctx, owner, repo, rule.RulesetID, github.RepositoryRuleset{
+				Name:         ruleset.Name,
+				Target:       ruleset.Target,
+				Enforcement:  ruleset.Enforcement,
+				BypassActors: ruleset.BypassActors,
+				Conditions:   ruleset.Conditions,
+				Rules:        ruleset.Rules,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to update ruleset %d", rule.RulesetID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if updated.Rules != nil && updated.Rules.MergeQueue != nil {
+				params = *updated.Rules.MergeQueue
+			}
+			return MarshalledTextResult(newMergeQueueConfig(branch, rule.BranchRuleMetadata, params)), nil
+		}
+}
+
+// maxMergeQueueEntries is how many entries of a merge queue list_merge_queue_entries returns.
+const maxMergeQueueEntries = 100
+
+// MergeQueueEntry is a pull request waiting in a merge queue. Position 1 is merged next.
+type MergeQueueEntry struct {
+	Position                    int       `json:"position"`
+	State                       string    `json:"state"`
+	PullRequest                 int       `json:"pull_request"`
+	Title                       string    `json:"title"`
+	URL                         string    `json:"url"`
+	EnqueuedBy                  string    `json:"enqueued_by"`
+	EnqueuedAt                  time.Time `json:"enqueued_at"`
+	EstimatedTimeToMergeSeconds *int      `json:"estimated_time_to_merge_seconds,omitempty"`
+	Jump                        bool      `json:"jump"`
+	Solo                        bool      `json:"solo"`
+}
+
+// MergeQueueEntries are the pull requests in the merge queue of a branch, in merge order.
+type MergeQueueEntries struct {
+	Branch     string            `json:"branch"`
+	URL        string            `json:"url"`
+	TotalCount int               `json:"total_count"`
+	Entries    []MergeQueueEntry `json:"entries"`
+	Note       string            `json:"note,omitempty"`
+}
+
+// pullRequestNodeID looks up the GraphQL ID of a pull request.
+func pullRequestNodeID(ctx context.Context, client *githubv4.Client, owner, repo string, number int) (githubv4.ID, error) {
+	var q struct {
+		Repository struct {
+			PullRequest struct {
+				ID githubv4.ID
+			} `graphql:"pullRequest(number: $prNum)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+	if err := client.Query(ctx, &q, map[string]any{
+		"owner": githubv4.String(owner),
+		"repo":  githubv4.String(repo),
+		"prNum": githubv4.Int(number), // #nosec G115 - pull request numbers are positive integers well below the int32 limit
+	}); err != nil {
+		return nil, err
+	}
+	return q.Repository.PullRequest.ID, nil
+}
+
+// ListMergeQueueEntries creates a tool to list the pull requests in the merge queue of a branch.
+func ListMergeQueueEntries(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_merge_queue_entries",
+			mcp.WithDescription(t("TOOL_LIST_MERGE_QUEUE_ENTRIES_DESCRIPTION", "List the pull requests waiting in the merge queue of a branch, in the order they will be merged, with their state and estimated time to merge.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_MERGE_QUEUE_ENTRIES_USER_TITLE", "List merge queue entries"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Description("Branch the queue merges into. Defaults to the default branch"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := OptionalParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			var q struct {
+				Repository struct {
+					DefaultBranchRef struct {
+						Name githubv4.String
+					}
+					MergeQueue *struct {
+						URL     githubv4.String `graphql:"url"`
+						Entries struct {
+							TotalCount githubv4.Int
+							Nodes      []struct {
+								Position             githubv4.Int
+								State                githubv4.MergeQueueEntryState
+								EnqueuedAt           githubv4.DateTime
+								EstimatedTimeToMerge *githubv4.Int
+								Jump                 githubv4.Boolean
+								Solo                 githubv4.Boolean
+								Enqueuer             struct {
+									Login githubv4.String
+								}
+								PullRequest struct {
+									Number githubv4.Int
+									Title  githubv4.String
+									URL    githubv4.String `graphql:"url"`
+								}
+							}
+						} `graphql:"entries(first: $first)"`
+					} `graphql:"mergeQueue(branch: $branch)"`
+				} `graphql:"repository(owner: $owner, name: $repo)"`
+			}
+			var branchVar *githubv4.String
+			if branch != "" {
+				branchVar = githubv4.NewString(githubv4.String(branch))
+			}
+			if err := client.Query(ctx, &q, map[string]any{
+				"owner":  githubv4.String(owner),
+				"repo":   githubv4.String(repo),
+				"branch": branchVar,
+				"first":  githubv4.Int(maxMergeQueueEntries),
+			}); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get merge queue", err), nil
+			}
+
+			if branch == "" {
+				branch = string(q.Repository.DefaultBranchRef.Name)
+			}
+			if q.Repository.MergeQueue == nil {
+				return mcp.NewToolResultText(fmt.Sprintf("No merge queue is configured for branch %s in %s/%s", branch, owner, repo)), nil
+			}
+
+			queue := q.Repository.MergeQueue
+			result := MergeQueueEntries{
+				Branch:     branch,
+				URL:        string(queue.URL),
+				TotalCount: int(queue.Entries.TotalCount),
+				Entries:    make([]MergeQueueEntry, 0, len(queue.Entries.Nodes)),
+			}
+			for _, n := range queue.Entries.Nodes {
+				entry := MergeQueueEntry{
+					Position:    int(n.Position),
+					State:       string(n.State),
+					PullRequest: int(n.PullRequest.Number),
+					Title:       string(n.PullRequest.Title),
+					URL:         string(n.PullRequest.URL),
+					EnqueuedBy:  string(n.Enqueuer.Login),
+					EnqueuedAt:  n.EnqueuedAt.Time,
+					Jump:        bool(n.Jump),
+					Solo:        bool(n.Solo),
+				}
+				if n.EstimatedTimeToMerge != nil {
+					seconds := int(*n.EstimatedTimeToMerge)
+					entry.EstimatedTimeToMergeSeconds = &seconds
+				}
+				result.Entries = append(result.Entries, entry)
+			}
+			if result.TotalCount > len(result.Entries) {
+				result.Note = fmt.Sprintf("only the first %d of %d entries are listed", len(result.Entries), result.TotalCount)
+			}
+			info := ListPageInfo{TotalCount: &result.TotalCount}
+			return MarshalledListPageResult(ctx, result, result.Entries, info, fmt.Sprintf("the merge queue of %s is empty", branch)), nil
+		}
+}
+
+// EnqueuePullRequest creates a tool to add a pull request to the merge queue of its base branch.
+func EnqueuePullRequest(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("enqueue_pull_request",
+			mcp.WithDescription(t("TOOL_ENQUEUE_PULL_REQUEST_DESCRIPTION", "Add a pull request to the merge queue of its base branch, which merges it once it passes the required checks together with the pull requests ahead of it. Use this instead of merge_pull_request when the branch has a merge queue.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ENQUEUE_PULL_REQUEST_USER_TITLE", "Add pull request to merge queue"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithBoolean("jump",
+				mcp.Description("Add the pull request to the front of the queue. Requires admin access"),
+			),
+			mcp.WithString("expected_head_sha",
+				mcp.Description("Only enqueue the pull request if its head is this commit, so that changes pushed since it was reviewed are not merged"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			jump, err := OptionalParam[bool](request, "jump")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			expectedHeadSHA, err := OptionalParam[string](request, "expected_head_sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			prID, err := pullRequestNodeID(ctx, client, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get pull request", err), nil
+			}
+
+			input := githubv4.EnqueuePullRequestInput{PullRequestID: prID}
+			if jump {
+				input.Jump = githubv4.NewBoolean(true)
+			}
+			if expectedHeadSHA != "" {
+				input.ExpectedHeadOid = githubv4.NewGitObjectID(githubv4.GitObjectID(expectedHeadSHA))
+			}
+			var mutation struct {
+				EnqueuePullRequest struct {
+					MergeQueueEntry struct {
+						Position githubv4.Int
+						State    githubv4.MergeQueueEntryState
+					}
+				} `graphql:"enqueuePullRequest(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to add pull request to merge queue", err), nil
+			}
+
+			entry := mutation.EnqueuePullRequest.MergeQueueEntry
+			return MarshalledTextResult(map[string]any{
+				"pull_request": pullNumber,
+				"position":     int(entry.Position),
+				"state":        string(entry.State),
+			}), nil
+		}
+}
+
+// DequeuePullRequest creates a tool to remove a pull request from a merge queue.
+func DequeuePullRequest(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("dequeue_pull_request",
+			mcp.WithDescription(t("TOOL_DEQUEUE_PULL_REQUEST_DESCRIPTION", "Remove a pull request from the merge queue it is in. Pull requests behind it may have to be tested again.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DEQUEUE_PULL_REQUEST_USER_TITLE", "Remove pull request from merge queue"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			prID, err := pullRequestNodeID(ctx, client, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get pull request", err), nil
+			}
+
+			var mutation struct {
+				DequeuePullRequest struct {
+					MergeQueueEntry struct {
+						State githubv4.MergeQueueEntryState
+					}
+				} `graphql:"dequeuePullRequest(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, githubv4.DequeuePullRequestInput{ID: prID}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to remove pull request from merge queue", err), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Removed pull request #%d from the merge queue of %s/%s", pullNumber, owner, repo)), nil
+		}
+}
@@ -0,0 +1,780 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mockMergeQueueParameters = github.MergeQueueRuleParameters{
+	CheckResponseTimeoutMinutes:  60,
+	GroupingStrategy:             github.MergeGroupingStrategyAllGreen,
+	MaxEntriesToBuild:            5,
+	MaxEntriesToMerge:            5,
+	MergeMethod:                  github.MergeQueueMergeMethodSquash,
+	MinEntriesToMerge:            1,
+	MinEntriesToMergeWaitMinutes: 5,
+}
+
+// mockBranchRules is the response of the get rules for a branch endpoint for a branch whose merge
+// queue is defined by the given ruleset.
+func mockBranchRules(sourceType string, rulesetID int64) []map[string]any {
+	return []map[string]any{
+		{
+			"type":                "deletion",
+			"ruleset_source_type": sourceType,
+			"ruleset_source":      "owner/repo",
+			"ruleset_id":          rulesetID,
+		},
+		{
+			"type":                "merge_queue",
+			"ruleset_source_type": sourceType,
+			"ruleset_source":      "owner/repo",
+			"ruleset_id":          rulesetID,
+			"parameters":          mockMergeQueueParameters,
+		},
+	}
+}
+
+func Test_GetMergeQueue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetMergeQueue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_merge_queue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+		expectedConfig *MergeQueueConfig
+	}{
+		{
+			name: "branch with a merge queue",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+					expectPath(t, "/repos/owner/repo/rules/branches/main").andThen(
+						mockResponse(t, http.StatusOK, mockBranchRules("Repository", 42)),
+					),
+				),
+			),
+			expectedConfig: &MergeQueueConfig{
+				Branch:                       "main",
+				RulesetID:                    42,
+				RulesetSource:                "owner/repo",
+				RulesetSourceType:            "Repository",
+				MergeMethod:                  "SQUASH",
+				GroupingStrategy:             "ALLGREEN",
+				MaxEntriesToBuild:            5,
+				MaxEntriesToMerge:            5,
+				MinEntriesToMerge:            1,
+				MinEntriesToMergeWaitMinutes: 5,
+				CheckResponseTimeoutMinutes:  60,
+			},
+		},
+		{
+			name: "branch without a merge queue",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+					[]map[string]any{},
+				),
+			),
+			expectedText: "No merge queue is configured for branch main in owner/repo",
+		},
+		{
+			name: "get rules fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get branch rules",
+		},
+		{
+			name: "error includes the GitHub request ID",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.Header().Set("X-GitHub-Request-Id", "CAFE:1234:5678AB:9ABCDE:66F0A1B2")
+						mockResponse(t, http.StatusInternalServerError, map[string]string{"message": "Server Error"})(w, r)
+					}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "(GitHub request ID: CAFE:1234:5678AB:9ABCDE:66F0A1B2)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetMergeQueue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			if tc.expectedText != "" {
+				assert.Equal(t, tc.expectedText, textContent.Text)
+				return
+			}
+
+			var config MergeQueueConfig
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &config))
+			assert.Equal(t, *tc.expectedConfig, config)
+		})
+	}
+}
+
+func Test_UpdateMergeQueue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateMergeQueue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_merge_queue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.Properties, "merge_method")
+	assert.Contains(t, tool.InputSchema.Properties, "max_entries_to_merge")
+	assert.Contains(t, tool.InputSchema.Properties, "check_response_timeout_minutes")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	mockRuleset := &github.RepositoryRuleset{
+		ID:          github.Ptr(int64(42)),
+		Name:        "main queue",
+		Target:      github.Ptr(github.RulesetTargetBranch),
+		Enforcement: github.RulesetEnforcementActive,
+		Rules: &github.RepositoryRulesetRules{
+			Deletion:   &github.EmptyRuleParameters{},
+			MergeQueue: &mockMergeQueueParameters,
+		},
+	}
+
+	t.Run("bounded update changes only the given settings", func(t *testing.T) {
+		var sent github.RepositoryRuleset
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(
+				mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+				mockBranchRules("Repository", 42),
+			),
+			mock.WithRequestMatchHandler(
+				mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+				expectPath(t, "/repos/owner/repo/rulesets/42").andThen(
+					mockResponse(t, http.StatusOK, mockRuleset),
+				),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PutReposRulesetsByOwnerByRepoByRulesetId,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&sent))
+					mockResponse(t, http.StatusOK, sent)(w, r)
+				}),
+			),
+		)
+
+		client := github.NewClient(mockedClient)
+		_, handler := UpdateMergeQueue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":                "owner",
+			"repo":                 "repo",
+			"branch":               "main",
+			"merge_method":         "merge",
+			"max_entries_to_merge": float64(10),
+			"min_entries_to_merge": float64(0),
+		}))
+		require.NoError(t, err)
+		textContent := getTextResult(t, result)
+
+		// The rest of the ruleset is sent back unchanged.
+		assert.Equal(t, "main queue", sent.Name)
+		require.NotNil(t, sent.Rules)
+		assert.NotNil(t, sent.Rules.Deletion)
+		require.NotNil(t, sent.Rules.MergeQueue)
+		assert.Equal(t, github.MergeQueueRuleParameters{
+			CheckResponseTimeoutMinutes:  60,
+			GroupingStrategy:             github.MergeGroupingStrategyAllGreen,
+			MaxEntriesToBuild:            5,
+			MaxEntriesToMerge:            10,
+			MergeMethod:                  github.MergeQueueMergeMethodMerge,
+			MinEntriesToMerge:            0,
+			MinEntriesToMergeWaitMinutes: 5,
+		}, *sent.Rules.MergeQueue)
+
+		var config MergeQueueConfig
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &config))
+		assert.Equal(t, "MERGE", config.MergeMethod)
+		assert.Equal(t, 10, config.MaxEntriesToMerge)
+		assert.Equal(t, 0, config.MinEntriesToMerge)
+		assert.Equal(t, int64(42), config.RulesetID)
+	})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedErrMsg string
+	}{
+		{
+			name:         "no settings given",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			},
+			expectedErrMsg: "at least one merge queue setting must be provided",
+		},
+		{
+			name: "invalid merge method",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+					mockBranchRules("Repository", 42),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+					mockRuleset,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"branch":       "main",
+				"merge_method": "fast-forward",
+			},
+			expectedErrMsg: `invalid merge_method "FAST-FORWARD"`,
+		},
+		{
+			name: "value out of bounds",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+					mockBranchRules("Repository", 42),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+					mockRuleset,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":                          "owner",
+				"repo":                           "repo",
+				"branch":                         "main",
+				"check_response_timeout_minutes": float64(0),
+			},
+			expectedErrMsg: "check_response_timeout_minutes must be between 1 and 360, got 0",
+		},
+		{
+			name: "minimum group size above maximum",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+					mockBranchRules("Repository", 42),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+					mockRuleset,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":                "owner",
+				"repo":                 "repo",
+				"branch":               "main",
+				"min_entries_to_merge": float64(8),
+			},
+			expectedErrMsg: "min_entries_to_merge (8) must not be greater than max_entries_to_merge (5)",
+		},
+		{
+			name: "queue inherited from an organization ruleset",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+					mockBranchRules("Organization", 7),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"branch":       "main",
+				"merge_method": "MERGE",
+			},
+			expectedErrMsg: "defined by ruleset 7 of organization",
+		},
+		{
+			name: "branch without a merge queue",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+					[]map[string]any{},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"branch":       "main",
+				"merge_method": "MERGE",
+			},
+			expectedErrMsg: "no merge queue is configured for branch main",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateMergeQueue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			errorContent := getErrorResult(t, result)
+			assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+		})
+	}
+}
+
+// pullRequestIDMatcher answers the lookup of the node ID of pull request 42 in owner/repo.
+func pullRequestIDMatcher() githubv4mock.Matcher {
+	return githubv4mock.NewQueryMatcher(
+		struct {
+			Repository struct {
+				PullRequest struct {
+					ID githubv4.ID
+				} `graphql:"pullRequest(number: $prNum)"`
+			} `graphql:"repository(owner: $owner, name: $repo)"`
+		}{},
+		map[string]any{
+			"owner": githubv4.String("owner"),
+			"repo":  githubv4.String("repo"),
+			"prNum": githubv4.Int(42),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{"pullRequest": map[string]any{"id": "PR_42"}},
+		}),
+	)
+}
+
+func Test_ListMergeQueueEntries(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := ListMergeQueueEntries(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_merge_queue_entries", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mergeQueueQuery := struct {
+		Repository struct {
+			DefaultBranchRef struct {
+				Name githubv4.String
+			}
+			MergeQueue *struct {
+				URL     githubv4.String `graphql:"url"`
+				Entries struct {
+					TotalCount githubv4.Int
+					Nodes      []struct {
+						Position             githubv4.Int
+						State                githubv4.MergeQueueEntryState
+						EnqueuedAt           githubv4.DateTime
+						EstimatedTimeToMerge *githubv4.Int
+						Jump                 githubv4.Boolean
+						Solo                 githubv4.Boolean
+						Enqueuer             struct {
+							Login githubv4.String
+						}
+						PullRequest struct {
+							Number githubv4.Int
+							Title  githubv4.String
+							URL    githubv4.String `graphql:"url"`
+						}
+					}
+				} `graphql:"entries(first: $first)"`
+			} `graphql:"mergeQueue(branch: $branch)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}{}
+	enqueuedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	estimate := 300
+	release := githubv4.String("release")
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expected       MergeQueueEntries
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "entries of the default branch queue",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					mergeQueueQuery,
+					map[string]any{
+						"owner":  githubv4.String("owner"),
+						"repo":   githubv4.String("repo"),
+						"branch": (*githubv4.String)(nil),
+						"first":  githubv4.Int(100),
+					},
+					githubv4mock.DataResponse(map[string]any{
+						"repository": map[string]any{
+							"defaultBranchRef": map[string]any{"name": "main"},
+							"mergeQueue": map[string]any{
+								"url": "https://github.com/owner/repo/queue/main",
+								"entries": map[string]any{
+									"totalCount": 2,
+									"nodes": []map[string]any{
+										{
+											"position":             1,
+											"state":                "AWAITING_CHECKS",
+											"enqueuedAt":           enqueuedAt,
+											"estimatedTimeToMerge": estimate,
+											"jump":                 false,
+											"solo":                 false,
+											"enqueuer":             map[string]any{"login": "octocat"},
+											"pullRequest":          map[string]any{"number": 42, "title": "Fix bug", "url": "https://github.com/owner/repo/pull/42"},
+										},
+										{
+											"position":    2,
+											"state":       "QUEUED",
+											"enqueuedAt":  enqueuedAt,
+											"jump":        true,
+											"solo":        true,
+											"enqueuer":    map[string]any{"login": "hubot"},
+											"pullRequest": map[string]any{"number": 43, "title": "Add feature", "url": "https://github.com/owner/repo/pull/43"},
+										},
+									},
+								},
+							},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expected: MergeQueueEntries{
+				Branch:     "main",
+				URL:        "https://github.com/owner/repo/queue/main",
+				TotalCount: 2,
+				Entries: []MergeQueueEntry{
+					{Position: 1, State: "AWAITING_CHECKS", PullRequest: 42, Title: "Fix bug", URL: "https://github.com/owner/repo/pull/42", EnqueuedBy: "octocat", EnqueuedAt: enqueuedAt, EstimatedTimeToMergeSeconds: &estimate},
+					{Position: 2, State: "QUEUED", PullRequest: 43, Title: "Add feature", URL: "https://github.com/owner/repo/pull/43", EnqueuedBy: "hubot", EnqueuedAt: enqueuedAt, Jump: true, Solo: true},
+				},
+			},
+		},
+		{
+			name: "branch without merge queue",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					mergeQueueQuery,
+					map[string]any{
+						"owner":  githubv4.String("owner"),
+						"repo":   githubv4.String("repo"),
+						"branch": &release,
+						"first":  githubv4.Int(100),
+					},
+					githubv4mock.DataResponse(map[string]any{
+						"repository": map[string]any{
+							"defaultBranchRef": map[string]any{"name": "main"},
+							"mergeQueue":       nil,
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "release",
+			},
+			expectedText: "No merge queue is configured for branch release in owner/repo",
+		},
+		{
+			name: "query fails",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					mergeQueueQuery,
+					map[string]any{
+						"owner":  githubv4.String("owner"),
+						"repo":   githubv4.String("repo"),
+						"branch": (*githubv4.String)(nil),
+						"first":  githubv4.Int(100),
+					},
+					githubv4mock.ErrorResponse("Could not resolve to a Repository with the name 'owner/repo'."),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get merge queue",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := ListMergeQueueEntries(stubGetGQLClientFn(githubv4.NewClient(tc.mockedClient)), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			if tc.expectedText != "" {
+				assert.Equal(t, tc.expectedText, textContent.Text)
+				return
+			}
+
+			var returned MergeQueueEntries
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
+
+func Test_EnqueuePullRequest(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := EnqueuePullRequest(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "enqueue_pull_request", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "jump")
+	assert.Contains(t, tool.InputSchema.Properties, "expected_head_sha")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	enqueueMutation := struct {
+		EnqueuePullRequest struct {
+			MergeQueueEntry struct {
+				Position githubv4.Int
+				State    githubv4.MergeQueueEntryState
+			}
+		} `graphql:"enqueuePullRequest(input: $input)"`
+	}{}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expected       map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "enqueue at the back of the queue",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				pullRequestIDMatcher(),
+				githubv4mock.NewMutationMatcher(
+					enqueueMutation,
+					githubv4.EnqueuePullRequestInput{PullRequestID: githubv4.ID("PR_42")},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"enqueuePullRequest": map[string]any{
+							"mergeQueueEntry": map[string]any{"position": 3, "state": "QUEUED"},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expected: map[string]any{"pull_request": float64(42), "position": float64(3), "state": "QUEUED"},
+		},
+		{
+			name: "jump the queue at the expected head",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				pullRequestIDMatcher(),
+				githubv4mock.NewMutationMatcher(
+					enqueueMutation,
+					githubv4.EnqueuePullRequestInput{
+						PullRequestID:   githubv4.ID("PR_42"),
+						Jump:            githubv4.NewBoolean(true),
+						ExpectedHeadOid: githubv4.NewGitObjectID("abc123"),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"enqueuePullRequest": map[string]any{
+							"mergeQueueEntry": map[string]any{"position": 1, "state": "AWAITING_CHECKS"},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":             "owner",
+				"repo":              "repo",
+				"pullNumber":        float64(42),
+				"jump":              true,
+				"expected_head_sha": "abc123",
+			},
+			expected: map[string]any{"pull_request": float64(42), "position": float64(1), "state": "AWAITING_CHECKS"},
+		},
+		{
+			name: "mutation fails",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				pullRequestIDMatcher(),
+				githubv4mock.NewMutationMatcher(
+					enqueueMutation,
+					githubv4.EnqueuePullRequestInput{PullRequestID: githubv4.ID("PR_42")},
+					nil,
+					githubv4mock.ErrorResponse("Pull request is not mergeable"),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to add pull request to merge queue",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := EnqueuePullRequest(stubGetGQLClientFn(githubv4.NewClient(tc.mockedClient)), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned map[string]any
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
+
+func Test_DequeuePullRequest(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := DequeuePullRequest(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "dequeue_pull_request", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	dequeueMutation := struct {
+		DequeuePullRequest struct {
+			MergeQueueEntry struct {
+				State githubv4.MergeQueueEntryState
+			}
+		} `graphql:"dequeuePullRequest(input: $input)"`
+	}{}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "pull request removed from queue",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				pullRequestIDMatcher(),
+				githubv4mock.NewMutationMatcher(
+					dequeueMutation,
+					githubv4.DequeuePullRequestInput{ID: githubv4.ID("PR_42")},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"dequeuePullRequest": map[string]any{
+							"mergeQueueEntry": map[string]any{"state": "QUEUED"},
+						},
+					}),
+				),
+			),
+			expectedText: "Removed pull request #42 from the merge queue of owner/repo",
+		},
+		{
+			name: "pull request not in queue",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				pullRequestIDMatcher(),
+				githubv4mock.NewMutationMatcher(
+					dequeueMutation,
+					githubv4.DequeuePullRequestInput{ID: githubv4.ID("PR_42")},
+					nil,
+					githubv4mock.ErrorResponse("Pull request is not in a merge queue"),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to remove pull request from merge queue",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := DequeuePullRequest(stubGetGQLClientFn(githubv4.NewClient(tc.mockedClient)), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
@@ -0,0 +1,360 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Milestone is a repository milestone as returned by the milestone tools.
+type Milestone struct {
+	Number       int    `json:"number"`
+	Title        string `json:"title"`
+	Description  string `json:"description,omitempty"`
+	State        string `json:"state"`
+	DueOn        string `json:"due_on,omitempty"`
+	OpenIssues   int    `json:"open_issues"`
+	ClosedIssues int    `json:"closed_issues"`
+	URL          string `json:"url"`
+}
+
+func newMilestone(m *github.Milestone) Milestone {
+	milestone := Milestone{
+		Number:       m.GetNumber(),
+		Title:        m.GetTitle(),
+		Description:  m.GetDescription(),
+		State:        m.GetState(),
+		OpenIssues:   m.GetOpenIssues(),
+		ClosedIssues: m.GetClosedIssues(),
+		URL:          m.GetHTMLURL(),
+	}
+	if m.DueOn != nil {
+		milestone.DueOn = m.DueOn.Format("2006-01-02")
+	}
+	return milestone
+}
+
+// ListMilestones creates a tool to list the milestones of a repository.
+func ListMilestones(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_milestones",
+			mcp.WithDescription(t("TOOL_LIST_MILESTONES_DESCRIPTION", "List the milestones of a GitHub repository with their due dates and progress")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_MILESTONES_USER_TITLE", "List milestones"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("state",
+				mcp.Description("Filter by state, defaults to open"),
+				mcp.Enum("open", "closed", "all"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort by due date or by completeness, defaults to due_on"),
+				mcp.Enum("due_on", "completeness"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("Sort direction, defaults to asc"),
+				mcp.Enum("asc", "desc"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := OptionalParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sort, err := OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			direction, err := OptionalParam[string](request, "direction")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			milestones, resp, err := client.Issues.ListMilestones(ctx, owner, repo, &github.MilestoneListOptions{
+				State:     state,
+				Sort:      sort,
+				Direction: direction,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list milestones", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]Milestone, 0, len(milestones))
+			for _, m := range milestones {
+				result = append(result, newMilestone(m))
+			}
+			return MarshalledListResult(ctx, result, "no milestones found"), nil
+		}
+}
+
+// CreateMilestone creates a tool to add a milestone to a repository.
+func CreateMilestone(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_milestone",
+			mcp.WithDescription(t("TOOL_CREATE_MILESTONE_DESCRIPTION", "Create a milestone in a GitHub repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_MILESTONE_USER_TITLE", "Create milestone"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Milestone title"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Milestone description"),
+			),
+			mcp.WithString("due_on",
+				mcp.Description("Due date (ISO 8601 date, e.g. 2025-06-30)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := RequiredParam[string](request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			description, err := OptionalParam[string](request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dueOn, err := OptionalParam[string](request, "due_on")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			milestone := &github.Milestone{Title: github.Ptr(title)}
+			if description != "" {
+				milestone.Description = github.Ptr(description)
+			}
+			if dueOn != "" {
+				due, err := parseISOTimestamp(dueOn)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				milestone.DueOn = &github.Timestamp{Time: due}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			created, resp, err := client.Issues.CreateMilestone(ctx, owner, repo, milestone)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to create milestone %s", title), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newMilestone(created)), nil
+		}
+}
+
+// UpdateMilestone creates a tool to change the title, description, due date or state of a milestone.
+func UpdateMilestone(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_milestone",
+			mcp.WithDescription(t("TOOL_UPDATE_MILESTONE_DESCRIPTION", "Update a milestone in a GitHub repository. Only the given fields are changed.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_MILESTONE_USER_TITLE", "Update milestone"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("milestone",
+				mcp.Required(),
+				mcp.Description("Milestone number or exact title"),
+			),
+			mcp.WithString("title",
+				mcp.Description("New title"),
+			),
+			mcp.WithString("description",
+				mcp.Description("New description; an empty string removes it"),
+			),
+			mcp.WithString("due_on",
+				mcp.Description("New due date (ISO 8601 date, e.g. 2025-06-30)"),
+			),
+			mcp.WithString("state",
+				mcp.Description("New state"),
+				mcp.Enum("open", "closed"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			milestoneRef, err := RequiredParam[string](request, "milestone")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			update := &github.Milestone{}
+			hasUpdate := false
+			if v, ok, err := OptionalParamOK[string](request, "title"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok && v != "" {
+				update.Title = github.Ptr(v)
+				hasUpdate = true
+			}
+			if v, ok, err := OptionalParamOK[string](request, "description"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				update.Description = github.Ptr(v)
+				hasUpdate = true
+			}
+			if v, ok, err := OptionalParamOK[string](request, "due_on"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok && v != "" {
+				due, err := parseISOTimestamp(v)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				update.DueOn = &github.Timestamp{Time: due}
+				hasUpdate = true
+			}
+			if v, ok, err := OptionalParamOK[string](request, "state"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok && v != "" {
+				if v != "open" && v != "closed" {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid state %q: must be open or closed", v)), nil
+				}
+				update.State = github.Ptr(v)
+				hasUpdate = true
+			}
+			if !hasUpdate {
+				return mcp.NewToolResultError("No update parameters provided."), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			m, err := resolveMilestone(ctx, client, owner, repo, milestoneRef)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			updated, resp, err := client.Issues.EditMilestone(ctx, owner, repo, m.GetNumber(), update)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to update milestone #%d", m.GetNumber()), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newMilestone(updated)), nil
+		}
+}
+
+// CloseMilestone creates a tool to close a milestone.
+func CloseMilestone(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("close_milestone",
+			mcp.WithDescription(t("TOOL_CLOSE_MILESTONE_DESCRIPTION", "Close a milestone in a GitHub repository, reporting how many of its issues and pull requests are still open")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:          t("TOOL_CLOSE_MILESTONE_USER_TITLE", "Close milestone"),
+				ReadOnlyHint:   ToBoolPtr(false),
+				IdempotentHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("milestone",
+				mcp.Required(),
+				mcp.Description("Milestone number or exact title"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			milestoneRef, err := RequiredParam[string](request, "milestone")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			m, err := resolveMilestone(ctx, client, owner, repo, milestoneRef)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			closed, resp, err := client.Issues.EditMilestone(ctx, owner, repo, m.GetNumber(), &github.Milestone{State: github.Ptr("closed")})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to close milestone #%d", m.GetNumber()), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newMilestone(closed)), nil
+		}
+}
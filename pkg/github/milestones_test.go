@@ -0,0 +1,280 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mockMilestone = &github.Milestone{
+	Number:       github.Ptr(3),
+	Title:        github.Ptr("v1.2"),
+	Description:  github.Ptr("Spring release"),
+	State:        github.Ptr("open"),
+	DueOn:        &github.Timestamp{Time: time.Date(2025, 6, 30, 7, 0, 0, 0, time.UTC)},
+	OpenIssues:   github.Ptr(4),
+	ClosedIssues: github.Ptr(12),
+	HTMLURL:      github.Ptr("https://github.com/owner/repo/milestone/3"),
+}
+
+func Test_ListMilestones(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListMilestones(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_milestones", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposMilestonesByOwnerByRepo,
+			expectQueryParams(t, map[string]string{
+				"state":     "all",
+				"sort":      "completeness",
+				"direction": "desc",
+				"page":      "1",
+				"per_page":  "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, []*github.Milestone{mockMilestone}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := ListMilestones(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":     "owner",
+		"repo":      "repo",
+		"state":     "all",
+		"sort":      "completeness",
+		"direction": "desc",
+	}))
+	require.NoError(t, err)
+
+	var returned []Milestone
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, []Milestone{{
+		Number:       3,
+		Title:        "v1.2",
+		Description:  "Spring release",
+		State:        "open",
+		DueOn:        "2025-06-30",
+		OpenIssues:   4,
+		ClosedIssues: 12,
+		URL:          "https://github.com/owner/repo/milestone/3",
+	}}, returned)
+}
+
+func Test_CreateMilestone(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateMilestone(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_milestone", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "title"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "create with due date",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposMilestonesByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"title":       "v1.2",
+						"description": "Spring release",
+						"due_on":      "2025-06-30T00:00:00Z",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockMilestone),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"title":       "v1.2",
+				"description": "Spring release",
+				"due_on":      "2025-06-30",
+			},
+		},
+		{
+			name:         "invalid due date",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"title":  "v1.2",
+				"due_on": "end of june",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid ISO 8601 timestamp",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateMilestone(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			args := map[string]any{"owner": "owner", "repo": "repo"}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned Milestone
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, 3, returned.Number)
+		})
+	}
+}
+
+func Test_UpdateMilestone(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateMilestone(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_milestone", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "milestone"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "move the due date of a milestone given by title",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposMilestonesByOwnerByRepo,
+					[]*github.Milestone{mockMilestone},
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposMilestonesByOwnerByRepoByMilestoneNumber,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/milestones/3",
+						requestBody: map[string]any{"due_on": "2025-07-15T00:00:00Z"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockMilestone),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"milestone": "v1.2",
+				"due_on":    "2025-07-15",
+			},
+		},
+		{
+			name:         "nothing to update",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"milestone": "3",
+			},
+			expectError:    true,
+			expectedErrMsg: "No update parameters provided.",
+		},
+		{
+			name: "unknown milestone",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposMilestonesByOwnerByRepoByMilestoneNumber,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]any{
+				"milestone": "9",
+				"title":     "v9",
+			},
+			expectError:    true,
+			expectedErrMsg: "milestone #9 does not exist in owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateMilestone(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			args := map[string]any{"owner": "owner", "repo": "repo"}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned Milestone
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, "v1.2", returned.Title)
+		})
+	}
+}
+
+func Test_CloseMilestone(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CloseMilestone(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "close_milestone", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "milestone"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	closed := *mockMilestone
+	closed.State = github.Ptr("closed")
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposMilestonesByOwnerByRepoByMilestoneNumber,
+			mockMilestone,
+		),
+		mock.WithRequestMatchHandler(
+			mock.PatchReposMilestonesByOwnerByRepoByMilestoneNumber,
+			expectRequestBody(t, map[string]any{"state": "closed"}).andThen(
+				mockResponse(t, http.StatusOK, &closed),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := CloseMilestone(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":     "owner",
+		"repo":      "repo",
+		"milestone": "3",
+	}))
+	require.NoError(t, err)
+
+	var returned Milestone
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, "closed", returned.State)
+	assert.Equal(t, 4, returned.OpenIssues)
+}
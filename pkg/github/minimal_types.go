@@ -45,6 +45,52 @@ type MinimalSearchRepositoriesResult struct {
 	Items             []MinimalRepository `json:"items"`
 }
 
+// MinimalFork is the trimmed output type for repository forks.
+type MinimalFork struct {
+	FullName string `json:"full_name"`
+	Owner    string `json:"owner"`
+	HTMLURL  string `json:"html_url"`
+	Stars    int    `json:"stargazers_count"`
+	PushedAt string `json:"pushed_at,omitempty"`
+}
+
+// ForkNetworkRepository identifies a repository within a fork network.
+type ForkNetworkRepository struct {
+	FullName string `json:"full_name"`
+	HTMLURL  string `json:"html_url"`
+	Stars    int    `json:"stargazers_count"`
+	Forks    int    `json:"forks_count"`
+}
+
+// ForkNetwork summarizes where a repository sits in its fork network.
+type ForkNetwork struct {
+	Repository   ForkNetworkRepository  `json:"repository"`
+	IsFork       bool                   `json:"is_fork"`
+	Parent       *ForkNetworkRepository `json:"parent,omitempty"`
+	Source       *ForkNetworkRepository `json:"source,omitempty"`
+	NetworkCount int                    `json:"network_count"`
+}
+
+// SyncForkResult is the outcome of syncing a branch of a fork with its upstream repository.
+type SyncForkResult struct {
+	Branch    string `json:"branch"`
+	MergeType string `json:"merge_type"`
+	Message   string `json:"message"`
+}
+
+// RefComparison is the difference between two refs of a repository: how far head is ahead of and
+// behind base, the commits on head that are not on base, and the files they change.
+type RefComparison struct {
+	Status          string              `json:"status"`
+	AheadBy         int                 `json:"ahead_by"`
+	BehindBy        int                 `json:"behind_by"`
+	TotalCommits    int                 `json:"total_commits"`
+	MergeBaseCommit string              `json:"merge_base_commit,omitempty"`
+	HTMLURL         string              `json:"html_url,omitempty"`
+	Commits         []MinimalCommit     `json:"commits"`
+	Files           []MinimalCommitFile `json:"files"`
+}
+
 // MinimalCommitAuthor represents commit author information.
 type MinimalCommitAuthor struct {
 	Name  string `json:"name,omitempty"`
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strconv"
 	"time"
 
@@ -22,6 +23,25 @@ const (
 	FilterOnlyParticipating = "only_participating"
 )
 
+// notificationReasons are the reasons GitHub gives for notifying a user about a thread.
+var notificationReasons = []string{
+	"approval_requested",
+	"assign",
+	"author",
+	"ci_activity",
+	"comment",
+	"invitation",
+	"manual",
+	"member_feature_requested",
+	"mention",
+	"review_requested",
+	"security_advisory_credit",
+	"security_alert",
+	"state_change",
+	"subscribed",
+	"team_mention",
+}
+
 // ListNotifications creates a tool to list notifications for the current user.
 func ListNotifications(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_notifications",
@@ -46,6 +66,13 @@ func ListNotifications(getClient GetClientFn, t translations.TranslationHelperFu
 			mcp.WithString("repo",
 				mcp.Description("Optional repository name. If provided with owner, only notifications for this repository are listed."),
 			),
+			mcp.WithArray("reasons",
+				mcp.Description("Only list notifications with one of these reasons, e.g. review_requested or mention. The API cannot filter by reason, so a page may hold fewer notifications than perPage."),
+				mcp.Items(map[string]any{
+					"type": "string",
+					"enum": notificationReasons,
+				}),
+			),
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -78,6 +105,11 @@ func ListNotifications(getClient GetClientFn, t translations.TranslationHelperFu
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
+			reasons, err := OptionalStringArrayParam(request, "reasons")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
 			paginationParams, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -135,13 +167,17 @@ func ListNotifications(getClient GetClientFn, t translations.TranslationHelperFu
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get notifications: %s", string(body))), nil
 			}
 
-			// Marshal response to JSON
-			r, err := json.Marshal(notifications)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			if len(reasons) > 0 {
+				filtered := make([]*github.Notification, 0, len(notifications))
+				for _, n := range notifications {
+					if slices.Contains(reasons, n.GetReason()) {
+						filtered = append(filtered, n)
+					}
+				}
+				notifications = filtered
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledListResult(ctx, notifications, "no notifications matched"), nil
 		}
 }
 
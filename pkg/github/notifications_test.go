@@ -27,6 +27,7 @@ func Test_ListNotifications(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "before")
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "reasons")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	// All fields are optional, so Required should be empty
@@ -105,6 +106,27 @@ func Test_ListNotifications(t *testing.T) {
 			expectError:    false,
 			expectedResult: []*github.Notification{mockNotification},
 		},
+		{
+			name: "success filtered by reason",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetNotifications,
+					[]*github.Notification{
+						mockNotification,
+						{ID: github.Ptr("124"), Reason: github.Ptr("subscribed")},
+						{ID: github.Ptr("125"), Reason: github.Ptr("review_requested")},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"reasons": []any{"mention", "review_requested"},
+			},
+			expectError: false,
+			expectedResult: []*github.Notification{
+				mockNotification,
+				{ID: github.Ptr("125"), Reason: github.Ptr("review_requested")},
+			},
+		},
 		{
 			name: "error",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -143,8 +165,10 @@ func Test_ListNotifications(t *testing.T) {
 			var returned []*github.Notification
 			err = json.Unmarshal([]byte(textContent.Text), &returned)
 			require.NoError(t, err)
-			require.NotEmpty(t, returned)
-			assert.Equal(t, *tc.expectedResult[0].ID, *returned[0].ID)
+			require.Len(t, returned, len(tc.expectedResult))
+			for i, n := range tc.expectedResult {
+				assert.Equal(t, n.GetID(), returned[i].GetID())
+			}
 		})
 	}
 }
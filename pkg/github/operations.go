@@ -0,0 +1,145 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// operationTokenParameter is the argument of a long-running tool that names the call, so that
+// cancel_operation can stop it from another call.
+const operationTokenParameter = "operation_token"
+
+// errOperationCancelled is the cause of a context that was cancelled through cancel_operation.
+var errOperationCancelled = errors.New("operation cancelled through cancel_operation")
+
+// operationKey names an operation. Tokens are picked by callers, so they are only unique within
+// the MCP session that started the operation.
+type operationKey struct {
+	session string
+	token   string
+}
+
+// OperationRegistry tracks the long-running tool calls that are in flight, keyed by session and
+// token. A session can only cancel its own operations, even when several sessions share a server
+// and pick the same tokens.
+type OperationRegistry struct {
+	mu  sync.Mutex
+	ops map[operationKey]context.CancelCauseFunc
+}
+
+// NewOperationRegistry creates the registry that the polling tools and cancel_operation of one
+// server share.
+func NewOperationRegistry() *OperationRegistry {
+	return &OperationRegistry{ops: make(map[operationKey]context.CancelCauseFunc)}
+}
+
+// newOperationKey returns the key of token in the MCP session of ctx.
+func newOperationKey(ctx context.Context, token string) operationKey {
+	key := operationKey{token: token}
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		key.session = session.SessionID()
+	}
+	return key
+}
+
+// start registers an operation under token in the session of ctx. The returned context is
+// cancelled by cancel, and done must be called when the operation finishes to release it. An
+// operation without a token is not registered and cannot be cancelled.
+func (r *OperationRegistry) start(ctx context.Context, token string) (context.Context, func(), error) {
+	if token == "" {
+		return ctx, func() {}, nil
+	}
+	key := newOperationKey(ctx, token)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.ops[key]; ok {
+		return nil, nil, fmt.Errorf("operation token %q is already in use by another call", token)
+	}
+	opCtx, cancel := context.WithCancelCause(ctx)
+	r.ops[key] = cancel
+
+	done := func() {
+		r.mu.Lock()
+		delete(r.ops, key)
+		r.mu.Unlock()
+		cancel(nil)
+	}
+	return opCtx, done, nil
+}
+
+// cancel cancels the operation registered under token in the session of ctx. It reports whether
+// the operation was still in flight.
+func (r *OperationRegistry) cancel(ctx context.Context, token string) bool {
+	key := newOperationKey(ctx, token)
+
+	r.mu.Lock()
+	cancel, ok := r.ops[key]
+	delete(r.ops, key)
+	r.mu.Unlock()
+	if ok {
+		cancel(errOperationCancelled)
+	}
+	return ok
+}
+
+// inFlight returns the number of operations that have not finished.
+func (r *OperationRegistry) inFlight() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.ops)
+}
+
+// operationCancelled reports whether ctx was cancelled through cancel_operation.
+func operationCancelled(ctx context.Context) bool {
+	return errors.Is(context.Cause(ctx), errOperationCancelled)
+}
+
+// WithOperationToken adds the operation_token parameter to a long-running tool. The caller picks
+// the token rather than the server returning one, because the caller needs it while the call is
+// still blocking.
+func WithOperationToken() mcp.ToolOption {
+	return mcp.WithString(operationTokenParameter,
+		mcp.Description("A token you pick to name this call, so it can be stopped early by calling cancel_operation with the same token. The server does not return a token, so pick one that no other call of yours still in flight uses. Without a token the call cannot be cancelled."),
+	)
+}
+
+// CancelOperation creates a tool to stop a long-running tool call that is still in flight. It only
+// stops the call in this server and changes nothing on GitHub, so it is a read tool.
+func CancelOperation(operations *OperationRegistry, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("cancel_operation",
+			mcp.WithDescription(t("TOOL_CANCEL_OPERATION_DESCRIPTION", "Cancel a long-running call, such as wait_for_workflow_run or check_mergeability, that is still in flight. Pass the operation_token you picked for that call; calls made without one cannot be cancelled. The cancelled call returns what it had found so far with cancelled set.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CANCEL_OPERATION_USER_TITLE", "Cancel operation"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString(operationTokenParameter,
+				mcp.Required(),
+				mcp.Description("Token of the call to cancel"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			token, err := RequiredParam[string](request, operationTokenParameter)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			cancelled := operations.cancel(ctx, token)
+			message := "operation cancelled"
+			if !cancelled {
+				message = "no operation with this token is in flight in this session; it may have already finished"
+			}
+			result := map[string]any{
+				operationTokenParameter: token,
+				"cancelled":             cancelled,
+				"message":               message,
+			}
+			return MarshalledTextResult(result), nil
+		}
+}
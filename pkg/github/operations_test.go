@@ -0,0 +1,101 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OperationRegistry(t *testing.T) {
+	r := NewOperationRegistry()
+
+	ctx, done, err := r.start(context.Background(), "running")
+	require.NoError(t, err)
+	assert.Equal(t, 1, r.inFlight())
+
+	_, _, err = r.start(context.Background(), "running")
+	assert.ErrorContains(t, err, "already in use")
+
+	assert.True(t, r.cancel(context.Background(), "running"))
+	assert.True(t, operationCancelled(ctx))
+	assert.Zero(t, r.inFlight())
+	assert.False(t, r.cancel(context.Background(), "running"), "a token can only be cancelled once")
+	done()
+
+	// Finished operations are released without being reported as cancelled.
+	ctx, done, err = r.start(context.Background(), "finished")
+	require.NoError(t, err)
+	done()
+	assert.Zero(t, r.inFlight())
+	assert.Error(t, ctx.Err())
+	assert.False(t, operationCancelled(ctx))
+	assert.False(t, r.cancel(context.Background(), "finished"))
+
+	// Operations without a token run on the caller's context and are not tracked.
+	ctx, done, err = r.start(context.Background(), "")
+	require.NoError(t, err)
+	assert.Zero(t, r.inFlight())
+	done()
+	assert.NoError(t, ctx.Err())
+}
+
+// testSession is an MCP session that only has an ID.
+type testSession struct{ id string }
+
+func (s testSession) Initialize()                                         {}
+func (s testSession) Initialized() bool                                   { return true }
+func (s testSession) NotificationChannel() chan<- mcp.JSONRPCNotification { return nil }
+func (s testSession) SessionID() string                                   { return s.id }
+
+func Test_OperationRegistrySessions(t *testing.T) {
+	r := NewOperationRegistry()
+	mcpServer := server.NewMCPServer("test", "1.0.0")
+	first := mcpServer.WithContext(context.Background(), testSession{id: "first"})
+	second := mcpServer.WithContext(context.Background(), testSession{id: "second"})
+
+	// Sessions pick tokens independently, and cannot cancel each other's operations.
+	firstCtx, firstDone, err := r.start(first, "wait")
+	require.NoError(t, err)
+	defer firstDone()
+	secondCtx, secondDone, err := r.start(second, "wait")
+	require.NoError(t, err)
+	defer secondDone()
+
+	assert.False(t, r.cancel(context.Background(), "wait"))
+	assert.True(t, r.cancel(second, "wait"))
+	assert.True(t, operationCancelled(secondCtx))
+	assert.False(t, operationCancelled(firstCtx))
+	assert.Equal(t, 1, r.inFlight())
+}
+
+func Test_CancelOperation(t *testing.T) {
+	// Verify tool definition once
+	tool, handler := CancelOperation(NewOperationRegistry(), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "cancel_operation", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"operation_token"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint, "cancelling only stops a call in this server")
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"operation_token": "unknown",
+	}))
+	require.NoError(t, err)
+
+	var response map[string]any
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, false, response["cancelled"])
+	assert.Equal(t, "no operation with this token is in flight in this session; it may have already finished", response["message"])
+
+	result, err = handler(context.Background(), createMCPRequest(map[string]any{}))
+	require.NoError(t, err)
+	assert.Equal(t, "missing required parameter: operation_token", getErrorResult(t, result).Text)
+}
@@ -0,0 +1,414 @@
+package github
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"golang.org/x/crypto/nacl/box"
+)
+
+// orgVisibilities are the repositories an organization secret or variable can be made available to.
+var orgVisibilities = []string{"all", "private", "selected"}
+
+// actionsNamePattern is the rule GitHub applies to the names of secrets and variables: letters,
+// digits and underscores, not starting with a digit.
+var actionsNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// validateActionsName checks a secret or variable name against the rules GitHub enforces, so that
+// a bad name fails before anything is encrypted or sent.
+func validateActionsName(kind, name string) error {
+	if !actionsNamePattern.MatchString(name) {
+		return fmt.Errorf("%s name %q may only contain letters, digits and underscores, and must not start with a digit", kind, name)
+	}
+	if strings.HasPrefix(strings.ToUpper(name), "GITHUB_") {
+		return fmt.Errorf("%s name %q must not start with GITHUB_", kind, name)
+	}
+	return nil
+}
+
+// orgVisibilityParams reads the visibility and selected_repository_ids arguments. Repository ids
+// are only meaningful, and required, when visibility is selected.
+func orgVisibilityParams(request mcp.CallToolRequest) (string, github.SelectedRepoIDs, error) {
+	visibility, err := RequiredParam[string](request, "visibility")
+	if err != nil {
+		return "", nil, err
+	}
+	ids, err := OptionalIntArrayParam(request, "selected_repository_ids")
+	if err != nil {
+		return "", nil, err
+	}
+
+	switch visibility {
+	case "all", "private":
+		if len(ids) > 0 {
+			return "", nil, fmt.Errorf("selected_repository_ids can only be given when visibility is selected, not %s", visibility)
+		}
+		return visibility, nil, nil
+	case "selected":
+		if len(ids) == 0 {
+			return "", nil, fmt.Errorf("visibility selected needs at least one repository id in selected_repository_ids")
+		}
+		selected := make(github.SelectedRepoIDs, 0, len(ids))
+		for _, id := range ids {
+			if id <= 0 {
+				return "", nil, fmt.Errorf("selected_repository_ids must be positive repository ids, got %d", id)
+			}
+			selected = append(selected, int64(id))
+		}
+		return visibility, selected, nil
+	default:
+		return "", nil, fmt.Errorf("visibility must be one of %s, got %q", strings.Join(orgVisibilities, ", "), visibility)
+	}
+}
+
+// withOrgVisibilityParams adds the visibility and selected_repository_ids parameters.
+func withOrgVisibilityParams(kind string) mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("visibility",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("Which repositories of the organization can use the %s: all, private (private and internal repositories only) or selected", kind)),
+			mcp.Enum(orgVisibilities...),
+		)(tool)
+		mcp.WithArray("selected_repository_ids",
+			mcp.Description(fmt.Sprintf("IDs of the repositories that can use the %s. Required when visibility is selected, and not allowed otherwise.", kind)),
+			mcp.Items(map[string]any{
+				"type": "number",
+			}),
+		)(tool)
+	}
+}
+
+// sealSecret encrypts value for GitHub with a libsodium sealed box, using the base64 encoded
+// public key GitHub provides, and returns the result base64 encoded.
+func sealSecret(publicKey, value string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode public key: %w", err)
+	}
+	if len(decoded) != 32 {
+		return "", fmt.Errorf("public key is %d bytes, expected 32", len(decoded))
+	}
+	var key [32]byte
+	copy(key[:], decoded)
+
+	sealed, err := box.SealAnonymous(nil, []byte(value), &key, crand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// OrgSecret describes an organization secret. GitHub never returns secret values.
+type OrgSecret struct {
+	Name       string    `json:"name"`
+	Visibility string    `json:"visibility"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// OrgVariable is an organization variable with its value.
+type OrgVariable struct {
+	Name       string     `json:"name"`
+	Value      string     `json:"value"`
+	Masked     bool       `json:"masked,omitempty"`
+	Visibility string     `json:"visibility"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+// ListOrgSecrets creates a tool to list the GitHub Actions secrets of an organization.
+func ListOrgSecrets(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_secrets",
+			mcp.WithDescription(t("TOOL_LIST_ORG_SECRETS_DESCRIPTION", "List the GitHub Actions secrets of an organization with their visibility. Secret values are never returned.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ORG_SECRETS_USER_TITLE", "List organization secrets"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			secrets, resp, err := client.Actions.ListOrgSecrets(ctx, org, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list organization secrets", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]OrgSecret, 0, len(secrets.Secrets))
+			for _, s := range secrets.Secrets {
+				result = append(result, OrgSecret{
+					Name:       s.Name,
+					Visibility: s.Visibility,
+					CreatedAt:  s.CreatedAt.Time,
+					UpdatedAt:  s.UpdatedAt.Time,
+				})
+			}
+
+			return MarshalledListResult(ctx, result, "no organization secrets found"), nil
+		}
+}
+
+// SetOrgSecret creates a tool to create or update a GitHub Actions secret of an organization.
+func SetOrgSecret(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("set_org_secret",
+			mcp.WithDescription(t("TOOL_SET_ORG_SECRET_DESCRIPTION", "Create or update a GitHub Actions secret of an organization. The value is encrypted with the organization's public key before it is sent, and is never returned or logged by this tool.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SET_ORG_SECRET_USER_TITLE", "Set organization secret"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Secret name"),
+			),
+			mcp.WithString("value",
+				mcp.Required(),
+				mcp.Description("Secret value"),
+			),
+			withOrgVisibilityParams("secret"),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := RequiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			value, err := RequiredParam[string](request, "value")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if err := validateActionsName("secret", name); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			visibility, selectedIDs, err := orgVisibilityParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			key, resp, err := client.Actions.GetOrgPublicKey(ctx, org)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get organization public key", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			encrypted, err := sealSecret(key.GetKey(), value)
+			if err != nil {
+				return nil, err
+			}
+
+			resp, err = client.Actions.CreateOrUpdateOrgSecret(ctx, org, &github.EncryptedSecret{
+				Name:                  name,
+				KeyID:                 key.GetKeyID(),
+				EncryptedValue:        encrypted,
+				Visibility:            visibility,
+				SelectedRepositoryIDs: selectedIDs,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to set organization secret %s", name), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			action := "updated"
+			if resp.StatusCode == http.StatusCreated {
+				action = "created"
+			}
+			result := map[string]any{
+				"name":       name,
+				"visibility": visibility,
+				"message":    fmt.Sprintf("secret %s %s in organization %s", name, action, org),
+			}
+			if selectedIDs != nil {
+				result["selected_repository_ids"] = selectedIDs
+			}
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// ListOrgVariables creates a tool to list the GitHub Actions variables of an organization.
+func ListOrgVariables(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_variables",
+			mcp.WithDescription(t("TOOL_LIST_ORG_VARIABLES_DESCRIPTION", "List the GitHub Actions variables of an organization with their values and visibility. Values of variables the server is configured to mask are replaced with ***.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ORG_VARIABLES_USER_TITLE", "List organization variables"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			variables, resp, err := client.Actions.ListOrgVariables(ctx, org, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list organization variables", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]OrgVariable, 0, len(variables.Variables))
+			for _, v := range variables.Variables {
+				variable := OrgVariable{
+					Name:       v.Name,
+					Visibility: v.GetVisibility(),
+				}
+				variable.Value, variable.Masked = maskVariableValue(ctx, v.Name, v.Value)
+				if v.CreatedAt != nil {
+					variable.CreatedAt = &v.CreatedAt.Time
+				}
+				if v.UpdatedAt != nil {
+					variable.UpdatedAt = &v.UpdatedAt.Time
+				}
+				result = append(result, variable)
+			}
+
+			return MarshalledListResult(ctx, result, "no organization variables found"), nil
+		}
+}
+
+// SetOrgVariable creates a tool to create or update a GitHub Actions variable of an organization.
+func SetOrgVariable(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("set_org_variable",
+			mcp.WithDescription(t("TOOL_SET_ORG_VARIABLE_DESCRIPTION", "Create or update a GitHub Actions variable of an organization. Variables are stored in plain text; use set_org_secret for sensitive values.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SET_ORG_VARIABLE_USER_TITLE", "Set organization variable"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Variable name"),
+			),
+			mcp.WithString("value",
+				mcp.Required(),
+				mcp.Description("Variable value"),
+			),
+			withOrgVisibilityParams("variable"),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := RequiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			value, err := RequiredParam[string](request, "value")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if err := validateActionsName("variable", name); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			visibility, selectedIDs, err := orgVisibilityParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			variable := &github.ActionsVariable{
+				Name:       name,
+				Value:      value,
+				Visibility: github.Ptr(visibility),
+			}
+			if selectedIDs != nil {
+				variable.SelectedRepositoryIDs = &selectedIDs
+			}
+
+			// Variables, unlike secrets, have separate create and update endpoints.
+			_, resp, err := client.Actions.GetOrgVariable(ctx, org, name)
+			exists := err == nil
+			if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get organization variable %s", name), resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			action := "created"
+			if exists {
+				action = "updated"
+				resp, err = client.Actions.UpdateOrgVariable(ctx, org, variable)
+			} else {
+				resp, err = client.Actions.CreateOrgVariable(ctx, org, variable)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to set organization variable %s", name), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := map[string]any{
+				"name":       name,
+				"visibility": visibility,
+				"message":    fmt.Sprintf("variable %s %s in organization %s", name, action, org),
+			}
+			if selectedIDs != nil {
+				result["selected_repository_ids"] = selectedIDs
+			}
+			return MarshalledTextResult(result), nil
+		}
+}
@@ -0,0 +1,263 @@
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/box"
+)
+
+func Test_SealSecret(t *testing.T) {
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	sealed, err := sealSecret(base64.StdEncoding.EncodeToString(publicKey[:]), "hunter2")
+	require.NoError(t, err)
+
+	decoded, err := base64.StdEncoding.DecodeString(sealed)
+	require.NoError(t, err)
+	opened, ok := box.OpenAnonymous(nil, decoded, publicKey, privateKey)
+	require.True(t, ok, "the organization's private key should open the sealed box")
+	assert.Equal(t, "hunter2", string(opened))
+
+	_, err = sealSecret(base64.StdEncoding.EncodeToString([]byte("short")), "hunter2")
+	assert.EqualError(t, err, "public key is 5 bytes, expected 32")
+}
+
+func Test_ListOrgSecrets(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListOrgSecrets(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_org_secrets", tool.Name)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetOrgsActionsSecretsByOrg,
+			expectPath(t, "/orgs/acme/actions/secrets").andThen(
+				mockResponse(t, http.StatusOK, &github.Secrets{
+					TotalCount: 1,
+					Secrets: []*github.Secret{
+						{
+							Name:       "DEPLOY_KEY",
+							Visibility: "selected",
+							CreatedAt:  github.Timestamp{Time: created},
+							UpdatedAt:  github.Timestamp{Time: created},
+						},
+					},
+				}),
+			),
+		),
+	)
+	_, handler := ListOrgSecrets(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{"org": "acme"}))
+	require.NoError(t, err)
+
+	var secrets []OrgSecret
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &secrets))
+	assert.Equal(t, []OrgSecret{{Name: "DEPLOY_KEY", Visibility: "selected", CreatedAt: created, UpdatedAt: created}}, secrets)
+}
+
+func Test_SetOrgSecret(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SetOrgSecret(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "set_org_secret", tool.Name)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.Properties, "selected_repository_ids")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "name", "value", "visibility"})
+
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	orgKey := &github.PublicKey{
+		KeyID: github.Ptr("568250167242549743"),
+		Key:   github.Ptr(base64.StdEncoding.EncodeToString(publicKey[:])),
+	}
+
+	t.Run("value is sealed against the organization key", func(t *testing.T) {
+		var sent struct {
+			KeyID                 string  `json:"key_id"`
+			EncryptedValue        string  `json:"encrypted_value"`
+			Visibility            string  `json:"visibility"`
+			SelectedRepositoryIDs []int64 `json:"selected_repository_ids"`
+		}
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetOrgsActionsSecretsPublicKeyByOrg,
+				expectPath(t, "/orgs/acme/actions/secrets/public-key").andThen(
+					mockResponse(t, http.StatusOK, orgKey),
+				),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PutOrgsActionsSecretsByOrgBySecretName,
+				expectPath(t, "/orgs/acme/actions/secrets/DEPLOY_KEY").andThen(
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						require.NoError(t, json.NewDecoder(r.Body).Decode(&sent))
+						w.WriteHeader(http.StatusCreated)
+					}),
+				),
+			),
+		)
+		_, handler := SetOrgSecret(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"org":                     "acme",
+			"name":                    "DEPLOY_KEY",
+			"value":                   "hunter2",
+			"visibility":              "selected",
+			"selected_repository_ids": []any{float64(1296269), float64(1296270)},
+		}))
+		require.NoError(t, err)
+		textContent := getTextResult(t, result)
+		assert.NotContains(t, textContent.Text, "hunter2")
+		assert.Contains(t, textContent.Text, "secret DEPLOY_KEY created in organization acme")
+
+		assert.Equal(t, "568250167242549743", sent.KeyID)
+		assert.Equal(t, "selected", sent.Visibility)
+		assert.Equal(t, []int64{1296269, 1296270}, sent.SelectedRepositoryIDs)
+		sealed, err := base64.StdEncoding.DecodeString(sent.EncryptedValue)
+		require.NoError(t, err)
+		opened, ok := box.OpenAnonymous(nil, sealed, publicKey, privateKey)
+		require.True(t, ok)
+		assert.Equal(t, "hunter2", string(opened))
+	})
+
+	invalid := []struct {
+		name           string
+		args           map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name:           "selected visibility without repositories",
+			args:           map[string]any{"visibility": "selected"},
+			expectedErrMsg: "visibility selected needs at least one repository id in selected_repository_ids",
+		},
+		{
+			name:           "repositories with all visibility",
+			args:           map[string]any{"visibility": "all", "selected_repository_ids": []any{float64(1)}},
+			expectedErrMsg: "selected_repository_ids can only be given when visibility is selected, not all",
+		},
+		{
+			name:           "non-positive repository id",
+			args:           map[string]any{"visibility": "selected", "selected_repository_ids": []any{float64(0)}},
+			expectedErrMsg: "selected_repository_ids must be positive repository ids, got 0",
+		},
+		{
+			name:           "unknown visibility",
+			args:           map[string]any{"visibility": "public"},
+			expectedErrMsg: `visibility must be one of all, private, selected, got "public"`,
+		},
+		{
+			name:           "reserved name",
+			args:           map[string]any{"name": "GITHUB_TOKEN", "visibility": "all"},
+			expectedErrMsg: `secret name "GITHUB_TOKEN" must not start with GITHUB_`,
+		},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			// No requests are expected, so the mocked client has no handlers.
+			_, handler := SetOrgSecret(stubGetClientFn(github.NewClient(mock.NewMockedHTTPClient())), translations.NullTranslationHelper)
+
+			args := map[string]any{"org": "acme", "name": "DEPLOY_KEY", "value": "hunter2"}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+			errorContent := getErrorResult(t, result)
+			assert.Equal(t, tc.expectedErrMsg, errorContent.Text)
+			assert.NotContains(t, errorContent.Text, "hunter2")
+		})
+	}
+}
+
+func Test_SetOrgVariable(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SetOrgVariable(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "set_org_variable", tool.Name)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "name", "value", "visibility"})
+
+	notFound := mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"})
+
+	t.Run("missing variable is created with selected repositories", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetOrgsActionsVariablesByOrgByName,
+				notFound,
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostOrgsActionsVariablesByOrg,
+				expectRequestBody(t, map[string]any{
+					"name":                    "REGION",
+					"value":                   "eu-west-1",
+					"visibility":              "selected",
+					"selected_repository_ids": []any{float64(42)},
+				}).andThen(
+					mockResponse(t, http.StatusCreated, nil),
+				),
+			),
+		)
+		_, handler := SetOrgVariable(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"org":                     "acme",
+			"name":                    "REGION",
+			"value":                   "eu-west-1",
+			"visibility":              "selected",
+			"selected_repository_ids": []any{float64(42)},
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "variable REGION created in organization acme")
+	})
+
+	t.Run("existing variable is updated", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(
+				mock.GetOrgsActionsVariablesByOrgByName,
+				&github.ActionsVariable{Name: "REGION", Value: "us-east-1"},
+			),
+			mock.WithRequestMatchHandler(
+				mock.PatchOrgsActionsVariablesByOrgByName,
+				expectRequestBody(t, map[string]any{
+					"name":       "REGION",
+					"value":      "eu-west-1",
+					"visibility": "private",
+				}).andThen(
+					mockResponse(t, http.StatusNoContent, nil),
+				),
+			),
+		)
+		_, handler := SetOrgVariable(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"org":        "acme",
+			"name":       "REGION",
+			"value":      "eu-west-1",
+			"visibility": "private",
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "variable REGION updated in organization acme")
+	})
+}
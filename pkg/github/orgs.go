@@ -0,0 +1,406 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// OrgTeam is a team of an organization as returned by list_org_teams.
+type OrgTeam struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description,omitempty"`
+	Privacy     string `json:"privacy,omitempty"`
+	Parent      string `json:"parent,omitempty"`
+	URL         string `json:"url"`
+}
+
+// TeamMembership is the membership of a user in a team.
+type TeamMembership struct {
+	Org      string `json:"org"`
+	TeamSlug string `json:"team_slug"`
+	Username string `json:"username"`
+	Role     string `json:"role"`
+	// State is "pending" while the user has not accepted the invitation to the organization.
+	State string `json:"state"`
+}
+
+// TeamRepository is a repository a team has access to.
+type TeamRepository struct {
+	FullName   string `json:"full_name"`
+	Permission string `json:"permission"`
+	Private    bool   `json:"private"`
+	URL        string `json:"url"`
+}
+
+// repositoryPermissions lists repository permissions from the highest to the lowest.
+var repositoryPermissions = []string{"admin", "maintain", "push", "triage", "pull"}
+
+// highestPermission returns the highest permission set in a repository's permissions map.
+func highestPermission(permissions map[string]bool) string {
+	for _, p := range repositoryPermissions {
+		if permissions[p] {
+			return p
+		}
+	}
+	return ""
+}
+
+// ListOrgMembers creates a tool to list the members of an organization.
+func ListOrgMembers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_members",
+			mcp.WithDescription(t("TOOL_LIST_ORG_MEMBERS_DESCRIPTION", "List the members of a GitHub organization. Only public members are listed unless the authenticated user is a member of the organization.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ORG_MEMBERS_USER_TITLE", "List organization members"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithString("role",
+				mcp.Description("Filter by role: admin for organization owners, member for everyone else. Defaults to all."),
+				mcp.Enum("all", "admin", "member"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			role, err := OptionalParam[string](request, "role")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			members, resp, err := client.Organizations.ListMembers(ctx, org, &github.ListMembersOptions{
+				Role: role,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list members of %s", org), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]MinimalUser, 0, len(members))
+			for _, m := range members {
+				result = append(result, MinimalUser{
+					Login:      m.GetLogin(),
+					ID:         m.GetID(),
+					ProfileURL: m.GetHTMLURL(),
+					AvatarURL:  m.GetAvatarURL(),
+				})
+			}
+			return MarshalledListResult(ctx, result, "no members found"), nil
+		}
+}
+
+// ListOrgTeams creates a tool to list the teams of an organization.
+func ListOrgTeams(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_teams",
+			mcp.WithDescription(t("TOOL_LIST_ORG_TEAMS_DESCRIPTION", "List the teams of a GitHub organization that are visible to the authenticated user")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ORG_TEAMS_USER_TITLE", "List organization teams"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			teams, resp, err := client.Teams.ListTeams(ctx, org, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list teams of %s", org), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]OrgTeam, 0, len(teams))
+			for _, team := range teams {
+				result = append(result, OrgTeam{
+					ID:          team.GetID(),
+					Name:        team.GetName(),
+					Slug:        team.GetSlug(),
+					Description: team.GetDescription(),
+					Privacy:     team.GetPrivacy(),
+					Parent:      team.GetParent().GetSlug(),
+					URL:         team.GetHTMLURL(),
+				})
+			}
+			return MarshalledListResult(ctx, result, "no teams found"), nil
+		}
+}
+
+// GetTeamMembership creates a tool to get the role of a user in a team.
+func GetTeamMembership(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_team_membership",
+			mcp.WithDescription(t("TOOL_GET_TEAM_MEMBERSHIP_DESCRIPTION", "Check whether a user is a member of a team in a GitHub organization, and with which role")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_TEAM_MEMBERSHIP_USER_TITLE", "Get team membership"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithString("team_slug",
+				mcp.Required(),
+				mcp.Description("Team slug"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("GitHub username"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := RequiredParam[string](request, "team_slug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			membership, resp, err := client.Teams.GetTeamMembershipBySlug(ctx, org, teamSlug, username)
+			if err != nil {
+				// The API answers 404 both for users outside the team and for unknown teams.
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultText(fmt.Sprintf("%s is not a member of %s/%s, or the team does not exist", username, org, teamSlug)), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get membership of %s in %s/%s", username, org, teamSlug), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(TeamMembership{
+				Org:      org,
+				TeamSlug: teamSlug,
+				Username: username,
+				Role:     membership.GetRole(),
+				State:    membership.GetState(),
+			}), nil
+		}
+}
+
+// ListTeamRepositories creates a tool to list the repositories a team has access to.
+func ListTeamRepositories(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_team_repositories",
+			mcp.WithDescription(t("TOOL_LIST_TEAM_REPOSITORIES_DESCRIPTION", "List the repositories a team in a GitHub organization has access to, with the team's permission on each")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_TEAM_REPOSITORIES_USER_TITLE", "List team repositories"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithString("team_slug",
+				mcp.Required(),
+				mcp.Description("Team slug"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := RequiredParam[string](request, "team_slug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			repos, resp, err := client.Teams.ListTeamReposBySlug(ctx, org, teamSlug, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list repositories of %s/%s", org, teamSlug), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]TeamRepository, 0, len(repos))
+			for _, repo := range repos {
+				result = append(result, TeamRepository{
+					FullName:   repo.GetFullName(),
+					Permission: highestPermission(repo.Permissions),
+					Private:    repo.GetPrivate(),
+					URL:        repo.GetHTMLURL(),
+				})
+			}
+			return MarshalledListResult(ctx, result, "no repositories found"), nil
+		}
+}
+
+// AddTeamMember creates a tool to add a user to a team or change their role in it.
+func AddTeamMember(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_team_member",
+			mcp.WithDescription(t("TOOL_ADD_TEAM_MEMBER_DESCRIPTION", "Add a user to a team in a GitHub organization, or change the role of a member. Users outside the organization are invited and stay pending until they accept.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:          t("TOOL_ADD_TEAM_MEMBER_USER_TITLE", "Add team member"),
+				ReadOnlyHint:   ToBoolPtr(false),
+				IdempotentHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithString("team_slug",
+				mcp.Required(),
+				mcp.Description("Team slug"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("GitHub username"),
+			),
+			mcp.WithString("role",
+				mcp.Description("Role in the team, defaults to member"),
+				mcp.Enum("member", "maintainer"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := RequiredParam[string](request, "team_slug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			role, err := OptionalParam[string](request, "role")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			membership, resp, err := client.Teams.AddTeamMembershipBySlug(ctx, org, teamSlug, username, &github.TeamAddTeamMembershipOptions{Role: role})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to add %s to %s/%s", username, org, teamSlug), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(TeamMembership{
+				Org:      org,
+				TeamSlug: teamSlug,
+				Username: username,
+				Role:     membership.GetRole(),
+				State:    membership.GetState(),
+			}), nil
+		}
+}
+
+// RemoveTeamMember creates a tool to remove a user from a team.
+func RemoveTeamMember(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_team_member",
+			mcp.WithDescription(t("TOOL_REMOVE_TEAM_MEMBER_DESCRIPTION", "Remove a user from a team in a GitHub organization. The user stays a member of the organization.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_REMOVE_TEAM_MEMBER_USER_TITLE", "Remove team member"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithString("team_slug",
+				mcp.Required(),
+				mcp.Description("Team slug"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("GitHub username"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := RequiredParam[string](request, "team_slug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Teams.RemoveTeamMembershipBySlug(ctx, org, teamSlug, username)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to remove %s from %s/%s", username, org, teamSlug), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Successfully removed %s from %s/%s", username, org, teamSlug)), nil
+		}
+}
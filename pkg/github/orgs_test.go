@@ -0,0 +1,272 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListOrgMembers(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListOrgMembers(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_org_members", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetOrgsMembersByOrg,
+			expect(t, expectations{
+				path:        "/orgs/acme/members",
+				queryParams: map[string]string{"role": "admin", "page": "1", "per_page": "30"},
+			}).andThen(
+				mockResponse(t, http.StatusOK, []*github.User{
+					{Login: github.Ptr("octocat"), ID: github.Ptr(int64(1)), HTMLURL: github.Ptr("https://github.com/octocat")},
+				}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := ListOrgMembers(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"org":  "acme",
+		"role": "admin",
+	}))
+	require.NoError(t, err)
+
+	var returned []MinimalUser
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	require.Len(t, returned, 1)
+	assert.Equal(t, "octocat", returned[0].Login)
+	assert.Equal(t, "https://github.com/octocat", returned[0].ProfileURL)
+}
+
+func Test_ListOrgTeams(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListOrgTeams(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_org_teams", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetOrgsTeamsByOrg,
+			[]*github.Team{
+				{ID: github.Ptr(int64(1)), Name: github.Ptr("Engineering"), Slug: github.Ptr("engineering"), Privacy: github.Ptr("closed")},
+				{ID: github.Ptr(int64(2)), Name: github.Ptr("Platform"), Slug: github.Ptr("platform"), Parent: &github.Team{Slug: github.Ptr("engineering")}},
+			},
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := ListOrgTeams(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{"org": "acme"}))
+	require.NoError(t, err)
+
+	var returned []OrgTeam
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	require.Len(t, returned, 2)
+	assert.Equal(t, "closed", returned[0].Privacy)
+	assert.Equal(t, "engineering", returned[1].Parent)
+}
+
+func Test_GetTeamMembership(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetTeamMembership(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_team_membership", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "team_slug", "username"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "maintainer",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsTeamsMembershipsByOrgByTeamSlugByUsername,
+					expectPath(t, "/orgs/acme/teams/platform/memberships/octocat").andThen(
+						mockResponse(t, http.StatusOK, &github.Membership{Role: github.Ptr("maintainer"), State: github.Ptr("active")}),
+					),
+				),
+			),
+			expectedText: `{"org":"acme","team_slug":"platform","username":"octocat","role":"maintainer","state":"active"}`,
+		},
+		{
+			name: "not a member",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsTeamsMembershipsByOrgByTeamSlugByUsername,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectedText: "octocat is not a member of acme/platform, or the team does not exist",
+		},
+		{
+			name: "forbidden",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsTeamsMembershipsByOrgByTeamSlugByUsername,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must have admin rights"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get membership of octocat in acme/platform",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetTeamMembership(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"org":       "acme",
+				"team_slug": "platform",
+				"username":  "octocat",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_ListTeamRepositories(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListTeamRepositories(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_team_repositories", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "team_slug"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetOrgsTeamsReposByOrgByTeamSlug,
+			expectPath(t, "/orgs/acme/teams/platform/repos").andThen(
+				mockResponse(t, http.StatusOK, []*github.Repository{
+					{
+						FullName:    github.Ptr("acme/api"),
+						Private:     github.Ptr(true),
+						Permissions: map[string]bool{"admin": false, "maintain": true, "push": true, "triage": true, "pull": true},
+					},
+					{
+						FullName:    github.Ptr("acme/docs"),
+						Permissions: map[string]bool{"pull": true},
+					},
+				}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := ListTeamRepositories(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"org":       "acme",
+		"team_slug": "platform",
+	}))
+	require.NoError(t, err)
+
+	var returned []TeamRepository
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, []TeamRepository{
+		{FullName: "acme/api", Permission: "maintain", Private: true},
+		{FullName: "acme/docs", Permission: "pull"},
+	}, returned)
+}
+
+func Test_AddTeamMember(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AddTeamMember(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "add_team_member", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "team_slug", "username"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PutOrgsTeamsMembershipsByOrgByTeamSlugByUsername,
+			expect(t, expectations{
+				path:        "/orgs/acme/teams/platform/memberships/newcomer",
+				requestBody: map[string]any{"role": "maintainer"},
+			}).andThen(
+				mockResponse(t, http.StatusOK, &github.Membership{Role: github.Ptr("maintainer"), State: github.Ptr("pending")}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := AddTeamMember(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"org":       "acme",
+		"team_slug": "platform",
+		"username":  "newcomer",
+		"role":      "maintainer",
+	}))
+	require.NoError(t, err)
+
+	var returned TeamMembership
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, "pending", returned.State)
+	assert.Equal(t, "maintainer", returned.Role)
+}
+
+func Test_RemoveTeamMember(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RemoveTeamMember(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "remove_team_member", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "team_slug", "username"})
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteOrgsTeamsMembershipsByOrgByTeamSlugByUsername,
+			expectPath(t, "/orgs/acme/teams/platform/memberships/octocat").andThen(
+				mockResponse(t, http.StatusNoContent, nil),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := RemoveTeamMember(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"org":       "acme",
+		"team_slug": "platform",
+		"username":  "octocat",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "Successfully removed octocat from acme/platform", getTextResult(t, result).Text)
+}
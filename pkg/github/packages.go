@@ -0,0 +1,388 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// packageTypes are the registries GitHub Packages can hold. Docker is the legacy registry that
+// container replaced.
+var packageTypes = []string{"npm", "maven", "rubygems", "docker", "nuget", "container"}
+
+// Package is a package published to GitHub Packages.
+type Package struct {
+	ID           int64      `json:"id"`
+	Name         string     `json:"name"`
+	PackageType  string     `json:"package_type"`
+	Visibility   string     `json:"visibility"`
+	VersionCount int64      `json:"version_count,omitempty"`
+	Repository   string     `json:"repository,omitempty"`
+	URL          string     `json:"url"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+}
+
+// PackageVersion is one published version of a package. For container images the name is the
+// image digest and tags lists the tags pointing at it.
+type PackageVersion struct {
+	ID        int64      `json:"id"`
+	Name      string     `json:"name"`
+	Tags      []string   `json:"tags,omitempty"`
+	URL       string     `json:"url,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+func newPackage(p *github.Package) Package {
+	pkg := Package{
+		ID:           p.GetID(),
+		Name:         p.GetName(),
+		PackageType:  p.GetPackageType(),
+		Visibility:   p.GetVisibility(),
+		VersionCount: p.GetVersionCount(),
+		Repository:   p.GetRepository().GetFullName(),
+		URL:          p.GetHTMLURL(),
+	}
+	if p.CreatedAt != nil {
+		pkg.CreatedAt = &p.CreatedAt.Time
+	}
+	if p.UpdatedAt != nil {
+		pkg.UpdatedAt = &p.UpdatedAt.Time
+	}
+	return pkg
+}
+
+func newPackageVersion(v *github.PackageVersion) PackageVersion {
+	version := PackageVersion{
+		ID:   v.GetID(),
+		Name: v.GetName(),
+		URL:  v.GetHTMLURL(),
+	}
+	if version.URL == "" {
+		version.URL = v.GetPackageHTMLURL()
+	}
+	var metadata github.PackageMetadata
+	if len(v.Metadata) > 0 && json.Unmarshal(v.Metadata, &metadata) == nil {
+		version.Tags = metadata.GetContainer().Tags
+	}
+	if v.CreatedAt != nil {
+		version.CreatedAt = &v.CreatedAt.Time
+	}
+	if v.UpdatedAt != nil {
+		version.UpdatedAt = &v.UpdatedAt.Time
+	}
+	if v.DeletedAt != nil {
+		version.DeletedAt = &v.DeletedAt.Time
+	}
+	return version
+}
+
+// packageOwner is the user or organization that owns packages. The REST API has the same
+// operations for both under different paths.
+type packageOwner struct {
+	login string
+	org   bool
+}
+
+// withPackage adds the parameters that pick the owner and, when named, a package.
+func withPackage(named bool) []mcp.ToolOption {
+	options := []mcp.ToolOption{
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Login of the user or organization that owns the packages"),
+		),
+		mcp.WithString("owner_type",
+			mcp.Description("Whether owner is an organization or a user (default: org)"),
+			mcp.Enum("org", "user"),
+		),
+		mcp.WithString("package_type",
+			mcp.Required(),
+			mcp.Description("Registry of the packages. Use container for images in ghcr.io"),
+			mcp.Enum(packageTypes...),
+		),
+	}
+	if named {
+		options = append(options, mcp.WithString("package_name",
+			mcp.Required(),
+			mcp.Description("Name of the package"),
+		))
+	}
+	return options
+}
+
+func requiredPackageOwner(request mcp.CallToolRequest) (packageOwner, error) {
+	login, err := RequiredParam[string](request, "owner")
+	if err != nil {
+		return packageOwner{}, err
+	}
+	ownerType, err := OptionalParam[string](request, "owner_type")
+	if err != nil {
+		return packageOwner{}, err
+	}
+	return packageOwner{login: login, org: ownerType != "user"}, nil
+}
+
+func (o packageOwner) list(ctx context.Context, client *github.Client, opts *github.PackageListOptions) ([]*github.Package, *github.Response, error) {
+	if o.org {
+		return client.Organizations.ListPackages(ctx, o.login, opts)
+	}
+	return client.Users.ListPackages(ctx, o.login, opts)
+}
+
+func (o packageOwner) get(ctx context.Context, client *github.Client, packageType, name string) (*github.Package, *github.Response, error) {
+	if o.org {
+		return client.Organizations.GetPackage(ctx, o.login, packageType, name)
+	}
+	return client.Users.GetPackage(ctx, o.login, packageType, name)
+}
+
+func (o packageOwner) listVersions(ctx context.Context, client *github.Client, packageType, name string, opts *github.PackageListOptions) ([]*github.PackageVersion, *github.Response, error) {
+	if o.org {
+		return client.Organizations.PackageGetAllVersions(ctx, o.login, packageType, name, opts)
+	}
+	return client.Users.PackageGetAllVersions(ctx, o.login, packageType, name, opts)
+}
+
+func (o packageOwner) deleteVersion(ctx context.Context, client *github.Client, packageType, name string, id int64) (*github.Response, error) {
+	if o.org {
+		return client.Organizations.PackageDeleteVersion(ctx, o.login, packageType, name, id)
+	}
+	return client.Users.PackageDeleteVersion(ctx, o.login, packageType, name, id)
+}
+
+// ListPackages creates a tool to list the packages of a user or organization.
+func ListPackages(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_LIST_PACKAGES_DESCRIPTION", "List the packages of one type that a GitHub user or organization has published to GitHub Packages.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_LIST_PACKAGES_USER_TITLE", "List packages"),
+			ReadOnlyHint: ToBoolPtr(true),
+		}),
+	}
+	options = append(options, withPackage(false)...)
+	options = append(options,
+		mcp.WithString("visibility",
+			mcp.Description("Only list packages with this visibility"),
+			mcp.Enum("public", "private", "internal"),
+		),
+		WithPagination(),
+	)
+
+	return mcp.NewTool("list_packages", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredPackageOwner(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			packageType, err := RequiredParam[string](request, "package_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			visibility, err := OptionalParam[string](request, "visibility")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.PackageListOptions{
+				PackageType: github.Ptr(packageType),
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+			if visibility != "" {
+				opts.Visibility = github.Ptr(visibility)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			packages, resp, err := owner.list(ctx, client, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list %s packages of %s", packageType, owner.login), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]Package, 0, len(packages))
+			for _, p := range packages {
+				result = append(result, newPackage(p))
+			}
+			return MarshalledListResult(ctx, result, "no packages found"), nil
+		}
+}
+
+// GetPackage creates a tool to get the metadata of a package.
+func GetPackage(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_GET_PACKAGE_DESCRIPTION", "Get a package of a GitHub user or organization: its visibility, number of versions and linked repository.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_GET_PACKAGE_USER_TITLE", "Get package"),
+			ReadOnlyHint: ToBoolPtr(true),
+		}),
+	}
+	options = append(options, withPackage(true)...)
+
+	return mcp.NewTool("get_package", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredPackageOwner(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			packageType, err := RequiredParam[string](request, "package_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := RequiredParam[string](request, "package_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			pkg, resp, err := owner.get(ctx, client, packageType, name)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get package %s", name), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newPackage(pkg)), nil
+		}
+}
+
+// ListPackageVersions creates a tool to list the versions of a package.
+func ListPackageVersions(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_LIST_PACKAGE_VERSIONS_DESCRIPTION", "List the versions of a package of a GitHub user or organization, newest first. Container image versions are listed by digest with their tags; versions without tags are candidates for cleanup.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_LIST_PACKAGE_VERSIONS_USER_TITLE", "List package versions"),
+			ReadOnlyHint: ToBoolPtr(true),
+		}),
+	}
+	options = append(options, withPackage(true)...)
+	options = append(options,
+		mcp.WithString("state",
+			mcp.Description("List active versions, or deleted ones that can still be restored (default: active)"),
+			mcp.Enum("active", "deleted"),
+		),
+		WithPagination(),
+	)
+
+	return mcp.NewTool("list_package_versions", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredPackageOwner(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			packageType, err := RequiredParam[string](request, "package_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := RequiredParam[string](request, "package_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := OptionalParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.PackageListOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+			if state != "" {
+				opts.State = github.Ptr(state)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			versions, resp, err := owner.listVersions(ctx, client, packageType, name, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list versions of package %s", name), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]PackageVersion, 0, len(versions))
+			for _, v := range versions {
+				result = append(result, newPackageVersion(v))
+			}
+			return MarshalledListResult(ctx, result, "no package versions found"), nil
+		}
+}
+
+// DeletePackageVersion creates a tool to delete a version of a package.
+func DeletePackageVersion(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_DELETE_PACKAGE_VERSION_DESCRIPTION", "Delete a version of a package of a GitHub user or organization. Deleted versions can be restored for 30 days. Public packages with more than 5,000 downloads cannot be deleted, and the last version of a package can only be removed by deleting the package.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           t("TOOL_DELETE_PACKAGE_VERSION_USER_TITLE", "Delete package version"),
+			ReadOnlyHint:    ToBoolPtr(false),
+			DestructiveHint: ToBoolPtr(true),
+		}),
+	}
+	options = append(options, withPackage(true)...)
+	options = append(options, mcp.WithNumber("version_id",
+		mcp.Required(),
+		mcp.Description("ID of the version, from list_package_versions"),
+	))
+
+	return mcp.NewTool("delete_package_version", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredPackageOwner(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			packageType, err := RequiredParam[string](request, "package_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := RequiredParam[string](request, "package_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			versionID, err := RequiredInt(request, "version_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := owner.deleteVersion(ctx, client, packageType, name, int64(versionID))
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to delete version %d of package %s", versionID, name), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Successfully deleted version %d of package %s", versionID, name)), nil
+		}
+}
@@ -0,0 +1,248 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mockPackage = &github.Package{
+	ID:           github.Ptr(int64(5)),
+	Name:         github.Ptr("api"),
+	PackageType:  github.Ptr("container"),
+	Visibility:   github.Ptr("private"),
+	VersionCount: github.Ptr(int64(120)),
+	HTMLURL:      github.Ptr("https://github.com/orgs/acme/packages/container/package/api"),
+	Repository:   &github.Repository{FullName: github.Ptr("acme/api")},
+}
+
+func Test_ListPackages(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListPackages(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_packages", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "package_type"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		requestArgs  map[string]any
+	}{
+		{
+			name: "organization packages",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsPackagesByOrg,
+					expect(t, expectations{
+						path: "/orgs/acme/packages",
+						queryParams: map[string]string{
+							"package_type": "container",
+							"visibility":   "private",
+							"page":         "1",
+							"per_page":     "30",
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Package{mockPackage}),
+					),
+				),
+			),
+			requestArgs: map[string]any{"owner": "acme", "visibility": "private"},
+		},
+		{
+			name: "user packages",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersPackagesByUsername,
+					expectPath(t, "/users/octocat/packages").andThen(
+						mockResponse(t, http.StatusOK, []*github.Package{mockPackage}),
+					),
+				),
+			),
+			requestArgs: map[string]any{"owner": "octocat", "owner_type": "user"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListPackages(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			args := map[string]any{"package_type": "container"}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			var returned []Package
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, []Package{{
+				ID:           5,
+				Name:         "api",
+				PackageType:  "container",
+				Visibility:   "private",
+				VersionCount: 120,
+				Repository:   "acme/api",
+				URL:          "https://github.com/orgs/acme/packages/container/package/api",
+			}}, returned)
+		})
+	}
+}
+
+func Test_GetPackage(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPackage(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_package", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "package_type", "package_name"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "package found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsPackagesByOrgByPackageTypeByPackageName,
+					expectPath(t, "/orgs/acme/packages/container/api").andThen(
+						mockResponse(t, http.StatusOK, mockPackage),
+					),
+				),
+			),
+		},
+		{
+			name: "package not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsPackagesByOrgByPackageTypeByPackageName,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Package not found."}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get package api",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPackage(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":        "acme",
+				"package_type": "container",
+				"package_name": "api",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned Package
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, int64(120), returned.VersionCount)
+		})
+	}
+}
+
+func Test_ListPackageVersions(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListPackageVersions(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_package_versions", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "package_type", "package_name"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetOrgsPackagesVersionsByOrgByPackageTypeByPackageName,
+			expect(t, expectations{
+				path:        "/orgs/acme/packages/container/api/versions",
+				queryParams: map[string]string{"state": "active", "page": "1", "per_page": "30"},
+			}).andThen(
+				mockResponse(t, http.StatusOK, []*github.PackageVersion{
+					{
+						ID:       github.Ptr(int64(900)),
+						Name:     github.Ptr("sha256:aaa"),
+						Metadata: json.RawMessage(`{"package_type":"container","container":{"tags":["latest","v2.1.0"]}}`),
+					},
+					{
+						ID:       github.Ptr(int64(899)),
+						Name:     github.Ptr("sha256:bbb"),
+						Metadata: json.RawMessage(`{"package_type":"container","container":{"tags":[]}}`),
+					},
+				}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := ListPackageVersions(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":        "acme",
+		"package_type": "container",
+		"package_name": "api",
+		"state":        "active",
+	}))
+	require.NoError(t, err)
+
+	var returned []PackageVersion
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, []PackageVersion{
+		{ID: 900, Name: "sha256:aaa", Tags: []string{"latest", "v2.1.0"}},
+		{ID: 899, Name: "sha256:bbb"},
+	}, returned)
+}
+
+func Test_DeletePackageVersion(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DeletePackageVersion(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_package_version", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "package_type", "package_name", "version_id"})
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteUsersPackagesVersionsByUsernameByPackageTypeByPackageNameByPackageVersionId,
+			expectPath(t, "/users/octocat/packages/container/api/versions/899").andThen(
+				mockResponse(t, http.StatusNoContent, nil),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := DeletePackageVersion(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":        "octocat",
+		"owner_type":   "user",
+		"package_type": "container",
+		"package_name": "api",
+		"version_id":   float64(899),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "Successfully deleted version 899 of package api", getTextResult(t, result).Text)
+}
@@ -0,0 +1,54 @@
+package github
+
+import (
+	"context"
+	"time"
+)
+
+// pollBackoff describes how long a polling loop waits between attempts.
+// The delay starts at Initial and is multiplied by Factor after every attempt, up to Max.
+type pollBackoff struct {
+	Initial time.Duration
+	Max     time.Duration
+	Factor  float64
+}
+
+// next returns the delay to use after the given delay.
+func (b pollBackoff) next(delay time.Duration) time.Duration {
+	if b.Factor <= 1 {
+		return delay
+	}
+	delay = time.Duration(float64(delay) * b.Factor)
+	if b.Max > 0 && delay > b.Max {
+		return b.Max
+	}
+	return delay
+}
+
+// pollUntil calls check until it reports done, maxAttempts is reached, or the context is cancelled.
+// It returns whether check reported done and the number of attempts made. An error returned by check
+// stops polling immediately and is returned to the caller.
+func pollUntil(ctx context.Context, b pollBackoff, maxAttempts int, check func(ctx context.Context) (bool, error)) (bool, int, error) {
+	delay := b.Initial
+	for attempt := 1; ; attempt++ {
+		done, err := check(ctx)
+		if err != nil {
+			return false, attempt, err
+		}
+		if done {
+			return true, attempt, nil
+		}
+		if maxAttempts > 0 && attempt >= maxAttempts {
+			return false, attempt, nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return false, attempt, ctx.Err()
+		case <-timer.C:
+		}
+		delay = b.next(delay)
+	}
+}
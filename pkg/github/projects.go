@@ -0,0 +1,1155 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// projectStatusField is a single select field on a Projects v2 board, such as the built-in Status field.
+type projectStatusField struct {
+	ProjectV2SingleSelectField struct {
+		ID      githubv4.ID
+		Name    githubv4.String
+		Options []struct {
+			ID   githubv4.String
+			Name githubv4.String
+		}
+	} `graphql:"... on ProjectV2SingleSelectField"`
+}
+
+type projectV2Fragment struct {
+	ID     githubv4.ID
+	Number githubv4.Int
+	Field  projectStatusField `graphql:"field(name: $fieldName)"`
+}
+
+type projectItemQuery struct {
+	Node struct {
+		ProjectV2Item struct {
+			ID      githubv4.ID
+			Project projectV2Fragment
+			Content struct {
+				Typename    githubv4.String          `graphql:"__typename"`
+				Issue       struct{ ID githubv4.ID } `graphql:"... on Issue"`
+				PullRequest struct{ ID githubv4.ID } `graphql:"... on PullRequest"`
+			}
+		} `graphql:"... on ProjectV2Item"`
+	} `graphql:"node(id: $itemId)"`
+}
+
+type orgProjectQuery struct {
+	Organization struct {
+		ProjectV2 projectV2Fragment `graphql:"projectV2(number: $number)"`
+	} `graphql:"organization(login: $owner)"`
+}
+
+type userProjectQuery struct {
+	User struct {
+		ProjectV2 projectV2Fragment `graphql:"projectV2(number: $number)"`
+	} `graphql:"user(login: $owner)"`
+}
+
+// ProjectItemMove is the result of a move_project_item call.
+type ProjectItemMove struct {
+	ItemID        string `json:"item_id"`
+	ProjectID     string `json:"project_id"`
+	ProjectNumber int    `json:"project_number"`
+	Moved         bool   `json:"moved"`
+	Status        string `json:"status,omitempty"`
+}
+
+// resolveStatusOption finds the option of a single select field by name, ignoring case.
+// The error lists the available options so the caller can correct the name.
+func resolveStatusOption(fieldName string, field projectStatusField, status string) (githubv4.String, string, error) {
+	f := field.ProjectV2SingleSelectField
+	if f.ID == nil || f.ID == "" {
+		return "", "", fmt.Errorf("project has no single select field named %q", fieldName)
+	}
+
+	names := make([]string, 0, len(f.Options))
+	for _, option := range f.Options {
+		if strings.EqualFold(string(option.Name), status) {
+			return option.ID, string(option.Name), nil
+		}
+		names = append(names, string(option.Name))
+	}
+	return "", "", fmt.Errorf("status %q does not exist in field %q, available options: %s", status, fieldName, strings.Join(names, ", "))
+}
+
+// MoveProjectItem creates a tool to move a Projects v2 item to another project and/or change its status column.
+func MoveProjectItem(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("move_project_item",
+			mcp.WithDescription(t("TOOL_MOVE_PROJECT_ITEM_DESCRIPTION", "Move a GitHub Projects item to another project owned by the same user or organization, and/or change its status column. Status options are resolved by name.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_MOVE_PROJECT_ITEM_USER_TITLE", "Move project item"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Node ID of the project item to move"),
+			),
+			mcp.WithString("status",
+				mcp.Description("Name of the status option to set, e.g. 'In Progress'. Matching is case-insensitive"),
+			),
+			mcp.WithString("status_field",
+				mcp.Description("Name of the single select field holding the status (default: Status)"),
+			),
+			mcp.WithNumber("target_project_number",
+				mcp.Description("Number of the project to move the item to. Requires owner"),
+			),
+			mcp.WithString("owner",
+				mcp.Description("Login of the user or organization that owns the target project"),
+			),
+			mcp.WithString("owner_type",
+				mcp.Description("Whether owner is an organization or a user (default: org)"),
+				mcp.Enum("org", "user"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			itemID, err := RequiredParam[string](request, "item_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			status, err := OptionalParam[string](request, "status")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			statusField, err := OptionalParam[string](request, "status_field")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if statusField == "" {
+				statusField = "Status"
+			}
+			targetNumber, err := OptionalIntParam(request, "target_project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := OptionalParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := OptionalParam[string](request, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if ownerType == "" {
+				ownerType = "org"
+			}
+
+			if targetNumber == 0 && status == "" {
+				return mcp.NewToolResultError("at least one of target_project_number or status must be provided"), nil
+			}
+			if targetNumber != 0 && owner == "" {
+				return mcp.NewToolResultError("owner is required when target_project_number is provided"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			var itemQuery projectItemQuery
+			if err := client.Query(ctx, &itemQuery, map[string]any{
+				"itemId":    githubv4.ID(itemID),
+				"fieldName": githubv4.String(statusField),
+			}); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get project item", err), nil
+			}
+			item := itemQuery.Node.ProjectV2Item
+			if item.ID == nil || item.ID == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("project item %s not found", itemID)), nil
+			}
+
+			source := item.Project
+			target := source
+			if targetNumber != 0 {
+				vars := map[string]any{
+					"owner":     githubv4.String(owner),
+					"number":    githubv4.Int(targetNumber), // #nosec G115 - project numbers are small positive integers
+					"fieldName": githubv4.String(statusField),
+				}
+				if ownerType == "user" {
+					var q userProjectQuery
+					if err := client.Query(ctx, &q, vars); err != nil {
+						return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get target project", err), nil
+					}
+					target = q.User.ProjectV2
+				} else {
+					var q orgProjectQuery
+					if err := client.Query(ctx, &q, vars); err != nil {
+						return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get target project", err), nil
+					}
+					target = q.Organization.ProjectV2
+				}
+				if target.ID == nil || target.ID == "" {
+					return mcp.NewToolResultError(fmt.Sprintf("project %d not found for %s", targetNumber, owner)), nil
+				}
+			}
+			moving := target.ID != source.ID
+
+			// Resolve the status before changing anything, so a typo doesn't leave the item half moved.
+			var optionID githubv4.String
+			if status != "" {
+				optionID, status, err = resolveStatusOption(statusField, target.Field, status)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+
+			newItemID := item.ID
+			if moving {
+				var contentID githubv4.ID
+				switch item.Content.Typename {
+				case "Issue":
+					contentID = item.Content.Issue.ID
+				case "PullRequest":
+					contentID = item.Content.PullRequest.ID
+				default:
+					return mcp.NewToolResultError(fmt.Sprintf("only issues and pull requests can be moved between projects, item content is %s", item.Content.Typename)), nil
+				}
+
+				var addMutation struct {
+					AddProjectV2ItemByID struct {
+						Item struct {
+							ID githubv4.ID
+						}
+					} `graphql:"addProjectV2ItemById(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &addMutation, githubv4.AddProjectV2ItemByIdInput{
+					ProjectID: target.ID,
+					ContentID: contentID,
+				}, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to add item to target project", err), nil
+				}
+				newItemID = addMutation.AddProjectV2ItemByID.Item.ID
+
+				var deleteMutation struct {
+					DeleteProjectV2Item struct {
+						DeletedItemID githubv4.ID `graphql:"deletedItemId"`
+					} `graphql:"deleteProjectV2Item(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &deleteMutation, githubv4.DeleteProjectV2ItemInput{
+					ProjectID: source.ID,
+					ItemID:    item.ID,
+				}, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, fmt.Sprintf("item was added to the target project as %v but could not be removed from the source project", newItemID), err), nil
+				}
+			}
+
+			if status != "" {
+				var updateMutation struct {
+					UpdateProjectV2ItemFieldValue struct {
+						ProjectV2Item struct {
+							ID githubv4.ID
+						} `graphql:"projectV2Item"`
+					} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &updateMutation, githubv4.UpdateProjectV2ItemFieldValueInput{
+					ProjectID: target.ID,
+					ItemID:    newItemID,
+					FieldID:   target.Field.ProjectV2SingleSelectField.ID,
+					Value: githubv4.ProjectV2FieldValue{
+						SingleSelectOptionID: &optionID,
+					},
+				}, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to update item status", err), nil
+				}
+			}
+
+			return MarshalledTextResult(ProjectItemMove{
+				ItemID:        fmt.Sprintf("%v", newItemID),
+				ProjectID:     fmt.Sprintf("%v", target.ID),
+				ProjectNumber: int(target.Number),
+				Moved:         moving,
+				Status:        status,
+			}), nil
+		}
+}
+
+// projectV2Summary is the part of a project that list_projects returns.
+type projectV2Summary struct {
+	ID               githubv4.ID
+	Number           githubv4.Int
+	Title            githubv4.String
+	ShortDescription githubv4.String
+	Closed           githubv4.Boolean
+	URL              githubv4.String
+	Items            struct {
+		TotalCount githubv4.Int
+	}
+}
+
+type projectV2Connection struct {
+	Nodes      []projectV2Summary
+	PageInfo   PageInfoFragment
+	TotalCount githubv4.Int
+}
+
+type orgProjectsQuery struct {
+	Organization struct {
+		ProjectsV2 projectV2Connection `graphql:"projectsV2(first: $first, after: $after, query: $query)"`
+	} `graphql:"organization(login: $owner)"`
+}
+
+type userProjectsQuery struct {
+	User struct {
+		ProjectsV2 projectV2Connection `graphql:"projectsV2(first: $first, after: $after, query: $query)"`
+	} `graphql:"user(login: $owner)"`
+}
+
+// projectV2FieldNode is a field of a project. Every field has the common part; single select and
+// iteration fields also have their options.
+type projectV2FieldNode struct {
+	Common struct {
+		ID       githubv4.ID
+		Name     githubv4.String
+		DataType githubv4.String
+	} `graphql:"... on ProjectV2FieldCommon"`
+	SingleSelect struct {
+		Options []struct {
+			ID   githubv4.String
+			Name githubv4.String
+		}
+	} `graphql:"... on ProjectV2SingleSelectField"`
+	Iteration struct {
+		Configuration struct {
+			Iterations []struct {
+				ID        githubv4.String
+				Title     githubv4.String
+				StartDate githubv4.String
+			}
+		}
+	} `graphql:"... on ProjectV2IterationField"`
+}
+
+type projectV2WithFields struct {
+	projectV2Summary
+	Fields struct {
+		Nodes []projectV2FieldNode
+	} `graphql:"fields(first: 50)"`
+}
+
+type orgProjectFieldsQuery struct {
+	Organization struct {
+		ProjectV2 projectV2WithFields `graphql:"projectV2(number: $number)"`
+	} `graphql:"organization(login: $owner)"`
+}
+
+type userProjectFieldsQuery struct {
+	User struct {
+		ProjectV2 projectV2WithFields `graphql:"projectV2(number: $number)"`
+	} `graphql:"user(login: $owner)"`
+}
+
+// projectV2FieldName is the name of the field a value belongs to.
+type projectV2FieldName struct {
+	Common struct {
+		Name githubv4.String
+	} `graphql:"... on ProjectV2FieldCommon"`
+}
+
+type projectV2ItemContentFields struct {
+	Number     githubv4.Int
+	Title      githubv4.String
+	State      githubv4.String
+	URL        githubv4.String
+	Repository struct {
+		NameWithOwner githubv4.String
+	}
+}
+
+type projectV2ItemNode struct {
+	ID         githubv4.ID
+	Type       githubv4.String
+	IsArchived githubv4.Boolean
+	Content    struct {
+		Typename    githubv4.String            `graphql:"__typename"`
+		Issue       projectV2ItemContentFields `graphql:"... on Issue"`
+		PullRequest projectV2ItemContentFields `graphql:"... on PullRequest"`
+		DraftIssue  struct {
+			Title githubv4.String
+		} `graphql:"... on DraftIssue"`
+	}
+	FieldValues struct {
+		Nodes []struct {
+			Typename githubv4.String `graphql:"__typename"`
+			Text     struct {
+				Text  githubv4.String
+				Field projectV2FieldName
+			} `graphql:"... on ProjectV2ItemFieldTextValue"`
+			Number struct {
+				Number githubv4.Float
+				Field  projectV2FieldName
+			} `graphql:"... on ProjectV2ItemFieldNumberValue"`
+			Date struct {
+				Date  githubv4.String
+				Field projectV2FieldName
+			} `graphql:"... on ProjectV2ItemFieldDateValue"`
+			SingleSelect struct {
+				Name  githubv4.String
+				Field projectV2FieldName
+			} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
+			Iteration struct {
+				Title githubv4.String
+				Field projectV2FieldName
+			} `graphql:"... on ProjectV2ItemFieldIterationValue"`
+		}
+	} `graphql:"fieldValues(first: 50)"`
+}
+
+type projectV2ItemConnection struct {
+	Nodes      []projectV2ItemNode
+	PageInfo   PageInfoFragment
+	TotalCount githubv4.Int
+}
+
+type orgProjectItemsQuery struct {
+	Organization struct {
+		ProjectV2 struct {
+			Items projectV2ItemConnection `graphql:"items(first: $first, after: $after)"`
+		} `graphql:"projectV2(number: $number)"`
+	} `graphql:"organization(login: $owner)"`
+}
+
+type userProjectItemsQuery struct {
+	User struct {
+		ProjectV2 struct {
+			Items projectV2ItemConnection `graphql:"items(first: $first, after: $after)"`
+		} `graphql:"projectV2(number: $number)"`
+	} `graphql:"user(login: $owner)"`
+}
+
+// ProjectField is a field of a project, with the options of single select fields and the
+// iterations of iteration fields.
+type ProjectField struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	DataType   string   `json:"data_type"`
+	Options    []string `json:"options,omitempty"`
+	Iterations []string `json:"iterations,omitempty"`
+}
+
+// Project is a Projects v2 project.
+type Project struct {
+	ID               string         `json:"id"`
+	Number           int            `json:"number"`
+	Title            string         `json:"title"`
+	ShortDescription string         `json:"short_description,omitempty"`
+	Closed           bool           `json:"closed"`
+	URL              string         `json:"url"`
+	ItemCount        int            `json:"item_count"`
+	Fields           []ProjectField `json:"fields,omitempty"`
+}
+
+// ProjectItemContent is the issue, pull request or draft issue a project item stands for.
+type ProjectItemContent struct {
+	Type       string `json:"type"`
+	Repository string `json:"repository,omitempty"`
+	Number     int    `json:"number,omitempty"`
+	Title      string `json:"title"`
+	State      string `json:"state,omitempty"`
+	URL        string `json:"url,omitempty"`
+}
+
+// ProjectItem is an item of a project with its field values by field name.
+type ProjectItem struct {
+	ID       string             `json:"id"`
+	Archived bool               `json:"archived,omitempty"`
+	Content  ProjectItemContent `json:"content"`
+	Fields   map[string]any     `json:"fields"`
+}
+
+func projectFromSummary(p projectV2Summary) Project {
+	return Project{
+		ID:               fmt.Sprintf("%v", p.ID),
+		Number:           int(p.Number),
+		Title:            string(p.Title),
+		ShortDescription: string(p.ShortDescription),
+		Closed:           bool(p.Closed),
+		URL:              string(p.URL),
+		ItemCount:        int(p.Items.TotalCount),
+	}
+}
+
+func projectField(f projectV2FieldNode) ProjectField {
+	field := ProjectField{
+		ID:       fmt.Sprintf("%v", f.Common.ID),
+		Name:     string(f.Common.Name),
+		DataType: string(f.Common.DataType),
+	}
+	for _, option := range f.SingleSelect.Options {
+		field.Options = append(field.Options, string(option.Name))
+	}
+	for _, iteration := range f.Iteration.Configuration.Iterations {
+		field.Iterations = append(field.Iterations, string(iteration.Title))
+	}
+	return field
+}
+
+func projectItem(node projectV2ItemNode) ProjectItem {
+	item := ProjectItem{
+		ID:       fmt.Sprintf("%v", node.ID),
+		Archived: bool(node.IsArchived),
+		Fields:   map[string]any{},
+	}
+	content := node.Content.Issue
+	if node.Content.Typename == "PullRequest" {
+		content = node.Content.PullRequest
+	}
+	item.Content = ProjectItemContent{
+		Type:       string(node.Content.Typename),
+		Repository: string(content.Repository.NameWithOwner),
+		Number:     int(content.Number),
+		Title:      string(content.Title),
+		State:      string(content.State),
+		URL:        string(content.URL),
+	}
+	if node.Content.Typename == "DraftIssue" {
+		item.Content.Title = string(node.Content.DraftIssue.Title)
+	}
+
+	for _, value := range node.FieldValues.Nodes {
+		switch value.Typename {
+		case "ProjectV2ItemFieldTextValue":
+			item.Fields[string(value.Text.Field.Common.Name)] = string(value.Text.Text)
+		case "ProjectV2ItemFieldNumberValue":
+			item.Fields[string(value.Number.Field.Common.Name)] = float64(value.Number.Number)
+		case "ProjectV2ItemFieldDateValue":
+			item.Fields[string(value.Date.Field.Common.Name)] = string(value.Date.Date)
+		case "ProjectV2ItemFieldSingleSelectValue":
+			item.Fields[string(value.SingleSelect.Field.Common.Name)] = string(value.SingleSelect.Name)
+		case "ProjectV2ItemFieldIterationValue":
+			item.Fields[string(value.Iteration.Field.Common.Name)] = string(value.Iteration.Title)
+		}
+	}
+	return item
+}
+
+// getProjectWithFields looks up a project of an organization or user with its fields.
+func getProjectWithFields(ctx context.Context, client *githubv4.Client, owner, ownerType string, number int) (projectV2WithFields, error) {
+	vars := map[string]any{
+		"owner":  githubv4.String(owner),
+		"number": githubv4.Int(number), // #nosec G115 - project numbers are small positive integers
+	}
+	if ownerType == "user" {
+		var q userProjectFieldsQuery
+		err := client.Query(ctx, &q, vars)
+		return q.User.ProjectV2, err
+	}
+	var q orgProjectFieldsQuery
+	err := client.Query(ctx, &q, vars)
+	return q.Organization.ProjectV2, err
+}
+
+// projectOwnerType returns the owner_type parameter, which defaults to org.
+func projectOwnerType(request mcp.CallToolRequest) (string, error) {
+	ownerType, err := OptionalParam[string](request, "owner_type")
+	if err != nil {
+		return "", err
+	}
+	if ownerType == "" {
+		ownerType = "org"
+	}
+	return ownerType, nil
+}
+
+// ListProjects creates a tool to list the Projects v2 projects of an organization or user.
+func ListProjects(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_projects",
+			mcp.WithDescription(t("TOOL_LIST_PROJECTS_DESCRIPTION", "List the GitHub Projects of an organization or user")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECTS_USER_TITLE", "List projects"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Login of the organization or user that owns the projects"),
+			),
+			mcp.WithString("owner_type",
+				mcp.Description("Whether owner is an organization or a user (default: org)"),
+				mcp.Enum("org", "user"),
+			),
+			mcp.WithString("query",
+				mcp.Description("Only list projects matching this search, e.g. a word in the title or 'is:open'"),
+			),
+			WithCursorPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := projectOwnerType(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			query, err := OptionalParam[string](request, "query")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalCursorPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			paginationParams, err := pagination.ToGraphQLParams()
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			vars := map[string]any{
+				"owner": githubv4.String(owner),
+				"first": githubv4.Int(*paginationParams.First),
+				"after": (*githubv4.String)(nil),
+				"query": (*githubv4.String)(nil),
+			}
+			if paginationParams.After != nil {
+				vars["after"] = githubv4.String(*paginationParams.After)
+			}
+			if query != "" {
+				vars["query"] = githubv4.String(query)
+			}
+
+			var connection projectV2Connection
+			if ownerType == "user" {
+				var q userProjectsQuery
+				if err := client.Query(ctx, &q, vars); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to list projects", err), nil
+				}
+				connection = q.User.ProjectsV2
+			} else {
+				var q orgProjectsQuery
+				if err := client.Query(ctx, &q, vars); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to list projects", err), nil
+				}
+				connection = q.Organization.ProjectsV2
+			}
+
+			projects := make([]Project, 0, len(connection.Nodes))
+			for _, node := range connection.Nodes {
+				projects = append(projects, projectFromSummary(node))
+			}
+			totalCount := int(connection.TotalCount)
+			page := map[string]any{
+				"projects":   projects,
+				"totalCount": totalCount,
+				"pageInfo": map[string]any{
+					"hasNextPage": connection.PageInfo.HasNextPage,
+					"endCursor":   string(connection.PageInfo.EndCursor),
+				},
+			}
+			info := ListPageInfo{
+				TotalCount:  &totalCount,
+				HasNextPage: bool(connection.PageInfo.HasNextPage),
+				NextCursor:  string(connection.PageInfo.EndCursor),
+			}
+			return MarshalledListPageResult(ctx, page, projects, info, "no projects matched"), nil
+		}
+}
+
+// GetProject creates a tool to get a Projects v2 project with its fields.
+func GetProject(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_DESCRIPTION", "Get a GitHub Project with its fields, including the options of single select fields and the iterations of iteration fields")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_USER_TITLE", "Get project"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Login of the organization or user that owns the project"),
+			),
+			mcp.WithString("owner_type",
+				mcp.Description("Whether owner is an organization or a user (default: org)"),
+				mcp.Enum("org", "user"),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("Number of the project"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := projectOwnerType(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			number, err := RequiredInt(request, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			p, err := getProjectWithFields(ctx, client, owner, ownerType, number)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get project", err), nil
+			}
+			if p.ID == nil || p.ID == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("project %d not found for %s", number, owner)), nil
+			}
+
+			project := projectFromSummary(p.projectV2Summary)
+			for _, f := range p.Fields.Nodes {
+				project.Fields = append(project.Fields, projectField(f))
+			}
+			return MarshalledTextResult(project), nil
+		}
+}
+
+// ListProjectItems creates a tool to list the items of a Projects v2 project with their field values.
+func ListProjectItems(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_project_items",
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_ITEMS_DESCRIPTION", "List the items of a GitHub Project, with the issue, pull request or draft issue each stands for and its field values by field name")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECT_ITEMS_USER_TITLE", "List project items"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Login of the organization or user that owns the project"),
+			),
+			mcp.WithString("owner_type",
+				mcp.Description("Whether owner is an organization or a user (default: org)"),
+				mcp.Enum("org", "user"),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("Number of the project"),
+			),
+			WithCursorPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := projectOwnerType(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			number, err := RequiredInt(request, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalCursorPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			paginationParams, err := pagination.ToGraphQLParams()
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			vars := map[string]any{
+				"owner":  githubv4.String(owner),
+				"number": githubv4.Int(number), // #nosec G115 - project numbers are small positive integers
+				"first":  githubv4.Int(*paginationParams.First),
+				"after":  (*githubv4.String)(nil),
+			}
+			if paginationParams.After != nil {
+				vars["after"] = githubv4.String(*paginationParams.After)
+			}
+
+			var connection projectV2ItemConnection
+			if ownerType == "user" {
+				var q userProjectItemsQuery
+				if err := client.Query(ctx, &q, vars); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to list project items", err), nil
+				}
+				connection = q.User.ProjectV2.Items
+			} else {
+				var q orgProjectItemsQuery
+				if err := client.Query(ctx, &q, vars); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to list project items", err), nil
+				}
+				connection = q.Organization.ProjectV2.Items
+			}
+
+			items := make([]ProjectItem, 0, len(connection.Nodes))
+			for _, node := range connection.Nodes {
+				items = append(items, projectItem(node))
+			}
+			totalCount := int(connection.TotalCount)
+			page := map[string]any{
+				"items":      items,
+				"totalCount": totalCount,
+				"pageInfo": map[string]any{
+					"hasNextPage": connection.PageInfo.HasNextPage,
+					"endCursor":   string(connection.PageInfo.EndCursor),
+				},
+			}
+			info := ListPageInfo{
+				TotalCount:  &totalCount,
+				HasNextPage: bool(connection.PageInfo.HasNextPage),
+				NextCursor:  string(connection.PageInfo.EndCursor),
+			}
+			return MarshalledListPageResult(ctx, page, items, info, "the project has no items matching the query"), nil
+		}
+}
+
+// AddProjectItem creates a tool to add an issue, pull request or draft issue to a Projects v2 project.
+func AddProjectItem(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_project_item",
+			mcp.WithDescription(t("TOOL_ADD_PROJECT_ITEM_DESCRIPTION", "Add an issue or pull request to a GitHub Project, or create a draft issue in it. Give either repository and number, or draft_title")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ADD_PROJECT_ITEM_USER_TITLE", "Add project item"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Login of the organization or user that owns the project"),
+			),
+			mcp.WithString("owner_type",
+				mcp.Description("Whether owner is an organization or a user (default: org)"),
+				mcp.Enum("org", "user"),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("Number of the project"),
+			),
+			mcp.WithString("repository",
+				mcp.Description("Repository of the issue or pull request to add, as owner/name"),
+			),
+			mcp.WithNumber("number",
+				mcp.Description("Number of the issue or pull request to add"),
+			),
+			mcp.WithString("draft_title",
+				mcp.Description("Title of a draft issue to create in the project instead"),
+			),
+			mcp.WithString("draft_body",
+				mcp.Description("Body of the draft issue"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := projectOwnerType(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(request, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repository, err := OptionalParam[string](request, "repository")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			number, err := OptionalIntParam(request, "number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			draftTitle, err := OptionalParam[string](request, "draft_title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			draftBody, err := OptionalParam[string](request, "draft_body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			var repoOwner, repoName string
+			switch {
+			case draftTitle != "" && (repository != "" || number != 0):
+				return mcp.NewToolResultError("give either repository and number, or draft_title, not both"), nil
+			case draftTitle == "":
+				var ok bool
+				repoOwner, repoName, ok = strings.Cut(repository, "/")
+				if !ok || repoOwner == "" || repoName == "" || number == 0 {
+					return mcp.NewToolResultError("repository as owner/name and number are required to add an issue or pull request"), nil
+				}
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			project, err := getProjectWithFields(ctx, client, owner, ownerType, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get project", err), nil
+			}
+			if project.ID == nil || project.ID == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("project %d not found for %s", projectNumber, owner)), nil
+			}
+
+			if draftTitle != "" {
+				var mutation struct {
+					AddProjectV2DraftIssue struct {
+						ProjectItem struct {
+							ID githubv4.ID
+						}
+					} `graphql:"addProjectV2DraftIssue(input: $input)"`
+				}
+				input := githubv4.AddProjectV2DraftIssueInput{
+					ProjectID: project.ID,
+					Title:     githubv4.String(draftTitle),
+				}
+				if draftBody != "" {
+					input.Body = githubv4.NewString(githubv4.String(draftBody))
+				}
+				if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to create draft issue", err), nil
+				}
+				return MarshalledTextResult(map[string]any{
+					"item_id":    fmt.Sprintf("%v", mutation.AddProjectV2DraftIssue.ProjectItem.ID),
+					"project_id": fmt.Sprintf("%v", project.ID),
+				}), nil
+			}
+
+			var contentQuery struct {
+				Repository struct {
+					IssueOrPullRequest struct {
+						Issue       struct{ ID githubv4.ID } `graphql:"... on Issue"`
+						PullRequest struct{ ID githubv4.ID } `graphql:"... on PullRequest"`
+					} `graphql:"issueOrPullRequest(number: $number)"`
+				} `graphql:"repository(owner: $owner, name: $name)"`
+			}
+			if err := client.Query(ctx, &contentQuery, map[string]any{
+				"owner":  githubv4.String(repoOwner),
+				"name":   githubv4.String(repoName),
+				"number": githubv4.Int(number), // #nosec G115 - issue numbers are positive integers well below the int32 limit
+			}); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to find issue or pull request", err), nil
+			}
+			contentID := contentQuery.Repository.IssueOrPullRequest.Issue.ID
+			if contentID == nil || contentID == "" {
+				contentID = contentQuery.Repository.IssueOrPullRequest.PullRequest.ID
+			}
+			if contentID == nil || contentID == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("%s#%d is not an issue or pull request", repository, number)), nil
+			}
+
+			var mutation struct {
+				AddProjectV2ItemByID struct {
+					Item struct {
+						ID githubv4.ID
+					}
+				} `graphql:"addProjectV2ItemById(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, githubv4.AddProjectV2ItemByIdInput{
+				ProjectID: project.ID,
+				ContentID: contentID,
+			}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to add item to project", err), nil
+			}
+			return MarshalledTextResult(map[string]any{
+				"item_id":    fmt.Sprintf("%v", mutation.AddProjectV2ItemByID.Item.ID),
+				"project_id": fmt.Sprintf("%v", project.ID),
+			}), nil
+		}
+}
+
+// projectFieldValue converts value to the value of field, resolving single select options and
+// iterations by name, ignoring case.
+func projectFieldValue(field projectV2FieldNode, value string) (githubv4.ProjectV2FieldValue, error) {
+	name := string(field.Common.Name)
+	switch field.Common.DataType {
+	case "TEXT":
+		return githubv4.ProjectV2FieldValue{Text: githubv4.NewString(githubv4.String(value))}, nil
+	case "NUMBER":
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return githubv4.ProjectV2FieldValue{}, fmt.Errorf("field %q holds numbers, got %q", name, value)
+		}
+		return githubv4.ProjectV2FieldValue{Number: githubv4.NewFloat(githubv4.Float(n))}, nil
+	case "DATE":
+		d, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return githubv4.ProjectV2FieldValue{}, fmt.Errorf("field %q holds dates as YYYY-MM-DD, got %q", name, value)
+		}
+		return githubv4.ProjectV2FieldValue{Date: githubv4.NewDate(githubv4.Date{Time: d})}, nil
+	case "SINGLE_SELECT":
+		names := make([]string, 0, len(field.SingleSelect.Options))
+		for _, option := range field.SingleSelect.Options {
+			if strings.EqualFold(string(option.Name), value) {
+				id := option.ID
+				return githubv4.ProjectV2FieldValue{SingleSelectOptionID: &id}, nil
+			}
+			names = append(names, string(option.Name))
+		}
+		return githubv4.ProjectV2FieldValue{}, fmt.Errorf("option %q does not exist in field %q, available options: %s", value, name, strings.Join(names, ", "))
+	case "ITERATION":
+		titles := make([]string, 0, len(field.Iteration.Configuration.Iterations))
+		for _, iteration := range field.Iteration.Configuration.Iterations {
+			if strings.EqualFold(string(iteration.Title), value) {
+				id := iteration.ID
+				return githubv4.ProjectV2FieldValue{IterationID: &id}, nil
+			}
+			titles = append(titles, string(iteration.Title))
+		}
+		return githubv4.ProjectV2FieldValue{}, fmt.Errorf("iteration %q does not exist in field %q, available iterations: %s", value, name, strings.Join(titles, ", "))
+	}
+	return githubv4.ProjectV2FieldValue{}, fmt.Errorf("field %q of type %s cannot be set", name, field.Common.DataType)
+}
+
+// UpdateProjectItemField creates a tool to set or clear a field value of a Projects v2 item.
+func UpdateProjectItemField(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_project_item_field",
+			mcp.WithDescription(t("TOOL_UPDATE_PROJECT_ITEM_FIELD_DESCRIPTION", "Set or clear the value of a custom field of a GitHub Project item. The field is found by name; single select options and iterations are resolved by name, numbers and dates (YYYY-MM-DD) are parsed")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_PROJECT_ITEM_FIELD_USER_TITLE", "Update project item field"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Login of the organization or user that owns the project"),
+			),
+			mcp.WithString("owner_type",
+				mcp.Description("Whether owner is an organization or a user (default: org)"),
+				mcp.Enum("org", "user"),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("Number of the project"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Node ID of the project item"),
+			),
+			mcp.WithString("field",
+				mcp.Required(),
+				mcp.Description("Name of the field, e.g. 'Priority'. Matching is case-insensitive"),
+			),
+			mcp.WithString("value",
+				mcp.Description("Value to set. Required unless clear is true"),
+			),
+			mcp.WithBoolean("clear",
+				mcp.Description("Clear the field instead of setting it"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := projectOwnerType(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(request, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			itemID, err := RequiredParam[string](request, "item_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fieldName, err := RequiredParam[string](request, "field")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			value, err := OptionalParam[string](request, "value")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			clearValue, err := OptionalParam[bool](request, "clear")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !clearValue && value == "" {
+				return mcp.NewToolResultError("value is required unless clear is true"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			project, err := getProjectWithFields(ctx, client, owner, ownerType, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get project", err), nil
+			}
+			if project.ID == nil || project.ID == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("project %d not found for %s", projectNumber, owner)), nil
+			}
+
+			var field *projectV2FieldNode
+			names := make([]string, 0, len(project.Fields.Nodes))
+			for i, f := range project.Fields.Nodes {
+				if strings.EqualFold(string(f.Common.Name), fieldName) {
+					field = &project.Fields.Nodes[i]
+					break
+				}
+				names = append(names, string(f.Common.Name))
+			}
+			if field == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project has no field named %q, available fields: %s", fieldName, strings.Join(names, ", "))), nil
+			}
+
+			if clearValue {
+				var mutation struct {
+					ClearProjectV2ItemFieldValue struct {
+						ProjectV2Item struct {
+							ID githubv4.ID
+						} `graphql:"projectV2Item"`
+					} `graphql:"clearProjectV2ItemFieldValue(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &mutation, githubv4.ClearProjectV2ItemFieldValueInput{
+					ProjectID: project.ID,
+					ItemID:    githubv4.ID(itemID),
+					FieldID:   field.Common.ID,
+				}, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to clear field", err), nil
+				}
+				return MarshalledTextResult(map[string]any{
+					"item_id": itemID,
+					"field":   string(field.Common.Name),
+					"cleared": true,
+				}), nil
+			}
+
+			fieldValue, err := projectFieldValue(*field, value)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			var mutation struct {
+				UpdateProjectV2ItemFieldValue struct {
+					ProjectV2Item struct {
+						ID githubv4.ID
+					} `graphql:"projectV2Item"`
+				} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, githubv4.UpdateProjectV2ItemFieldValueInput{
+				ProjectID: project.ID,
+				ItemID:    githubv4.ID(itemID),
+				FieldID:   field.Common.ID,
+				Value:     fieldValue,
+			}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to update field", err), nil
+			}
+			return MarshalledTextResult(map[string]any{
+				"item_id": itemID,
+				"field":   string(field.Common.Name),
+				"value":   value,
+			}), nil
+		}
+}
@@ -0,0 +1,900 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MoveProjectItem(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := MoveProjectItem(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "move_project_item", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "item_id")
+	assert.Contains(t, tool.InputSchema.Properties, "status")
+	assert.Contains(t, tool.InputSchema.Properties, "status_field")
+	assert.Contains(t, tool.InputSchema.Properties, "target_project_number")
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "owner_type")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"item_id"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	statusField := map[string]any{
+		"id":   "PVTSSF_status",
+		"name": "Status",
+		"options": []any{
+			map[string]any{"id": "opt_todo", "name": "Todo"},
+			map[string]any{"id": "opt_progress", "name": "In Progress"},
+			map[string]any{"id": "opt_done", "name": "Done"},
+		},
+	}
+
+	itemQuery := githubv4mock.NewQueryMatcher(
+		projectItemQuery{},
+		map[string]any{
+			"itemId":    githubv4.ID("PVTI_source"),
+			"fieldName": githubv4.String("Status"),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"node": map[string]any{
+				"id": "PVTI_source",
+				"project": map[string]any{
+					"id":     "PVT_source",
+					"number": 1,
+					"field":  statusField,
+				},
+				"content": map[string]any{
+					"__typename": "Issue",
+					"id":         "I_issue",
+				},
+			},
+		}),
+	)
+
+	targetProjectQuery := githubv4mock.NewQueryMatcher(
+		orgProjectQuery{},
+		map[string]any{
+			"owner":     githubv4.String("octo-org"),
+			"number":    githubv4.Int(2),
+			"fieldName": githubv4.String("Status"),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"organization": map[string]any{
+				"projectV2": map[string]any{
+					"id":     "PVT_target",
+					"number": 2,
+					"field":  statusField,
+				},
+			},
+		}),
+	)
+
+	updateStatusMutation := func(projectID, itemID, optionID string) githubv4mock.Matcher {
+		return githubv4mock.NewMutationMatcher(
+			struct {
+				UpdateProjectV2ItemFieldValue struct {
+					ProjectV2Item struct {
+						ID githubv4.ID
+					} `graphql:"projectV2Item"`
+				} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+			}{},
+			githubv4.UpdateProjectV2ItemFieldValueInput{
+				ProjectID: githubv4.ID(projectID),
+				ItemID:    githubv4.ID(itemID),
+				FieldID:   githubv4.ID("PVTSSF_status"),
+				Value: githubv4.ProjectV2FieldValue{
+					SingleSelectOptionID: githubv4.NewString(githubv4.String(optionID)),
+				},
+			},
+			nil,
+			githubv4mock.DataResponse(map[string]any{
+				"updateProjectV2ItemFieldValue": map[string]any{
+					"projectV2Item": map[string]any{"id": itemID},
+				},
+			}),
+		)
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedResult ProjectItemMove
+	}{
+		{
+			name: "change status column",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				itemQuery,
+				updateStatusMutation("PVT_source", "PVTI_source", "opt_progress"),
+			),
+			requestArgs: map[string]any{
+				"item_id": "PVTI_source",
+				"status":  "in progress",
+			},
+			expectedResult: ProjectItemMove{
+				ItemID:        "PVTI_source",
+				ProjectID:     "PVT_source",
+				ProjectNumber: 1,
+				Moved:         false,
+				Status:        "In Progress",
+			},
+		},
+		{
+			name: "move to another project and set status",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				itemQuery,
+				targetProjectQuery,
+				githubv4mock.NewMutationMatcher(
+					struct {
+						AddProjectV2ItemByID struct {
+							Item struct {
+								ID githubv4.ID
+							}
+						} `graphql:"addProjectV2ItemById(input: $input)"`
+					}{},
+					githubv4.AddProjectV2ItemByIdInput{
+						ProjectID: githubv4.ID("PVT_target"),
+						ContentID: githubv4.ID("I_issue"),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"addProjectV2ItemById": map[string]any{
+							"item": map[string]any{"id": "PVTI_target"},
+						},
+					}),
+				),
+				githubv4mock.NewMutationMatcher(
+					struct {
+						DeleteProjectV2Item struct {
+							DeletedItemID githubv4.ID `graphql:"deletedItemId"`
+						} `graphql:"deleteProjectV2Item(input: $input)"`
+					}{},
+					githubv4.DeleteProjectV2ItemInput{
+						ProjectID: githubv4.ID("PVT_source"),
+						ItemID:    githubv4.ID("PVTI_source"),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"deleteProjectV2Item": map[string]any{"deletedItemId": "PVTI_source"},
+					}),
+				),
+				updateStatusMutation("PVT_target", "PVTI_target", "opt_done"),
+			),
+			requestArgs: map[string]any{
+				"item_id":               "PVTI_source",
+				"owner":                 "octo-org",
+				"target_project_number": float64(2),
+				"status":                "Done",
+			},
+			expectedResult: ProjectItemMove{
+				ItemID:        "PVTI_target",
+				ProjectID:     "PVT_target",
+				ProjectNumber: 2,
+				Moved:         true,
+				Status:        "Done",
+			},
+		},
+		{
+			// No mutation matchers are registered, so any attempt to move the item would fail the test
+			name: "target status does not exist",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				itemQuery,
+				targetProjectQuery,
+			),
+			requestArgs: map[string]any{
+				"item_id":               "PVTI_source",
+				"owner":                 "octo-org",
+				"target_project_number": float64(2),
+				"status":                "Blocked",
+			},
+			expectError:    true,
+			expectedErrMsg: `status "Blocked" does not exist in field "Status", available options: Todo, In Progress, Done`,
+		},
+		{
+			name:         "nothing to change",
+			mockedClient: githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"item_id": "PVTI_source",
+			},
+			expectError:    true,
+			expectedErrMsg: "at least one of target_project_number or status must be provided",
+		},
+		{
+			name:         "target project without owner",
+			mockedClient: githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"item_id":               "PVTI_source",
+				"target_project_number": float64(2),
+			},
+			expectError:    true,
+			expectedErrMsg: "owner is required when target_project_number is provided",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := githubv4.NewClient(tc.mockedClient)
+			_, handler := MoveProjectItem(stubGetGQLClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+
+			var returned ProjectItemMove
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_ListProjects(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := ListProjects(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_projects", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	projectsResponse := map[string]any{
+		"projectsV2": map[string]any{
+			"nodes": []any{
+				map[string]any{
+					"id":               "PVT_roadmap",
+					"number":           1,
+					"title":            "Roadmap",
+					"shortDescription": "What we are working on",
+					"closed":           false,
+					"url":              "https://github.com/orgs/octo-org/projects/1",
+					"items":            map[string]any{"totalCount": 12},
+				},
+			},
+			"pageInfo": map[string]any{
+				"hasNextPage":     true,
+				"hasPreviousPage": false,
+				"startCursor":     "",
+				"endCursor":       "Y3Vyc29yOjE=",
+			},
+			"totalCount": 3,
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "organization projects",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					orgProjectsQuery{},
+					map[string]any{
+						"owner": githubv4.String("octo-org"),
+						"first": githubv4.Int(10),
+						"after": (*githubv4.String)(nil),
+						"query": (*githubv4.String)(nil),
+					},
+					githubv4mock.DataResponse(map[string]any{"organization": projectsResponse}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":   "octo-org",
+				"perPage": float64(10),
+			},
+		},
+		{
+			name: "user projects matching a query",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					userProjectsQuery{},
+					map[string]any{
+						"owner": githubv4.String("octocat"),
+						"first": githubv4.Int(10),
+						"after": githubv4.String("Y3Vyc29yOjA="),
+						"query": githubv4.String("roadmap"),
+					},
+					githubv4mock.DataResponse(map[string]any{"user": projectsResponse}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "octocat",
+				"owner_type": "user",
+				"query":      "roadmap",
+				"perPage":    float64(10),
+				"after":      "Y3Vyc29yOjA=",
+			},
+		},
+		{
+			name: "owner not found",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					orgProjectsQuery{},
+					map[string]any{
+						"owner": githubv4.String("nobody"),
+						"first": githubv4.Int(10),
+						"after": (*githubv4.String)(nil),
+						"query": (*githubv4.String)(nil),
+					},
+					githubv4mock.ErrorResponse("Could not resolve to an Organization with the login of 'nobody'."),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":   "nobody",
+				"perPage": float64(10),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list projects",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := githubv4.NewClient(tc.mockedClient)
+			_, handler := ListProjects(stubGetGQLClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned struct {
+				Projects   []Project `json:"projects"`
+				TotalCount int       `json:"totalCount"`
+				PageInfo   struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, 3, returned.TotalCount)
+			assert.True(t, returned.PageInfo.HasNextPage)
+			assert.Equal(t, "Y3Vyc29yOjE=", returned.PageInfo.EndCursor)
+			assert.Equal(t, []Project{{
+				ID:               "PVT_roadmap",
+				Number:           1,
+				Title:            "Roadmap",
+				ShortDescription: "What we are working on",
+				URL:              "https://github.com/orgs/octo-org/projects/1",
+				ItemCount:        12,
+			}}, returned.Projects)
+		})
+	}
+}
+
+// projectFieldsResponse is a project with a field of every kind the project tools handle.
+func projectFieldsResponse() map[string]any {
+	return map[string]any{
+		"id":               "PVT_roadmap",
+		"number":           1,
+		"title":            "Roadmap",
+		"shortDescription": "",
+		"closed":           false,
+		"url":              "https://github.com/orgs/octo-org/projects/1",
+		"items":            map[string]any{"totalCount": 2},
+		"fields": map[string]any{
+			"nodes": []any{
+				map[string]any{"id": "PVTF_title", "name": "Title", "dataType": "TITLE"},
+				map[string]any{"id": "PVTF_notes", "name": "Notes", "dataType": "TEXT"},
+				map[string]any{"id": "PVTF_estimate", "name": "Estimate", "dataType": "NUMBER"},
+				map[string]any{"id": "PVTF_due", "name": "Due", "dataType": "DATE"},
+				map[string]any{
+					"id":       "PVTSSF_priority",
+					"name":     "Priority",
+					"dataType": "SINGLE_SELECT",
+					"options": []any{
+						map[string]any{"id": "opt_high", "name": "High"},
+						map[string]any{"id": "opt_low", "name": "Low"},
+					},
+				},
+				map[string]any{
+					"id":       "PVTIF_sprint",
+					"name":     "Sprint",
+					"dataType": "ITERATION",
+					"configuration": map[string]any{
+						"iterations": []any{
+							map[string]any{"id": "it_1", "title": "Sprint 1", "startDate": "2026-10-01"},
+							map[string]any{"id": "it_2", "title": "Sprint 2", "startDate": "2026-10-15"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func projectFieldsQueryMatcher() githubv4mock.Matcher {
+	return githubv4mock.NewQueryMatcher(
+		orgProjectFieldsQuery{},
+		map[string]any{
+			"owner":  githubv4.String("octo-org"),
+			"number": githubv4.Int(1),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"organization": map[string]any{"projectV2": projectFieldsResponse()},
+		}),
+	)
+}
+
+func Test_GetProject(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := GetProject(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_project", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "project_number"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	t.Run("project with its fields", func(t *testing.T) {
+		client := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(projectFieldsQueryMatcher()))
+		_, handler := GetProject(stubGetGQLClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":          "octo-org",
+			"project_number": float64(1),
+		}))
+		require.NoError(t, err)
+
+		var returned Project
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		assert.Equal(t, "PVT_roadmap", returned.ID)
+		assert.Equal(t, 2, returned.ItemCount)
+		require.Len(t, returned.Fields, 6)
+		assert.Equal(t, ProjectField{ID: "PVTF_notes", Name: "Notes", DataType: "TEXT"}, returned.Fields[1])
+		assert.Equal(t, []string{"High", "Low"}, returned.Fields[4].Options)
+		assert.Equal(t, []string{"Sprint 1", "Sprint 2"}, returned.Fields[5].Iterations)
+	})
+
+	t.Run("project not found", func(t *testing.T) {
+		client := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(
+				userProjectFieldsQuery{},
+				map[string]any{
+					"owner":  githubv4.String("octocat"),
+					"number": githubv4.Int(7),
+				},
+				githubv4mock.ErrorResponse("Could not resolve to a ProjectV2 with the number 7."),
+			),
+		))
+		_, handler := GetProject(stubGetGQLClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":          "octocat",
+			"owner_type":     "user",
+			"project_number": float64(7),
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to get project")
+	})
+}
+
+func Test_ListProjectItems(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := ListProjectItems(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_project_items", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "project_number"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	fieldName := func(name string) map[string]any {
+		return map[string]any{"name": name}
+	}
+	client := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+		githubv4mock.NewQueryMatcher(
+			orgProjectItemsQuery{},
+			map[string]any{
+				"owner":  githubv4.String("octo-org"),
+				"number": githubv4.Int(1),
+				"first":  githubv4.Int(30),
+				"after":  (*githubv4.String)(nil),
+			},
+			githubv4mock.DataResponse(map[string]any{
+				"organization": map[string]any{
+					"projectV2": map[string]any{
+						"items": map[string]any{
+							"nodes": []any{
+								map[string]any{
+									"id":         "PVTI_issue",
+									"type":       "ISSUE",
+									"isArchived": false,
+									"content": map[string]any{
+										"__typename": "Issue",
+										"number":     42,
+										"title":      "Crash on start",
+										"state":      "OPEN",
+										"url":        "https://github.com/octo-org/app/issues/42",
+										"repository": map[string]any{"nameWithOwner": "octo-org/app"},
+									},
+									"fieldValues": map[string]any{
+										"nodes": []any{
+											map[string]any{"__typename": "ProjectV2ItemFieldTextValue", "text": "Crash on start", "field": fieldName("Title")},
+											map[string]any{"__typename": "ProjectV2ItemFieldSingleSelectValue", "name": "High", "field": fieldName("Priority")},
+											map[string]any{"__typename": "ProjectV2ItemFieldNumberValue", "number": 3, "field": fieldName("Estimate")},
+											map[string]any{"__typename": "ProjectV2ItemFieldDateValue", "date": "2026-10-20", "field": fieldName("Due")},
+											map[string]any{"__typename": "ProjectV2ItemFieldIterationValue", "title": "Sprint 2", "field": fieldName("Sprint")},
+										},
+									},
+								},
+								map[string]any{
+									"id":         "PVTI_draft",
+									"type":       "DRAFT_ISSUE",
+									"isArchived": false,
+									"content": map[string]any{
+										"__typename": "DraftIssue",
+										"title":      "Write release notes",
+									},
+									"fieldValues": map[string]any{"nodes": []any{}},
+								},
+							},
+							"pageInfo": map[string]any{
+								"hasNextPage":     false,
+								"hasPreviousPage": false,
+								"startCursor":     "",
+								"endCursor":       "",
+							},
+							"totalCount": 2,
+						},
+					},
+				},
+			}),
+		),
+	))
+	_, handler := ListProjectItems(stubGetGQLClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":          "octo-org",
+		"project_number": float64(1),
+	}))
+	require.NoError(t, err)
+
+	var returned struct {
+		Items      []ProjectItem `json:"items"`
+		TotalCount int           `json:"totalCount"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, 2, returned.TotalCount)
+	require.Len(t, returned.Items, 2)
+	assert.Equal(t, ProjectItem{
+		ID: "PVTI_issue",
+		Content: ProjectItemContent{
+			Type:       "Issue",
+			Repository: "octo-org/app",
+			Number:     42,
+			Title:      "Crash on start",
+			State:      "OPEN",
+			URL:        "https://github.com/octo-org/app/issues/42",
+		},
+		Fields: map[string]any{
+			"Title":    "Crash on start",
+			"Priority": "High",
+			"Estimate": float64(3),
+			"Due":      "2026-10-20",
+			"Sprint":   "Sprint 2",
+		},
+	}, returned.Items[0])
+	assert.Equal(t, ProjectItemContent{Type: "DraftIssue", Title: "Write release notes"}, returned.Items[1].Content)
+	assert.Empty(t, returned.Items[1].Fields)
+}
+
+func Test_AddProjectItem(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := AddProjectItem(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "add_project_item", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "project_number"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedItemID string
+	}{
+		{
+			name: "add an issue",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				projectFieldsQueryMatcher(),
+				githubv4mock.NewQueryMatcher(
+					struct {
+						Repository struct {
+							IssueOrPullRequest struct {
+								Issue       struct{ ID githubv4.ID } `graphql:"... on Issue"`
+								PullRequest struct{ ID githubv4.ID } `graphql:"... on PullRequest"`
+							} `graphql:"issueOrPullRequest(number: $number)"`
+						} `graphql:"repository(owner: $owner, name: $name)"`
+					}{},
+					map[string]any{
+						"owner":  githubv4.String("octo-org"),
+						"name":   githubv4.String("app"),
+						"number": githubv4.Int(42),
+					},
+					githubv4mock.DataResponse(map[string]any{
+						"repository": map[string]any{
+							"issueOrPullRequest": map[string]any{"id": "I_42"},
+						},
+					}),
+				),
+				githubv4mock.NewMutationMatcher(
+					struct {
+						AddProjectV2ItemByID struct {
+							Item struct {
+								ID githubv4.ID
+							}
+						} `graphql:"addProjectV2ItemById(input: $input)"`
+					}{},
+					githubv4.AddProjectV2ItemByIdInput{
+						ProjectID: githubv4.ID("PVT_roadmap"),
+						ContentID: githubv4.ID("I_42"),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"addProjectV2ItemById": map[string]any{
+							"item": map[string]any{"id": "PVTI_42"},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":          "octo-org",
+				"project_number": float64(1),
+				"repository":     "octo-org/app",
+				"number":         float64(42),
+			},
+			expectedItemID: "PVTI_42",
+		},
+		{
+			name: "create a draft issue",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				projectFieldsQueryMatcher(),
+				githubv4mock.NewMutationMatcher(
+					struct {
+						AddProjectV2DraftIssue struct {
+							ProjectItem struct {
+								ID githubv4.ID
+							}
+						} `graphql:"addProjectV2DraftIssue(input: $input)"`
+					}{},
+					githubv4.AddProjectV2DraftIssueInput{
+						ProjectID: githubv4.ID("PVT_roadmap"),
+						Title:     githubv4.String("Write release notes"),
+						Body:      githubv4.NewString("For 2.0"),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"addProjectV2DraftIssue": map[string]any{
+							"projectItem": map[string]any{"id": "PVTI_draft"},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":          "octo-org",
+				"project_number": float64(1),
+				"draft_title":    "Write release notes",
+				"draft_body":     "For 2.0",
+			},
+			expectedItemID: "PVTI_draft",
+		},
+		{
+			name:         "nothing to add",
+			mockedClient: githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":          "octo-org",
+				"project_number": float64(1),
+				"repository":     "octo-org/app",
+			},
+			expectError:    true,
+			expectedErrMsg: "repository as owner/name and number are required",
+		},
+		{
+			name:         "both content and draft",
+			mockedClient: githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":          "octo-org",
+				"project_number": float64(1),
+				"repository":     "octo-org/app",
+				"number":         float64(42),
+				"draft_title":    "Write release notes",
+			},
+			expectError:    true,
+			expectedErrMsg: "give either repository and number, or draft_title, not both",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := githubv4.NewClient(tc.mockedClient)
+			_, handler := AddProjectItem(stubGetGQLClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned map[string]string
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expectedItemID, returned["item_id"])
+			assert.Equal(t, "PVT_roadmap", returned["project_id"])
+		})
+	}
+}
+
+func Test_UpdateProjectItemField(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := UpdateProjectItemField(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_project_item_field", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "project_number", "item_id", "field"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	updateMutation := func(fieldID string, value githubv4.ProjectV2FieldValue) *githubv4mock.Matcher {
+		m := githubv4mock.NewMutationMatcher(
+			struct {
+				UpdateProjectV2ItemFieldValue struct {
+					ProjectV2Item struct {
+						ID githubv4.ID
+					} `graphql:"projectV2Item"`
+				} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+			}{},
+			githubv4.UpdateProjectV2ItemFieldValueInput{
+				ProjectID: githubv4.ID("PVT_roadmap"),
+				ItemID:    githubv4.ID("PVTI_42"),
+				FieldID:   githubv4.ID(fieldID),
+				Value:     value,
+			},
+			nil,
+			githubv4mock.DataResponse(map[string]any{
+				"updateProjectV2ItemFieldValue": map[string]any{
+					"projectV2Item": map[string]any{"id": "PVTI_42"},
+				},
+			}),
+		)
+		return &m
+	}
+
+	clearMutation := githubv4mock.NewMutationMatcher(
+		struct {
+			ClearProjectV2ItemFieldValue struct {
+				ProjectV2Item struct {
+					ID githubv4.ID
+				} `graphql:"projectV2Item"`
+			} `graphql:"clearProjectV2ItemFieldValue(input: $input)"`
+		}{},
+		githubv4.ClearProjectV2ItemFieldValueInput{
+			ProjectID: githubv4.ID("PVT_roadmap"),
+			ItemID:    githubv4.ID("PVTI_42"),
+			FieldID:   githubv4.ID("PVTF_due"),
+		},
+		nil,
+		githubv4mock.DataResponse(map[string]any{
+			"clearProjectV2ItemFieldValue": map[string]any{
+				"projectV2Item": map[string]any{"id": "PVTI_42"},
+			},
+		}),
+	)
+
+	tests := []struct {
+		name           string
+		mutation       *githubv4mock.Matcher
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name:        "single select option by name",
+			mutation:    updateMutation("PVTSSF_priority", githubv4.ProjectV2FieldValue{SingleSelectOptionID: githubv4.NewString("opt_low")}),
+			requestArgs: map[string]any{"field": "priority", "value": "low"},
+		},
+		{
+			name:        "iteration by title",
+			mutation:    updateMutation("PVTIF_sprint", githubv4.ProjectV2FieldValue{IterationID: githubv4.NewString("it_2")}),
+			requestArgs: map[string]any{"field": "Sprint", "value": "Sprint 2"},
+		},
+		{
+			name:        "number",
+			mutation:    updateMutation("PVTF_estimate", githubv4.ProjectV2FieldValue{Number: githubv4.NewFloat(2.5)}),
+			requestArgs: map[string]any{"field": "Estimate", "value": "2.5"},
+		},
+		{
+			name:        "text",
+			mutation:    updateMutation("PVTF_notes", githubv4.ProjectV2FieldValue{Text: githubv4.NewString("Needs design")}),
+			requestArgs: map[string]any{"field": "Notes", "value": "Needs design"},
+		},
+		{
+			name:        "clear",
+			mutation:    &clearMutation,
+			requestArgs: map[string]any{"field": "Due", "clear": true},
+		},
+		{
+			name:           "unknown option",
+			requestArgs:    map[string]any{"field": "Priority", "value": "Urgent"},
+			expectError:    true,
+			expectedErrMsg: `option "Urgent" does not exist in field "Priority", available options: High, Low`,
+		},
+		{
+			name:           "malformed date",
+			requestArgs:    map[string]any{"field": "Due", "value": "next week"},
+			expectError:    true,
+			expectedErrMsg: `field "Due" holds dates as YYYY-MM-DD, got "next week"`,
+		},
+		{
+			name:           "unknown field",
+			requestArgs:    map[string]any{"field": "Owner", "value": "octocat"},
+			expectError:    true,
+			expectedErrMsg: `project has no field named "Owner"`,
+		},
+		{
+			name:           "title cannot be set",
+			requestArgs:    map[string]any{"field": "Title", "value": "New title"},
+			expectError:    true,
+			expectedErrMsg: `field "Title" of type TITLE cannot be set`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			matchers := []githubv4mock.Matcher{projectFieldsQueryMatcher()}
+			if tc.mutation != nil {
+				matchers = append(matchers, *tc.mutation)
+			}
+			client := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(matchers...))
+			_, handler := UpdateProjectItemField(stubGetGQLClientFn(client), translations.NullTranslationHelper)
+
+			args := map[string]any{
+				"owner":          "octo-org",
+				"project_number": float64(1),
+				"item_id":        "PVTI_42",
+			}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			getTextResult(t, result)
+		})
+	}
+}
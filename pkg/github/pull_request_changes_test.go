@@ -0,0 +1,198 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OpenPullRequestWithChanges(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := OpenPullRequestWithChanges(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "open_pull_request_with_changes", tool.Name)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.Properties, "draft")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "base", "branch", "message", "files", "title"})
+
+	baseRef := &github.Reference{
+		Ref:    github.Ptr("refs/heads/main"),
+		Object: &github.GitObject{SHA: github.Ptr("abc123")},
+	}
+	baseCommit := &github.Commit{
+		SHA:  github.Ptr("abc123"),
+		Tree: &github.Tree{SHA: github.Ptr("def456")},
+	}
+	newTree := &github.Tree{SHA: github.Ptr("ghi789")}
+	newCommit := &github.Commit{SHA: github.Ptr("jkl012")}
+
+	// commitHandlers serve every request up to and including the branch creation.
+	commitHandlers := func(t *testing.T) []mock.MockBackendOption {
+		return []mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposGitRefByOwnerByRepoByRef,
+				expectPath(t, "/repos/owner/repo/git/ref/heads/main").andThen(
+					mockResponse(t, http.StatusOK, baseRef),
+				),
+			),
+			mock.WithRequestMatch(mock.GetReposGitCommitsByOwnerByRepoByCommitSha, baseCommit),
+			mock.WithRequestMatchHandler(
+				mock.PostReposGitTreesByOwnerByRepo,
+				expectRequestBody(t, map[string]any{
+					"base_tree": "def456",
+					"tree": []any{
+						map[string]any{"path": "main.go", "mode": "100644", "type": "blob", "content": "package main\n"},
+					},
+				}).andThen(
+					mockResponse(t, http.StatusCreated, newTree),
+				),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposGitCommitsByOwnerByRepo,
+				expectRequestBody(t, map[string]any{
+					"message": "Add main",
+					"tree":    "ghi789",
+					"parents": []any{"abc123"},
+				}).andThen(
+					mockResponse(t, http.StatusCreated, newCommit),
+				),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposGitRefsByOwnerByRepo,
+				expectRequestBody(t, map[string]any{
+					"ref": "refs/heads/add-main",
+					"sha": "jkl012",
+				}).andThen(
+					mockResponse(t, http.StatusCreated, &github.Reference{Ref: github.Ptr("refs/heads/add-main")}),
+				),
+			),
+		}
+	}
+	args := map[string]any{
+		"owner":   "owner",
+		"repo":    "repo",
+		"base":    "main",
+		"branch":  "add-main",
+		"message": "Add main",
+		"files":   []any{map[string]any{"path": "main.go", "content": "package main\n"}},
+		"title":   "Add main",
+		"body":    "Adds the entry point.",
+	}
+
+	t.Run("creates branch, commit and pull request", func(t *testing.T) {
+		handlers := append(commitHandlers(t),
+			mock.WithRequestMatchHandler(
+				mock.PostReposPullsByOwnerByRepo,
+				expectRequestBody(t, map[string]any{
+					"title": "Add main",
+					"head":  "add-main",
+					"base":  "main",
+					"body":  "Adds the entry point.",
+					"draft": false,
+				}).andThen(
+					mockResponse(t, http.StatusCreated, &github.PullRequest{
+						Number:  github.Ptr(7),
+						HTMLURL: github.Ptr("https://github.com/owner/repo/pull/7"),
+					}),
+				),
+			),
+		)
+		_, handler := OpenPullRequestWithChanges(stubGetClientFn(github.NewClient(mock.NewMockedHTTPClient(handlers...))), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args))
+		require.NoError(t, err)
+
+		var opened PullRequestWithChanges
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &opened))
+		assert.Equal(t, PullRequestWithChanges{
+			Number:    7,
+			URL:       "https://github.com/owner/repo/pull/7",
+			Branch:    "add-main",
+			CommitSHA: "jkl012",
+		}, opened)
+	})
+
+	t.Run("branch is deleted when the pull request fails", func(t *testing.T) {
+		deleted := false
+		handlers := append(commitHandlers(t),
+			mock.WithRequestMatchHandler(
+				mock.PostReposPullsByOwnerByRepo,
+				mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Validation Failed"}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.DeleteReposGitRefsByOwnerByRepoByRef,
+				expectPath(t, "/repos/owner/repo/git/refs/heads/add-main").andThen(
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						deleted = true
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+		)
+		_, handler := OpenPullRequestWithChanges(stubGetClientFn(github.NewClient(mock.NewMockedHTTPClient(handlers...))), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to create pull request; branch add-main was deleted")
+		assert.True(t, deleted, "the branch created for the pull request should be deleted")
+	})
+
+	t.Run("failed cleanup is reported", func(t *testing.T) {
+		handlers := append(commitHandlers(t),
+			mock.WithRequestMatchHandler(
+				mock.PostReposPullsByOwnerByRepo,
+				mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Validation Failed"}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.DeleteReposGitRefsByOwnerByRepoByRef,
+				mockResponse(t, http.StatusForbidden, map[string]string{"message": "Forbidden"}),
+			),
+		)
+		_, handler := OpenPullRequestWithChanges(stubGetClientFn(github.NewClient(mock.NewMockedHTTPClient(handlers...))), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "branch add-main could not be deleted")
+	})
+
+	t.Run("existing branch is left alone", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetReposGitRefByOwnerByRepoByRef, baseRef),
+			mock.WithRequestMatch(mock.GetReposGitCommitsByOwnerByRepoByCommitSha, baseCommit),
+			mock.WithRequestMatchHandler(mock.PostReposGitTreesByOwnerByRepo, mockResponse(t, http.StatusCreated, newTree)),
+			mock.WithRequestMatchHandler(mock.PostReposGitCommitsByOwnerByRepo, mockResponse(t, http.StatusCreated, newCommit)),
+			mock.WithRequestMatchHandler(
+				mock.PostReposGitRefsByOwnerByRepo,
+				mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Reference already exists"}),
+			),
+		)
+		_, handler := OpenPullRequestWithChanges(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to create branch add-main")
+	})
+
+	t.Run("branch equal to base", func(t *testing.T) {
+		_, handler := OpenPullRequestWithChanges(stubGetClientFn(github.NewClient(mock.NewMockedHTTPClient())), translations.NullTranslationHelper)
+
+		badArgs := map[string]any{}
+		for k, v := range args {
+			badArgs[k] = v
+		}
+		badArgs["branch"] = "main"
+		result, err := handler(context.Background(), createMCPRequest(badArgs))
+		require.NoError(t, err)
+		assert.Equal(t, "branch must differ from base", getErrorResult(t, result).Text)
+	})
+}
@@ -0,0 +1,186 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	commitCommentsConcurrency = 5
+	// maxPullRequestCommits is the most commits GitHub lists for a pull request.
+	maxPullRequestCommits = 250
+)
+
+// CommitComment is a comment left on a commit, either on the commit as a whole or on a file.
+// Position is the line index in the commit's diff, as for review comments.
+type CommitComment struct {
+	ID        int64     `json:"id"`
+	User      string    `json:"user"`
+	Body      string    `json:"body"`
+	Path      string    `json:"path,omitempty"`
+	Position  int       `json:"position,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	HTMLURL   string    `json:"html_url"`
+}
+
+// CommitComments are the comments on one commit of a pull request.
+type CommitComments struct {
+	SHA      string          `json:"sha"`
+	Message  string          `json:"message"`
+	Comments []CommitComment `json:"comments"`
+}
+
+// PullRequestCommitComments groups the commit comments of a pull request by commit. Commits
+// without comments are left out.
+type PullRequestCommitComments struct {
+	CommitsScanned int              `json:"commits_scanned"`
+	TotalComments  int              `json:"total_comments"`
+	Commits        []CommitComments `json:"commits"`
+	Notes          []string         `json:"notes,omitempty"`
+}
+
+// listAllCommitComments returns every comment on a commit, following pagination.
+func listAllCommitComments(ctx context.Context, client *github.Client, owner, repo, sha string) ([]*github.RepositoryComment, error) {
+	var all []*github.RepositoryComment
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		comments, resp, err := client.Repositories.ListCommitComments(ctx, owner, repo, sha, opts)
+		if err != nil {
+			return nil, err
+		}
+		_ = resp.Body.Close()
+		all = append(all, comments...)
+		if resp.NextPage == 0 {
+			return all, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// ListPullRequestCommitComments creates a tool to list the comments on the commits of a pull request.
+func ListPullRequestCommitComments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_pull_request_commit_comments",
+			mcp.WithDescription(t("TOOL_LIST_PULL_REQUEST_COMMIT_COMMENTS_DESCRIPTION", "List the comments left directly on the commits of a pull request, grouped by commit. These are different from pull request review comments and issue comments, and are not returned by the pull request review tools.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PULL_REQUEST_COMMIT_COMMENTS_USER_TITLE", "List pull request commit comments"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var commits []*github.RepositoryCommit
+			opts := &github.ListOptions{PerPage: 100}
+			for {
+				page, resp, err := client.PullRequests.ListCommits(ctx, owner, repo, pullNumber, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list pull request commits", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				commits = append(commits, page...)
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			comments := make([][]*github.RepositoryComment, len(commits))
+			errs := make([]error, len(commits))
+			sem := make(chan struct{}, commitCommentsConcurrency)
+			var wg sync.WaitGroup
+			for i, commit := range commits {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					select {
+					case sem <- struct{}{}:
+						defer func() { <-sem }()
+					case <-ctx.Done():
+						errs[i] = ctx.Err()
+						return
+					}
+					comments[i], errs[i] = listAllCommitComments(ctx, client, owner, repo, commit.GetSHA())
+				}()
+			}
+			wg.Wait()
+
+			result := PullRequestCommitComments{
+				CommitsScanned: len(commits),
+				Commits:        []CommitComments{},
+			}
+			var failed []string
+			for i, commit := range commits {
+				if errs[i] != nil {
+					failed = append(failed, commit.GetSHA())
+					continue
+				}
+				if len(comments[i]) == 0 {
+					continue
+				}
+				message, _, _ := strings.Cut(commit.GetCommit().GetMessage(), "\n")
+				group := CommitComments{
+					SHA:      commit.GetSHA(),
+					Message:  message,
+					Comments: make([]CommitComment, 0, len(comments[i])),
+				}
+				for _, c := range comments[i] {
+					group.Comments = append(group.Comments, CommitComment{
+						ID:        c.GetID(),
+						User:      c.GetUser().GetLogin(),
+						Body:      c.GetBody(),
+						Path:      c.GetPath(),
+						Position:  c.GetPosition(),
+						CreatedAt: c.GetCreatedAt().Time,
+						HTMLURL:   c.GetHTMLURL(),
+					})
+				}
+				result.TotalComments += len(group.Comments)
+				result.Commits = append(result.Commits, group)
+			}
+			if len(failed) > 0 {
+				result.Notes = append(result.Notes, fmt.Sprintf("comments could not be fetched for %d commits: %s", len(failed), strings.Join(failed, ", ")))
+			}
+			if len(commits) == maxPullRequestCommits {
+				result.Notes = append(result.Notes, fmt.Sprintf("GitHub lists at most %d commits of a pull request; comments on later commits are not included", maxPullRequestCommits))
+			}
+
+			return MarshalledListPageResult(ctx, result, result.Commits, ListPageInfo{}, "the pull request has no commit comments"), nil
+		}
+}
@@ -0,0 +1,144 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListPullRequestCommitComments(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListPullRequestCommitComments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_pull_request_commit_comments", tool.Name)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	commits := []*github.RepositoryCommit{
+		{SHA: github.Ptr("aaa111"), Commit: &github.Commit{Message: github.Ptr("Add parser\n\nLonger description")}},
+		{SHA: github.Ptr("bbb222"), Commit: &github.Commit{Message: github.Ptr("Fix typo")}},
+		{SHA: github.Ptr("ccc333"), Commit: &github.Commit{Message: github.Ptr("Handle empty input")}},
+	}
+	commitComments := map[string][]*github.RepositoryComment{
+		"aaa111": {
+			{ID: github.Ptr(int64(1)), User: &github.User{Login: github.Ptr("reviewer")}, Body: github.Ptr("Why a regexp here?"), Path: github.Ptr("parser.go"), Position: github.Ptr(12)},
+			{ID: github.Ptr(int64(2)), User: &github.User{Login: github.Ptr("author")}, Body: github.Ptr("It is only compiled once")},
+		},
+		"ccc333": {
+			{ID: github.Ptr(int64(3)), User: &github.User{Login: github.Ptr("reviewer")}, Body: github.Ptr("Needs a test")},
+		},
+	}
+	commentsHandler := func(failing string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			sha := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/repos/owner/repo/commits/"), "/comments")
+			if sha == failing {
+				mockResponse(t, http.StatusInternalServerError, map[string]string{"message": "Internal Server Error"})(w, r)
+				return
+			}
+			comments := commitComments[sha]
+			if comments == nil {
+				comments = []*github.RepositoryComment{}
+			}
+			mockResponse(t, http.StatusOK, comments)(w, r)
+		}
+	}
+
+	t.Run("comments are grouped by commit", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposPullsCommitsByOwnerByRepoByPullNumber,
+				expectPath(t, "/repos/owner/repo/pulls/42/commits").andThen(
+					mockResponse(t, http.StatusOK, commits),
+				),
+			),
+			mock.WithRequestMatchHandler(
+				mock.GetReposCommitsCommentsByOwnerByRepoByCommitSha,
+				commentsHandler(""),
+			),
+		)
+		_, handler := ListPullRequestCommitComments(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":      "owner",
+			"repo":       "repo",
+			"pullNumber": float64(42),
+		}))
+		require.NoError(t, err)
+
+		var returned PullRequestCommitComments
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		assert.Equal(t, 3, returned.CommitsScanned)
+		assert.Equal(t, 3, returned.TotalComments)
+		assert.Empty(t, returned.Notes)
+		require.Len(t, returned.Commits, 2)
+
+		assert.Equal(t, "aaa111", returned.Commits[0].SHA)
+		assert.Equal(t, "Add parser", returned.Commits[0].Message)
+		require.Len(t, returned.Commits[0].Comments, 2)
+		assert.Equal(t, "reviewer", returned.Commits[0].Comments[0].User)
+		assert.Equal(t, "parser.go", returned.Commits[0].Comments[0].Path)
+		assert.Equal(t, 12, returned.Commits[0].Comments[0].Position)
+		assert.Equal(t, "It is only compiled once", returned.Commits[0].Comments[1].Body)
+
+		assert.Equal(t, "ccc333", returned.Commits[1].SHA)
+		require.Len(t, returned.Commits[1].Comments, 1)
+		assert.Equal(t, "Needs a test", returned.Commits[1].Comments[0].Body)
+	})
+
+	t.Run("commits whose comments cannot be fetched are noted", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(
+				mock.GetReposPullsCommitsByOwnerByRepoByPullNumber,
+				commits,
+			),
+			mock.WithRequestMatchHandler(
+				mock.GetReposCommitsCommentsByOwnerByRepoByCommitSha,
+				commentsHandler("ccc333"),
+			),
+		)
+		_, handler := ListPullRequestCommitComments(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":      "owner",
+			"repo":       "repo",
+			"pullNumber": float64(42),
+		}))
+		require.NoError(t, err)
+
+		var returned PullRequestCommitComments
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		assert.Equal(t, 2, returned.TotalComments)
+		require.Len(t, returned.Commits, 1)
+		assert.Equal(t, "aaa111", returned.Commits[0].SHA)
+		assert.Equal(t, []string{"comments could not be fetched for 1 commits: ccc333"}, returned.Notes)
+	})
+
+	t.Run("pull request not found", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposPullsCommitsByOwnerByRepoByPullNumber,
+				mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+			),
+		)
+		_, handler := ListPullRequestCommitComments(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":      "owner",
+			"repo":       "repo",
+			"pullNumber": float64(999),
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to list pull request commits")
+	})
+}
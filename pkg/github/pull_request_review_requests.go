@@ -0,0 +1,242 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ReviewRequests are the users and teams whose review of a pull request is still pending.
+type ReviewRequests struct {
+	Users []string `json:"users"`
+	Teams []string `json:"teams"`
+}
+
+func newReviewRequests(users []*github.User, teams []*github.Team) ReviewRequests {
+	requests := ReviewRequests{
+		Users: make([]string, 0, len(users)),
+		Teams: make([]string, 0, len(teams)),
+	}
+	for _, u := range users {
+		requests.Users = append(requests.Users, u.GetLogin())
+	}
+	for _, team := range teams {
+		requests.Teams = append(requests.Teams, team.GetSlug())
+	}
+	return requests
+}
+
+// ReviewRequest is a user or team whose review of a pull request is still pending, as listed in
+// the envelope result style.
+type ReviewRequest struct {
+	User string `json:"user,omitempty"`
+	Team string `json:"team,omitempty"`
+}
+
+func (r ReviewRequests) items() []ReviewRequest {
+	items := make([]ReviewRequest, 0, len(r.Users)+len(r.Teams))
+	for _, u := range r.Users {
+		items = append(items, ReviewRequest{User: u})
+	}
+	for _, team := range r.Teams {
+		items = append(items, ReviewRequest{Team: team})
+	}
+	return items
+}
+
+// reviewRequestTargets reads the users and teams a review request tool works on, at least one of
+// which must be given.
+func reviewRequestTargets(request mcp.CallToolRequest) (github.ReviewersRequest, error) {
+	users, err := OptionalStringArrayParam(request, "users")
+	if err != nil {
+		return github.ReviewersRequest{}, err
+	}
+	teams, err := OptionalStringArrayParam(request, "teams")
+	if err != nil {
+		return github.ReviewersRequest{}, err
+	}
+	if len(users) == 0 && len(teams) == 0 {
+		return github.ReviewersRequest{}, fmt.Errorf("at least one of users or teams must be given")
+	}
+	return github.ReviewersRequest{Reviewers: users, TeamReviewers: teams}, nil
+}
+
+// ListPullRequestReviewRequests creates a tool to list the pending review requests of a pull request.
+func ListPullRequestReviewRequests(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_pull_request_review_requests",
+			mcp.WithDescription(t("TOOL_LIST_PULL_REQUEST_REVIEW_REQUESTS_DESCRIPTION", "List the users and teams whose review of a pull request is still pending. A reviewer is no longer listed once they submit a review.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PULL_REQUEST_REVIEW_REQUESTS_USER_TITLE", "List pull request review requests"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			reviewers, resp, err := client.PullRequests.ListReviewers(ctx, owner, repo, pullNumber, nil)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list review requests", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			requests := newReviewRequests(reviewers.Users, reviewers.Teams)
+			return MarshalledListPageResult(ctx, requests, requests.items(), ListPageInfo{}, "the pull request has no pending review requests"), nil
+		}
+}
+
+// RequestPullRequestReviewers creates a tool to request reviews of a pull request from users and teams.
+func RequestPullRequestReviewers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("request_pull_request_reviewers",
+			mcp.WithDescription(t("TOOL_REQUEST_PULL_REQUEST_REVIEWERS_DESCRIPTION", "Request reviews of a pull request from users and teams, who are then notified. Reviewers need read access to the repository; teams must belong to the repository owner's organization. Returns all pending review requests.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_REQUEST_PULL_REQUEST_REVIEWERS_USER_TITLE", "Request pull request reviewers"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithArray("users",
+				mcp.Description("Logins of the users to request a review from"),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+			mcp.WithArray("teams",
+				mcp.Description("Slugs of the teams to request a review from"),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			reviewers, err := reviewRequestTargets(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			pr, resp, err := client.PullRequests.RequestReviewers(ctx, owner, repo, pullNumber, reviewers)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to request reviewers", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newReviewRequests(pr.RequestedReviewers, pr.RequestedTeams)), nil
+		}
+}
+
+// RemovePullRequestReviewRequests creates a tool to withdraw review requests of a pull request.
+func RemovePullRequestReviewRequests(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_pull_request_review_requests",
+			mcp.WithDescription(t("TOOL_REMOVE_PULL_REQUEST_REVIEW_REQUESTS_DESCRIPTION", "Withdraw pending review requests of a pull request from users and teams. Reviews they already submitted are kept.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_REMOVE_PULL_REQUEST_REVIEW_REQUESTS_USER_TITLE", "Remove pull request review requests"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithArray("users",
+				mcp.Description("Logins of the users whose review request to remove"),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+			mcp.WithArray("teams",
+				mcp.Description("Slugs of the teams whose review request to remove"),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			reviewers, err := reviewRequestTargets(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.PullRequests.RemoveReviewers(ctx, owner, repo, pullNumber, reviewers)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to remove review requests", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Removed %d review requests from pull request #%d in %s/%s", len(reviewers.Reviewers)+len(reviewers.TeamReviewers), pullNumber, owner, repo)), nil
+		}
+}
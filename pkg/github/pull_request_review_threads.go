@@ -0,0 +1,334 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ReviewThreadComment is one comment of a review thread.
+type ReviewThreadComment struct {
+	ID        int64     `json:"id"`
+	User      string    `json:"user"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	HTMLURL   string    `json:"html_url"`
+}
+
+// PullRequestReviewThread is a review comment on the diff of a pull request with its replies. Its
+// ID is the ID of the first comment, which replies are made to. Line is empty when the thread
+// is outdated, that is when the lines it is on changed since.
+type PullRequestReviewThread struct {
+	ID        int64                 `json:"id"`
+	Path      string                `json:"path"`
+	Line      int                   `json:"line,omitempty"`
+	StartLine int                   `json:"start_line,omitempty"`
+	Side      string                `json:"side,omitempty"`
+	Outdated  bool                  `json:"outdated"`
+	Comments  []ReviewThreadComment `json:"comments"`
+}
+
+// ReviewThreadContext is where on the diff of a pull request a review thread is, with the diff
+// hunk it was left on.
+type ReviewThreadContext struct {
+	ThreadID         int64  `json:"thread_id"`
+	Path             string `json:"path"`
+	Line             int    `json:"line,omitempty"`
+	StartLine        int    `json:"start_line,omitempty"`
+	Side             string `json:"side,omitempty"`
+	OriginalLine     int    `json:"original_line,omitempty"`
+	CommitID         string `json:"commit_id"`
+	OriginalCommitID string `json:"original_commit_id"`
+	Outdated         bool   `json:"outdated"`
+	DiffHunk         string `json:"diff_hunk"`
+}
+
+func newReviewThreadComment(c *github.PullRequestComment) ReviewThreadComment {
+	return ReviewThreadComment{
+		ID:        c.GetID(),
+		User:      c.GetUser().GetLogin(),
+		Body:      c.GetBody(),
+		CreatedAt: c.GetCreatedAt().Time,
+		HTMLURL:   c.GetHTMLURL(),
+	}
+}
+
+// listAllReviewComments returns every review comment of a pull request, following pagination.
+func listAllReviewComments(ctx context.Context, client *github.Client, owner, repo string, pullNumber int) ([]*github.PullRequestComment, *github.Response, error) {
+	var all []*github.PullRequestComment
+	opts := &github.PullRequestListCommentsOptions{
+		Sort:        "created",
+		Direction:   "asc",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	for {
+		comments, resp, err := client.PullRequests.ListComments(ctx, owner, repo, pullNumber, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		all = append(all, comments...)
+		if resp.NextPage == 0 {
+			return all, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// groupReviewThreads groups review comments into threads. Replies always point at the first
+// comment of their thread.
+func groupReviewThreads(comments []*github.PullRequestComment) []PullRequestReviewThread {
+	threads := make(map[int64]*PullRequestReviewThread)
+	var order []int64
+	for _, c := range comments {
+		if c.InReplyTo != nil {
+			continue
+		}
+		threads[c.GetID()] = &PullRequestReviewThread{
+			ID:        c.GetID(),
+			Path:      c.GetPath(),
+			Line:      c.GetLine(),
+			StartLine: c.GetStartLine(),
+			Side:      c.GetSide(),
+			Outdated:  c.Line == nil,
+			Comments:  []ReviewThreadComment{newReviewThreadComment(c)},
+		}
+		order = append(order, c.GetID())
+	}
+	for _, c := range comments {
+		if c.InReplyTo == nil {
+			continue
+		}
+		// A reply to a comment that is no longer listed is left out.
+		if thread, ok := threads[c.GetInReplyTo()]; ok {
+			thread.Comments = append(thread.Comments, newReviewThreadComment(c))
+		}
+	}
+
+	result := make([]PullRequestReviewThread, 0, len(order))
+	for _, id := range order {
+		thread := threads[id]
+		sort.SliceStable(thread.Comments, func(i, j int) bool {
+			return thread.Comments[i].CreatedAt.Before(thread.Comments[j].CreatedAt)
+		})
+		result = append(result, *thread)
+	}
+	return result
+}
+
+// ListPullRequestReviewThreads creates a tool to list the review comments of a pull request grouped
+// by thread.
+func ListPullRequestReviewThreads(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_pull_request_review_threads",
+			mcp.WithDescription(t("TOOL_LIST_PULL_REQUEST_REVIEW_THREADS_DESCRIPTION", "List the review comments on the diff of a pull request grouped into conversation threads, in the order they were started. Each thread has the file and lines it is on and its comments oldest first. Use the thread ID to reply with reply_to_pull_request_review_thread or to get the diff it is on with get_pull_request_review_thread_context.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PULL_REQUEST_REVIEW_THREADS_USER_TITLE", "List pull request review threads"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithString("path",
+				mcp.Description("Only list threads on this file"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := OptionalParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			comments, resp, err := listAllReviewComments(ctx, client, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get pull request review comments", resp, err), nil
+			}
+
+			threads := groupReviewThreads(comments)
+			if path != "" {
+				filtered := make([]PullRequestReviewThread, 0, len(threads))
+				for _, thread := range threads {
+					if thread.Path == path {
+						filtered = append(filtered, thread)
+					}
+				}
+				threads = filtered
+			}
+			return MarshalledListResult(ctx, threads, "no review threads matched"), nil
+		}
+}
+
+// ReplyToPullRequestReviewThread creates a tool to reply to a review thread of a pull request.
+func ReplyToPullRequestReviewThread(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("reply_to_pull_request_review_thread",
+			mcp.WithDescription(t("TOOL_REPLY_TO_PULL_REQUEST_REVIEW_THREAD_DESCRIPTION", "Reply to a review thread on the diff of a pull request. The reply is posted right away, not as part of a pending review.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_REPLY_TO_PULL_REQUEST_REVIEW_THREAD_USER_TITLE", "Reply to pull request review thread"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithNumber("thread_id",
+				mcp.Required(),
+				mcp.Description("ID of the thread, as returned by list_pull_request_review_threads. The ID of any comment in the thread works too"),
+			),
+			mcp.WithString("body",
+				mcp.Required(),
+				mcp.Description("Text of the reply"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			threadID, err := RequiredInt(request, "thread_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := RequiredParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// GitHub does not accept replies to replies, so reply to the first comment of the thread.
+			comment, resp, err := client.PullRequests.GetComment(ctx, owner, repo, int64(threadID))
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get review comment %d", threadID), resp, err), nil
+			}
+			_ = resp.Body.Close()
+			rootID := comment.GetID()
+			if comment.InReplyTo != nil {
+				rootID = comment.GetInReplyTo()
+			}
+
+			reply, resp, err := client.PullRequests.CreateCommentInReplyTo(ctx, owner, repo, pullNumber, body, rootID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to reply to review thread %d", rootID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newReviewThreadComment(reply)), nil
+		}
+}
+
+// GetPullRequestReviewThreadContext creates a tool to get the diff hunk a review thread was left on.
+func GetPullRequestReviewThreadContext(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pull_request_review_thread_context",
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_REVIEW_THREAD_CONTEXT_DESCRIPTION", "Get the diff hunk a review thread of a pull request was left on, ending at the commented line, with the file, lines and commits it refers to. Outdated threads are on lines that changed since; compare commit_id with the head of the pull request to see whether the comment still applies.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PULL_REQUEST_REVIEW_THREAD_CONTEXT_USER_TITLE", "Get pull request review thread context"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("thread_id",
+				mcp.Required(),
+				mcp.Description("ID of the thread, as returned by list_pull_request_review_threads. The ID of any comment in the thread works too"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			threadID, err := RequiredInt(request, "thread_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			comment, resp, err := client.PullRequests.GetComment(ctx, owner, repo, int64(threadID))
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get review comment %d", threadID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// Replies share the position and diff hunk of the first comment of their thread.
+			rootID := comment.GetID()
+			if comment.InReplyTo != nil {
+				rootID = comment.GetInReplyTo()
+			}
+			return MarshalledTextResult(ReviewThreadContext{
+				ThreadID:         rootID,
+				Path:             comment.GetPath(),
+				Line:             comment.GetLine(),
+				StartLine:        comment.GetStartLine(),
+				Side:             comment.GetSide(),
+				OriginalLine:     comment.GetOriginalLine(),
+				CommitID:         comment.GetCommitID(),
+				OriginalCommitID: comment.GetOriginalCommitID(),
+				Outdated:         comment.Line == nil,
+				DiffHunk:         comment.GetDiffHunk(),
+			}), nil
+		}
+}
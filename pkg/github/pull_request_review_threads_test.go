@@ -0,0 +1,362 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListPullRequestReviewThreads(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListPullRequestReviewThreads(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_pull_request_review_threads", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	at := func(minute int) *github.Timestamp {
+		return &github.Timestamp{Time: time.Date(2026, 10, 1, 9, minute, 0, 0, time.UTC)}
+	}
+	comment := func(id int64, replyTo int64, user, body, path string, line *int, minute int) *github.PullRequestComment {
+		c := &github.PullRequestComment{
+			ID:        github.Ptr(id),
+			User:      &github.User{Login: github.Ptr(user)},
+			Body:      github.Ptr(body),
+			Path:      github.Ptr(path),
+			Line:      line,
+			Side:      github.Ptr("RIGHT"),
+			CreatedAt: at(minute),
+			HTMLURL:   github.Ptr("https://github.com/owner/repo/pull/42#discussion_r" + body),
+		}
+		if replyTo != 0 {
+			c.InReplyTo = github.Ptr(replyTo)
+		}
+		return c
+	}
+	// The second page holds a reply to a thread of the first page.
+	pages := [][]*github.PullRequestComment{
+		{
+			comment(1, 0, "reviewer", "a", "parser.go", github.Ptr(12), 0),
+			comment(2, 0, "reviewer", "b", "lexer.go", nil, 1),
+			comment(3, 1, "author", "c", "parser.go", github.Ptr(12), 2),
+		},
+		{
+			comment(4, 1, "reviewer", "d", "parser.go", github.Ptr(12), 3),
+		},
+	}
+	commentsHandler := func(w http.ResponseWriter, r *http.Request) {
+		page := 0
+		if r.URL.Query().Get("page") == "2" {
+			page = 1
+		} else {
+			w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/pulls/42/comments?page=2>; rel="next"`)
+		}
+		mockResponse(t, http.StatusOK, pages[page])(w, r)
+	}
+
+	parserThread := PullRequestReviewThread{
+		ID:   1,
+		Path: "parser.go",
+		Line: 12,
+		Side: "RIGHT",
+		Comments: []ReviewThreadComment{
+			{ID: 1, User: "reviewer", Body: "a", CreatedAt: at(0).Time, HTMLURL: "https://github.com/owner/repo/pull/42#discussion_ra"},
+			{ID: 3, User: "author", Body: "c", CreatedAt: at(2).Time, HTMLURL: "https://github.com/owner/repo/pull/42#discussion_rc"},
+			{ID: 4, User: "reviewer", Body: "d", CreatedAt: at(3).Time, HTMLURL: "https://github.com/owner/repo/pull/42#discussion_rd"},
+		},
+	}
+	lexerThread := PullRequestReviewThread{
+		ID:       2,
+		Path:     "lexer.go",
+		Side:     "RIGHT",
+		Outdated: true,
+		Comments: []ReviewThreadComment{
+			{ID: 2, User: "reviewer", Body: "b", CreatedAt: at(1).Time, HTMLURL: "https://github.com/owner/repo/pull/42#discussion_rb"},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       []PullRequestReviewThread
+	}{
+		{
+			name: "comments grouped into threads",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposPullsCommentsByOwnerByRepoByPullNumber, http.HandlerFunc(commentsHandler)),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expected: []PullRequestReviewThread{parserThread, lexerThread},
+		},
+		{
+			name: "threads on one file",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposPullsCommentsByOwnerByRepoByPullNumber, http.HandlerFunc(commentsHandler)),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"path":       "lexer.go",
+			},
+			expected: []PullRequestReviewThread{lexerThread},
+		},
+		{
+			name: "pull request not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request review comments",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListPullRequestReviewThreads(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned []PullRequestReviewThread
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
+
+func Test_ReplyToPullRequestReviewThread(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ReplyToPullRequestReviewThread(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "reply_to_pull_request_review_thread", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber", "thread_id", "body"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	createdAt := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	reply := mock.WithRequestMatchHandler(
+		mock.PostReposPullsCommentsByOwnerByRepoByPullNumber,
+		expect(t, expectations{
+			path:        "/repos/owner/repo/pulls/42/comments",
+			requestBody: map[string]any{"body": "Done, thanks!", "in_reply_to": float64(1)},
+		}).andThen(
+			mockResponse(t, http.StatusCreated, &github.PullRequestComment{
+				ID:        github.Ptr(int64(5)),
+				InReplyTo: github.Ptr(int64(1)),
+				User:      &github.User{Login: github.Ptr("author")},
+				Body:      github.Ptr("Done, thanks!"),
+				CreatedAt: &github.Timestamp{Time: createdAt},
+				HTMLURL:   github.Ptr("https://github.com/owner/repo/pull/42#discussion_r5"),
+			}),
+		),
+	)
+	expectedReply := ReviewThreadComment{
+		ID:        5,
+		User:      "author",
+		Body:      "Done, thanks!",
+		CreatedAt: createdAt,
+		HTMLURL:   "https://github.com/owner/repo/pull/42#discussion_r5",
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "reply to the first comment of a thread",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsCommentsByOwnerByRepoByCommentId,
+					expectPath(t, "/repos/owner/repo/pulls/comments/1").andThen(
+						mockResponse(t, http.StatusOK, &github.PullRequestComment{ID: github.Ptr(int64(1))}),
+					),
+				),
+				reply,
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"thread_id":  float64(1),
+				"body":       "Done, thanks!",
+			},
+		},
+		{
+			name: "reply to a reply goes to the first comment",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsCommentsByOwnerByRepoByCommentId,
+					mockResponse(t, http.StatusOK, &github.PullRequestComment{ID: github.Ptr(int64(3)), InReplyTo: github.Ptr(int64(1))}),
+				),
+				reply,
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"thread_id":  float64(3),
+				"body":       "Done, thanks!",
+			},
+		},
+		{
+			name: "thread not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsCommentsByOwnerByRepoByCommentId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"thread_id":  float64(99),
+				"body":       "Done, thanks!",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get review comment 99",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ReplyToPullRequestReviewThread(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned ReviewThreadComment
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, expectedReply, returned)
+		})
+	}
+}
+
+func Test_GetPullRequestReviewThreadContext(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPullRequestReviewThreadContext(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_pull_request_review_thread_context", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "thread_id"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	diffHunk := "@@ -10,3 +10,4 @@ func parse(s string) {\n \tif s == \"\" {\n+\t\treturn nil\n"
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+		expected       ReviewThreadContext
+	}{
+		{
+			name: "outdated reply",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsCommentsByOwnerByRepoByCommentId,
+					expectPath(t, "/repos/owner/repo/pulls/comments/3").andThen(
+						mockResponse(t, http.StatusOK, &github.PullRequestComment{
+							ID:               github.Ptr(int64(3)),
+							InReplyTo:        github.Ptr(int64(1)),
+							Path:             github.Ptr("parser.go"),
+							Side:             github.Ptr("RIGHT"),
+							OriginalLine:     github.Ptr(12),
+							CommitID:         github.Ptr("def456"),
+							OriginalCommitID: github.Ptr("abc123"),
+							DiffHunk:         github.Ptr(diffHunk),
+						}),
+					),
+				),
+			),
+			expected: ReviewThreadContext{
+				ThreadID:         1,
+				Path:             "parser.go",
+				Side:             "RIGHT",
+				OriginalLine:     12,
+				CommitID:         "def456",
+				OriginalCommitID: "abc123",
+				Outdated:         true,
+				DiffHunk:         diffHunk,
+			},
+		},
+		{
+			name: "comment not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsCommentsByOwnerByRepoByCommentId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get review comment 3",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPullRequestReviewThreadContext(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"thread_id": float64(3),
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned ReviewThreadContext
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/google/go-github/v74/github"
@@ -208,6 +210,60 @@ func CreatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 		}
 }
 
+// setPullRequestDraft converts a pull request to a draft or marks it ready for review. It
+// reports whether the pull request changed, which it does not when already in that state.
+func setPullRequestDraft(ctx context.Context, client *githubv4.Client, owner, repo string, pullNumber int, draft bool) (bool, error) {
+	var prQuery struct {
+		Repository struct {
+			PullRequest struct {
+				ID      githubv4.ID
+				IsDraft githubv4.Boolean
+			} `graphql:"pullRequest(number: $prNum)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+	err := client.Query(ctx, &prQuery, map[string]interface{}{
+		"owner": githubv4.String(owner),
+		"repo":  githubv4.String(repo),
+		"prNum": githubv4.Int(pullNumber), // #nosec G115 - pull request numbers are always small positive integers
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to find pull request: %w", err)
+	}
+
+	pr := prQuery.Repository.PullRequest
+	if bool(pr.IsDraft) == draft {
+		return false, nil
+	}
+
+	if draft {
+		var mutation struct {
+			ConvertPullRequestToDraft struct {
+				PullRequest struct {
+					ID      githubv4.ID
+					IsDraft githubv4.Boolean
+				}
+			} `graphql:"convertPullRequestToDraft(input: $input)"`
+		}
+		if err := client.Mutate(ctx, &mutation, githubv4.ConvertPullRequestToDraftInput{PullRequestID: pr.ID}, nil); err != nil {
+			return false, fmt.Errorf("failed to convert pull request to draft: %w", err)
+		}
+		return true, nil
+	}
+
+	var mutation struct {
+		MarkPullRequestReadyForReview struct {
+			PullRequest struct {
+				ID      githubv4.ID
+				IsDraft githubv4.Boolean
+			}
+		} `graphql:"markPullRequestReadyForReview(input: $input)"`
+	}
+	if err := client.Mutate(ctx, &mutation, githubv4.MarkPullRequestReadyForReviewInput{PullRequestID: pr.ID}, nil); err != nil {
+		return false, fmt.Errorf("failed to mark pull request ready for review: %w", err)
+	}
+	return true, nil
+}
+
 // UpdatePullRequest creates a tool to update an existing pull request.
 func UpdatePullRequest(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	return mcp.NewTool("update_pull_request",
@@ -361,62 +417,8 @@ func UpdatePullRequest(getClient GetClientFn, getGQLClient GetGQLClientFn, t tra
 					return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
 				}
 
-				var prQuery struct {
-					Repository struct {
-						PullRequest struct {
-							ID      githubv4.ID
-							IsDraft githubv4.Boolean
-						} `graphql:"pullRequest(number: $prNum)"`
-					} `graphql:"repository(owner: $owner, name: $repo)"`
-				}
-
-				err = gqlClient.Query(ctx, &prQuery, map[string]interface{}{
-					"owner": githubv4.String(owner),
-					"repo":  githubv4.String(repo),
-					"prNum": githubv4.Int(pullNumber), // #nosec G115 - pull request numbers are always small positive integers
-				})
-				if err != nil {
-					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to find pull request", err), nil
-				}
-
-				currentIsDraft := bool(prQuery.Repository.PullRequest.IsDraft)
-
-				if currentIsDraft != draftValue {
-					if draftValue {
-						// Convert to draft
-						var mutation struct {
-							ConvertPullRequestToDraft struct {
-								PullRequest struct {
-									ID      githubv4.ID
-									IsDraft githubv4.Boolean
-								}
-							} `graphql:"convertPullRequestToDraft(input: $input)"`
-						}
-
-						err = gqlClient.Mutate(ctx, &mutation, githubv4.ConvertPullRequestToDraftInput{
-							PullRequestID: prQuery.Repository.PullRequest.ID,
-						}, nil)
-						if err != nil {
-							return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to convert pull request to draft", err), nil
-						}
-					} else {
-						// Mark as ready for review
-						var mutation struct {
-							MarkPullRequestReadyForReview struct {
-								PullRequest struct {
-									ID      githubv4.ID
-									IsDraft githubv4.Boolean
-								}
-							} `graphql:"markPullRequestReadyForReview(input: $input)"`
-						}
-
-						err = gqlClient.Mutate(ctx, &mutation, githubv4.MarkPullRequestReadyForReviewInput{
-							PullRequestID: prQuery.Repository.PullRequest.ID,
-						}, nil)
-						if err != nil {
-							return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to mark pull request ready for review", err), nil
-						}
-					}
+				if _, err := setPullRequestDraft(ctx, gqlClient, owner, repo, pullNumber, draftValue); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to update draft state of pull request", err), nil
 				}
 			}
 
@@ -588,12 +590,7 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list pull requests: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(prs)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledListResult(ctx, prs, "no pull requests matched"), nil
 		}
 }
 
@@ -690,6 +687,250 @@ func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFun
 		}
 }
 
+// MarkPullRequestReadyForReview creates a tool to mark a draft pull request ready for review.
+func MarkPullRequestReadyForReview(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("mark_pull_request_ready_for_review",
+			mcp.WithDescription(t("TOOL_MARK_PULL_REQUEST_READY_FOR_REVIEW_DESCRIPTION", "Mark a draft pull request as ready for review, which notifies its requested reviewers and code owners.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_MARK_PULL_REQUEST_READY_FOR_REVIEW_USER_TITLE", "Mark pull request ready for review"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			changed, err := setPullRequestDraft(ctx, client, owner, repo, pullNumber, false)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to update pull request", err), nil
+			}
+			if !changed {
+				return mcp.NewToolResultText(fmt.Sprintf("Pull request #%d in %s/%s is already ready for review", pullNumber, owner, repo)), nil
+			}
+			return mcp.NewToolResultText(fmt.Sprintf("Marked pull request #%d in %s/%s ready for review", pullNumber, owner, repo)), nil
+		}
+}
+
+// ConvertPullRequestToDraft creates a tool to convert a pull request back to a draft.
+func ConvertPullRequestToDraft(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("convert_pull_request_to_draft",
+			mcp.WithDescription(t("TOOL_CONVERT_PULL_REQUEST_TO_DRAFT_DESCRIPTION", "Convert an open pull request back to a draft, so it cannot be merged and code owners are not asked to review it until it is marked ready again.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CONVERT_PULL_REQUEST_TO_DRAFT_USER_TITLE", "Convert pull request to draft"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			changed, err := setPullRequestDraft(ctx, client, owner, repo, pullNumber, true)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to update pull request", err), nil
+			}
+			if !changed {
+				return mcp.NewToolResultText(fmt.Sprintf("Pull request #%d in %s/%s is already a draft", pullNumber, owner, repo)), nil
+			}
+			return mcp.NewToolResultText(fmt.Sprintf("Converted pull request #%d in %s/%s to a draft", pullNumber, owner, repo)), nil
+		}
+}
+
+// UpdatePullRequestAutoMerge creates a tool to enable or disable auto-merge on a pull request.
+func UpdatePullRequestAutoMerge(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("update_pull_request_auto_merge",
+			mcp.WithDescription(t("TOOL_UPDATE_PULL_REQUEST_AUTO_MERGE_DESCRIPTION", "Enable or disable auto-merge on a pull request. With auto-merge enabled, the pull request is merged with the given method and commit message as soon as all required reviews and checks pass. The repository must allow auto-merge.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_PULL_REQUEST_AUTO_MERGE_USER_TITLE", "Enable or disable pull request auto-merge"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithBoolean("enable",
+				mcp.Description("Enable auto-merge (default), or disable it when false"),
+			),
+			mcp.WithString("merge_method",
+				mcp.Description("Merge method once the pull request can be merged. Defaults to merge. Ignored when the branch has a merge queue"),
+				mcp.Enum("merge", "squash", "rebase"),
+			),
+			mcp.WithString("commit_title",
+				mcp.Description("Title for merge commit"),
+			),
+			mcp.WithString("commit_message",
+				mcp.Description("Extra detail for merge commit"),
+			),
+			mcp.WithString("expected_head_sha",
+				mcp.Description("Only enable auto-merge if the head of the pull request is this commit"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			enable, enableSet, err := OptionalParamOK[bool](request, "enable")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !enableSet {
+				enable = true
+			}
+			mergeMethod, err := OptionalParam[string](request, "merge_method")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commitTitle, err := OptionalParam[string](request, "commit_title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commitMessage, err := OptionalParam[string](request, "commit_message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			expectedHeadSHA, err := OptionalParam[string](request, "expected_head_sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !enable && (mergeMethod != "" || commitTitle != "" || commitMessage != "" || expectedHeadSHA != "") {
+				return mcp.NewToolResultError("merge_method, commit_title, commit_message and expected_head_sha can only be given when enabling auto-merge"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			prID, err := pullRequestNodeID(ctx, client, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get pull request", err), nil
+			}
+
+			if !enable {
+				var mutation struct {
+					DisablePullRequestAutoMerge struct {
+						PullRequest struct {
+							Number githubv4.Int
+						}
+					} `graphql:"disablePullRequestAutoMerge(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &mutation, githubv4.DisablePullRequestAutoMergeInput{PullRequestID: prID}, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to disable auto-merge", err), nil
+				}
+				return mcp.NewToolResultText(fmt.Sprintf("Disabled auto-merge on pull request #%d in %s/%s", pullNumber, owner, repo)), nil
+			}
+
+			input := githubv4.EnablePullRequestAutoMergeInput{PullRequestID: prID}
+			if mergeMethod != "" {
+				method := githubv4.PullRequestMergeMethod(strings.ToUpper(mergeMethod))
+				input.MergeMethod = &method
+			}
+			if commitTitle != "" {
+				input.CommitHeadline = githubv4.NewString(githubv4.String(commitTitle))
+			}
+			if commitMessage != "" {
+				input.CommitBody = githubv4.NewString(githubv4.String(commitMessage))
+			}
+			if expectedHeadSHA != "" {
+				input.ExpectedHeadOid = githubv4.NewGitObjectID(githubv4.GitObjectID(expectedHeadSHA))
+			}
+			var mutation struct {
+				EnablePullRequestAutoMerge struct {
+					PullRequest struct {
+						AutoMergeRequest struct {
+							MergeMethod githubv4.PullRequestMergeMethod
+							EnabledAt   githubv4.DateTime
+							EnabledBy   struct {
+								Login githubv4.String
+							}
+						}
+					}
+				} `graphql:"enablePullRequestAutoMerge(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to enable auto-merge", err), nil
+			}
+
+			autoMerge := mutation.EnablePullRequestAutoMerge.PullRequest.AutoMergeRequest
+			return MarshalledTextResult(map[string]any{
+				"pull_request": pullNumber,
+				"merge_method": strings.ToLower(string(autoMerge.MergeMethod)),
+				"enabled_by":   string(autoMerge.EnabledBy.Login),
+				"enabled_at":   autoMerge.EnabledAt.Time,
+			}), nil
+		}
+}
+
 // SearchPullRequests creates a tool to search for pull requests.
 func SearchPullRequests(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("search_pull_requests",
@@ -708,6 +949,9 @@ func SearchPullRequests(getClient GetClientFn, t translations.TranslationHelperF
 			mcp.WithString("repo",
 				mcp.Description("Optional repository name. If provided with owner, only pull requests for this repository are listed."),
 			),
+			mcp.WithString("milestone",
+				mcp.Description("Optional milestone title. Only pull requests in this milestone are listed; use \"none\" for pull requests without a milestone."),
+			),
 			mcp.WithString("sort",
 				mcp.Description("Sort field by number of matches of categories, defaults to best match"),
 				mcp.Enum(
@@ -895,6 +1139,334 @@ func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelpe
 		}
 }
 
+// maxPullRequestCheckAnnotations caps how many annotations get_pull_request_check_annotations
+// returns, so a noisy linter can't flood the context.
+const maxPullRequestCheckAnnotations = 300
+
+// PullRequestCheckAnnotation is an annotation a check run left on a line of code.
+type PullRequestCheckAnnotation struct {
+	CheckRun  string `json:"check_run"`
+	Path      string `json:"path"`
+	StartLine int    `json:"start_line"`
+	EndLine   int    `json:"end_line"`
+	Level     string `json:"level"`
+	Title     string `json:"title,omitempty"`
+	Message   string `json:"message"`
+}
+
+// PullRequestCheckAnnotations are the annotations of the check runs on a pull request's head commit.
+type PullRequestCheckAnnotations struct {
+	HeadSHA     string                       `json:"head_sha"`
+	Annotations []PullRequestCheckAnnotation `json:"annotations"`
+	// Truncated is set when there were more than maxPullRequestCheckAnnotations annotations.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// GetPullRequestCheckAnnotations creates a tool to get the annotations of the check runs on a pull request.
+func GetPullRequestCheckAnnotations(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pull_request_check_annotations",
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_CHECK_ANNOTATIONS_DESCRIPTION", fmt.Sprintf("Get the annotations that check runs, such as linters and test reporters, left on lines of code in the head commit of a pull request. Returns the file, lines, severity and message of each, up to %d in total.", maxPullRequestCheckAnnotations))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PULL_REQUEST_CHECK_ANNOTATIONS_USER_TITLE", "Get pull request check annotations"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithString("check_name",
+				mcp.Description("Only return annotations of check runs with this name"),
+			),
+			mcp.WithString("level",
+				mcp.Description("Only return annotations with this severity"),
+				mcp.Enum("notice", "warning", "failure"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			checkName, err := OptionalParam[string](request, "check_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			level, err := OptionalParam[string](request, "level")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get pull request", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			result := PullRequestCheckAnnotations{
+				HeadSHA:     pr.GetHead().GetSHA(),
+				Annotations: []PullRequestCheckAnnotation{},
+			}
+
+			runOpts := &github.ListCheckRunsOptions{
+				CheckName:   optionalStringPtr(checkName),
+				ListOptions: github.ListOptions{PerPage: 100},
+			}
+			for {
+				runs, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, result.HeadSHA, runOpts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list check runs", resp, err), nil
+				}
+				_ = resp.Body.Close()
+
+				for _, run := range runs.CheckRuns {
+					// Skip the request for the many check runs that have no annotations.
+					if run.GetOutput().GetAnnotationsCount() == 0 {
+						continue
+					}
+					annotationOpts := &github.ListOptions{PerPage: 100}
+					for {
+						annotations, resp, err := client.Checks.ListCheckRunAnnotations(ctx, owner, repo, run.GetID(), annotationOpts)
+						if err != nil {
+							return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list annotations of check run %s", run.GetName()), resp, err), nil
+						}
+						_ = resp.Body.Close()
+
+						for _, a := range annotations {
+							if level != "" && a.GetAnnotationLevel() != level {
+								continue
+							}
+							if len(result.Annotations) == maxPullRequestCheckAnnotations {
+								result.Truncated = true
+								return MarshalledTextResult(result), nil
+							}
+							result.Annotations = append(result.Annotations, PullRequestCheckAnnotation{
+								CheckRun:  run.GetName(),
+								Path:      a.GetPath(),
+								StartLine: a.GetStartLine(),
+								EndLine:   a.GetEndLine(),
+								Level:     a.GetAnnotationLevel(),
+								Title:     a.GetTitle(),
+								Message:   a.GetMessage(),
+							})
+						}
+						if resp.NextPage == 0 {
+							break
+						}
+						annotationOpts.Page = resp.NextPage
+					}
+				}
+				if resp.NextPage == 0 {
+					break
+				}
+				runOpts.Page = resp.NextPage
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// mergeabilityPollBackoff controls how check_mergeability waits for GitHub to compute mergeability.
+var mergeabilityPollBackoff = pollBackoff{Initial: time.Second, Max: 8 * time.Second, Factor: 2}
+
+// MergeabilityResult is the mergeability report for a pull request.
+type MergeabilityResult struct {
+	Number                      int      `json:"number"`
+	Mergeable                   *bool    `json:"mergeable"`
+	MergeableState              string   `json:"mergeable_state"`
+	Determined                  bool     `json:"determined"`
+	Attempts                    int      `json:"attempts"`
+	Cancelled                   bool     `json:"cancelled,omitempty"`
+	PotentiallyConflictingFiles []string `json:"potentially_conflicting_files,omitempty"`
+	Message                     string   `json:"message"`
+	OperationToken              string   `json:"operation_token,omitempty"`
+}
+
+// CheckMergeability creates a tool to check whether a pull request can be merged, waiting for GitHub to compute it.
+func CheckMergeability(getClient GetClientFn, operations *OperationRegistry, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("check_mergeability",
+			mcp.WithDescription(t("TOOL_CHECK_MERGEABILITY_DESCRIPTION", "Check whether a pull request can be merged. GitHub computes mergeability asynchronously, so this polls with backoff until the result is known. When the pull request has conflicts, the files changed on both the head and base branches are reported as potentially conflicting.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CHECK_MERGEABILITY_USER_TITLE", "Check pull request mergeability"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithNumber("max_attempts",
+				mcp.Description("Maximum number of times to fetch the pull request while waiting for mergeability to be computed (default 5, max 10)"),
+				mcp.Min(1),
+				mcp.Max(10),
+			),
+			WithOperationToken(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxAttempts, err := OptionalIntParamWithDefault(request, "max_attempts", 5)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxAttempts > 10 {
+				maxAttempts = 10
+			}
+			token, err := OptionalParam[string](request, operationTokenParameter)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opCtx, done, err := operations.start(ctx, token)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			defer done()
+
+			var pr *github.PullRequest
+			var apiErrResult *mcp.CallToolResult
+			determined, attempts, err := pollUntil(opCtx, mergeabilityPollBackoff, maxAttempts, func(opCtx context.Context) (bool, error) {
+				latest, resp, getErr := client.PullRequests.Get(opCtx, owner, repo, pullNumber)
+				if getErr != nil {
+					// A request cut short by cancel_operation is not an API failure.
+					if !operationCancelled(opCtx) {
+						apiErrResult = ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get pull request", resp, getErr)
+					}
+					return false, getErr
+				}
+				defer func() { _ = resp.Body.Close() }()
+
+				pr = latest
+				// Closed pull requests never get a mergeability computed.
+				if pr.GetState() == "closed" {
+					return true, nil
+				}
+				return pr.Mergeable != nil && pr.GetMergeableState() != "unknown", nil
+			})
+			if apiErrResult != nil {
+				return apiErrResult, nil
+			}
+			if operationCancelled(opCtx) {
+				result := MergeabilityResult{
+					Number:         pullNumber,
+					Attempts:       attempts,
+					Cancelled:      true,
+					Message:        "stopped waiting for mergeability because the operation was cancelled",
+					OperationToken: token,
+				}
+				if pr != nil {
+					result.Mergeable = pr.Mergeable
+					result.MergeableState = pr.GetMergeableState()
+				}
+				return MarshalledTextResult(result), nil
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to check mergeability: %w", err)
+			}
+
+			result := MergeabilityResult{
+				Number:         pullNumber,
+				Mergeable:      pr.Mergeable,
+				MergeableState: pr.GetMergeableState(),
+				Determined:     determined,
+				Attempts:       attempts,
+				OperationToken: token,
+			}
+
+			switch {
+			case pr.GetState() == "closed":
+				result.Message = "pull request is closed"
+			case !determined:
+				result.Message = "GitHub has not finished computing mergeability, try again shortly"
+			case pr.GetMergeable():
+				result.Message = fmt.Sprintf("pull request can be merged (state: %s)", result.MergeableState)
+			default:
+				result.Message = fmt.Sprintf("pull request cannot be merged (state: %s)", result.MergeableState)
+			}
+
+			if determined && pr.Mergeable != nil && !pr.GetMergeable() {
+				files, err := potentiallyConflictingFiles(ctx, client, owner, repo, pr)
+				if err == nil {
+					result.PotentiallyConflictingFiles = files
+				}
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// potentiallyConflictingFiles returns the files changed by the pull request that were also changed on
+// the base branch since the pull request branched off. GitHub doesn't expose the exact conflicting
+// files through the API, so this is the closest approximation.
+func potentiallyConflictingFiles(ctx context.Context, client *github.Client, owner, repo string, pr *github.PullRequest) ([]string, error) {
+	prFiles, resp, err := client.PullRequests.ListFiles(ctx, owner, repo, pr.GetNumber(), &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return nil, err
+	}
+	_ = resp.Body.Close()
+
+	comparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, pr.GetHead().GetSHA(), pr.GetBase().GetRef(), &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return nil, err
+	}
+	_ = resp.Body.Close()
+
+	changedOnBase := make(map[string]bool, len(comparison.Files))
+	for _, f := range comparison.Files {
+		changedOnBase[f.GetFilename()] = true
+	}
+
+	files := []string{}
+	for _, f := range prFiles {
+		if changedOnBase[f.GetFilename()] {
+			files = append(files, f.GetFilename())
+		}
+	}
+	return files, nil
+}
+
 // UpdatePullRequestBranch creates a tool to update a pull request branch with the latest changes from the base branch.
 func UpdatePullRequestBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	return mcp.NewTool("update_pull_request_branch",
@@ -1115,6 +1687,143 @@ func GetPullRequestReviews(getClient GetClientFn, t translations.TranslationHelp
 		}
 }
 
+// maxLinkedIssues is how many linked issues get_pull_request_linked_issues returns. GitHub caps
+// a connection page at 100 and pull requests rarely close more issues than that.
+const maxLinkedIssues = 100
+
+// LinkedIssue is an issue that a pull request will close when it is merged.
+type LinkedIssue struct {
+	Number     int    `json:"number"`
+	Repository string `json:"repository"`
+	Title      string `json:"title"`
+	State      string `json:"state"`
+	URL        string `json:"url"`
+	// LinkedBy is "manual" for issues linked through the development sidebar and
+	// "closing_keyword" for issues referenced with a keyword such as "Fixes #123".
+	LinkedBy string `json:"linked_by"`
+}
+
+// PullRequestLinkedIssues lists the issues a pull request will close.
+type PullRequestLinkedIssues struct {
+	PullNumber int           `json:"pull_number"`
+	TotalCount int           `json:"total_count"`
+	Issues     []LinkedIssue `json:"issues"`
+	Note       string        `json:"note,omitempty"`
+}
+
+type linkedIssueNode struct {
+	ID         githubv4.ID
+	Number     githubv4.Int
+	Title      githubv4.String
+	State      githubv4.String
+	URL        githubv4.String
+	Repository struct {
+		NameWithOwner githubv4.String
+	}
+}
+
+type linkedIssuesQuery struct {
+	Repository struct {
+		PullRequest struct {
+			ClosingIssuesReferences struct {
+				TotalCount githubv4.Int
+				Nodes      []linkedIssueNode
+			} `graphql:"closingIssuesReferences(first: $first)"`
+			UserLinkedIssues struct {
+				Nodes []struct {
+					ID githubv4.ID
+				}
+			} `graphql:"userLinkedIssues: closingIssuesReferences(first: $first, userLinkedOnly: true)"`
+		} `graphql:"pullRequest(number: $prNum)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// GetPullRequestLinkedIssues creates a tool to list the issues a pull request will close.
+func GetPullRequestLinkedIssues(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pull_request_linked_issues",
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_LINKED_ISSUES_DESCRIPTION", "Get the issues a pull request will close when it is merged, whether they are referenced with a closing keyword such as \"Fixes #123\" or linked manually.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PULL_REQUEST_LINKED_ISSUES_USER_TITLE", "Get pull request linked issues"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			var query linkedIssuesQuery
+			if err := client.Query(ctx, &query, map[string]any{
+				"owner": githubv4.String(owner),
+				"repo":  githubv4.String(repo),
+				"prNum": githubv4.Int(pullNumber), // #nosec G115 - pull request numbers are always small positive integers
+				"first": githubv4.Int(maxLinkedIssues),
+			}); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get pull request linked issues", err), nil
+			}
+
+			pr := query.Repository.PullRequest
+			userLinked := make(map[githubv4.ID]bool, len(pr.UserLinkedIssues.Nodes))
+			for _, node := range pr.UserLinkedIssues.Nodes {
+				userLinked[node.ID] = true
+			}
+
+			result := PullRequestLinkedIssues{
+				PullNumber: pullNumber,
+				TotalCount: int(pr.ClosingIssuesReferences.TotalCount),
+				Issues:     make([]LinkedIssue, 0, len(pr.ClosingIssuesReferences.Nodes)),
+			}
+			for _, node := range pr.ClosingIssuesReferences.Nodes {
+				linkedBy := "closing_keyword"
+				if userLinked[node.ID] {
+					linkedBy = "manual"
+				}
+				result.Issues = append(result.Issues, LinkedIssue{
+					Number:     int(node.Number),
+					Repository: string(node.Repository.NameWithOwner),
+					Title:      string(node.Title),
+					State:      string(node.State),
+					URL:        string(node.URL),
+					LinkedBy:   linkedBy,
+				})
+			}
+			switch {
+			case result.TotalCount == 0:
+				result.Note = fmt.Sprintf("pull request #%d is not linked to any issues it will close", pullNumber)
+			case result.TotalCount > len(result.Issues):
+				result.Note = fmt.Sprintf("only the first %d of %d linked issues are listed", len(result.Issues), result.TotalCount)
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
 func CreateAndSubmitPullRequestReview(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	return mcp.NewTool("create_and_submit_pull_request_review",
 			mcp.WithDescription(t("TOOL_CREATE_AND_SUBMIT_PULL_REQUEST_REVIEW_DESCRIPTION", "Create and submit a review for a pull request without review comments.")),
@@ -1217,6 +1926,212 @@ func CreateAndSubmitPullRequestReview(getGQLClient GetGQLClientFn, t translation
 		}
 }
 
+// maxReviewComments is how many inline comments create_pull_request_review submits at once.
+const maxReviewComments = 50
+
+// ReviewCommentSpec is an inline comment of a review submitted with create_pull_request_review.
+type ReviewCommentSpec struct {
+	Path      string `mapstructure:"path"`
+	Body      string `mapstructure:"body"`
+	Line      int    `mapstructure:"line"`
+	Side      string `mapstructure:"side"`
+	StartLine int    `mapstructure:"startLine"`
+	StartSide string `mapstructure:"startSide"`
+}
+
+// SubmittedPullRequestReview is the outcome of create_pull_request_review.
+type SubmittedPullRequestReview struct {
+	ID       int64  `json:"id"`
+	State    string `json:"state"`
+	HTMLURL  string `json:"html_url"`
+	Comments int    `json:"comments"`
+}
+
+// parseReviewComments decodes and checks the comments parameter of create_pull_request_review.
+func parseReviewComments(request mcp.CallToolRequest) ([]*github.DraftReviewComment, error) {
+	raw, err := OptionalParam[[]any](request, "comments")
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) > maxReviewComments {
+		return nil, fmt.Errorf("at most %d comments can be submitted at once, got %d", maxReviewComments, len(raw))
+	}
+
+	comments := make([]*github.DraftReviewComment, len(raw))
+	for i, item := range raw {
+		var spec ReviewCommentSpec
+		if err := mapstructure.Decode(item, &spec); err != nil {
+			return nil, fmt.Errorf("comments[%d]: %w", i, err)
+		}
+		switch {
+		case spec.Path == "":
+			return nil, fmt.Errorf("comments[%d]: path is required", i)
+		case spec.Body == "":
+			return nil, fmt.Errorf("comments[%d]: body is required", i)
+		case spec.Line < 1:
+			return nil, fmt.Errorf("comments[%d]: line is required", i)
+		case spec.StartLine != 0 && spec.StartLine >= spec.Line:
+			return nil, fmt.Errorf("comments[%d]: startLine must be before line", i)
+		}
+		if spec.Side == "" {
+			spec.Side = "RIGHT"
+		}
+
+		comment := &github.DraftReviewComment{
+			Path: github.Ptr(spec.Path),
+			Body: github.Ptr(spec.Body),
+			Line: github.Ptr(spec.Line),
+			Side: github.Ptr(spec.Side),
+		}
+		if spec.StartLine != 0 {
+			if spec.StartSide == "" {
+				spec.StartSide = spec.Side
+			}
+			comment.StartLine = github.Ptr(spec.StartLine)
+			comment.StartSide = github.Ptr(spec.StartSide)
+		}
+		comments[i] = comment
+	}
+	return comments, nil
+}
+
+// CreatePullRequestReview creates a tool to submit a review with inline comments in one call.
+func CreatePullRequestReview(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("create_pull_request_review",
+			mcp.WithDescription(t("TOOL_CREATE_PULL_REQUEST_REVIEW_DESCRIPTION", fmt.Sprintf("Submit a review of a pull request with its verdict, overall comment and up to %d inline comments on lines of the diff, all at once. Either the whole review is submitted or, if a comment is invalid, for example on a line outside the diff, nothing is.", maxReviewComments))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_PULL_REQUEST_REVIEW_USER_TITLE", "Submit pull request review with comments"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithString("event",
+				mcp.Required(),
+				mcp.Description("Review verdict"),
+				mcp.Enum("APPROVE", "REQUEST_CHANGES", "COMMENT"),
+			),
+			mcp.WithString("body",
+				mcp.Description("Overall review comment. Required to request changes, and to comment without inline comments"),
+			),
+			mcp.WithArray("comments",
+				mcp.Description("Inline comments on lines of the diff"),
+				mcp.Items(map[string]any{
+					"type":     "object",
+					"required": []string{"path", "body", "line"},
+					"properties": map[string]any{
+						"path": map[string]any{
+							"type":        "string",
+							"description": "Path of the file, relative to the repository root",
+						},
+						"body": map[string]any{
+							"type":        "string",
+							"description": "Text of the comment",
+						},
+						"line": map[string]any{
+							"type":        "number",
+							"description": "Line of the file the comment is on. For a multi-line comment, the last line",
+						},
+						"side": map[string]any{
+							"type":        "string",
+							"description": "Side of the diff: LEFT for the old version of the file, RIGHT for the new one (default: RIGHT)",
+							"enum":        []string{"LEFT", "RIGHT"},
+						},
+						"startLine": map[string]any{
+							"type":        "number",
+							"description": "For a multi-line comment, the first line",
+						},
+						"startSide": map[string]any{
+							"type":        "string",
+							"description": "For a multi-line comment, the side of the first line (default: side)",
+							"enum":        []string{"LEFT", "RIGHT"},
+						},
+					},
+				}),
+			),
+			mcp.WithString("commitID",
+				mcp.Description("SHA of the commit to review. Defaults to the head of the pull request"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			event, err := RequiredParam[string](request, "event")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := OptionalParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commitID, err := OptionalParam[string](request, "commitID")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			comments, err := parseReviewComments(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			switch {
+			case event != "APPROVE" && event != "REQUEST_CHANGES" && event != "COMMENT":
+				return mcp.NewToolResultError(fmt.Sprintf("event must be APPROVE, REQUEST_CHANGES or COMMENT, got %q", event)), nil
+			case event == "REQUEST_CHANGES" && body == "":
+				return mcp.NewToolResultError("body is required to request changes"), nil
+			case event == "COMMENT" && body == "" && len(comments) == 0:
+				return mcp.NewToolResultError("body or comments are required to comment"), nil
+			}
+
+			review := &github.PullRequestReviewRequest{
+				Event:    github.Ptr(event),
+				Comments: comments,
+			}
+			if body != "" {
+				review.Body = github.Ptr(body)
+			}
+			if commitID != "" {
+				review.CommitID = github.Ptr(commitID)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			submitted, resp, err := client.PullRequests.CreateReview(ctx, owner, repo, pullNumber, review)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to submit pull request review", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(SubmittedPullRequestReview{
+				ID:       submitted.GetID(),
+				State:    submitted.GetState(),
+				HTMLURL:  submitted.GetHTMLURL(),
+				Comments: len(comments),
+			}), nil
+		}
+}
+
 // CreatePendingPullRequestReview creates a tool to create a pending review on a pull request.
 func CreatePendingPullRequestReview(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	return mcp.NewTool("create_pending_pull_request_review",
@@ -821,6 +821,383 @@ func Test_MergePullRequest(t *testing.T) {
 	}
 }
 
+func Test_UpdatePullRequestAutoMerge(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := UpdatePullRequestAutoMerge(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_pull_request_auto_merge", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "enable")
+	assert.Contains(t, tool.InputSchema.Properties, "merge_method")
+	assert.Contains(t, tool.InputSchema.Properties, "commit_title")
+	assert.Contains(t, tool.InputSchema.Properties, "commit_message")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	enableMutation := struct {
+		EnablePullRequestAutoMerge struct {
+			PullRequest struct {
+				AutoMergeRequest struct {
+					MergeMethod githubv4.PullRequestMergeMethod
+					EnabledAt   githubv4.DateTime
+					EnabledBy   struct {
+						Login githubv4.String
+					}
+				}
+			}
+		} `graphql:"enablePullRequestAutoMerge(input: $input)"`
+	}{}
+	disableMutation := struct {
+		DisablePullRequestAutoMerge struct {
+			PullRequest struct {
+				Number githubv4.Int
+			}
+		} `graphql:"disablePullRequestAutoMerge(input: $input)"`
+	}{}
+	squash := githubv4.PullRequestMergeMethodSquash
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expected       map[string]any
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "enable with squash and commit message",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				pullRequestIDMatcher(),
+				githubv4mock.NewMutationMatcher(
+					enableMutation,
+					githubv4.EnablePullRequestAutoMergeInput{
+						PullRequestID:  githubv4.ID("PR_42"),
+						MergeMethod:    &squash,
+						CommitHeadline: githubv4.NewString("Fix bug (#42)"),
+						CommitBody:     githubv4.NewString("Closes #41"),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"enablePullRequestAutoMerge": map[string]any{
+							"pullRequest": map[string]any{
+								"autoMergeRequest": map[string]any{
+									"mergeMethod": "SQUASH",
+									"enabledAt":   "2025-06-01T12:00:00Z",
+									"enabledBy":   map[string]any{"login": "octocat"},
+								},
+							},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"pullNumber":     float64(42),
+				"merge_method":   "squash",
+				"commit_title":   "Fix bug (#42)",
+				"commit_message": "Closes #41",
+			},
+			expected: map[string]any{
+				"pull_request": float64(42),
+				"merge_method": "squash",
+				"enabled_by":   "octocat",
+				"enabled_at":   "2025-06-01T12:00:00Z",
+			},
+		},
+		{
+			name: "disable",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				pullRequestIDMatcher(),
+				githubv4mock.NewMutationMatcher(
+					disableMutation,
+					githubv4.DisablePullRequestAutoMergeInput{PullRequestID: githubv4.ID("PR_42")},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"disablePullRequestAutoMerge": map[string]any{
+							"pullRequest": map[string]any{"number": 42},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"enable":     false,
+			},
+			expectedText: "Disabled auto-merge on pull request #42 in owner/repo",
+		},
+		{
+			name:         "merge options when disabling",
+			mockedClient: githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"pullNumber":   float64(42),
+				"enable":       false,
+				"merge_method": "squash",
+			},
+			expectError:    true,
+			expectedErrMsg: "can only be given when enabling auto-merge",
+		},
+		{
+			name: "auto-merge not allowed",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				pullRequestIDMatcher(),
+				githubv4mock.NewMutationMatcher(
+					enableMutation,
+					githubv4.EnablePullRequestAutoMergeInput{PullRequestID: githubv4.ID("PR_42")},
+					nil,
+					githubv4mock.ErrorResponse("Auto merge is not allowed for this repository"),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to enable auto-merge",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := UpdatePullRequestAutoMerge(stubGetGQLClientFn(githubv4.NewClient(tc.mockedClient)), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			if tc.expectedText != "" {
+				assert.Equal(t, tc.expectedText, textContent.Text)
+				return
+			}
+
+			var returned map[string]any
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
+
+// pullRequestDraftMatcher answers the lookup of the draft state of pull request 42 in owner/repo.
+func pullRequestDraftMatcher(isDraft bool) githubv4mock.Matcher {
+	return githubv4mock.NewQueryMatcher(
+		struct {
+			Repository struct {
+				PullRequest struct {
+					ID      githubv4.ID
+					IsDraft githubv4.Boolean
+				} `graphql:"pullRequest(number: $prNum)"`
+			} `graphql:"repository(owner: $owner, name: $repo)"`
+		}{},
+		map[string]any{
+			"owner": githubv4.String("owner"),
+			"repo":  githubv4.String("repo"),
+			"prNum": githubv4.Int(42),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{"pullRequest": map[string]any{"id": "PR_42", "isDraft": isDraft}},
+		}),
+	)
+}
+
+func Test_MarkPullRequestReadyForReview(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := MarkPullRequestReadyForReview(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "mark_pull_request_ready_for_review", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	readyMutation := struct {
+		MarkPullRequestReadyForReview struct {
+			PullRequest struct {
+				ID      githubv4.ID
+				IsDraft githubv4.Boolean
+			}
+		} `graphql:"markPullRequestReadyForReview(input: $input)"`
+	}{}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "draft marked ready",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				pullRequestDraftMatcher(true),
+				githubv4mock.NewMutationMatcher(
+					readyMutation,
+					githubv4.MarkPullRequestReadyForReviewInput{PullRequestID: githubv4.ID("PR_42")},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"markPullRequestReadyForReview": map[string]any{
+							"pullRequest": map[string]any{"id": "PR_42", "isDraft": false},
+						},
+					}),
+				),
+			),
+			expectedText: "Marked pull request #42 in owner/repo ready for review",
+		},
+		{
+			name:         "already ready",
+			mockedClient: githubv4mock.NewMockedHTTPClient(pullRequestDraftMatcher(false)),
+			expectedText: "Pull request #42 in owner/repo is already ready for review",
+		},
+		{
+			name: "mutation fails",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				pullRequestDraftMatcher(true),
+				githubv4mock.NewMutationMatcher(
+					readyMutation,
+					githubv4.MarkPullRequestReadyForReviewInput{PullRequestID: githubv4.ID("PR_42")},
+					nil,
+					githubv4mock.ErrorResponse("Resource not accessible by integration"),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to mark pull request ready for review",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := MarkPullRequestReadyForReview(stubGetGQLClientFn(githubv4.NewClient(tc.mockedClient)), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_ConvertPullRequestToDraft(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := ConvertPullRequestToDraft(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "convert_pull_request_to_draft", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	draftMutation := struct {
+		ConvertPullRequestToDraft struct {
+			PullRequest struct {
+				ID      githubv4.ID
+				IsDraft githubv4.Boolean
+			}
+		} `graphql:"convertPullRequestToDraft(input: $input)"`
+	}{}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "ready pull request converted",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				pullRequestDraftMatcher(false),
+				githubv4mock.NewMutationMatcher(
+					draftMutation,
+					githubv4.ConvertPullRequestToDraftInput{PullRequestID: githubv4.ID("PR_42")},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"convertPullRequestToDraft": map[string]any{
+							"pullRequest": map[string]any{"id": "PR_42", "isDraft": true},
+						},
+					}),
+				),
+			),
+			expectedText: "Converted pull request #42 in owner/repo to a draft",
+		},
+		{
+			name:         "already a draft",
+			mockedClient: githubv4mock.NewMockedHTTPClient(pullRequestDraftMatcher(true)),
+			expectedText: "Pull request #42 in owner/repo is already a draft",
+		},
+		{
+			name: "pull request not found",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					struct {
+						Repository struct {
+							PullRequest struct {
+								ID      githubv4.ID
+								IsDraft githubv4.Boolean
+							} `graphql:"pullRequest(number: $prNum)"`
+						} `graphql:"repository(owner: $owner, name: $repo)"`
+					}{},
+					map[string]any{
+						"owner": githubv4.String("owner"),
+						"repo":  githubv4.String("repo"),
+						"prNum": githubv4.Int(42),
+					},
+					githubv4mock.ErrorResponse("Could not resolve to a PullRequest with the number of 42."),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to find pull request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := ConvertPullRequestToDraft(stubGetGQLClientFn(githubv4.NewClient(tc.mockedClient)), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
 func Test_SearchPullRequests(t *testing.T) {
 	mockClient := github.NewClient(nil)
 	tool, _ := SearchPullRequests(stubGetClientFn(mockClient), translations.NullTranslationHelper)
@@ -1328,64 +1705,393 @@ func Test_GetPullRequestStatus(t *testing.T) {
 		{
 			name: "successful status fetch",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					mockStatus,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    false,
+			expectedStatus: mockStatus,
+		},
+		{
+			name: "PR fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request",
+		},
+		{
+			name: "status fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsStatusesByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get combined status",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPullRequestStatus(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedStatus github.CombinedStatus
+			err = json.Unmarshal([]byte(textContent.Text), &returnedStatus)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedStatus.State, *returnedStatus.State)
+			assert.Equal(t, *tc.expectedStatus.TotalCount, *returnedStatus.TotalCount)
+			assert.Len(t, returnedStatus.Statuses, len(tc.expectedStatus.Statuses))
+			for i, status := range returnedStatus.Statuses {
+				assert.Equal(t, *tc.expectedStatus.Statuses[i].State, *status.State)
+				assert.Equal(t, *tc.expectedStatus.Statuses[i].Context, *status.Context)
+				assert.Equal(t, *tc.expectedStatus.Statuses[i].Description, *status.Description)
+			}
+		})
+	}
+}
+
+func Test_GetPullRequestCheckAnnotations(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPullRequestCheckAnnotations(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_pull_request_check_annotations", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mockPR := &github.PullRequest{
+		Number: github.Ptr(42),
+		Head:   &github.PullRequestBranch{SHA: github.Ptr("abcd1234")},
+	}
+	mockRuns := &github.ListCheckRunsResults{
+		Total: github.Ptr(2),
+		CheckRuns: []*github.CheckRun{
+			{
+				ID:     github.Ptr(int64(1)),
+				Name:   github.Ptr("build"),
+				Output: &github.CheckRunOutput{AnnotationsCount: github.Ptr(0)},
+			},
+			{
+				ID:     github.Ptr(int64(2)),
+				Name:   github.Ptr("lint"),
+				Output: &github.CheckRunOutput{AnnotationsCount: github.Ptr(2)},
+			},
+		},
+	}
+	mockAnnotations := []*github.CheckRunAnnotation{
+		{
+			Path:            github.Ptr("pkg/server.go"),
+			StartLine:       github.Ptr(10),
+			EndLine:         github.Ptr(10),
+			AnnotationLevel: github.Ptr("failure"),
+			Title:           github.Ptr("errcheck"),
+			Message:         github.Ptr("Error return value is not checked"),
+		},
+		{
+			Path:            github.Ptr("pkg/server.go"),
+			StartLine:       github.Ptr(20),
+			EndLine:         github.Ptr(22),
+			AnnotationLevel: github.Ptr("notice"),
+			Message:         github.Ptr("Consider simplifying this"),
+		},
+	}
+
+	tests := []struct {
+		name                string
+		requestArgs         map[string]interface{}
+		expectedAnnotations []PullRequestCheckAnnotation
+	}{
+		{
+			name: "all annotations",
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedAnnotations: []PullRequestCheckAnnotation{
+				{CheckRun: "lint", Path: "pkg/server.go", StartLine: 10, EndLine: 10, Level: "failure", Title: "errcheck", Message: "Error return value is not checked"},
+				{CheckRun: "lint", Path: "pkg/server.go", StartLine: 20, EndLine: 22, Level: "notice", Message: "Consider simplifying this"},
+			},
+		},
+		{
+			name: "only failures",
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"level":      "failure",
+			},
+			expectedAnnotations: []PullRequestCheckAnnotation{
+				{CheckRun: "lint", Path: "pkg/server.go", StartLine: 10, EndLine: 10, Level: "failure", Title: "errcheck", Message: "Error return value is not checked"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Only the lint run has annotations, so only its annotations are requested.
+			mockedClient := mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					expectPath(t, "/repos/owner/repo/commits/abcd1234/check-runs").andThen(
+						mockResponse(t, http.StatusOK, mockRuns),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCheckRunsAnnotationsByOwnerByRepoByCheckRunId,
+					expectPath(t, "/repos/owner/repo/check-runs/2/annotations").andThen(
+						mockResponse(t, http.StatusOK, mockAnnotations),
+					),
+				),
+			)
+			client := github.NewClient(mockedClient)
+			_, handler := GetPullRequestCheckAnnotations(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			var returned PullRequestCheckAnnotations
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, "abcd1234", returned.HeadSHA)
+			assert.Equal(t, tc.expectedAnnotations, returned.Annotations)
+			assert.False(t, returned.Truncated)
+		})
+	}
+}
+
+func Test_CheckMergeability(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CheckMergeability(stubGetClientFn(mockClient), NewOperationRegistry(), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "check_mergeability", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "max_attempts")
+	assert.Contains(t, tool.InputSchema.Properties, "operation_token")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	// Don't wait between polls in tests
+	originalBackoff := mergeabilityPollBackoff
+	mergeabilityPollBackoff = pollBackoff{Initial: time.Millisecond, Max: time.Millisecond, Factor: 1}
+	t.Cleanup(func() { mergeabilityPollBackoff = originalBackoff })
+
+	pendingPR := &github.PullRequest{
+		Number:         github.Ptr(42),
+		State:          github.Ptr("open"),
+		MergeableState: github.Ptr("unknown"),
+		Head:           &github.PullRequestBranch{SHA: github.Ptr("headsha")},
+		Base:           &github.PullRequestBranch{Ref: github.Ptr("main")},
+	}
+	cleanPR := &github.PullRequest{
+		Number:         github.Ptr(42),
+		State:          github.Ptr("open"),
+		Mergeable:      github.Ptr(true),
+		MergeableState: github.Ptr("clean"),
+		Head:           &github.PullRequestBranch{SHA: github.Ptr("headsha")},
+		Base:           &github.PullRequestBranch{Ref: github.Ptr("main")},
+	}
+	dirtyPR := &github.PullRequest{
+		Number:         github.Ptr(42),
+		State:          github.Ptr("open"),
+		Mergeable:      github.Ptr(false),
+		MergeableState: github.Ptr("dirty"),
+		Head:           &github.PullRequestBranch{SHA: github.Ptr("headsha")},
+		Base:           &github.PullRequestBranch{Ref: github.Ptr("main")},
+	}
+
+	// sequentialPRs returns each of the given pull requests in turn, repeating the last one.
+	sequentialPRs := func(prs ...*github.PullRequest) http.HandlerFunc {
+		calls := 0
+		return func(w http.ResponseWriter, _ *http.Request) {
+			pr := prs[min(calls, len(prs)-1)]
+			calls++
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(pr)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedResult MergeabilityResult
+	}{
+		{
+			name: "mergeability is null until computed",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
 					mock.GetReposPullsByOwnerByRepoByPullNumber,
-					mockPR,
-				),
-				mock.WithRequestMatch(
-					mock.GetReposCommitsStatusByOwnerByRepoByRef,
-					mockStatus,
+					sequentialPRs(pendingPR, pendingPR, cleanPR),
 				),
 			),
-			requestArgs: map[string]interface{}{
-				"owner":      "owner",
-				"repo":       "repo",
-				"pullNumber": float64(42),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"pullNumber":      float64(42),
+				"operation_token": "mergeability-42",
+			},
+			expectedResult: MergeabilityResult{
+				Number:         42,
+				Mergeable:      github.Ptr(true),
+				MergeableState: "clean",
+				Determined:     true,
+				Attempts:       3,
+				Message:        "pull request can be merged (state: clean)",
+				OperationToken: "mergeability-42",
 			},
-			expectError:    false,
-			expectedStatus: mockStatus,
 		},
 		{
-			name: "PR fetch fails",
+			name: "mergeability never computed within attempts",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
 					mock.GetReposPullsByOwnerByRepoByPullNumber,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
-					}),
+					sequentialPRs(pendingPR),
 				),
 			),
-			requestArgs: map[string]interface{}{
-				"owner":      "owner",
-				"repo":       "repo",
-				"pullNumber": float64(999),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"pullNumber":      float64(42),
+				"max_attempts":    float64(2),
+				"operation_token": "mergeability-42",
+			},
+			expectedResult: MergeabilityResult{
+				Number:         42,
+				MergeableState: "unknown",
+				Determined:     false,
+				Attempts:       2,
+				Message:        "GitHub has not finished computing mergeability, try again shortly",
+				OperationToken: "mergeability-42",
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to get pull request",
 		},
 		{
-			name: "status fetch fails",
+			name: "conflicting pull request reports files changed on both sides",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
+				mock.WithRequestMatchHandler(
 					mock.GetReposPullsByOwnerByRepoByPullNumber,
-					mockPR,
+					sequentialPRs(dirtyPR),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+					[]*github.CommitFile{
+						{Filename: github.Ptr("README.md")},
+						{Filename: github.Ptr("main.go")},
+					},
 				),
 				mock.WithRequestMatchHandler(
-					mock.GetReposCommitsStatusesByOwnerByRepoByRef,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
-					}),
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					expectPath(t, "/repos/owner/repo/compare/headsha...main").andThen(
+						mockResponse(t, http.StatusOK, &github.CommitsComparison{
+							Files: []*github.CommitFile{
+								{Filename: github.Ptr("main.go")},
+								{Filename: github.Ptr("go.mod")},
+							},
+						}),
+					),
 				),
 			),
-			requestArgs: map[string]interface{}{
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"pullNumber":      float64(42),
+				"operation_token": "mergeability-42",
+			},
+			expectedResult: MergeabilityResult{
+				Number:                      42,
+				Mergeable:                   github.Ptr(false),
+				MergeableState:              "dirty",
+				Determined:                  true,
+				Attempts:                    1,
+				PotentiallyConflictingFiles: []string{"main.go"},
+				Message:                     "pull request cannot be merged (state: dirty)",
+				OperationToken:              "mergeability-42",
+			},
+		},
+		{
+			name: "pull request not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]any{
 				"owner":      "owner",
 				"repo":       "repo",
-				"pullNumber": float64(42),
+				"pullNumber": float64(999),
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to get combined status",
+			expectedErrMsg: "failed to get pull request",
 		},
 	}
 
@@ -1393,41 +2099,27 @@ func Test_GetPullRequestStatus(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := GetPullRequestStatus(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := CheckMergeability(stubGetClientFn(client), NewOperationRegistry(), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
 
 			// Call handler
 			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
 
-			// Verify results
 			if tc.expectError {
-				require.NoError(t, err)
-				require.True(t, result.IsError)
 				errorContent := getErrorResult(t, result)
 				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
 				return
 			}
 
-			require.NoError(t, err)
-			require.False(t, result.IsError)
-
-			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
-			// Unmarshal and verify the result
-			var returnedStatus github.CombinedStatus
-			err = json.Unmarshal([]byte(textContent.Text), &returnedStatus)
+			var returned MergeabilityResult
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
 			require.NoError(t, err)
-			assert.Equal(t, *tc.expectedStatus.State, *returnedStatus.State)
-			assert.Equal(t, *tc.expectedStatus.TotalCount, *returnedStatus.TotalCount)
-			assert.Len(t, returnedStatus.Statuses, len(tc.expectedStatus.Statuses))
-			for i, status := range returnedStatus.Statuses {
-				assert.Equal(t, *tc.expectedStatus.Statuses[i].State, *status.State)
-				assert.Equal(t, *tc.expectedStatus.Statuses[i].Context, *status.Context)
-				assert.Equal(t, *tc.expectedStatus.Statuses[i].Description, *status.Description)
-			}
+			assert.Equal(t, tc.expectedResult, returned)
 		})
 	}
 }
@@ -1961,6 +2653,161 @@ func Test_CreatePullRequest(t *testing.T) {
 	}
 }
 
+func Test_GetPullRequestLinkedIssues(t *testing.T) {
+	// Verify tool definition once
+	mockClient := githubv4.NewClient(nil)
+	tool, _ := GetPullRequestLinkedIssues(stubGetGQLClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_pull_request_linked_issues", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	vars := map[string]any{
+		"owner": githubv4.String("owner"),
+		"repo":  githubv4.String("repo"),
+		"prNum": githubv4.Int(42),
+		"first": githubv4.Int(100),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+		expectedResult PullRequestLinkedIssues
+	}{
+		{
+			name: "closing keyword and manual links",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					linkedIssuesQuery{},
+					vars,
+					githubv4mock.DataResponse(map[string]any{
+						"repository": map[string]any{
+							"pullRequest": map[string]any{
+								"closingIssuesReferences": map[string]any{
+									"totalCount": 2,
+									"nodes": []map[string]any{
+										{
+											"id":         "I_1",
+											"number":     7,
+											"title":      "Crash on startup",
+											"state":      "OPEN",
+											"url":        "https://github.com/owner/repo/issues/7",
+											"repository": map[string]any{"nameWithOwner": "owner/repo"},
+										},
+										{
+											"id":         "I_2",
+											"number":     3,
+											"title":      "Track the rollout",
+											"state":      "OPEN",
+											"url":        "https://github.com/owner/tracking/issues/3",
+											"repository": map[string]any{"nameWithOwner": "owner/tracking"},
+										},
+									},
+								},
+								"userLinkedIssues": map[string]any{
+									"nodes": []map[string]any{
+										{"id": "I_2"},
+									},
+								},
+							},
+						},
+					}),
+				),
+			),
+			expectedResult: PullRequestLinkedIssues{
+				PullNumber: 42,
+				TotalCount: 2,
+				Issues: []LinkedIssue{
+					{
+						Number:     7,
+						Repository: "owner/repo",
+						Title:      "Crash on startup",
+						State:      "OPEN",
+						URL:        "https://github.com/owner/repo/issues/7",
+						LinkedBy:   "closing_keyword",
+					},
+					{
+						Number:     3,
+						Repository: "owner/tracking",
+						Title:      "Track the rollout",
+						State:      "OPEN",
+						URL:        "https://github.com/owner/tracking/issues/3",
+						LinkedBy:   "manual",
+					},
+				},
+			},
+		},
+		{
+			name: "no linked issues",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					linkedIssuesQuery{},
+					vars,
+					githubv4mock.DataResponse(map[string]any{
+						"repository": map[string]any{
+							"pullRequest": map[string]any{
+								"closingIssuesReferences": map[string]any{
+									"totalCount": 0,
+									"nodes":      []map[string]any{},
+								},
+								"userLinkedIssues": map[string]any{
+									"nodes": []map[string]any{},
+								},
+							},
+						},
+					}),
+				),
+			),
+			expectedResult: PullRequestLinkedIssues{
+				PullNumber: 42,
+				Issues:     []LinkedIssue{},
+				Note:       "pull request #42 is not linked to any issues it will close",
+			},
+		},
+		{
+			name: "pull request not found",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					linkedIssuesQuery{},
+					vars,
+					githubv4mock.ErrorResponse("Could not resolve to a PullRequest with the number of 42."),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request linked issues",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := githubv4.NewClient(tc.mockedClient)
+			_, handler := GetPullRequestLinkedIssues(stubGetGQLClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var linked PullRequestLinkedIssues
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &linked))
+			assert.Equal(t, tc.expectedResult, linked)
+		})
+	}
+}
+
 func TestCreateAndSubmitPullRequestReview(t *testing.T) {
 	t.Parallel()
 
@@ -2156,6 +3003,155 @@ func TestCreateAndSubmitPullRequestReview(t *testing.T) {
 	}
 }
 
+func Test_CreatePullRequestReview(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreatePullRequestReview(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_pull_request_review", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber", "event"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       SubmittedPullRequestReview
+	}{
+		{
+			name: "changes requested with inline comments",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+					expect(t, expectations{
+						path: "/repos/owner/repo/pulls/42/reviews",
+						requestBody: map[string]any{
+							"event":     "REQUEST_CHANGES",
+							"body":      "A few things to fix",
+							"commit_id": "abc123",
+							"comments": []any{
+								map[string]any{"path": "parser.go", "body": "Handle the error", "line": float64(12), "side": "RIGHT"},
+								map[string]any{"path": "lexer.go", "body": "This block was removed on purpose?", "line": float64(30), "side": "LEFT", "start_line": float64(25), "start_side": "LEFT"},
+							},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.PullRequestReview{
+							ID:      github.Ptr(int64(80)),
+							State:   github.Ptr("CHANGES_REQUESTED"),
+							HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#pullrequestreview-80"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "REQUEST_CHANGES",
+				"body":       "A few things to fix",
+				"commitID":   "abc123",
+				"comments": []any{
+					map[string]any{"path": "parser.go", "body": "Handle the error", "line": float64(12)},
+					map[string]any{"path": "lexer.go", "body": "This block was removed on purpose?", "line": float64(30), "side": "LEFT", "startLine": float64(25)},
+				},
+			},
+			expected: SubmittedPullRequestReview{
+				ID:       80,
+				State:    "CHANGES_REQUESTED",
+				HTMLURL:  "https://github.com/owner/repo/pull/42#pullrequestreview-80",
+				Comments: 2,
+			},
+		},
+		{
+			name: "comment on a line outside the diff",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]any{
+						"message": "Unprocessable Entity",
+						"errors":  []string{"Line could not be resolved"},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "COMMENT",
+				"comments": []any{
+					map[string]any{"path": "parser.go", "body": "Typo", "line": float64(999)},
+				},
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to submit pull request review",
+		},
+		{
+			name: "request changes without body",
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "REQUEST_CHANGES",
+				"comments": []any{
+					map[string]any{"path": "parser.go", "body": "Handle the error", "line": float64(12)},
+				},
+			},
+			expectError:    true,
+			expectedErrMsg: "body is required to request changes",
+		},
+		{
+			name: "comment without line",
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "APPROVE",
+				"comments": []any{
+					map[string]any{"path": "parser.go", "body": "Nice"},
+				},
+			},
+			expectError:    true,
+			expectedErrMsg: "comments[0]: line is required",
+		},
+		{
+			name: "start line after line",
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "COMMENT",
+				"comments": []any{
+					map[string]any{"path": "parser.go", "body": "Nice", "line": float64(10), "startLine": float64(12)},
+				},
+			},
+			expectError:    true,
+			expectedErrMsg: "comments[0]: startLine must be before line",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreatePullRequestReview(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned SubmittedPullRequestReview
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
+
 func Test_RequestCopilotReview(t *testing.T) {
 	t.Parallel()
 
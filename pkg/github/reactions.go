@@ -0,0 +1,526 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// maxDiscussionReactions is how many reactions of a discussion or discussion comment can be listed,
+// as GraphQL connections can be paged through by cursor only.
+const maxDiscussionReactions = 100
+
+// Reaction is an emoji reaction a user left on an issue, pull request, comment or discussion.
+type Reaction struct {
+	ID        int64      `json:"id,omitempty"`
+	Content   string     `json:"content"`
+	User      string     `json:"user"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// reactionContentValues are the reactions GitHub supports, as named by the REST API.
+var reactionContentValues = []string{"+1", "-1", "laugh", "confused", "heart", "hooray", "rocket", "eyes"}
+
+// reactionContents maps the reaction contents of the REST API to those of the GraphQL API.
+var reactionContents = map[string]githubv4.ReactionContent{
+	"+1":       githubv4.ReactionContentThumbsUp,
+	"-1":       githubv4.ReactionContentThumbsDown,
+	"laugh":    githubv4.ReactionContentLaugh,
+	"confused": githubv4.ReactionContentConfused,
+	"heart":    githubv4.ReactionContentHeart,
+	"hooray":   githubv4.ReactionContentHooray,
+	"rocket":   githubv4.ReactionContentRocket,
+	"eyes":     githubv4.ReactionContentEyes,
+}
+
+func restReactionContent(content githubv4.ReactionContent) string {
+	for rest, gql := range reactionContents {
+		if gql == content {
+			return rest
+		}
+	}
+	return string(content)
+}
+
+// reactionSubject is what a reaction tool works on. Issues, pull requests and discussions are
+// identified by number, comments by ID; discussion comments have a GraphQL node ID.
+type reactionSubject struct {
+	kind      string
+	number    int
+	commentID int64
+	nodeID    string
+}
+
+func (s reactionSubject) isDiscussion() bool {
+	return s.kind == "discussion" || s.kind == "discussion_comment"
+}
+
+func (s reactionSubject) String() string {
+	switch s.kind {
+	case "issue", "pull_request", "discussion":
+		return fmt.Sprintf("%s #%d", s.kind, s.number)
+	case "discussion_comment":
+		return fmt.Sprintf("%s %s", s.kind, s.nodeID)
+	default:
+		return fmt.Sprintf("%s %d", s.kind, s.commentID)
+	}
+}
+
+// withReactionSubject adds the parameters that identify the subject of a reaction tool.
+func withReactionSubject() []mcp.ToolOption {
+	return []mcp.ToolOption{
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+		mcp.WithString("subject_type",
+			mcp.Required(),
+			mcp.Description("What the reactions are on. Comments in the conversation of a pull request are issue comments, comments on its diff are pull request review comments"),
+			mcp.Enum("issue", "pull_request", "issue_comment", "pull_request_review_comment", "discussion", "discussion_comment"),
+		),
+		mcp.WithNumber("number",
+			mcp.Description("Number of the issue, pull request or discussion"),
+		),
+		mcp.WithString("comment_id",
+			mcp.Description("ID of the comment. For a discussion comment, the ID returned by get_discussion_comments"),
+		),
+	}
+}
+
+func reactionSubjectParam(request mcp.CallToolRequest) (reactionSubject, error) {
+	kind, err := RequiredParam[string](request, "subject_type")
+	if err != nil {
+		return reactionSubject{}, err
+	}
+	s := reactionSubject{kind: kind}
+	switch kind {
+	case "issue", "pull_request", "discussion":
+		if s.number, err = RequiredInt(request, "number"); err != nil {
+			return reactionSubject{}, err
+		}
+	case "issue_comment", "pull_request_review_comment":
+		id, err := RequiredParam[string](request, "comment_id")
+		if err != nil {
+			return reactionSubject{}, err
+		}
+		if s.commentID, err = strconv.ParseInt(id, 10, 64); err != nil {
+			return reactionSubject{}, fmt.Errorf("comment_id of an %s must be a number, got %q", kind, id)
+		}
+	case "discussion_comment":
+		if s.nodeID, err = RequiredParam[string](request, "comment_id"); err != nil {
+			return reactionSubject{}, err
+		}
+	default:
+		return reactionSubject{}, fmt.Errorf("unknown subject_type %q", kind)
+	}
+	return s, nil
+}
+
+func reactionContentParam(request mcp.CallToolRequest, required bool) (string, error) {
+	var content string
+	var err error
+	if required {
+		content, err = RequiredParam[string](request, "content")
+	} else {
+		content, err = OptionalParam[string](request, "content")
+	}
+	if err != nil {
+		return "", err
+	}
+	if _, ok := reactionContents[content]; content != "" && !ok {
+		return "", fmt.Errorf("content must be one of +1, -1, laugh, confused, heart, hooray, rocket or eyes, got %q", content)
+	}
+	return content, nil
+}
+
+func listRESTReactions(ctx context.Context, client *github.Client, owner, repo string, s reactionSubject, opts *github.ListReactionOptions) ([]*github.Reaction, *github.Response, error) {
+	switch s.kind {
+	case "issue_comment":
+		return client.Reactions.ListIssueCommentReactions(ctx, owner, repo, s.commentID, opts)
+	case "pull_request_review_comment":
+		return client.Reactions.ListPullRequestCommentReactions(ctx, owner, repo, s.commentID, opts)
+	default:
+		// Pull requests are issues as far as reactions go.
+		return client.Reactions.ListIssueReactions(ctx, owner, repo, s.number, opts)
+	}
+}
+
+func createRESTReaction(ctx context.Context, client *github.Client, owner, repo string, s reactionSubject, content string) (*github.Reaction, *github.Response, error) {
+	switch s.kind {
+	case "issue_comment":
+		return client.Reactions.CreateIssueCommentReaction(ctx, owner, repo, s.commentID, content)
+	case "pull_request_review_comment":
+		return client.Reactions.CreatePullRequestCommentReaction(ctx, owner, repo, s.commentID, content)
+	default:
+		return client.Reactions.CreateIssueReaction(ctx, owner, repo, s.number, content)
+	}
+}
+
+func deleteRESTReaction(ctx context.Context, client *github.Client, owner, repo string, s reactionSubject, reactionID int64) (*github.Response, error) {
+	switch s.kind {
+	case "issue_comment":
+		return client.Reactions.DeleteIssueCommentReaction(ctx, owner, repo, s.commentID, reactionID)
+	case "pull_request_review_comment":
+		return client.Reactions.DeletePullRequestCommentReaction(ctx, owner, repo, s.commentID, reactionID)
+	default:
+		return client.Reactions.DeleteIssueReaction(ctx, owner, repo, s.number, reactionID)
+	}
+}
+
+// discussionReactionSubjectID returns the GraphQL node ID of a discussion or discussion comment.
+func discussionReactionSubjectID(ctx context.Context, client *githubv4.Client, owner, repo string, s reactionSubject) (githubv4.ID, error) {
+	if s.kind == "discussion_comment" {
+		return githubv4.ID(s.nodeID), nil
+	}
+	var q struct {
+		Repository struct {
+			Discussion struct {
+				ID githubv4.ID
+			} `graphql:"discussion(number: $discussionNumber)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+	if err := client.Query(ctx, &q, map[string]any{
+		"owner":            githubv4.String(owner),
+		"repo":             githubv4.String(repo),
+		"discussionNumber": githubv4.Int(s.number), // #nosec G115 - discussion numbers are positive integers well below the int32 limit
+	}); err != nil {
+		return nil, err
+	}
+	return q.Repository.Discussion.ID, nil
+}
+
+// ListReactions creates a tool to list the reactions on an issue, pull request, comment or discussion.
+func ListReactions(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_LIST_REACTIONS_DESCRIPTION", "List the emoji reactions on an issue, pull request, issue comment, pull request review comment, discussion or discussion comment, with who left them. Only the first 100 reactions of a discussion or discussion comment can be listed.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_LIST_REACTIONS_USER_TITLE", "List reactions"),
+			ReadOnlyHint: ToBoolPtr(true),
+		}),
+	}
+	options = append(options, withReactionSubject()...)
+	options = append(options,
+		mcp.WithString("content",
+			mcp.Description("Only list reactions with this content"),
+			mcp.Enum(reactionContentValues...),
+		),
+		WithPagination(),
+	)
+
+	return mcp.NewTool("list_reactions", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			subject, err := reactionSubjectParam(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			content, err := reactionContentParam(request, false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if subject.isDiscussion() {
+				return listDiscussionReactions(ctx, getGQLClient, owner, repo, subject, content, pagination)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			reactions, resp, err := listRESTReactions(ctx, client, owner, repo, subject, &github.ListReactionOptions{
+				Content: content,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list reactions on %s", subject), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]Reaction, 0, len(reactions))
+			for _, r := range reactions {
+				reaction := Reaction{
+					ID:      r.GetID(),
+					Content: r.GetContent(),
+					User:    r.GetUser().GetLogin(),
+				}
+				if r.CreatedAt != nil {
+					reaction.CreatedAt = &r.CreatedAt.Time
+				}
+				result = append(result, reaction)
+			}
+			return MarshalledListResult(ctx, result, fmt.Sprintf("%s has no reactions", subject)), nil
+		}
+}
+
+func listDiscussionReactions(ctx context.Context, getGQLClient GetGQLClientFn, owner, repo string, subject reactionSubject, content string, pagination PaginationParams) (*mcp.CallToolResult, error) {
+	// GraphQL has no page numbers, so pages are cut from the first reactions.
+	skip := (pagination.Page - 1) * pagination.PerPage
+	if skip >= maxDiscussionReactions {
+		return mcp.NewToolResultError(fmt.Sprintf("only the first %d reactions of a %s can be listed", maxDiscussionReactions, subject.kind)), nil
+	}
+	first := min(skip+pagination.PerPage, maxDiscussionReactions)
+
+	client, err := getGQLClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+	}
+
+	subjectID, err := discussionReactionSubjectID(ctx, client, owner, repo, subject)
+	if err != nil {
+		return ghErrors.NewGitHubGraphQLErrorResponse(ctx, fmt.Sprintf("failed to get %s", subject), err), nil
+	}
+
+	var contentFilter *githubv4.ReactionContent
+	if content != "" {
+		c := reactionContents[content]
+		contentFilter = &c
+	}
+	var q struct {
+		Node struct {
+			Reactable struct {
+				Reactions struct {
+					Nodes []struct {
+						Content   githubv4.ReactionContent
+						CreatedAt githubv4.DateTime
+						User      struct {
+							Login githubv4.String
+						}
+					}
+				} `graphql:"reactions(first: $first, content: $content)"`
+			} `graphql:"... on Reactable"`
+		} `graphql:"node(id: $id)"`
+	}
+	if err := client.Query(ctx, &q, map[string]any{
+		"id":      subjectID,
+		"first":   githubv4.Int(first), // #nosec G115 - first is at most maxDiscussionReactions
+		"content": contentFilter,
+	}); err != nil {
+		return ghErrors.NewGitHubGraphQLErrorResponse(ctx, fmt.Sprintf("failed to list reactions on %s", subject), err), nil
+	}
+
+	result := []Reaction{}
+	nodes := q.Node.Reactable.Reactions.Nodes
+	for i := skip; i < len(nodes); i++ {
+		createdAt := nodes[i].CreatedAt.Time
+		result = append(result, Reaction{
+			Content:   restReactionContent(nodes[i].Content),
+			User:      string(nodes[i].User.Login),
+			CreatedAt: &createdAt,
+		})
+	}
+	return MarshalledTextResult(result), nil
+}
+
+// AddReaction creates a tool to react with an emoji to an issue, pull request, comment or discussion.
+func AddReaction(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_ADD_REACTION_DESCRIPTION", "React with an emoji to an issue, pull request, issue comment, pull request review comment, discussion or discussion comment, for example to acknowledge it without commenting. Adding a reaction that already exists changes nothing.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_ADD_REACTION_USER_TITLE", "Add reaction"),
+			ReadOnlyHint: ToBoolPtr(false),
+		}),
+	}
+	options = append(options, withReactionSubject()...)
+	options = append(options,
+		mcp.WithString("content",
+			mcp.Required(),
+			mcp.Description("The reaction"),
+			mcp.Enum(reactionContentValues...),
+		),
+	)
+
+	return mcp.NewTool("add_reaction", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			subject, err := reactionSubjectParam(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			content, err := reactionContentParam(request, true)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if subject.isDiscussion() {
+				client, err := getGQLClient(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+				}
+				subjectID, err := discussionReactionSubjectID(ctx, client, owner, repo, subject)
+				if err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, fmt.Sprintf("failed to get %s", subject), err), nil
+				}
+				var mutation struct {
+					AddReaction struct {
+						Reaction struct {
+							Content githubv4.ReactionContent
+						}
+					} `graphql:"addReaction(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &mutation, githubv4.AddReactionInput{
+					SubjectID: subjectID,
+					Content:   reactionContents[content],
+				}, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, fmt.Sprintf("failed to add reaction to %s", subject), err), nil
+				}
+				return mcp.NewToolResultText(fmt.Sprintf("Added %s reaction to %s in %s/%s", content, subject, owner, repo)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			_, resp, err := createRESTReaction(ctx, client, owner, repo, subject, content)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to add reaction to %s", subject), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Added %s reaction to %s in %s/%s", content, subject, owner, repo)), nil
+		}
+}
+
+// RemoveReaction creates a tool to remove a reaction of the authenticated user.
+func RemoveReaction(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_REMOVE_REACTION_DESCRIPTION", "Remove a reaction the authenticated user left on an issue, pull request, issue comment, pull request review comment, discussion or discussion comment. Reactions of other users cannot be removed.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_REMOVE_REACTION_USER_TITLE", "Remove reaction"),
+			ReadOnlyHint: ToBoolPtr(false),
+		}),
+	}
+	options = append(options, withReactionSubject()...)
+	options = append(options,
+		mcp.WithString("content",
+			mcp.Required(),
+			mcp.Description("The reaction to remove"),
+			mcp.Enum(reactionContentValues...),
+		),
+	)
+
+	return mcp.NewTool("remove_reaction", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			subject, err := reactionSubjectParam(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			content, err := reactionContentParam(request, true)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if subject.isDiscussion() {
+				client, err := getGQLClient(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+				}
+				subjectID, err := discussionReactionSubjectID(ctx, client, owner, repo, subject)
+				if err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, fmt.Sprintf("failed to get %s", subject), err), nil
+				}
+				var mutation struct {
+					RemoveReaction struct {
+						Reaction struct {
+							Content githubv4.ReactionContent
+						}
+					} `graphql:"removeReaction(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &mutation, githubv4.RemoveReactionInput{
+					SubjectID: subjectID,
+					Content:   reactionContents[content],
+				}, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, fmt.Sprintf("failed to remove reaction from %s", subject), err), nil
+				}
+				return mcp.NewToolResultText(fmt.Sprintf("Removed %s reaction from %s in %s/%s", content, subject, owner, repo)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// The REST API deletes reactions by ID, so find the one of the authenticated user.
+			me, resp, err := client.Users.Get(ctx, "")
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get authenticated user", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			var reactionID int64
+			listOpts := &github.ListReactionOptions{
+				Content:     content,
+				ListOptions: github.ListOptions{PerPage: 100},
+			}
+			for reactionID == 0 {
+				reactions, resp, err := listRESTReactions(ctx, client, owner, repo, subject, listOpts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list reactions on %s", subject), resp, err), nil
+				}
+				_ = resp.Body.Close()
+				for _, r := range reactions {
+					if r.GetUser().GetLogin() == me.GetLogin() {
+						reactionID = r.GetID()
+						break
+					}
+				}
+				if resp.NextPage == 0 {
+					break
+				}
+				listOpts.Page = resp.NextPage
+			}
+			if reactionID == 0 {
+				return mcp.NewToolResultError(fmt.Sprintf("%s has no %s reaction of %s", subject, content, me.GetLogin())), nil
+			}
+
+			resp, err = deleteRESTReaction(ctx, client, owner, repo, subject, reactionID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to remove reaction from %s", subject), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Removed %s reaction from %s in %s/%s", content, subject, owner, repo)), nil
+		}
+}
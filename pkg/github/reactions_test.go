@@ -0,0 +1,478 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// discussionIDMatcher answers the lookup of the node ID of discussion 7 in owner/repo.
+func discussionIDMatcher() githubv4mock.Matcher {
+	return githubv4mock.NewQueryMatcher(
+		struct {
+			Repository struct {
+				Discussion struct {
+					ID githubv4.ID
+				} `graphql:"discussion(number: $discussionNumber)"`
+			} `graphql:"repository(owner: $owner, name: $repo)"`
+		}{},
+		map[string]any{
+			"owner":            githubv4.String("owner"),
+			"repo":             githubv4.String("repo"),
+			"discussionNumber": githubv4.Int(7),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{"discussion": map[string]any{"id": "D_7"}},
+		}),
+	)
+}
+
+func Test_ListReactions(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListReactions(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_reactions", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "subject_type"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	createdAt := time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC)
+	discussionReactionsQuery := struct {
+		Node struct {
+			Reactable struct {
+				Reactions struct {
+					Nodes []struct {
+						Content   githubv4.ReactionContent
+						CreatedAt githubv4.DateTime
+						User      struct {
+							Login githubv4.String
+						}
+					}
+				} `graphql:"reactions(first: $first, content: $content)"`
+			} `graphql:"... on Reactable"`
+		} `graphql:"node(id: $id)"`
+	}{}
+	heart := githubv4.ReactionContentHeart
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		mockedGQL      *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       []Reaction
+	}{
+		{
+			name: "issue comment reactions",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesCommentsReactionsByOwnerByRepoByCommentId,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/issues/comments/123/reactions",
+						queryParams: map[string]string{"content": "+1", "page": "1", "per_page": "30"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Reaction{
+							{
+								ID:        github.Ptr(int64(1)),
+								Content:   github.Ptr("+1"),
+								User:      &github.User{Login: github.Ptr("octocat")},
+								CreatedAt: &github.Timestamp{Time: createdAt},
+							},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue_comment",
+				"comment_id":   "123",
+				"content":      "+1",
+			},
+			expected: []Reaction{
+				{ID: 1, Content: "+1", User: "octocat", CreatedAt: &createdAt},
+			},
+		},
+		{
+			name: "discussion reactions are paged from the first ones",
+			mockedGQL: githubv4mock.NewMockedHTTPClient(
+				discussionIDMatcher(),
+				githubv4mock.NewQueryMatcher(
+					discussionReactionsQuery,
+					map[string]any{
+						"id":      githubv4.ID("D_7"),
+						"first":   githubv4.Int(4),
+						"content": &heart,
+					},
+					githubv4mock.DataResponse(map[string]any{
+						"node": map[string]any{
+							"reactions": map[string]any{
+								"nodes": []map[string]any{
+									{"content": "HEART", "createdAt": createdAt, "user": map[string]any{"login": "a"}},
+									{"content": "HEART", "createdAt": createdAt, "user": map[string]any{"login": "b"}},
+									{"content": "HEART", "createdAt": createdAt, "user": map[string]any{"login": "c"}},
+								},
+							},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "discussion",
+				"number":       float64(7),
+				"content":      "heart",
+				"page":         float64(2),
+				"perPage":      float64(2),
+			},
+			expected: []Reaction{
+				{Content: "heart", User: "c", CreatedAt: &createdAt},
+			},
+		},
+		{
+			name: "discussion comment reactions without filter",
+			mockedGQL: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					discussionReactionsQuery,
+					map[string]any{
+						"id":      githubv4.ID("DC_1"),
+						"first":   githubv4.Int(30),
+						"content": (*githubv4.ReactionContent)(nil),
+					},
+					githubv4mock.DataResponse(map[string]any{
+						"node": map[string]any{
+							"reactions": map[string]any{
+								"nodes": []map[string]any{
+									{"content": "THUMBS_UP", "createdAt": createdAt, "user": map[string]any{"login": "octocat"}},
+								},
+							},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "discussion_comment",
+				"comment_id":   "DC_1",
+			},
+			expected: []Reaction{
+				{Content: "+1", User: "octocat", CreatedAt: &createdAt},
+			},
+		},
+		{
+			name: "discussion page beyond the first reactions",
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "discussion",
+				"number":       float64(7),
+				"page":         float64(5),
+				"perPage":      float64(25),
+			},
+			expectError:    true,
+			expectedErrMsg: "only the first 100 reactions of a discussion can be listed",
+		},
+		{
+			name: "comment without comment_id",
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "pull_request_review_comment",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: comment_id",
+		},
+		{
+			name: "unknown content",
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue",
+				"number":       float64(42),
+				"content":      "thumbsup",
+			},
+			expectError:    true,
+			expectedErrMsg: `content must be one of +1, -1, laugh, confused, heart, hooray, rocket or eyes, got "thumbsup"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			gqlClient := githubv4.NewClient(tc.mockedGQL)
+			_, handler := ListReactions(stubGetClientFn(client), stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned []Reaction
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
+
+func Test_AddReaction(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AddReaction(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "add_reaction", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "subject_type", "content"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		mockedGQL      *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "reaction on a pull request",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesReactionsByOwnerByRepoByIssueNumber,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/issues/42/reactions",
+						requestBody: map[string]any{"content": "rocket"},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Reaction{ID: github.Ptr(int64(1)), Content: github.Ptr("rocket")}),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "pull_request",
+				"number":       float64(42),
+				"content":      "rocket",
+			},
+			expectedText: "Added rocket reaction to pull_request #42 in owner/repo",
+		},
+		{
+			name: "reaction on a discussion",
+			mockedGQL: githubv4mock.NewMockedHTTPClient(
+				discussionIDMatcher(),
+				githubv4mock.NewMutationMatcher(
+					struct {
+						AddReaction struct {
+							Reaction struct {
+								Content githubv4.ReactionContent
+							}
+						} `graphql:"addReaction(input: $input)"`
+					}{},
+					githubv4.AddReactionInput{
+						SubjectID: githubv4.ID("D_7"),
+						Content:   githubv4.ReactionContentEyes,
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"addReaction": map[string]any{"reaction": map[string]any{"content": "EYES"}},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "discussion",
+				"number":       float64(7),
+				"content":      "eyes",
+			},
+			expectedText: "Added eyes reaction to discussion #7 in owner/repo",
+		},
+		{
+			name: "comment ID that is not a number",
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue_comment",
+				"comment_id":   "IC_kwDO",
+				"content":      "+1",
+			},
+			expectError:    true,
+			expectedErrMsg: `comment_id of an issue_comment must be a number, got "IC_kwDO"`,
+		},
+		{
+			name: "locked issue",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesReactionsByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Issue is locked"}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue",
+				"number":       float64(42),
+				"content":      "+1",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to add reaction to issue #42",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			gqlClient := githubv4.NewClient(tc.mockedGQL)
+			_, handler := AddReaction(stubGetClientFn(client), stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_RemoveReaction(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RemoveReaction(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "remove_reaction", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "subject_type", "content"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	me := mock.WithRequestMatchHandler(
+		mock.GetUser,
+		mockResponse(t, http.StatusOK, &github.User{Login: github.Ptr("octocat")}),
+	)
+	heartReactions := mock.WithRequestMatchHandler(
+		mock.GetReposIssuesReactionsByOwnerByRepoByIssueNumber,
+		expectQueryParams(t, map[string]string{"content": "heart", "per_page": "100"}).andThen(
+			mockResponse(t, http.StatusOK, []*github.Reaction{
+				{ID: github.Ptr(int64(1)), Content: github.Ptr("heart"), User: &github.User{Login: github.Ptr("hubot")}},
+				{ID: github.Ptr(int64(2)), Content: github.Ptr("heart"), User: &github.User{Login: github.Ptr("octocat")}},
+			}),
+		),
+	)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		mockedGQL      *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "own reaction removed",
+			mockedClient: mock.NewMockedHTTPClient(
+				me,
+				heartReactions,
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposIssuesReactionsByOwnerByRepoByIssueNumberByReactionId,
+					expectPath(t, "/repos/owner/repo/issues/42/reactions/2").andThen(
+						mockResponse(t, http.StatusNoContent, nil),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue",
+				"number":       float64(42),
+				"content":      "heart",
+			},
+			expectedText: "Removed heart reaction from issue #42 in owner/repo",
+		},
+		{
+			name: "no reaction of the authenticated user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUser,
+					mockResponse(t, http.StatusOK, &github.User{Login: github.Ptr("monalisa")}),
+				),
+				heartReactions,
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue",
+				"number":       float64(42),
+				"content":      "heart",
+			},
+			expectError:    true,
+			expectedErrMsg: "issue #42 has no heart reaction of monalisa",
+		},
+		{
+			name: "reaction removed from a discussion comment",
+			mockedGQL: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewMutationMatcher(
+					struct {
+						RemoveReaction struct {
+							Reaction struct {
+								Content githubv4.ReactionContent
+							}
+						} `graphql:"removeReaction(input: $input)"`
+					}{},
+					githubv4.RemoveReactionInput{
+						SubjectID: githubv4.ID("DC_1"),
+						Content:   githubv4.ReactionContentThumbsDown,
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"removeReaction": map[string]any{"reaction": map[string]any{"content": "THUMBS_DOWN"}},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "discussion_comment",
+				"comment_id":   "DC_1",
+				"content":      "-1",
+			},
+			expectedText: "Removed -1 reaction from discussion_comment DC_1 in owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			gqlClient := githubv4.NewClient(tc.mockedGQL)
+			_, handler := RemoveReaction(stubGetClientFn(client), stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
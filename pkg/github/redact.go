@@ -0,0 +1,108 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// redactedValue replaces the value of a sensitive tool argument in logs.
+const redactedValue = "[REDACTED]"
+
+// sensitiveToolArguments names the arguments of each tool whose values must never be logged.
+var sensitiveToolArguments = map[string][]string{
+	"set_org_secret": {"value"},
+}
+
+// RedactToolArguments returns a JSON-RPC message with the values of sensitive tool arguments
+// replaced, for logging the messages a client sends. Messages that are not calls of a tool with
+// sensitive arguments are returned unchanged.
+func RedactToolArguments(message []byte) []byte {
+	trimmed := bytes.TrimSpace(message)
+	if len(trimmed) == 0 {
+		return message
+	}
+
+	if trimmed[0] == '[' {
+		var batch []json.RawMessage
+		if err := json.Unmarshal(trimmed, &batch); err != nil {
+			return message
+		}
+		redacted := false
+		for i, m := range batch {
+			if r, ok := redactToolCall(m); ok {
+				batch[i], redacted = r, true
+			}
+		}
+		if !redacted {
+			return message
+		}
+		encoded, err := json.Marshal(batch)
+		if err != nil {
+			return []byte(redactedValue)
+		}
+		return keepNewline(message, encoded)
+	}
+
+	redacted, ok := redactToolCall(trimmed)
+	if !ok {
+		return message
+	}
+	return keepNewline(message, redacted)
+}
+
+// keepNewline ends redacted with a newline if message, which it replaces, ended with one.
+func keepNewline(message, redacted []byte) []byte {
+	if bytes.HasSuffix(message, []byte("\n")) {
+		return append(redacted, '\n')
+	}
+	return redacted
+}
+
+// redactToolCall redacts the sensitive arguments of a single tools/call request. It reports
+// whether anything was redacted.
+func redactToolCall(message []byte) ([]byte, bool) {
+	var request map[string]json.RawMessage
+	if err := json.Unmarshal(message, &request); err != nil {
+		return nil, false
+	}
+	var method string
+	if err := json.Unmarshal(request["method"], &method); err != nil || method != "tools/call" {
+		return nil, false
+	}
+	var params map[string]json.RawMessage
+	if err := json.Unmarshal(request["params"], &params); err != nil {
+		return nil, false
+	}
+	var name string
+	if err := json.Unmarshal(params["name"], &name); err != nil {
+		return nil, false
+	}
+	sensitive, ok := sensitiveToolArguments[name]
+	if !ok {
+		return nil, false
+	}
+
+	// The call cannot be read safely, so none of it is logged.
+	var arguments map[string]json.RawMessage
+	if err := json.Unmarshal(params["arguments"], &arguments); err != nil {
+		return []byte(redactedValue), true
+	}
+	for _, arg := range sensitive {
+		if _, ok := arguments[arg]; ok {
+			arguments[arg] = json.RawMessage(`"` + redactedValue + `"`)
+		}
+	}
+
+	var err error
+	if params["arguments"], err = json.Marshal(arguments); err != nil {
+		return []byte(redactedValue), true
+	}
+	if request["params"], err = json.Marshal(params); err != nil {
+		return []byte(redactedValue), true
+	}
+	encoded, err := json.Marshal(request)
+	if err != nil {
+		return []byte(redactedValue), true
+	}
+	return encoded, true
+}
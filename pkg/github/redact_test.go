@@ -0,0 +1,70 @@
+package github
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	mcplog "github.com/github/github-mcp-server/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RedactToolArguments(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  string
+		expected string
+	}{
+		{
+			name:     "secret value is redacted",
+			message:  `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"set_org_secret","arguments":{"org":"octo","name":"TOKEN","value":"s3cr3t"}}}` + "\n",
+			expected: `{"id":1,"jsonrpc":"2.0","method":"tools/call","params":{"arguments":{"name":"TOKEN","org":"octo","value":"[REDACTED]"},"name":"set_org_secret"}}` + "\n",
+		},
+		{
+			name:     "batch is redacted",
+			message:  `[{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"set_org_secret","arguments":{"value":"s3cr3t"}}},{"jsonrpc":"2.0","id":2,"method":"ping"}]`,
+			expected: `[{"id":1,"jsonrpc":"2.0","method":"tools/call","params":{"arguments":{"value":"[REDACTED]"},"name":"set_org_secret"}},{"jsonrpc":"2.0","id":2,"method":"ping"}]`,
+		},
+		{
+			name:     "other tools are unchanged",
+			message:  `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"set_org_variable","arguments":{"value":"plain"}}}`,
+			expected: `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"set_org_variable","arguments":{"value":"plain"}}}`,
+		},
+		{
+			name:     "unreadable arguments are dropped",
+			message:  `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"set_org_secret","arguments":"s3cr3t"}}`,
+			expected: `[REDACTED]`,
+		},
+		{
+			name:     "not JSON",
+			message:  "hello\n",
+			expected: "hello\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, string(RedactToolArguments([]byte(tc.message))))
+		})
+	}
+}
+
+func Test_CommandLogOmitsSecretValues(t *testing.T) {
+	const secret = "ghp_doNotLogMe"
+	call := `{"jsonrpc":"2.0","id":7,"method":"tools/call","params":{"name":"set_org_secret","arguments":{"org":"octo","name":"TOKEN","value":"` + secret + `"}}}` + "\n"
+
+	var logBuffer bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logBuffer, nil))
+	// Reading a byte at a time splits the secret across many reads.
+	in := mcplog.NewIOLogger(iotest.OneByteReader(strings.NewReader(call)), nil, logger, mcplog.WithRedactor(RedactToolArguments))
+
+	received, err := io.ReadAll(in)
+	require.NoError(t, err)
+	assert.Equal(t, call, string(received), "the server still gets the secret")
+	assert.Contains(t, logBuffer.String(), "set_org_secret")
+	assert.NotContains(t, logBuffer.String(), secret)
+}
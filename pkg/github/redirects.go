@@ -0,0 +1,196 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxRepoRedirects bounds how many rename redirects are followed for a single request, in case a
+// repository was renamed several times.
+const maxRepoRedirects = 3
+
+var (
+	repoPathPattern         = regexp.MustCompile(`/repos/([^/]+)/([^/]+)`)
+	repoRedirectPathPattern = regexp.MustCompile(`/repositories/(\d+)(/.*)?$`)
+)
+
+// RepoRedirect records that a repository was reached under an old name.
+type RepoRedirect struct {
+	From string
+	To   string
+}
+
+type repoRedirectsKey struct{}
+
+type repoRedirects struct {
+	mu        sync.Mutex
+	redirects []RepoRedirect
+}
+
+func (r *repoRedirects) add(redirect RepoRedirect) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.redirects {
+		if existing == redirect {
+			return
+		}
+	}
+	r.redirects = append(r.redirects, redirect)
+}
+
+// ContextWithRepoRedirects returns a context that collects the repository redirects followed by
+// RepoRedirectTransport, for retrieval with RepoRedirectsFromContext.
+func ContextWithRepoRedirects(ctx context.Context) context.Context {
+	return context.WithValue(ctx, repoRedirectsKey{}, &repoRedirects{})
+}
+
+// RepoRedirectsFromContext returns the repository redirects followed so far with ctx.
+func RepoRedirectsFromContext(ctx context.Context) []RepoRedirect {
+	r, ok := ctx.Value(repoRedirectsKey{}).(*repoRedirects)
+	if !ok {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]RepoRedirect(nil), r.redirects...)
+}
+
+// RepoRedirectTransport handles the 301 GitHub returns when a repository has been renamed or
+// transferred. Reads are followed transparently and recorded on the request context, so tools can
+// tell the caller the repository has moved. Writes are not followed, because the HTTP client would
+// otherwise replay them as GET requests; they fail with an error naming the new location instead.
+type RepoRedirectTransport struct {
+	Transport http.RoundTripper
+}
+
+// NewRepoRedirectTransport wraps transport, or http.DefaultTransport if it is nil.
+func NewRepoRedirectTransport(transport http.RoundTripper) *RepoRedirectTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &RepoRedirectTransport{Transport: transport}
+}
+
+func (t *RepoRedirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.roundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusMovedPermanently {
+		return resp, err
+	}
+
+	from := repoPathPattern.FindStringSubmatch(req.URL.Path)
+	if from == nil {
+		return resp, nil
+	}
+	oldName := from[1] + "/" + from[2]
+
+	for i := 0; i < maxRepoRedirects && resp.StatusCode == http.StatusMovedPermanently; i++ {
+		location, ok := repoRedirectLocation(req, resp)
+		if !ok {
+			return resp, nil
+		}
+		_ = resp.Body.Close()
+
+		if req.Method != http.MethodGet && req.Method != http.MethodHead {
+			return nil, fmt.Errorf("repository %s has been renamed or transferred (now %s), retry the request with its new name", oldName, location.Path)
+		}
+
+		req = req.Clone(req.Context())
+		req.URL = location
+		req.Host = ""
+		resp, err = t.roundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if r, ok := req.Context().Value(repoRedirectsKey{}).(*repoRedirects); ok && resp.StatusCode == http.StatusOK {
+		r.add(RepoRedirect{From: oldName, To: t.newRepoName(req, resp)})
+	}
+	return resp, nil
+}
+
+// roundTrip hands the wrapped transport its own copy of req, as the original may be needed again
+// to follow a redirect.
+func (t *RepoRedirectTransport) roundTrip(req *http.Request) (*http.Response, error) {
+	return t.Transport.RoundTrip(req.Clone(req.Context()))
+}
+
+// repoRedirectLocation returns the target of a rename redirect, which points at the repository by
+// ID. Redirects to other hosts are never followed, so credentials can't leak.
+func repoRedirectLocation(req *http.Request, resp *http.Response) (*url.URL, bool) {
+	location, err := req.URL.Parse(resp.Header.Get("Location"))
+	if err != nil || location.Host != req.URL.Host || !repoRedirectPathPattern.MatchString(location.Path) {
+		return nil, false
+	}
+	return location, true
+}
+
+// newRepoName works out the full name of the repository a redirect led to. When the redirect
+// was for the repository itself the response already has it, otherwise it is looked up by ID.
+func (t *RepoRedirectTransport) newRepoName(req *http.Request, resp *http.Response) string {
+	match := repoRedirectPathPattern.FindStringSubmatch(req.URL.Path)
+	if match == nil {
+		return req.URL.Path
+	}
+	fallback := "repository ID " + match[1]
+
+	var repo struct {
+		FullName string `json:"full_name"`
+	}
+	if match[2] == "" && req.Method == http.MethodGet {
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		if err == nil && json.Unmarshal(body, &repo) == nil && repo.FullName != "" {
+			return repo.FullName
+		}
+		return fallback
+	}
+
+	lookup := req.Clone(req.Context())
+	lookup.Method = http.MethodGet
+	lookup.URL.Path = strings.TrimSuffix(req.URL.Path, match[2])
+	lookup.URL.RawPath = ""
+	lookup.URL.RawQuery = ""
+	lookupResp, err := t.roundTrip(lookup)
+	if err != nil {
+		return fallback
+	}
+	defer func() { _ = lookupResp.Body.Close() }()
+	if lookupResp.StatusCode != http.StatusOK || json.NewDecoder(lookupResp.Body).Decode(&repo) != nil || repo.FullName == "" {
+		return fallback
+	}
+	return repo.FullName
+}
+
+// WithRepoRedirectNotes is a server option that tells the caller when a tool reached a repository
+// under an old name, so it can use the new name from then on.
+func WithRepoRedirectNotes() server.ServerOption {
+	return server.WithToolHandlerMiddleware(repoRedirectNotesMiddleware)
+}
+
+func repoRedirectNotesMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx = ContextWithRepoRedirects(ctx)
+		result, err := next(ctx, request)
+		if result == nil {
+			return result, err
+		}
+		for _, redirect := range RepoRedirectsFromContext(ctx) {
+			result.Content = append(result.Content, mcp.NewTextContent(fmt.Sprintf(
+				"Note: repository %s has moved to %s. Use the new name in future requests.", redirect.From, redirect.To)))
+		}
+		return result, err
+	}
+}
@@ -0,0 +1,134 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var getRepositoriesByID = mock.EndpointPattern{
+	Pattern: "/repositories/{id}",
+	Method:  "GET",
+}
+
+func movedPermanently(location string) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Location", location)
+		w.WriteHeader(http.StatusMovedPermanently)
+		_, _ = w.Write([]byte(`{"message":"Moved Permanently"}`))
+	}
+}
+
+func Test_RepoRedirectTransport_GetRepository(t *testing.T) {
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposByOwnerByRepo,
+			movedPermanently("https://api.github.com/repositories/42"),
+		),
+		mock.WithRequestMatch(
+			getRepositoriesByID,
+			&github.Repository{
+				ID:       github.Ptr(int64(42)),
+				FullName: github.Ptr("new-owner/new-name"),
+				HTMLURL:  github.Ptr("https://github.com/new-owner/new-name"),
+			},
+		),
+	)
+	mockedClient.Transport = NewRepoRedirectTransport(mockedClient.Transport)
+	client := github.NewClient(mockedClient)
+
+	_, handler := GetForkNetwork(stubGetClientFn(client), translations.NullTranslationHelper)
+	handler = repoRedirectNotesMiddleware(handler)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner": "old-owner",
+		"repo":  "old-name",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Len(t, result.Content, 2)
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	require.True(t, ok)
+	var network ForkNetwork
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &network))
+	assert.Equal(t, "new-owner/new-name", network.Repository.FullName)
+
+	note, ok := result.Content[1].(mcp.TextContent)
+	require.True(t, ok)
+	assert.Equal(t, "Note: repository old-owner/old-name has moved to new-owner/new-name. Use the new name in future requests.", note.Text)
+}
+
+func Test_RepoRedirectTransport(t *testing.T) {
+	t.Run("sub-resource read looks up the new name", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposBranchesByOwnerByRepo,
+				movedPermanently("https://api.github.com/repositories/42/branches"),
+			),
+			mock.WithRequestMatch(
+				mock.EndpointPattern{Pattern: "/repositories/{id}/branches", Method: "GET"},
+				[]*github.Branch{{Name: github.Ptr("main")}},
+			),
+			mock.WithRequestMatch(
+				getRepositoriesByID,
+				&github.Repository{FullName: github.Ptr("new-owner/new-name")},
+			),
+		)
+		mockedClient.Transport = NewRepoRedirectTransport(mockedClient.Transport)
+		client := github.NewClient(mockedClient)
+
+		ctx := ContextWithRepoRedirects(context.Background())
+		branches, _, err := client.Repositories.ListBranches(ctx, "old-owner", "old-name", nil)
+		require.NoError(t, err)
+		require.Len(t, branches, 1)
+		assert.Equal(t, "main", branches[0].GetName())
+		assert.Equal(t, []RepoRedirect{{From: "old-owner/old-name", To: "new-owner/new-name"}}, RepoRedirectsFromContext(ctx))
+	})
+
+	t.Run("writes are not replayed", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.PatchReposByOwnerByRepo,
+				movedPermanently("https://api.github.com/repositories/42"),
+			),
+			mock.WithRequestMatchHandler(
+				getRepositoriesByID,
+				http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+					t.Error("write must not be followed as a GET")
+				}),
+			),
+		)
+		mockedClient.Transport = NewRepoRedirectTransport(mockedClient.Transport)
+		client := github.NewClient(mockedClient)
+
+		ctx := ContextWithRepoRedirects(context.Background())
+		_, _, err := client.Repositories.Edit(ctx, "old-owner", "old-name", &github.Repository{Description: github.Ptr("new")})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "repository old-owner/old-name has been renamed or transferred")
+		assert.Empty(t, RepoRedirectsFromContext(ctx))
+	})
+
+	t.Run("other redirects are passed through", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposByOwnerByRepo,
+				movedPermanently("https://example.com/somewhere-else"),
+			),
+		)
+		mockedClient.Transport = NewRepoRedirectTransport(mockedClient.Transport)
+		client := github.NewClient(mockedClient)
+
+		ctx := ContextWithRepoRedirects(context.Background())
+		_, _, _ = client.Repositories.Get(ctx, "owner", "repo")
+		assert.Empty(t, RepoRedirectsFromContext(ctx))
+	})
+}
@@ -0,0 +1,387 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// withReleaseSelector adds the parameters that pick the release to change: its ID, or its tag.
+// Draft releases have no tag yet, so they can only be picked by ID.
+func withReleaseSelector(action string) []mcp.ToolOption {
+	return []mcp.ToolOption{
+		mcp.WithNumber("release_id",
+			mcp.Description(fmt.Sprintf("ID of the release to %s. Required for draft releases", action)),
+		),
+		mcp.WithString("tag",
+			mcp.Description(fmt.Sprintf("Tag of the release to %s, when release_id is not given", action)),
+		),
+	}
+}
+
+// resolveRelease returns the release picked by the release_id or tag parameters. The release is
+// nil when it was picked by ID, since there is no need to fetch it then. A non-nil result reports
+// a problem to the caller.
+func resolveRelease(ctx context.Context, client *github.Client, owner, repo string, request mcp.CallToolRequest) (int64, *github.RepositoryRelease, *mcp.CallToolResult) {
+	releaseID, err := OptionalIntParam(request, "release_id")
+	if err != nil {
+		return 0, nil, mcp.NewToolResultError(err.Error())
+	}
+	tag, err := OptionalParam[string](request, "tag")
+	if err != nil {
+		return 0, nil, mcp.NewToolResultError(err.Error())
+	}
+
+	switch {
+	case releaseID != 0 && tag != "":
+		return 0, nil, mcp.NewToolResultError("give either release_id or tag, not both")
+	case releaseID != 0:
+		return int64(releaseID), nil, nil
+	case tag == "":
+		return 0, nil, mcp.NewToolResultError("either release_id or tag is required")
+	}
+
+	release, resp, err := client.Repositories.GetReleaseByTag(ctx, owner, repo, tag)
+	if err != nil {
+		return 0, nil, ghErrors.NewGitHubAPIErrorResponse(ctx,
+			fmt.Sprintf("failed to get release by tag: %s", tag),
+			resp,
+			err,
+		)
+	}
+	_ = resp.Body.Close()
+	return release.GetID(), release, nil
+}
+
+// releaseResponse is what the release tools return for a release they created or changed.
+func releaseResponse(release *github.RepositoryRelease) map[string]any {
+	return map[string]any{
+		"id":         strconv.FormatInt(release.GetID(), 10),
+		"tag_name":   release.GetTagName(),
+		"name":       release.GetName(),
+		"draft":      release.GetDraft(),
+		"prerelease": release.GetPrerelease(),
+		"url":        release.GetHTMLURL(),
+	}
+}
+
+// CreateRelease creates a tool to create a release in a GitHub repository.
+func CreateRelease(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_release",
+			mcp.WithDescription(t("TOOL_CREATE_RELEASE_DESCRIPTION", "Create a release in a GitHub repository. The tag is created from target_commitish if it does not exist yet")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_RELEASE_USER_TITLE", "Create release"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("tag_name",
+				mcp.Required(),
+				mcp.Description("Tag of the release (e.g., 'v1.0.0')"),
+			),
+			mcp.WithString("target_commitish",
+				mcp.Description("Branch or commit SHA to create the tag from, when it does not exist. Defaults to the default branch"),
+			),
+			mcp.WithString("name",
+				mcp.Description("Name of the release"),
+			),
+			mcp.WithString("body",
+				mcp.Description("Release notes in markdown"),
+			),
+			mcp.WithBoolean("draft",
+				mcp.Description("Create an unpublished draft release"),
+			),
+			mcp.WithBoolean("prerelease",
+				mcp.Description("Mark the release as a prerelease"),
+			),
+			mcp.WithBoolean("generate_release_notes",
+				mcp.Description("Generate the name and notes from the changes since the previous release. A given body is put before the generated notes"),
+			),
+			mcp.WithString("make_latest",
+				mcp.Description("Whether to make this the latest release. legacy picks the latest by creation date and semantic version"),
+				mcp.Enum("true", "false", "legacy"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tagName, err := RequiredParam[string](request, "tag_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			targetCommitish, err := OptionalParam[string](request, "target_commitish")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := OptionalParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := OptionalParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			draft, err := OptionalParam[bool](request, "draft")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			prerelease, err := OptionalParam[bool](request, "prerelease")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			generateNotes, err := OptionalParam[bool](request, "generate_release_notes")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			makeLatest, err := OptionalParam[string](request, "make_latest")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			release := &github.RepositoryRelease{
+				TagName:              github.Ptr(tagName),
+				Draft:                github.Ptr(draft),
+				Prerelease:           github.Ptr(prerelease),
+				GenerateReleaseNotes: github.Ptr(generateNotes),
+			}
+			if targetCommitish != "" {
+				release.TargetCommitish = github.Ptr(targetCommitish)
+			}
+			if name != "" {
+				release.Name = github.Ptr(name)
+			}
+			if body != "" {
+				release.Body = github.Ptr(body)
+			}
+			if makeLatest != "" {
+				release.MakeLatest = github.Ptr(makeLatest)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			created, resp, err := client.Repositories.CreateRelease(ctx, owner, repo, release)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to create release %s", tagName),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(releaseResponse(created)), nil
+		}
+}
+
+// UpdateRelease creates a tool to change the notes and settings of a release.
+func UpdateRelease(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_UPDATE_RELEASE_DESCRIPTION", "Update the notes or settings of a release, e.g. to publish a draft. Only the given fields are changed")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_UPDATE_RELEASE_USER_TITLE", "Update release"),
+			ReadOnlyHint: ToBoolPtr(false),
+		}),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+	}
+	options = append(options, withReleaseSelector("update")...)
+	options = append(options,
+		mcp.WithString("tag_name",
+			mcp.Description("New tag of the release"),
+		),
+		mcp.WithString("name",
+			mcp.Description("New name of the release"),
+		),
+		mcp.WithString("body",
+			mcp.Description("New release notes in markdown, replacing the current ones"),
+		),
+		mcp.WithBoolean("draft",
+			mcp.Description("false publishes a draft release"),
+		),
+		mcp.WithBoolean("prerelease",
+			mcp.Description("Whether the release is a prerelease"),
+		),
+		mcp.WithString("make_latest",
+			mcp.Description("Whether to make this the latest release"),
+			mcp.Enum("true", "false", "legacy"),
+		),
+	)
+
+	return mcp.NewTool("update_release", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// Build the update only with provided fields
+			update := &github.RepositoryRelease{}
+			updateNeeded := false
+			for _, field := range []struct {
+				name string
+				set  func(string)
+			}{
+				{"tag_name", func(v string) { update.TagName = github.Ptr(v) }},
+				{"name", func(v string) { update.Name = github.Ptr(v) }},
+				{"body", func(v string) { update.Body = github.Ptr(v) }},
+				{"make_latest", func(v string) { update.MakeLatest = github.Ptr(v) }},
+			} {
+				if v, ok, err := OptionalParamOK[string](request, field.name); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				} else if ok {
+					field.set(v)
+					updateNeeded = true
+				}
+			}
+			if draft, ok, err := OptionalParamOK[bool](request, "draft"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				update.Draft = github.Ptr(draft)
+				updateNeeded = true
+			}
+			if prerelease, ok, err := OptionalParamOK[bool](request, "prerelease"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				update.Prerelease = github.Ptr(prerelease)
+				updateNeeded = true
+			}
+			if !updateNeeded {
+				return mcp.NewToolResultError("No update parameters provided."), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			releaseID, _, errResult := resolveRelease(ctx, client, owner, repo, request)
+			if errResult != nil {
+				return errResult, nil
+			}
+
+			updated, resp, err := client.Repositories.EditRelease(ctx, owner, repo, releaseID, update)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to update release %d", releaseID),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(releaseResponse(updated)), nil
+		}
+}
+
+// DeleteRelease creates a tool to delete a release and, optionally, its tag.
+func DeleteRelease(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_DELETE_RELEASE_DESCRIPTION", "Delete a release. The tag is kept unless delete_tag is set")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           t("TOOL_DELETE_RELEASE_USER_TITLE", "Delete release"),
+			ReadOnlyHint:    ToBoolPtr(false),
+			DestructiveHint: ToBoolPtr(true),
+		}),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner"),
+		),
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		),
+	}
+	options = append(options, withReleaseSelector("delete")...)
+	options = append(options,
+		mcp.WithBoolean("delete_tag",
+			mcp.Description("Also delete the tag of the release. Only possible when the release is given by tag"),
+		),
+	)
+
+	return mcp.NewTool("delete_release", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			deleteTag, err := OptionalParam[bool](request, "delete_tag")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			releaseID, release, errResult := resolveRelease(ctx, client, owner, repo, request)
+			if errResult != nil {
+				return errResult, nil
+			}
+			if deleteTag && release == nil {
+				return mcp.NewToolResultError("delete_tag needs the release to be given by tag"), nil
+			}
+
+			resp, err := client.Repositories.DeleteRelease(ctx, owner, repo, releaseID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to delete release %d", releaseID),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			if !deleteTag {
+				return mcp.NewToolResultText(fmt.Sprintf("Successfully deleted release %d", releaseID)), nil
+			}
+
+			tag := release.GetTagName()
+			resp, err = client.Git.DeleteRef(ctx, owner, repo, "tags/"+tag)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("deleted release %d, but failed to delete tag %s", releaseID, tag),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Successfully deleted release %d and tag %s", releaseID, tag)), nil
+		}
+}
@@ -0,0 +1,320 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CreateRelease(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateRelease(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_release", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "tag_name"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	created := &github.RepositoryRelease{
+		ID:         github.Ptr(int64(42)),
+		TagName:    github.Ptr("v1.2.0-rc.1"),
+		Name:       github.Ptr("v1.2.0 RC 1"),
+		Draft:      github.Ptr(true),
+		Prerelease: github.Ptr(true),
+		HTMLURL:    github.Ptr("https://github.com/owner/repo/releases/tag/untagged-abc"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "draft prerelease",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposReleasesByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"tag_name":               "v1.2.0-rc.1",
+						"target_commitish":       "release-1.2",
+						"name":                   "v1.2.0 RC 1",
+						"body":                   "First release candidate",
+						"draft":                  true,
+						"prerelease":             true,
+						"generate_release_notes": false,
+					}).andThen(
+						mockResponse(t, http.StatusCreated, created),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":            "owner",
+				"repo":             "repo",
+				"tag_name":         "v1.2.0-rc.1",
+				"target_commitish": "release-1.2",
+				"name":             "v1.2.0 RC 1",
+				"body":             "First release candidate",
+				"draft":            true,
+				"prerelease":       true,
+			},
+		},
+		{
+			name: "tag already has a release",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposReleasesByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Validation Failed"}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"tag_name": "v1.2.0-rc.1",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create release v1.2.0-rc.1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateRelease(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned map[string]any
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, "42", returned["id"])
+			assert.Equal(t, "v1.2.0-rc.1", returned["tag_name"])
+			assert.Equal(t, true, returned["draft"])
+			assert.Equal(t, true, returned["prerelease"])
+		})
+	}
+}
+
+func Test_UpdateRelease(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateRelease(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_release", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	updated := &github.RepositoryRelease{
+		ID:      github.Ptr(int64(42)),
+		TagName: github.Ptr("v1.2.0"),
+		Body:    github.Ptr("Fixed notes"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/releases/tag/v1.2.0"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "update notes by tag",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposReleasesTagsByOwnerByRepoByTag,
+					expectPath(t, "/repos/owner/repo/releases/tags/v1.2.0").andThen(
+						mockResponse(t, http.StatusOK, updated),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposReleasesByOwnerByRepoByReleaseId,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/releases/42",
+						requestBody: map[string]any{"body": "Fixed notes"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, updated),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"tag":  "v1.2.0",
+				"body": "Fixed notes",
+			},
+		},
+		{
+			name: "publish a draft by ID",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposReleasesByOwnerByRepoByReleaseId,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/releases/42",
+						requestBody: map[string]any{"draft": false},
+					}).andThen(
+						mockResponse(t, http.StatusOK, updated),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"release_id": float64(42),
+				"draft":      false,
+			},
+		},
+		{
+			name:         "nothing to update",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"tag": "v1.2.0",
+			},
+			expectError:    true,
+			expectedErrMsg: "No update parameters provided.",
+		},
+		{
+			name:         "no release given",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"body": "Fixed notes",
+			},
+			expectError:    true,
+			expectedErrMsg: "either release_id or tag is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateRelease(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			args := map[string]any{"owner": "owner", "repo": "repo"}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned map[string]any
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, "42", returned["id"])
+		})
+	}
+}
+
+func Test_DeleteRelease(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteRelease(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_release", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	release := &github.RepositoryRelease{
+		ID:      github.Ptr(int64(42)),
+		TagName: github.Ptr("v1.2.0"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "delete by ID",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposReleasesByOwnerByRepoByReleaseId,
+					expectPath(t, "/repos/owner/repo/releases/42").andThen(
+						mockResponse(t, http.StatusNoContent, nil),
+					),
+				),
+			),
+			requestArgs:  map[string]any{"release_id": float64(42)},
+			expectedText: "Successfully deleted release 42",
+		},
+		{
+			name: "delete with its tag",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposReleasesTagsByOwnerByRepoByTag,
+					release,
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposReleasesByOwnerByRepoByReleaseId,
+					mockResponse(t, http.StatusNoContent, nil),
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposGitRefsByOwnerByRepoByRef,
+					expectPath(t, "/repos/owner/repo/git/refs/tags/v1.2.0").andThen(
+						mockResponse(t, http.StatusNoContent, nil),
+					),
+				),
+			),
+			requestArgs:  map[string]any{"tag": "v1.2.0", "delete_tag": true},
+			expectedText: "Successfully deleted release 42 and tag v1.2.0",
+		},
+		{
+			name:           "delete tag of a release given by ID",
+			mockedClient:   mock.NewMockedHTTPClient(),
+			requestArgs:    map[string]any{"release_id": float64(42), "delete_tag": true},
+			expectError:    true,
+			expectedErrMsg: "delete_tag needs the release to be given by tag",
+		},
+		{
+			name: "release not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposReleasesTagsByOwnerByRepoByTag,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs:    map[string]any{"tag": "v9.9.9"},
+			expectError:    true,
+			expectedErrMsg: "failed to get release by tag: v9.9.9",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DeleteRelease(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			args := map[string]any{"owner": "owner", "repo": "repo"}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
+	"time"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/raw"
@@ -191,12 +193,254 @@ func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				minimalCommits[i] = convertToMinimalCommit(commit, false)
 			}
 
-			r, err := json.Marshal(minimalCommits)
+			return MarshalledListResult(ctx, minimalCommits, "no commits matched"), nil
+		}
+}
+
+// CompareRefs creates a tool to compare two refs of a repository.
+func CompareRefs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("compare_refs",
+			mcp.WithDescription(t("TOOL_COMPARE_REFS_DESCRIPTION", "Compare two branches, tags or commit SHAs of a GitHub repository: how many commits head is ahead of and behind base, the commits on head that are not on base, and the files they change. Useful for release notes and to see what changed between two versions. Commits are paginated; GitHub lists at most 300 changed files.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_COMPARE_REFS_USER_TITLE", "Compare refs"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("base",
+				mcp.Required(),
+				mcp.Description("Branch, tag or commit SHA to compare from, e.g. the previous release tag"),
+			),
+			mcp.WithString("head",
+				mcp.Required(),
+				mcp.Description("Branch, tag or commit SHA to compare to. Use 'user:branch' to compare with a branch of a fork"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := RequiredParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			head, err := RequiredParam[string](request, "head")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			comparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, base, head, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to compare %s...%s", base, head),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := RefComparison{
+				Status:          comparison.GetStatus(),
+				AheadBy:         comparison.GetAheadBy(),
+				BehindBy:        comparison.GetBehindBy(),
+				TotalCommits:    comparison.GetTotalCommits(),
+				MergeBaseCommit: comparison.GetMergeBaseCommit().GetSHA(),
+				HTMLURL:         comparison.GetHTMLURL(),
+				Commits:         make([]MinimalCommit, 0, len(comparison.Commits)),
+				Files:           make([]MinimalCommitFile, 0, len(comparison.Files)),
+			}
+			for _, commit := range comparison.Commits {
+				result.Commits = append(result.Commits, convertToMinimalCommit(commit, false))
+			}
+			for _, file := range comparison.Files {
+				result.Files = append(result.Files, MinimalCommitFile{
+					Filename:  file.GetFilename(),
+					Status:    file.GetStatus(),
+					Additions: file.GetAdditions(),
+					Deletions: file.GetDeletions(),
+					Changes:   file.GetChanges(),
+				})
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// SuggestedReviewer is a recent author of a file, suggested as a reviewer for changes to it.
+type SuggestedReviewer struct {
+	Login        string `json:"login"`
+	Commits      int    `json:"commits"`
+	LastCommitAt string `json:"last_commit_at,omitempty"`
+}
+
+// rankFileAuthors counts commits per author login, most frequent first. Ties are broken by the
+// most recent commit. Commits without a linked GitHub account and bot authors are skipped.
+func rankFileAuthors(commits []*github.RepositoryCommit) []SuggestedReviewer {
+	byLogin := map[string]*SuggestedReviewer{}
+	lastCommit := map[string]time.Time{}
+	var order []string
+	for _, commit := range commits {
+		login := commit.GetAuthor().GetLogin()
+		if login == "" || commit.GetAuthor().GetType() == "Bot" || strings.HasSuffix(login, "[bot]") {
+			continue
+		}
+		reviewer, ok := byLogin[login]
+		if !ok {
+			reviewer = &SuggestedReviewer{Login: login}
+			byLogin[login] = reviewer
+			order = append(order, login)
+		}
+		reviewer.Commits++
+		if date := commit.GetCommit().GetAuthor().GetDate(); date.After(lastCommit[login]) {
+			lastCommit[login] = date.Time
+			reviewer.LastCommitAt = date.Format(time.RFC3339)
+		}
+	}
+
+	ranked := make([]SuggestedReviewer, 0, len(order))
+	for _, login := range order {
+		ranked = append(ranked, *byLogin[login])
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].Commits != ranked[j].Commits {
+			return ranked[i].Commits > ranked[j].Commits
+		}
+		return lastCommit[ranked[i].Login].After(lastCommit[ranked[j].Login])
+	})
+	return ranked
+}
+
+// SuggestReviewersForFile creates a tool to suggest reviewers for a file based on its recent commit history.
+func SuggestReviewersForFile(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("suggest_reviewers_for_file",
+			mcp.WithDescription(t("TOOL_SUGGEST_REVIEWERS_FOR_FILE_DESCRIPTION", "Suggest reviewers for changes to a file, based on who has committed to it most often recently. Only current collaborators on the repository are suggested.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SUGGEST_REVIEWERS_FOR_FILE_USER_TITLE", "Suggest reviewers for file"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path to the file or directory"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit SHA to read history from. Defaults to the default branch."),
+			),
+			mcp.WithNumber("max_commits",
+				mcp.Description("How many recent commits to examine (default 50, max 100)"),
+				mcp.Min(1),
+				mcp.Max(100),
+			),
+			mcp.WithNumber("limit",
+				mcp.Description("Maximum number of reviewers to suggest (default 5)"),
+				mcp.Min(1),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := RequiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxCommits, err := OptionalIntParamWithDefault(request, "max_commits", 50)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxCommits < 1 || maxCommits > 100 {
+				return mcp.NewToolResultError("max_commits must be between 1 and 100"), nil
+			}
+			limit, err := OptionalIntParamWithDefault(request, "limit", 5)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if limit < 1 {
+				return mcp.NewToolResultError("limit must be at least 1"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			commits, resp, err := client.Repositories.ListCommits(ctx, owner, repo, &github.CommitsListOptions{
+				SHA:         ref,
+				Path:        path,
+				ListOptions: github.ListOptions{PerPage: maxCommits},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list commits for %s", path),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			suggestions := make([]SuggestedReviewer, 0, limit)
+			for _, candidate := range rankFileAuthors(commits) {
+				if len(suggestions) == limit {
+					break
+				}
+				isCollaborator, resp, err := client.Repositories.IsCollaborator(ctx, owner, repo, candidate.Login)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to check if %s is a collaborator", candidate.Login),
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				if isCollaborator {
+					suggestions = append(suggestions, candidate)
+				}
+			}
+
+			return MarshalledTextResult(map[string]any{
+				"path":             path,
+				"commits_examined": len(commits),
+				"reviewers":        suggestions,
+			}), nil
 		}
 }
 
@@ -268,12 +512,7 @@ func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				minimalBranches = append(minimalBranches, convertToMinimalBranch(branch))
 			}
 
-			r, err := json.Marshal(minimalBranches)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledListResult(ctx, minimalBranches, "no branches found"), nil
 		}
 }
 
@@ -481,31 +720,123 @@ func CreateRepository(getClient GetClientFn, t translations.TranslationHelperFun
 		}
 }
 
-// GetFileContents creates a tool to get the contents of a file or directory from a GitHub repository.
-func GetFileContents(getClient GetClientFn, getRawClient raw.GetRawClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("get_file_contents",
-			mcp.WithDescription(t("TOOL_GET_FILE_CONTENTS_DESCRIPTION", "Get the contents of a file or directory from a GitHub repository")),
+// ArchiveRepository creates a tool to archive a repository.
+func ArchiveRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("archive_repository",
+			mcp.WithDescription(t("TOOL_ARCHIVE_REPOSITORY_DESCRIPTION", "Archive a GitHub repository, making it read-only: pushes, issues, pull requests and comments are no longer possible until it is unarchived. Requires admin access.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_GET_FILE_CONTENTS_USER_TITLE", "Get file or directory contents"),
-				ReadOnlyHint: ToBoolPtr(true),
+				Title:        t("TOOL_ARCHIVE_REPOSITORY_USER_TITLE", "Archive repository"),
+				ReadOnlyHint: ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
-				mcp.Description("Repository owner (username or organization)"),
+				mcp.Description("Repository owner"),
 			),
 			mcp.WithString("repo",
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
-			mcp.WithString("path",
-				mcp.Description("Path to file/directory (directories must end with a slash '/')"),
-				mcp.DefaultString("/"),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			_, resp, err := client.Repositories.Edit(ctx, owner, repo, &github.Repository{Archived: github.Ptr(true)})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to archive repository %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Successfully archived repository %s/%s", owner, repo)), nil
+		}
+}
+
+// UnarchiveRepository creates a tool to unarchive a repository.
+func UnarchiveRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("unarchive_repository",
+			mcp.WithDescription(t("TOOL_UNARCHIVE_REPOSITORY_DESCRIPTION", "Unarchive a GitHub repository so that it can be pushed to and its issues and pull requests can be changed again. Requires admin access.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UNARCHIVE_REPOSITORY_USER_TITLE", "Unarchive repository"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
 			),
-			mcp.WithString("ref",
-				mcp.Description("Accepts optional git refs such as `refs/tags/{tag}`, `refs/heads/{branch}` or `refs/pull/{pr_number}/head`"),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
 			),
-			mcp.WithString("sha",
-				mcp.Description("Accepts optional commit SHA. If specified, it will be used instead of ref"),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			_, resp, err := client.Repositories.Edit(ctx, owner, repo, &github.Repository{Archived: github.Ptr(false)})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to unarchive repository %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Successfully unarchived repository %s/%s", owner, repo)), nil
+		}
+}
+
+// TransferRepository creates a tool to transfer a repository to another user or organization.
+func TransferRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("transfer_repository",
+			mcp.WithDescription(t("TOOL_TRANSFER_REPOSITORY_DESCRIPTION", "Start transferring a GitHub repository to another user or organization. Requires admin access to the repository and, for an organization, permission to create repositories in it. A transfer to a user only completes once they accept it. Issues, stars and redirects move along, but the original owner loses access.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_TRANSFER_REPOSITORY_USER_TITLE", "Transfer repository"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("new_owner",
+				mcp.Required(),
+				mcp.Description("Login of the user or organization to transfer the repository to"),
+			),
+			mcp.WithString("new_name",
+				mcp.Description("New name of the repository after the transfer (default: keep the current name)"),
+			),
+			mcp.WithArray("team_ids",
+				mcp.Description("IDs of teams of the new organization to give access to the repository"),
+				mcp.Items(map[string]any{"type": "number"}),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -517,32 +848,111 @@ func GetFileContents(getClient GetClientFn, getRawClient raw.GetRawClientFn, t t
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			path, err := RequiredParam[string](request, "path")
+			newOwner, err := RequiredParam[string](request, "new_owner")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			ref, err := OptionalParam[string](request, "ref")
+			newName, err := OptionalParam[string](request, "new_name")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			sha, err := OptionalParam[string](request, "sha")
+			teamIDs, err := OptionalIntArrayParam(request, "team_ids")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
+			transfer := github.TransferRequest{
+				NewOwner: newOwner,
+				NewName:  optionalStringPtr(newName),
+			}
+			for _, id := range teamIDs {
+				transfer.TeamID = append(transfer.TeamID, int64(id))
+			}
+
 			client, err := getClient(ctx)
 			if err != nil {
-				return mcp.NewToolResultError("failed to get GitHub client"), nil
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			_, resp, err := client.Repositories.Transfer(ctx, owner, repo, transfer)
+			// GitHub answers 202 because the transfer runs in the background.
+			if err != nil && !(resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err)) {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to transfer repository %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
 			}
+			defer func() { _ = resp.Body.Close() }()
 
-			rawOpts, err := resolveGitReference(ctx, client, owner, repo, ref, sha)
-			if err != nil {
-				return mcp.NewToolResultError(fmt.Sprintf("failed to resolve git reference: %s", err)), nil
+			if newName == "" {
+				newName = repo
 			}
+			return mcp.NewToolResultText(fmt.Sprintf("Started transferring repository %s/%s to %s/%s. A transfer to a user completes once they accept it.", owner, repo, newOwner, newName)), nil
+		}
+}
 
-			// If the path is (most likely) not to be a directory, we will
-			// first try to get the raw content from the GitHub raw content API.
-			if path != "" && !strings.HasSuffix(path, "/") {
+// GetFileContents creates a tool to get the contents of a file or directory from a GitHub repository.
+func GetFileContents(getClient GetClientFn, getRawClient raw.GetRawClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_file_contents",
+			mcp.WithDescription(t("TOOL_GET_FILE_CONTENTS_DESCRIPTION", "Get the contents of a file or directory from a GitHub repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_FILE_CONTENTS_USER_TITLE", "Get file or directory contents"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner (username or organization)"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("path",
+				mcp.Description("Path to file/directory (directories must end with a slash '/')"),
+				mcp.DefaultString("/"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Accepts optional git refs such as `refs/tags/{tag}`, `refs/heads/{branch}` or `refs/pull/{pr_number}/head`"),
+			),
+			mcp.WithString("sha",
+				mcp.Description("Accepts optional commit SHA. If specified, it will be used instead of ref"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := RequiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := OptionalParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError("failed to get GitHub client"), nil
+			}
+
+			rawOpts, err := resolveGitReference(ctx, client, owner, repo, ref, sha)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to resolve git reference: %s", err)), nil
+			}
+
+			// If the path is (most likely) not to be a directory, we will
+			// first try to get the raw content from the GitHub raw content API.
+			if path != "" && !strings.HasSuffix(path, "/") {
 				// First, get file info from Contents API to retrieve SHA
 				var fileSHA string
 				opts := &github.RepositoryContentGetOptions{Ref: ref}
@@ -582,23 +992,9 @@ func GetFileContents(getClient GetClientFn, getRawClient raw.GetRawClientFn, t t
 					}
 					contentType := resp.Header.Get("Content-Type")
 
-					var resourceURI string
-					switch {
-					case sha != "":
-						resourceURI, err = url.JoinPath("repo://", owner, repo, "sha", sha, "contents", path)
-						if err != nil {
-							return nil, fmt.Errorf("failed to create resource URI: %w", err)
-						}
-					case ref != "":
-						resourceURI, err = url.JoinPath("repo://", owner, repo, ref, "contents", path)
-						if err != nil {
-							return nil, fmt.Errorf("failed to create resource URI: %w", err)
-						}
-					default:
-						resourceURI, err = url.JoinPath("repo://", owner, repo, "contents", path)
-						if err != nil {
-							return nil, fmt.Errorf("failed to create resource URI: %w", err)
-						}
+					resourceURI, err := contentResourceURI(owner, repo, ref, sha, path)
+					if err != nil {
+						return nil, err
 					}
 
 					if strings.HasPrefix(contentType, "application") || strings.HasPrefix(contentType, "text") {
@@ -677,6 +1073,183 @@ func GetFileContents(getClient GetClientFn, getRawClient raw.GetRawClientFn, t t
 		}
 }
 
+// contentResourceURI returns the repo:// URI of a file at a commit SHA, a ref, or the default branch.
+func contentResourceURI(owner, repo, ref, sha, path string) (string, error) {
+	var resourceURI string
+	var err error
+	switch {
+	case sha != "":
+		resourceURI, err = url.JoinPath("repo://", owner, repo, "sha", sha, "contents", path)
+	case ref != "":
+		resourceURI, err = url.JoinPath("repo://", owner, repo, ref, "contents", path)
+	default:
+		resourceURI, err = url.JoinPath("repo://", owner, repo, "contents", path)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to create resource URI: %w", err)
+	}
+	return resourceURI, nil
+}
+
+// maxRawContentSize is the largest file get_raw_content returns, including resolved LFS objects.
+const maxRawContentSize = 10 * 1024 * 1024
+
+// LFSPointerResult describes a Git LFS pointer file that get_raw_content could not replace with
+// the object it points to.
+type LFSPointerResult struct {
+	Path       string `json:"path"`
+	LFSPointer bool   `json:"lfs_pointer"`
+	OID        string `json:"oid"`
+	Size       int64  `json:"size"`
+	Message    string `json:"message"`
+}
+
+// GetRawContent creates a tool to fetch the raw bytes of a file, resolving Git LFS pointers.
+func GetRawContent(getClient GetClientFn, getRawClient raw.GetRawClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_raw_content",
+			mcp.WithDescription(t("TOOL_GET_RAW_CONTENT_DESCRIPTION", fmt.Sprintf("Get the raw content of a file at any ref or commit. When the file is a Git LFS pointer and the server allows LFS resolution, the LFS object it points to is returned instead; otherwise the pointer's object id and size are reported. Files over %d MiB are refused.", maxRawContentSize/1024/1024))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_RAW_CONTENT_USER_TITLE", "Get raw file content"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner (username or organization)"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path to the file"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Accepts optional git refs such as `refs/tags/{tag}`, `refs/heads/{branch}` or `refs/pull/{pr_number}/head`"),
+			),
+			mcp.WithString("sha",
+				mcp.Description("Accepts optional commit SHA. If specified, it will be used instead of ref"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := RequiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := OptionalParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path = strings.TrimPrefix(path, "/")
+			if path == "" || strings.HasSuffix(path, "/") {
+				return mcp.NewToolResultError("path must point to a file, use get_file_contents to list directories"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			rawClient, err := getRawClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub raw content client: %w", err)
+			}
+
+			rawOpts, err := resolveGitReference(ctx, client, owner, repo, ref, sha)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to resolve git reference: %s", err)), nil
+			}
+			resourceURI, err := contentResourceURI(owner, repo, ref, sha, path)
+			if err != nil {
+				return nil, err
+			}
+
+			resp, err := rawClient.GetRawContent(ctx, owner, repo, path, rawOpts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get raw content: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+			if resp.StatusCode == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("file %s not found in %s/%s", path, owner, repo)), nil
+			}
+			if resp.StatusCode != http.StatusOK {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get raw content: unexpected status %d", resp.StatusCode)), nil
+			}
+			body, err := io.ReadAll(io.LimitReader(resp.Body, maxRawContentSize+1))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read raw content: %w", err)
+			}
+			if len(body) > maxRawContentSize {
+				return mcp.NewToolResultError(fmt.Sprintf("file %s is larger than %d bytes", path, maxRawContentSize)), nil
+			}
+
+			pointer, isPointer := raw.ParseLFSPointer(body)
+			if !isPointer {
+				return newContentResourceResult(resourceURI, body, resp.Header.Get("Content-Type"), "successfully downloaded"), nil
+			}
+
+			pointerResult := LFSPointerResult{Path: path, LFSPointer: true, OID: pointer.OID, Size: pointer.Size}
+			switch {
+			case !rawClient.LFSEnabled():
+				pointerResult.Message = "the file is a Git LFS pointer; the server was not started with --resolve-lfs, so the LFS object was not downloaded"
+				return MarshalledTextResult(pointerResult), nil
+			case pointer.Size > maxRawContentSize:
+				pointerResult.Message = fmt.Sprintf("the file is a Git LFS pointer to an object larger than %d bytes, which was not downloaded", maxRawContentSize)
+				return MarshalledTextResult(pointerResult), nil
+			}
+
+			objectResp, err := rawClient.GetLFSObject(ctx, owner, repo, pointer)
+			if err != nil {
+				pointerResult.Message = fmt.Sprintf("the file is a Git LFS pointer and the LFS object could not be downloaded: %v", err)
+				return MarshalledTextResult(pointerResult), nil
+			}
+			defer func() { _ = objectResp.Body.Close() }()
+			if objectResp.StatusCode != http.StatusOK {
+				pointerResult.Message = fmt.Sprintf("the file is a Git LFS pointer and the LFS object could not be downloaded: unexpected status %d", objectResp.StatusCode)
+				return MarshalledTextResult(pointerResult), nil
+			}
+			object, err := io.ReadAll(io.LimitReader(objectResp.Body, maxRawContentSize+1))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read LFS object: %w", err)
+			}
+			if len(object) > maxRawContentSize {
+				pointerResult.Message = fmt.Sprintf("the file is a Git LFS pointer to an object larger than %d bytes, which was not downloaded", maxRawContentSize)
+				return MarshalledTextResult(pointerResult), nil
+			}
+
+			// LFS storage rarely knows what it holds, so the type is sniffed from the content.
+			return newContentResourceResult(resourceURI, object, http.DetectContentType(object), fmt.Sprintf("successfully resolved Git LFS pointer (OID: %s) and downloaded", pointer.OID)), nil
+		}
+}
+
+// newContentResourceResult returns file content as a text resource when the content type is
+// textual, or as a base64 blob otherwise. prefix starts the result message, e.g. "successfully downloaded".
+func newContentResourceResult(uri string, content []byte, contentType, prefix string) *mcp.CallToolResult {
+	if strings.HasPrefix(contentType, "application") || strings.HasPrefix(contentType, "text") {
+		return mcp.NewToolResultResource(prefix+" text file", mcp.TextResourceContents{
+			URI:      uri,
+			Text:     string(content),
+			MIMEType: contentType,
+		})
+	}
+	return mcp.NewToolResultResource(prefix+" binary file", mcp.BlobResourceContents{
+		URI:      uri,
+		Blob:     base64.StdEncoding.EncodeToString(content),
+		MIMEType: contentType,
+	})
+}
+
 // ForkRepository creates a tool to fork a repository.
 func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("fork_repository",
@@ -758,6 +1331,218 @@ func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc)
 		}
 }
 
+// ListForks creates a tool to list the forks of a repository.
+func ListForks(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_forks",
+			mcp.WithDescription(t("TOOL_LIST_FORKS_DESCRIPTION", "List forks of a GitHub repository, including each fork's owner, star count and last push time")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_FORKS_USER_TITLE", "List forks"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("How to sort the forks. Defaults to 'newest'."),
+				mcp.Enum("newest", "oldest", "stargazers"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sort, err := OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.RepositoryListForksOptions{
+				Sort: sort,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			forks, resp, err := client.Repositories.ListForks(ctx, owner, repo, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list forks",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			minimalForks := make([]MinimalFork, 0, len(forks))
+			for _, fork := range forks {
+				minimalFork := MinimalFork{
+					FullName: fork.GetFullName(),
+					Owner:    fork.GetOwner().GetLogin(),
+					HTMLURL:  fork.GetHTMLURL(),
+					Stars:    fork.GetStargazersCount(),
+				}
+				if fork.PushedAt != nil {
+					minimalFork.PushedAt = fork.PushedAt.Format("2006-01-02T15:04:05Z")
+				}
+				minimalForks = append(minimalForks, minimalFork)
+			}
+
+			return MarshalledListResult(ctx, minimalForks, "no forks found"), nil
+		}
+}
+
+// GetForkNetwork creates a tool to summarize a repository's position in its fork network.
+func GetForkNetwork(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_fork_network",
+			mcp.WithDescription(t("TOOL_GET_FORK_NETWORK_DESCRIPTION", "Summarize a repository's fork network: whether it is a fork, its parent and upstream source repositories, and the size of the network")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_FORK_NETWORK_USER_TITLE", "Get fork network"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get repository",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			toNetworkRepo := func(r *github.Repository) *ForkNetworkRepository {
+				if r == nil {
+					return nil
+				}
+				return &ForkNetworkRepository{
+					FullName: r.GetFullName(),
+					HTMLURL:  r.GetHTMLURL(),
+					Stars:    r.GetStargazersCount(),
+					Forks:    r.GetForksCount(),
+				}
+			}
+
+			network := ForkNetwork{
+				Repository:   *toNetworkRepo(repository),
+				IsFork:       repository.GetFork(),
+				Parent:       toNetworkRepo(repository.Parent),
+				Source:       toNetworkRepo(repository.Source),
+				NetworkCount: repository.GetNetworkCount(),
+			}
+
+			r, err := json.Marshal(network)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal fork network: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// SyncFork creates a tool to sync a branch of a fork with its upstream repository.
+func SyncFork(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("sync_fork",
+			mcp.WithDescription(t("TOOL_SYNC_FORK_DESCRIPTION", "Sync a branch of a forked repository with the same branch of its upstream repository, like the 'Sync fork' button on GitHub. The branch is fast-forwarded, or merged when it has commits of its own; a branch that would conflict is left unchanged.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SYNC_FORK_USER_TITLE", "Sync fork"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Owner of the fork"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Name of the fork"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch of the fork to sync, e.g. main"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := RequiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			synced, resp, err := client.Repositories.MergeUpstream(ctx, owner, repo, &github.RepoMergeUpstreamRequest{
+				Branch: github.Ptr(branch),
+			})
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusConflict {
+					return mcp.NewToolResultError(fmt.Sprintf("branch %s of %s/%s conflicts with upstream and was not synced; merge upstream into it locally or in a pull request", branch, owner, repo)), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to sync fork",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(SyncForkResult{
+				Branch:    branch,
+				MergeType: synced.GetMergeType(),
+				Message:   synced.GetMessage(),
+			}), nil
+		}
+}
+
 // DeleteFile creates a tool to delete a file in a GitHub repository.
 // This tool uses a more roundabout way of deleting a file than just using the client.Repositories.DeleteFile.
 // This is because REST file deletion endpoint (and client.Repositories.DeleteFile) don't add commit signing to the deletion commit,
@@ -1267,22 +2052,347 @@ func ListTags(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list tags: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(tags)
+			return MarshalledListResult(ctx, tags, "no tags found"), nil
+		}
+}
+
+// GetTag creates a tool to get details about a specific tag in a GitHub repository.
+func GetTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_tag",
+			mcp.WithDescription(t("TOOL_GET_TAG_DESCRIPTION", "Get details about a specific git tag in a GitHub repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_TAG_USER_TITLE", "Get tag details"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("tag",
+				mcp.Required(),
+				mcp.Description("Tag name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tag, err := RequiredParam[string](request, "tag")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// First get the tag reference
+			ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/tags/"+tag)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get tag reference",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get tag reference: %s", string(body))), nil
+			}
+
+			// Then get the tag object
+			tagObj, resp, err := client.Git.GetTag(ctx, owner, repo, *ref.Object.SHA)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get tag object",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get tag object: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(tagObj)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// validateTagName checks a tag name against the git check-ref-format rules that GitHub enforces.
+func validateTagName(name string) error {
+	if name == "" {
+		return fmt.Errorf("tag name must not be empty")
+	}
+	if strings.HasPrefix(name, "refs/") {
+		return fmt.Errorf("tag name %q must not include the refs/ prefix", name)
+	}
+	if name == "@" {
+		return fmt.Errorf("tag name must not be %q", name)
+	}
+	if strings.HasPrefix(name, "-") || strings.HasPrefix(name, "/") || strings.HasSuffix(name, "/") {
+		return fmt.Errorf("tag name %q must not start with '-' or '/' or end with '/'", name)
+	}
+	if strings.HasSuffix(name, ".") || strings.HasSuffix(name, ".lock") {
+		return fmt.Errorf("tag name %q must not end with '.' or '.lock'", name)
+	}
+	for _, seq := range []string{"..", "//", "@{"} {
+		if strings.Contains(name, seq) {
+			return fmt.Errorf("tag name %q must not contain %q", name, seq)
+		}
+	}
+	for _, r := range name {
+		if r < 0x20 || r == 0x7f || strings.ContainsRune(" ~^:?*[\\", r) {
+			return fmt.Errorf("tag name %q contains invalid character %q", name, r)
+		}
+	}
+	for _, component := range strings.Split(name, "/") {
+		if strings.HasPrefix(component, ".") {
+			return fmt.Errorf("tag name %q must not have a path component starting with '.'", name)
+		}
+	}
+	return nil
+}
+
+// CreateTag creates a tool to create a lightweight or annotated git tag in a GitHub repository.
+func CreateTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_tag",
+			mcp.WithDescription(t("TOOL_CREATE_TAG_DESCRIPTION", "Create a git tag in a GitHub repository. Providing a message creates an annotated tag, otherwise a lightweight tag is created.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_TAG_USER_TITLE", "Create tag"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("tag",
+				mcp.Required(),
+				mcp.Description("Tag name, e.g. v1.2.3"),
+			),
+			mcp.WithString("sha",
+				mcp.Required(),
+				mcp.Description("SHA of the commit to tag"),
+			),
+			mcp.WithString("message",
+				mcp.Description("Tag message. When provided, an annotated tag object is created"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tag, err := RequiredParam[string](request, "tag")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := RequiredParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			message, err := OptionalParam[string](request, "message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if err := validateTagName(tag); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Lightweight tags are just a ref pointing at the commit, while annotated tags
+			// need a tag object to be created first, which the ref then points at.
+			target := sha
+			if message != "" {
+				tagObj, resp, err := client.Git.CreateTag(ctx, owner, repo, &github.Tag{
+					Tag:     github.Ptr(tag),
+					Message: github.Ptr(message),
+					Object: &github.GitObject{
+						Type: github.Ptr("commit"),
+						SHA:  github.Ptr(sha),
+					},
+				})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to create tag object",
+						resp,
+						err,
+					), nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+
+				target = tagObj.GetSHA()
+			}
+
+			ref, resp, err := client.Git.CreateRef(ctx, owner, repo, &github.Reference{
+				Ref:    github.Ptr("refs/tags/" + tag),
+				Object: &github.GitObject{SHA: github.Ptr(target)},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to create tag reference",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(ref)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// DeleteTag creates a tool to delete a git tag from a GitHub repository.
+func DeleteTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_tag",
+			mcp.WithDescription(t("TOOL_DELETE_TAG_DESCRIPTION", "Delete a git tag from a GitHub repository. The tagged commit is kept. A published release for the tag becomes a draft; use delete_release to remove a release together with its tag.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_DELETE_TAG_USER_TITLE", "Delete tag"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("tag",
+				mcp.Required(),
+				mcp.Description("Tag name, e.g. v1.2.3"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tag, err := RequiredParam[string](request, "tag")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if err := validateTagName(tag); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Git.DeleteRef(ctx, owner, repo, "tags/"+tag)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to delete tag %s", tag),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Successfully deleted tag %s from %s/%s", tag, owner, repo)), nil
+		}
+}
+
+// ListTagProtection creates a tool to list the tag protection patterns of a GitHub repository.
+func ListTagProtection(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_tag_protection",
+			mcp.WithDescription(t("TOOL_LIST_TAG_PROTECTION_DESCRIPTION", "List the tag protection patterns of a GitHub repository. Tag protection is deprecated in favor of repository rulesets, so repositories that have migrated will return no patterns.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_TAG_PROTECTION_USER_TITLE", "List tag protection"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			protections, resp, err := client.Repositories.ListTagProtection(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list tag protection",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledListResult(ctx, protections, "no tag protection patterns found"), nil
 		}
 }
 
-// GetTag creates a tool to get details about a specific tag in a GitHub repository.
-func GetTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("get_tag",
-			mcp.WithDescription(t("TOOL_GET_TAG_DESCRIPTION", "Get details about a specific git tag in a GitHub repository")),
+// CreateTagProtection creates a tool to add a tag protection pattern to a GitHub repository.
+func CreateTagProtection(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_tag_protection",
+			mcp.WithDescription(t("TOOL_CREATE_TAG_PROTECTION_DESCRIPTION", "Protect tags matching a pattern in a GitHub repository, so only users with admin or maintain access can create or delete them")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_GET_TAG_USER_TITLE", "Get tag details"),
-				ReadOnlyHint: ToBoolPtr(true),
+				Title:        t("TOOL_CREATE_TAG_PROTECTION_USER_TITLE", "Create tag protection"),
+				ReadOnlyHint: ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -1292,9 +2402,9 @@ func GetTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool m
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
-			mcp.WithString("tag",
+			mcp.WithString("pattern",
 				mcp.Required(),
-				mcp.Description("Tag name"),
+				mcp.Description("Tag name pattern to protect, using fnmatch syntax, e.g. v*"),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -1306,7 +2416,7 @@ func GetTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool m
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			tag, err := RequiredParam[string](request, "tag")
+			pattern, err := RequiredParam[string](request, "pattern")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -1316,45 +2426,17 @@ func GetTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool m
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
-			// First get the tag reference
-			ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/tags/"+tag)
-			if err != nil {
-				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					"failed to get tag reference",
-					resp,
-					err,
-				), nil
-			}
-			defer func() { _ = resp.Body.Close() }()
-
-			if resp.StatusCode != http.StatusOK {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, fmt.Errorf("failed to read response body: %w", err)
-				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to get tag reference: %s", string(body))), nil
-			}
-
-			// Then get the tag object
-			tagObj, resp, err := client.Git.GetTag(ctx, owner, repo, *ref.Object.SHA)
+			protection, resp, err := client.Repositories.CreateTagProtection(ctx, owner, repo, pattern)
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					"failed to get tag object",
+					"failed to create tag protection",
 					resp,
 					err,
 				), nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusOK {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, fmt.Errorf("failed to read response body: %w", err)
-				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to get tag object: %s", string(body))), nil
-			}
-
-			r, err := json.Marshal(tagObj)
+			r, err := json.Marshal(protection)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -1419,12 +2501,7 @@ func ListReleases(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list releases: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(releases)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledListResult(ctx, releases, "no releases found"), nil
 		}
 }
 
@@ -1684,10 +2761,16 @@ func resolveGitReference(ctx context.Context, githubClient *github.Client, owner
 	return &raw.ContentOpts{Ref: ref, SHA: sha}, nil
 }
 
+// StarredRepository is a repository a user starred, with when they starred it.
+type StarredRepository struct {
+	MinimalRepository
+	StarredAt string `json:"starred_at,omitempty"`
+}
+
 // ListStarredRepositories creates a tool to list starred repositories for the authenticated user or a specified user.
 func ListStarredRepositories(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_starred_repositories",
-			mcp.WithDescription(t("TOOL_LIST_STARRED_REPOSITORIES_DESCRIPTION", "List starred repositories")),
+			mcp.WithDescription(t("TOOL_LIST_STARRED_REPOSITORIES_DESCRIPTION", "List starred repositories, with the time each one was starred")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_STARRED_REPOSITORIES_USER_TITLE", "List starred repositories"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -1769,7 +2852,7 @@ func ListStarredRepositories(getClient GetClientFn, t translations.TranslationHe
 			}
 
 			// Convert to minimal format
-			minimalRepos := make([]MinimalRepository, 0, len(repos))
+			starred := make([]StarredRepository, 0, len(repos))
 			for _, starredRepo := range repos {
 				repo := starredRepo.Repository
 				minimalRepo := MinimalRepository{
@@ -1792,15 +2875,15 @@ func ListStarredRepositories(getClient GetClientFn, t translations.TranslationHe
 					minimalRepo.UpdatedAt = repo.UpdatedAt.Format("2006-01-02T15:04:05Z")
 				}
 
-				minimalRepos = append(minimalRepos, minimalRepo)
-			}
+				result := StarredRepository{MinimalRepository: minimalRepo}
+				if starredRepo.StarredAt != nil {
+					result.StarredAt = starredRepo.StarredAt.UTC().Format("2006-01-02T15:04:05Z")
+				}
 
-			r, err := json.Marshal(minimalRepos)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal starred repositories: %w", err)
+				starred = append(starred, result)
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledListResult(ctx, starred, "no starred repositories found"), nil
 		}
 }
 
@@ -1911,3 +2994,364 @@ func UnstarRepository(getClient GetClientFn, t translations.TranslationHelperFun
 			return mcp.NewToolResultText(fmt.Sprintf("Successfully unstarred repository %s/%s", owner, repo)), nil
 		}
 }
+
+// Stargazer is a user who starred a repository, with when they starred it.
+type Stargazer struct {
+	MinimalUser
+	StarredAt *time.Time `json:"starred_at,omitempty"`
+}
+
+// ListStargazers creates a tool to list the users who starred a repository, with when each of them did.
+func ListStargazers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_stargazers",
+			mcp.WithDescription(t("TOOL_LIST_STARGAZERS_DESCRIPTION", "List the users who starred a GitHub repository, oldest first, with the time each one starred it. Useful for analyzing star growth over time.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_STARGAZERS_USER_TITLE", "List stargazers"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// go-github asks for the application/vnd.github.star+json media type here. Without it
+			// GitHub returns plain users and no starred_at.
+			stargazers, resp, err := client.Activity.ListStargazers(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list stargazers of %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]Stargazer, 0, len(stargazers))
+			for _, s := range stargazers {
+				stargazer := Stargazer{
+					MinimalUser: MinimalUser{
+						Login:      s.GetUser().GetLogin(),
+						ID:         s.GetUser().GetID(),
+						ProfileURL: s.GetUser().GetHTMLURL(),
+						AvatarURL:  s.GetUser().GetAvatarURL(),
+					},
+				}
+				if s.StarredAt != nil {
+					stargazer.StarredAt = &s.StarredAt.Time
+				}
+				result = append(result, stargazer)
+			}
+
+			return MarshalledListResult(ctx, result, "no stargazers found"), nil
+		}
+}
+
+// ListWatchers creates a tool to list the users watching a repository.
+func ListWatchers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_watchers",
+			mcp.WithDescription(t("TOOL_LIST_WATCHERS_DESCRIPTION", "List the users watching a GitHub repository, that is subscribed to its notifications. GitHub does not record when a user started watching.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_WATCHERS_USER_TITLE", "List watchers"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			watchers, resp, err := client.Activity.ListWatchers(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list watchers of %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]MinimalUser, 0, len(watchers))
+			for _, w := range watchers {
+				result = append(result, MinimalUser{
+					Login:      w.GetLogin(),
+					ID:         w.GetID(),
+					ProfileURL: w.GetHTMLURL(),
+					AvatarURL:  w.GetAvatarURL(),
+				})
+			}
+
+			return MarshalledListResult(ctx, result, "no watchers found"), nil
+		}
+}
+
+// repositoryActivityTypes are the activity types the repository activity API can filter by.
+var repositoryActivityTypes = []string{"push", "force_push", "branch_creation", "branch_deletion", "pr_merge", "merge_queue_merge"}
+
+// RepositoryActivity is a change to a ref of a repository, such as a push or a branch deletion.
+type RepositoryActivity struct {
+	ID           int64      `json:"id"`
+	Ref          string     `json:"ref"`
+	ActivityType string     `json:"activity_type"`
+	Actor        string     `json:"actor,omitempty"`
+	Timestamp    *time.Time `json:"timestamp,omitempty"`
+	Before       string     `json:"before"`
+	After        string     `json:"after"`
+}
+
+// RepositoryActivityPage is a page of repository activity.
+type RepositoryActivityPage struct {
+	Activities []RepositoryActivity `json:"activities"`
+	PageInfo   struct {
+		HasNextPage bool   `json:"hasNextPage"`
+		EndCursor   string `json:"endCursor,omitempty"`
+	} `json:"pageInfo"`
+}
+
+// repositoryActivity is an entry of the repository activity API, which go-github does not wrap.
+type repositoryActivity struct {
+	ID           int64             `json:"id"`
+	Before       string            `json:"before"`
+	After        string            `json:"after"`
+	Ref          string            `json:"ref"`
+	Timestamp    *github.Timestamp `json:"timestamp"`
+	ActivityType string            `json:"activity_type"`
+	Actor        *github.User      `json:"actor"`
+}
+
+// ListRepositoryActivity creates a tool to list pushes, force pushes and branch changes of a repository.
+func ListRepositoryActivity(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_activity",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_ACTIVITY_DESCRIPTION", "List changes to the branches of a GitHub repository, such as pushes, force pushes, branch creations and deletions and merges, with who made them and when. Unlike events, this includes force pushes together with the commits before and after them, which helps with incident analysis. For pagination, use the 'endCursor' from the previous response's 'pageInfo' in the 'after' parameter.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REPOSITORY_ACTIVITY_USER_TITLE", "List repository activity"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Only list activity on this ref, as a branch name or a fully qualified ref such as refs/heads/main"),
+			),
+			mcp.WithString("actor",
+				mcp.Description("Only list activity by this user"),
+			),
+			mcp.WithString("activity_type",
+				mcp.Description("Only list activity of this type"),
+				mcp.Enum(repositoryActivityTypes...),
+			),
+			mcp.WithString("time_period",
+				mcp.Description("Only list activity in this period before now"),
+				mcp.Enum("day", "week", "month", "quarter", "year"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("Sort order by time (default: desc)"),
+				mcp.Enum("asc", "desc"),
+			),
+			WithCursorPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			query := url.Values{}
+			for _, name := range []string{"ref", "actor", "activity_type", "time_period", "direction"} {
+				value, err := OptionalParam[string](request, name)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if value != "" {
+					query.Set(name, value)
+				}
+			}
+			pagination, err := OptionalCursorPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			query.Set("per_page", fmt.Sprintf("%d", pagination.PerPage))
+			if pagination.After != "" {
+				query.Set("after", pagination.After)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// go-github has no wrapper for this endpoint yet.
+			req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("repos/%s/%s/activity?%s", owner, repo, query.Encode()), nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+
+			var activities []repositoryActivity
+			resp, err := client.Do(ctx, req, &activities)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list repository activity", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			page := RepositoryActivityPage{Activities: make([]RepositoryActivity, 0, len(activities))}
+			for _, a := range activities {
+				activity := RepositoryActivity{
+					ID:           a.ID,
+					Ref:          a.Ref,
+					ActivityType: a.ActivityType,
+					Actor:        a.Actor.GetLogin(),
+					Before:       a.Before,
+					After:        a.After,
+				}
+				if a.Timestamp != nil {
+					activity.Timestamp = &a.Timestamp.Time
+				}
+				page.Activities = append(page.Activities, activity)
+			}
+			// The activity API paginates with cursors in the Link header, which go-github parses.
+			page.PageInfo.HasNextPage = resp.After != ""
+			page.PageInfo.EndCursor = resp.After
+
+			info := ListPageInfo{HasNextPage: page.PageInfo.HasNextPage, NextCursor: page.PageInfo.EndCursor}
+			return MarshalledListPageResult(ctx, page, page.Activities, info, "no repository activity matched"), nil
+		}
+}
+
+// RepositoryLicense is the license GitHub detected for a repository, with the text of its license file.
+type RepositoryLicense struct {
+	Detected bool   `json:"detected"`
+	SPDXID   string `json:"spdx_id,omitempty"`
+	Key      string `json:"key,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Path     string `json:"path,omitempty"`
+	HTMLURL  string `json:"html_url,omitempty"`
+	Content  string `json:"content,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// GetRepositoryLicense creates a tool to get the license of a repository and the text of its license file.
+func GetRepositoryLicense(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_license",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_LICENSE_DESCRIPTION", "Get the license GitHub detected for a repository, with its SPDX identifier, name and the full text of the license file. An spdx_id of NOASSERTION means a license file exists but GitHub could not identify it, so review the content.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_LICENSE_USER_TITLE", "Get repository license"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			license, resp, err := client.Repositories.License(ctx, owner, repo)
+			if err != nil {
+				// The license endpoint answers 404 when no license file was found.
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					_ = resp.Body.Close()
+					return MarshalledTextResult(RepositoryLicense{
+						Message: fmt.Sprintf("no license detected in %s/%s", owner, repo),
+					}), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository license", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			content := license.GetContent()
+			if license.GetEncoding() == "base64" {
+				// GitHub wraps base64 content at 60 characters.
+				decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(content, "\n", ""))
+				if err != nil {
+					return nil, fmt.Errorf("failed to decode license file: %w", err)
+				}
+				content = string(decoded)
+			}
+
+			return MarshalledTextResult(RepositoryLicense{
+				Detected: true,
+				SPDXID:   license.GetLicense().GetSPDXID(),
+				Key:      license.GetLicense().GetKey(),
+				Name:     license.GetLicense().GetName(),
+				Path:     license.GetPath(),
+				HTMLURL:  license.GetHTMLURL(),
+				Content:  content,
+			}), nil
+		}
+}
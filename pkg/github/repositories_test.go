@@ -282,6 +282,134 @@ func Test_GetFileContents(t *testing.T) {
 	}
 }
 
+func Test_GetRawContent(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	mockRawClient := raw.NewClient(mockClient, &url.URL{Scheme: "https", Host: "raw.githubusercontent.com", Path: "/"})
+	tool, _ := GetRawContent(stubGetClientFn(mockClient), stubGetRawClientFn(mockRawClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_raw_content", tool.Name)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "path"})
+
+	rawURL := &url.URL{Scheme: "https", Host: "raw.example.com", Path: "/"}
+	lfsURL := &url.URL{Scheme: "https", Host: "github.example.com", Path: "/"}
+	oid := "4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393"
+	pointerFile := "version https://git-lfs.github.com/spec/v1\noid sha256:" + oid + "\nsize 8\n"
+	pngHeader := "\x89PNG\r\n\x1a\n"
+	serveRaw := func(content, contentType string) mock.MockBackendOption {
+		return mock.WithRequestMatchHandler(
+			raw.GetRawReposContentsByOwnerByRepoBySHAByPath,
+			expectPath(t, "/owner/repo/abc123/assets/logo.png").andThen(
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.Header().Set("Content-Type", contentType)
+					_, _ = w.Write([]byte(content))
+				}),
+			),
+		)
+	}
+	serveLFS := []mock.MockBackendOption{
+		mock.WithRequestMatchHandler(
+			raw.PostLFSObjectsBatchByOwnerByRepo,
+			expectPath(t, "/owner/repo.git/info/lfs/objects/batch").andThen(
+				mockResponse(t, http.StatusOK, map[string]any{
+					"objects": []any{map[string]any{
+						"oid":     oid,
+						"actions": map[string]any{"download": map[string]any{"href": "https://lfs.example.com/objects/" + oid}},
+					}},
+				}),
+			),
+		),
+		mock.WithRequestMatchHandler(
+			mock.EndpointPattern{Pattern: "/objects/{oid}", Method: "GET"},
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/octet-stream")
+				_, _ = w.Write([]byte(pngHeader))
+			}),
+		),
+	}
+	args := map[string]any{"owner": "owner", "repo": "repo", "path": "assets/logo.png", "sha": "abc123"}
+
+	t.Run("regular file", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(serveRaw("# README\n", "text/plain; charset=utf-8"))
+		rawClient := raw.NewClient(github.NewClient(mockedClient), rawURL)
+		_, handler := GetRawContent(stubGetClientFn(github.NewClient(mockedClient)), stubGetRawClientFn(rawClient), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		textResource := getTextResourceResult(t, result)
+		assert.Equal(t, "# README\n", textResource.Text)
+		assert.Equal(t, "repo://owner/repo/sha/abc123/contents/assets/logo.png", textResource.URI)
+	})
+
+	t.Run("LFS pointer is resolved", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(append([]mock.MockBackendOption{serveRaw(pointerFile, "text/plain; charset=utf-8")}, serveLFS...)...)
+		rawClient := raw.NewClient(github.NewClient(mockedClient), rawURL).WithLFS(lfsURL, mockedClient)
+		_, handler := GetRawContent(stubGetClientFn(github.NewClient(mockedClient)), stubGetRawClientFn(rawClient), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		blobResource := getBlobResourceResult(t, result)
+		assert.Equal(t, "successfully resolved Git LFS pointer (OID: "+oid+") and downloaded binary file", result.Content[0].(mcp.TextContent).Text)
+		assert.Equal(t, "image/png", blobResource.MIMEType)
+		assert.Equal(t, base64.StdEncoding.EncodeToString([]byte(pngHeader)), blobResource.Blob)
+	})
+
+	t.Run("LFS pointer without resolution", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(serveRaw(pointerFile, "text/plain; charset=utf-8"))
+		rawClient := raw.NewClient(github.NewClient(mockedClient), rawURL)
+		_, handler := GetRawContent(stubGetClientFn(github.NewClient(mockedClient)), stubGetRawClientFn(rawClient), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args))
+		require.NoError(t, err)
+
+		var pointer LFSPointerResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &pointer))
+		assert.True(t, pointer.LFSPointer)
+		assert.Equal(t, oid, pointer.OID)
+		assert.Equal(t, int64(8), pointer.Size)
+		assert.Contains(t, pointer.Message, "--resolve-lfs")
+	})
+
+	t.Run("LFS object that cannot be downloaded", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			serveRaw(pointerFile, "text/plain; charset=utf-8"),
+			mock.WithRequestMatchHandler(
+				raw.PostLFSObjectsBatchByOwnerByRepo,
+				mockResponse(t, http.StatusForbidden, map[string]string{"message": "Forbidden"}),
+			),
+		)
+		rawClient := raw.NewClient(github.NewClient(mockedClient), rawURL).WithLFS(lfsURL, mockedClient)
+		_, handler := GetRawContent(stubGetClientFn(github.NewClient(mockedClient)), stubGetRawClientFn(rawClient), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args))
+		require.NoError(t, err)
+
+		var pointer LFSPointerResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &pointer))
+		assert.Equal(t, oid, pointer.OID)
+		assert.Contains(t, pointer.Message, "LFS batch request failed with status 403")
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				raw.GetRawReposContentsByOwnerByRepoBySHAByPath,
+				mockResponse(t, http.StatusNotFound, nil),
+			),
+		)
+		rawClient := raw.NewClient(github.NewClient(mockedClient), rawURL)
+		_, handler := GetRawContent(stubGetClientFn(github.NewClient(mockedClient)), stubGetRawClientFn(rawClient), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args))
+		require.NoError(t, err)
+		assert.Equal(t, "file assets/logo.png not found in owner/repo", getErrorResult(t, result).Text)
+	})
+}
+
 func Test_ForkRepository(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -384,6 +512,352 @@ func Test_ForkRepository(t *testing.T) {
 	}
 }
 
+func Test_ListForks(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListForks(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_forks", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	pushedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	mockForks := []*github.Repository{
+		{
+			FullName:        github.Ptr("alice/repo"),
+			Owner:           &github.User{Login: github.Ptr("alice")},
+			HTMLURL:         github.Ptr("https://github.com/alice/repo"),
+			StargazersCount: github.Ptr(42),
+			PushedAt:        &github.Timestamp{Time: pushedAt},
+		},
+		{
+			FullName:        github.Ptr("bob/repo"),
+			Owner:           &github.User{Login: github.Ptr("bob")},
+			HTMLURL:         github.Ptr("https://github.com/bob/repo"),
+			StargazersCount: github.Ptr(3),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedForks  []MinimalFork
+	}{
+		{
+			name: "sort by stargazers with pagination",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposForksByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"sort":     "stargazers",
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockForks),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"sort":    "stargazers",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectedForks: []MinimalFork{
+				{FullName: "alice/repo", Owner: "alice", HTMLURL: "https://github.com/alice/repo", Stars: 42, PushedAt: "2024-05-01T12:00:00Z"},
+				{FullName: "bob/repo", Owner: "bob", HTMLURL: "https://github.com/bob/repo", Stars: 3},
+			},
+		},
+		{
+			name: "default options",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposForksByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Repository{}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedForks: []MinimalFork{},
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposForksByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list forks",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListForks(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+
+			var returned []MinimalFork
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedForks, returned)
+		})
+	}
+}
+
+func Test_GetForkNetwork(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetForkNetwork(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_fork_network", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedErrMsg  string
+		expectedNetwork ForkNetwork
+	}{
+		{
+			name: "fork of a fork",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					&github.Repository{
+						FullName:     github.Ptr("carol/repo"),
+						HTMLURL:      github.Ptr("https://github.com/carol/repo"),
+						Fork:         github.Ptr(true),
+						NetworkCount: github.Ptr(120),
+						Parent: &github.Repository{
+							FullName:        github.Ptr("alice/repo"),
+							HTMLURL:         github.Ptr("https://github.com/alice/repo"),
+							StargazersCount: github.Ptr(42),
+							ForksCount:      github.Ptr(5),
+						},
+						Source: &github.Repository{
+							FullName:        github.Ptr("upstream/repo"),
+							HTMLURL:         github.Ptr("https://github.com/upstream/repo"),
+							StargazersCount: github.Ptr(9000),
+							ForksCount:      github.Ptr(115),
+						},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "carol",
+				"repo":  "repo",
+			},
+			expectedNetwork: ForkNetwork{
+				Repository:   ForkNetworkRepository{FullName: "carol/repo", HTMLURL: "https://github.com/carol/repo"},
+				IsFork:       true,
+				Parent:       &ForkNetworkRepository{FullName: "alice/repo", HTMLURL: "https://github.com/alice/repo", Stars: 42, Forks: 5},
+				Source:       &ForkNetworkRepository{FullName: "upstream/repo", HTMLURL: "https://github.com/upstream/repo", Stars: 9000, Forks: 115},
+				NetworkCount: 120,
+			},
+		},
+		{
+			name: "upstream repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					&github.Repository{
+						FullName:        github.Ptr("upstream/repo"),
+						HTMLURL:         github.Ptr("https://github.com/upstream/repo"),
+						StargazersCount: github.Ptr(9000),
+						ForksCount:      github.Ptr(115),
+						NetworkCount:    github.Ptr(120),
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "upstream",
+				"repo":  "repo",
+			},
+			expectedNetwork: ForkNetwork{
+				Repository:   ForkNetworkRepository{FullName: "upstream/repo", HTMLURL: "https://github.com/upstream/repo", Stars: 9000, Forks: 115},
+				NetworkCount: 120,
+			},
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetForkNetwork(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+
+			var returned ForkNetwork
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedNetwork, returned)
+		})
+	}
+}
+
+func Test_SyncFork(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SyncFork(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "sync_fork", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+		expectedResult SyncForkResult
+	}{
+		{
+			name: "branch fast-forwarded",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposMergeUpstreamByOwnerByRepo,
+					expect(t, expectations{
+						path:        "/repos/contributor/repo/merge-upstream",
+						requestBody: map[string]any{"branch": "main"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.RepoMergeUpstreamResult{
+							Message:    github.Ptr("Successfully fetched and fast-forwarded from upstream owner:main."),
+							MergeType:  github.Ptr("fast-forward"),
+							BaseBranch: github.Ptr("owner:main"),
+						}),
+					),
+				),
+			),
+			expectedResult: SyncForkResult{
+				Branch:    "main",
+				MergeType: "fast-forward",
+				Message:   "Successfully fetched and fast-forwarded from upstream owner:main.",
+			},
+		},
+		{
+			name: "branch conflicts with upstream",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposMergeUpstreamByOwnerByRepo,
+					mockResponse(t, http.StatusConflict, map[string]string{"message": "There are merge conflicts"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "branch main of contributor/repo conflicts with upstream and was not synced",
+		},
+		{
+			name: "repository is not a fork",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposMergeUpstreamByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "This repository is not a fork"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to sync fork",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SyncFork(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":  "contributor",
+				"repo":   "repo",
+				"branch": "main",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned SyncForkResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
 func Test_CreateBranch(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -880,11 +1354,280 @@ func Test_ListCommits(t *testing.T) {
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner": "owner",
-				"repo":  "nonexistent-repo",
+				"owner": "owner",
+				"repo":  "nonexistent-repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list commits",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListCommits(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedCommits []MinimalCommit
+			err = json.Unmarshal([]byte(textContent.Text), &returnedCommits)
+			require.NoError(t, err)
+			assert.Len(t, returnedCommits, len(tc.expectedCommits))
+			for i, commit := range returnedCommits {
+				assert.Equal(t, tc.expectedCommits[i].GetSHA(), commit.SHA)
+				assert.Equal(t, tc.expectedCommits[i].GetHTMLURL(), commit.HTMLURL)
+				if tc.expectedCommits[i].Commit != nil {
+					assert.Equal(t, tc.expectedCommits[i].Commit.GetMessage(), commit.Commit.Message)
+				}
+				if tc.expectedCommits[i].Author != nil {
+					assert.Equal(t, tc.expectedCommits[i].Author.GetLogin(), commit.Author.Login)
+				}
+
+				// Files and stats are never included in list_commits
+				assert.Nil(t, commit.Files)
+				assert.Nil(t, commit.Stats)
+			}
+		})
+	}
+}
+
+func Test_CompareRefs(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CompareRefs(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "compare_refs", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "base", "head"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "tag compared with branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/compare/v1.0.0...main",
+						queryParams: map[string]string{"page": "1", "per_page": "30"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.CommitsComparison{
+							Status:          github.Ptr("diverged"),
+							AheadBy:         github.Ptr(1),
+							BehindBy:        github.Ptr(2),
+							TotalCommits:    github.Ptr(1),
+							MergeBaseCommit: &github.RepositoryCommit{SHA: github.Ptr("base123")},
+							HTMLURL:         github.Ptr("https://github.com/owner/repo/compare/v1.0.0...main"),
+							Commits: []*github.RepositoryCommit{
+								{
+									SHA:     github.Ptr("abc123"),
+									HTMLURL: github.Ptr("https://github.com/owner/repo/commit/abc123"),
+									Commit:  &github.Commit{Message: github.Ptr("Add compare tool")},
+								},
+							},
+							Files: []*github.CommitFile{
+								{Filename: github.Ptr("pkg/compare.go"), Status: github.Ptr("added"), Additions: github.Ptr(40), Changes: github.Ptr(40)},
+							},
+						}),
+					),
+				),
+			),
+		},
+		{
+			name: "unknown ref",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to compare v1.0.0...main",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CompareRefs(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "v1.0.0",
+				"head":  "main",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned RefComparison
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, RefComparison{
+				Status:          "diverged",
+				AheadBy:         1,
+				BehindBy:        2,
+				TotalCommits:    1,
+				MergeBaseCommit: "base123",
+				HTMLURL:         "https://github.com/owner/repo/compare/v1.0.0...main",
+				Commits: []MinimalCommit{{
+					SHA:     "abc123",
+					HTMLURL: "https://github.com/owner/repo/commit/abc123",
+					Commit:  &MinimalCommitInfo{Message: "Add compare tool"},
+				}},
+				Files: []MinimalCommitFile{{Filename: "pkg/compare.go", Status: "added", Additions: 40, Changes: 40}},
+			}, returned)
+		})
+	}
+}
+
+func Test_SuggestReviewersForFile(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SuggestReviewersForFile(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "suggest_reviewers_for_file", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "max_commits")
+	assert.Contains(t, tool.InputSchema.Properties, "limit")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "path"})
+
+	commitBy := func(login string, userType string, day int) *github.RepositoryCommit {
+		return &github.RepositoryCommit{
+			Author: &github.User{Login: github.Ptr(login), Type: github.Ptr(userType)},
+			Commit: &github.Commit{
+				Author: &github.CommitAuthor{
+					Date: &github.Timestamp{Time: time.Date(2024, 3, day, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+		}
+	}
+	mockCommits := []*github.RepositoryCommit{
+		commitBy("alice", "User", 20),
+		commitBy("dependabot[bot]", "Bot", 19),
+		commitBy("bob", "User", 18),
+		commitBy("alice", "User", 15),
+		commitBy("carol", "User", 14),
+		commitBy("bob", "User", 10),
+		commitBy("dave", "User", 9),
+		commitBy("dave", "User", 8),
+		commitBy("dave", "User", 7),
+		{Commit: &github.Commit{Message: github.Ptr("commit without a linked account")}},
+	}
+
+	// dave left the project and is no longer a collaborator
+	collaboratorHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/dave") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	tests := []struct {
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]interface{}
+		expectError       bool
+		expectedErrMsg    string
+		expectedReviewers []SuggestedReviewer
+	}{
+		{
+			name: "suggest frequent recent authors who are collaborators",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"path":     "pkg/server.go",
+						"per_page": "20",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockCommits),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCollaboratorsByOwnerByRepoByUsername,
+					collaboratorHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"path":        "pkg/server.go",
+				"max_commits": float64(20),
+			},
+			expectedReviewers: []SuggestedReviewer{
+				{Login: "alice", Commits: 2, LastCommitAt: "2024-03-20T00:00:00Z"},
+				{Login: "bob", Commits: 2, LastCommitAt: "2024-03-18T00:00:00Z"},
+				{Login: "carol", Commits: 1, LastCommitAt: "2024-03-14T00:00:00Z"},
+			},
+		},
+		{
+			name: "limit suggestions",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCommitsByOwnerByRepo,
+					mockCommits,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCollaboratorsByOwnerByRepoByUsername,
+					collaboratorHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "pkg/server.go",
+				"limit": float64(1),
+			},
+			expectedReviewers: []SuggestedReviewer{
+				{Login: "alice", Commits: 2, LastCommitAt: "2024-03-20T00:00:00Z"},
+			},
+		},
+		{
+			name:         "history depth is capped",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"path":        "pkg/server.go",
+				"max_commits": float64(500),
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to list commits",
+			expectedErrMsg: "max_commits must be between 1 and 100",
 		},
 	}
 
@@ -892,48 +1635,33 @@ func Test_ListCommits(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := ListCommits(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := SuggestReviewersForFile(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
 
 			// Call handler
 			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
 
-			// Verify results
 			if tc.expectError {
-				require.NoError(t, err)
-				require.True(t, result.IsError)
 				errorContent := getErrorResult(t, result)
 				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
 				return
 			}
 
-			require.NoError(t, err)
-			require.False(t, result.IsError)
-
-			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
-			// Unmarshal and verify the result
-			var returnedCommits []MinimalCommit
-			err = json.Unmarshal([]byte(textContent.Text), &returnedCommits)
-			require.NoError(t, err)
-			assert.Len(t, returnedCommits, len(tc.expectedCommits))
-			for i, commit := range returnedCommits {
-				assert.Equal(t, tc.expectedCommits[i].GetSHA(), commit.SHA)
-				assert.Equal(t, tc.expectedCommits[i].GetHTMLURL(), commit.HTMLURL)
-				if tc.expectedCommits[i].Commit != nil {
-					assert.Equal(t, tc.expectedCommits[i].Commit.GetMessage(), commit.Commit.Message)
-				}
-				if tc.expectedCommits[i].Author != nil {
-					assert.Equal(t, tc.expectedCommits[i].Author.GetLogin(), commit.Author.Login)
-				}
-
-				// Files and stats are never included in list_commits
-				assert.Nil(t, commit.Files)
-				assert.Nil(t, commit.Stats)
+			var returned struct {
+				Path            string              `json:"path"`
+				CommitsExamined int                 `json:"commits_examined"`
+				Reviewers       []SuggestedReviewer `json:"reviewers"`
 			}
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, "pkg/server.go", returned.Path)
+			assert.Equal(t, len(mockCommits), returned.CommitsExamined)
+			assert.Equal(t, tc.expectedReviewers, returned.Reviewers)
 		})
 	}
 }
@@ -1279,6 +2007,178 @@ func Test_CreateRepository(t *testing.T) {
 	}
 }
 
+func Test_ArchiveRepository(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ArchiveRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "archive_repository", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "archive repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposByOwnerByRepo,
+					expect(t, expectations{
+						path:        "/repos/owner/repo",
+						requestBody: map[string]any{"archived": true},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Repository{Archived: github.Ptr(true)}),
+					),
+				),
+			),
+		},
+		{
+			name: "no admin access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must have admin rights to Repository."}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to archive repository owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ArchiveRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Equal(t, "Successfully archived repository owner/repo", getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_UnarchiveRepository(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UnarchiveRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "unarchive_repository", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PatchReposByOwnerByRepo,
+			expectRequestBody(t, map[string]any{"archived": false}).andThen(
+				mockResponse(t, http.StatusOK, &github.Repository{Archived: github.Ptr(false)}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := UnarchiveRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "Successfully unarchived repository owner/repo", getTextResult(t, result).Text)
+}
+
+func Test_TransferRepository(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := TransferRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "transfer_repository", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "new_owner"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name: "transfer to an organization with a new name",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposTransferByOwnerByRepo,
+					expect(t, expectations{
+						path: "/repos/owner/repo/transfer",
+						requestBody: map[string]any{
+							"new_owner": "new-org",
+							"new_name":  "renamed",
+							"team_ids":  []any{float64(42)},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusAccepted, &github.Repository{Name: github.Ptr("renamed")}),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"new_owner": "new-org",
+				"new_name":  "renamed",
+				"team_ids":  []any{float64(42)},
+			},
+			expectedText: "Started transferring repository owner/repo to new-org/renamed. A transfer to a user completes once they accept it.",
+		},
+		{
+			name: "name already taken",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposTransferByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "new-org already has a repository with this name"}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"new_owner": "new-org",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to transfer repository owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := TransferRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
+
 func Test_PushFiles(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -2141,7 +3041,177 @@ func Test_GetTag(t *testing.T) {
 				"tag":   "v1.0.0",
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to get tag object",
+			expectedErrMsg: "failed to get tag object",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetTag(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Parse and verify the result
+			var returnedTag github.Tag
+			err = json.Unmarshal([]byte(textContent.Text), &returnedTag)
+			require.NoError(t, err)
+
+			assert.Equal(t, *tc.expectedTag.SHA, *returnedTag.SHA)
+			assert.Equal(t, *tc.expectedTag.Tag, *returnedTag.Tag)
+			assert.Equal(t, *tc.expectedTag.Message, *returnedTag.Message)
+			assert.Equal(t, *tc.expectedTag.Object.Type, *returnedTag.Object.Type)
+			assert.Equal(t, *tc.expectedTag.Object.SHA, *returnedTag.Object.SHA)
+		})
+	}
+}
+
+func Test_CreateTag(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateTag(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_tag", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "tag")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.Contains(t, tool.InputSchema.Properties, "message")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "tag", "sha"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedRef    *github.Reference
+	}{
+		{
+			name: "lightweight tag points the ref at the commit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitTagsByOwnerByRepo,
+					http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+						t.Fatal("lightweight tags must not create a tag object")
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitRefsByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"ref": "refs/tags/v1.0.0",
+						"sha": "commitsha",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Reference{
+							Ref:    github.Ptr("refs/tags/v1.0.0"),
+							Object: &github.GitObject{SHA: github.Ptr("commitsha"), Type: github.Ptr("commit")},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"tag":   "v1.0.0",
+				"sha":   "commitsha",
+			},
+			expectedRef: &github.Reference{
+				Ref:    github.Ptr("refs/tags/v1.0.0"),
+				Object: &github.GitObject{SHA: github.Ptr("commitsha"), Type: github.Ptr("commit")},
+			},
+		},
+		{
+			name: "annotated tag creates a tag object then a ref to it",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitTagsByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"tag":     "v1.0.0",
+						"message": "Release v1.0.0",
+						"object":  "commitsha",
+						"type":    "commit",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Tag{
+							Tag: github.Ptr("v1.0.0"),
+							SHA: github.Ptr("tagobjectsha"),
+						}),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitRefsByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"ref": "refs/tags/v1.0.0",
+						"sha": "tagobjectsha",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Reference{
+							Ref:    github.Ptr("refs/tags/v1.0.0"),
+							Object: &github.GitObject{SHA: github.Ptr("tagobjectsha"), Type: github.Ptr("tag")},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"tag":     "v1.0.0",
+				"sha":     "commitsha",
+				"message": "Release v1.0.0",
+			},
+			expectedRef: &github.Reference{
+				Ref:    github.Ptr("refs/tags/v1.0.0"),
+				Object: &github.GitObject{SHA: github.Ptr("tagobjectsha"), Type: github.Ptr("tag")},
+			},
+		},
+		{
+			name:         "invalid tag name is rejected",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"tag":   "v1..0",
+				"sha":   "commitsha",
+			},
+			expectError:    true,
+			expectedErrMsg: `must not contain ".."`,
+		},
+		{
+			name: "ref creation fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitRefsByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Reference already exists"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"tag":   "v1.0.0",
+				"sha":   "commitsha",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create tag reference",
 		},
 	}
 
@@ -2149,43 +3219,191 @@ func Test_GetTag(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := GetTag(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := CreateTag(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
 
 			// Call handler
 			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
 
-			// Verify results
 			if tc.expectError {
-				require.NoError(t, err)
-				require.True(t, result.IsError)
 				errorContent := getErrorResult(t, result)
 				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
 				return
 			}
 
+			textContent := getTextResult(t, result)
+
+			var returnedRef github.Reference
+			err = json.Unmarshal([]byte(textContent.Text), &returnedRef)
 			require.NoError(t, err)
-			require.False(t, result.IsError)
+			assert.Equal(t, *tc.expectedRef.Ref, *returnedRef.Ref)
+			assert.Equal(t, *tc.expectedRef.Object.SHA, *returnedRef.Object.SHA)
+			assert.Equal(t, *tc.expectedRef.Object.Type, *returnedRef.Object.Type)
+		})
+	}
+}
 
-			// Parse the result and get the text content if no error
-			textContent := getTextResult(t, result)
+func Test_DeleteTag(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteTag(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
 
-			// Parse and verify the result
-			var returnedTag github.Tag
-			err = json.Unmarshal([]byte(textContent.Text), &returnedTag)
+	assert.Equal(t, "delete_tag", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "tag"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		tag            string
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "tag deleted",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposGitRefsByOwnerByRepoByRef,
+					expectPath(t, "/repos/owner/repo/git/refs/tags/v1.2.3").andThen(
+						mockResponse(t, http.StatusNoContent, nil),
+					),
+				),
+			),
+			tag: "v1.2.3",
+		},
+		{
+			name:           "full ref name rejected",
+			mockedClient:   mock.NewMockedHTTPClient(),
+			tag:            "refs/tags/v1.2.3",
+			expectError:    true,
+			expectedErrMsg: "must not include the refs/ prefix",
+		},
+		{
+			name: "tag not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposGitRefsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Reference does not exist"}),
+				),
+			),
+			tag:            "v9.9.9",
+			expectError:    true,
+			expectedErrMsg: "failed to delete tag v9.9.9",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DeleteTag(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"tag":   tc.tag,
+			}))
 			require.NoError(t, err)
 
-			assert.Equal(t, *tc.expectedTag.SHA, *returnedTag.SHA)
-			assert.Equal(t, *tc.expectedTag.Tag, *returnedTag.Tag)
-			assert.Equal(t, *tc.expectedTag.Message, *returnedTag.Message)
-			assert.Equal(t, *tc.expectedTag.Object.Type, *returnedTag.Object.Type)
-			assert.Equal(t, *tc.expectedTag.Object.SHA, *returnedTag.Object.SHA)
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Equal(t, "Successfully deleted tag v1.2.3 from owner/repo", getTextResult(t, result).Text)
 		})
 	}
 }
 
+func Test_validateTagName(t *testing.T) {
+	valid := []string{"v1.0.0", "release/2024-01", "v2.0.0-rc.1", "nightly_build"}
+	for _, name := range valid {
+		assert.NoError(t, validateTagName(name), name)
+	}
+
+	invalid := []string{"", "refs/tags/v1", "-v1", "v1/", "v1.", "v1.lock", "v1 beta", "v1~1", "v1^", "v1:2", "a//b", "v@{1}", "@", "release/.hidden"}
+	for _, name := range invalid {
+		assert.Error(t, validateTagName(name), name)
+	}
+}
+
+func Test_ListTagProtection(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListTagProtection(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_tag_protection", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockProtections := []*github.TagProtection{
+		{ID: github.Ptr(int64(1)), Pattern: github.Ptr("v*")},
+	}
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.EndpointPattern{
+				Pattern: "/repos/owner/repo/tags/protection",
+				Method:  "GET",
+			},
+			mockProtections,
+		),
+	))
+	_, handler := ListTagProtection(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	var returned []*github.TagProtection
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+	require.Len(t, returned, 1)
+	assert.Equal(t, "v*", returned[0].GetPattern())
+}
+
+func Test_CreateTagProtection(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateTagProtection(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_tag_protection", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pattern"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.EndpointPattern{
+				Pattern: "/repos/owner/repo/tags/protection",
+				Method:  "POST",
+			},
+			expectRequestBody(t, map[string]any{"pattern": "v*"}).andThen(
+				mockResponse(t, http.StatusCreated, &github.TagProtection{ID: github.Ptr(int64(2)), Pattern: github.Ptr("v*")}),
+			),
+		),
+	))
+	_, handler := CreateTagProtection(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":   "owner",
+		"repo":    "repo",
+		"pattern": "v*",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	var returned github.TagProtection
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+	assert.Equal(t, int64(2), returned.GetID())
+}
+
 func Test_ListReleases(t *testing.T) {
 	mockClient := github.NewClient(nil)
 	tool, _ := ListReleases(stubGetClientFn(mockClient), translations.NullTranslationHelper)
@@ -3009,7 +4227,7 @@ func Test_ListStarredRepositories(t *testing.T) {
 				textContent := getTextResult(t, result)
 
 				// Unmarshal and verify the result
-				var returnedRepos []MinimalRepository
+				var returnedRepos []StarredRepository
 				err = json.Unmarshal([]byte(textContent.Text), &returnedRepos)
 				require.NoError(t, err)
 
@@ -3017,12 +4235,129 @@ func Test_ListStarredRepositories(t *testing.T) {
 				if tc.expectedCount > 0 {
 					assert.Equal(t, "awesome-repo", returnedRepos[0].Name)
 					assert.Equal(t, "owner/awesome-repo", returnedRepos[0].FullName)
+					assert.Equal(t, starredAt.UTC().Format("2006-01-02T15:04:05Z"), returnedRepos[0].StarredAt)
 				}
 			}
 		})
 	}
 }
 
+func Test_ListStargazers(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListStargazers(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_stargazers", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	t.Run("starred_at is parsed from the star media type", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposStargazersByOwnerByRepo,
+				expect(t, expectations{
+					path:        "/repos/owner/repo/stargazers",
+					queryParams: map[string]string{"page": "2", "per_page": "10"},
+				}).andThen(
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						// Without this media type GitHub returns plain users.
+						if r.Header.Get("Accept") != "application/vnd.github.star+json" {
+							w.WriteHeader(http.StatusOK)
+							_, _ = w.Write([]byte(`[{"login":"octocat","id":1}]`))
+							return
+						}
+						w.WriteHeader(http.StatusOK)
+						_, _ = w.Write([]byte(`[
+							{"starred_at":"2024-01-02T03:04:05Z","user":{"login":"octocat","id":1,"html_url":"https://github.com/octocat"}},
+							{"starred_at":"2024-02-03T04:05:06Z","user":{"login":"hubot","id":2,"html_url":"https://github.com/hubot"}}
+						]`))
+					}),
+				),
+			),
+		)
+		client := github.NewClient(mockedClient)
+		_, handler := ListStargazers(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":   "owner",
+			"repo":    "repo",
+			"page":    float64(2),
+			"perPage": float64(10),
+		}))
+		require.NoError(t, err)
+		textContent := getTextResult(t, result)
+
+		var stargazers []Stargazer
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &stargazers))
+		require.Len(t, stargazers, 2)
+		assert.Equal(t, "octocat", stargazers[0].Login)
+		assert.Equal(t, "https://github.com/octocat", stargazers[0].ProfileURL)
+		require.NotNil(t, stargazers[0].StarredAt)
+		assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), stargazers[0].StarredAt.UTC())
+		require.NotNil(t, stargazers[1].StarredAt)
+		assert.Equal(t, time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC), stargazers[1].StarredAt.UTC())
+	})
+
+	t.Run("API error", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposStargazersByOwnerByRepo,
+				mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+			),
+		)
+		client := github.NewClient(mockedClient)
+		_, handler := ListStargazers(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner": "owner",
+			"repo":  "repo",
+		}))
+		require.NoError(t, err)
+		errorContent := getErrorResult(t, result)
+		assert.Contains(t, errorContent.Text, "failed to list stargazers of owner/repo")
+	})
+}
+
+func Test_ListWatchers(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListWatchers(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_watchers", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposSubscribersByOwnerByRepo,
+			expectPath(t, "/repos/owner/repo/subscribers").andThen(
+				mockResponse(t, http.StatusOK, []*github.User{
+					{Login: github.Ptr("octocat"), ID: github.Ptr(int64(1)), HTMLURL: github.Ptr("https://github.com/octocat")},
+				}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := ListWatchers(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+	textContent := getTextResult(t, result)
+
+	var watchers []MinimalUser
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &watchers))
+	assert.Equal(t, []MinimalUser{{Login: "octocat", ID: 1, ProfileURL: "https://github.com/octocat"}}, watchers)
+}
+
 func Test_StarRepository(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -3192,3 +4527,246 @@ func Test_UnstarRepository(t *testing.T) {
 		})
 	}
 }
+
+func Test_ListRepositoryActivity(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepositoryActivity(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_repository_activity", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.Properties, "activity_type")
+	assert.Contains(t, tool.InputSchema.Properties, "time_period")
+	assert.Contains(t, tool.InputSchema.Properties, "after")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	forcePushes := []map[string]any{
+		{
+			"id":            1296269,
+			"node_id":       "RA_kwDOAAABAAAAAAA",
+			"before":        "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"after":         "827efc6d56897b048c772eb4087f854f46256132",
+			"ref":           "refs/heads/main",
+			"timestamp":     "2025-05-01T12:00:00Z",
+			"activity_type": "force_push",
+			"actor":         map[string]any{"login": "octocat"},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedPage   RepositoryActivityPage
+	}{
+		{
+			name: "force pushes filtered by activity type",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActivityByOwnerByRepo,
+					expect(t, expectations{
+						path: "/repos/owner/repo/activity",
+						queryParams: map[string]string{
+							"activity_type": "force_push",
+							"ref":           "main",
+							"time_period":   "week",
+							"per_page":      "30",
+						},
+					}).andThen(
+						func(w http.ResponseWriter, r *http.Request) {
+							w.Header().Set("Link", `<https://api.github.com/repositories/1/activity?activity_type=force_push&after=Y3Vyc29yOjE%3D>; rel="next"`)
+							mockResponse(t, http.StatusOK, forcePushes)(w, r)
+						},
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"ref":           "main",
+				"activity_type": "force_push",
+				"time_period":   "week",
+			},
+			expectedPage: func() RepositoryActivityPage {
+				timestamp := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+				page := RepositoryActivityPage{
+					Activities: []RepositoryActivity{
+						{
+							ID:           1296269,
+							Ref:          "refs/heads/main",
+							ActivityType: "force_push",
+							Actor:        "octocat",
+							Timestamp:    &timestamp,
+							Before:       "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+							After:        "827efc6d56897b048c772eb4087f854f46256132",
+						},
+					},
+				}
+				page.PageInfo.HasNextPage = true
+				page.PageInfo.EndCursor = "Y3Vyc29yOjE="
+				return page
+			}(),
+		},
+		{
+			name: "cursor is passed through",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActivityByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"after":    "Y3Vyc29yOjE=",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, []map[string]any{}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"perPage": float64(10),
+				"after":   "Y3Vyc29yOjE=",
+			},
+			expectedPage: RepositoryActivityPage{Activities: []RepositoryActivity{}},
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActivityByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list repository activity",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListRepositoryActivity(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var page RepositoryActivityPage
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &page))
+			assert.Equal(t, tc.expectedPage, page)
+		})
+	}
+}
+
+func Test_GetRepositoryLicense(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositoryLicense(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_repository_license", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		expectError     bool
+		expectedErrMsg  string
+		expectedLicense RepositoryLicense
+	}{
+		{
+			name: "license content is decoded",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposLicenseByOwnerByRepo,
+					expectPath(t, "/repos/owner/repo/license").andThen(
+						mockResponse(t, http.StatusOK, &github.RepositoryLicense{
+							Name:     github.Ptr("LICENSE"),
+							Path:     github.Ptr("LICENSE"),
+							HTMLURL:  github.Ptr("https://github.com/owner/repo/blob/main/LICENSE"),
+							Encoding: github.Ptr("base64"),
+							// "MIT License\n\nCopyright (c) 2024 Owner\n", wrapped as GitHub does.
+							Content: github.Ptr("TUlUIExpY2Vuc2UKCkNvcHlyaWdo\ndCAoYykgMjAyNCBPd25lcgo=\n"),
+							License: &github.License{
+								Key:    github.Ptr("mit"),
+								Name:   github.Ptr("MIT License"),
+								SPDXID: github.Ptr("MIT"),
+							},
+						}),
+					),
+				),
+			),
+			expectedLicense: RepositoryLicense{
+				Detected: true,
+				SPDXID:   "MIT",
+				Key:      "mit",
+				Name:     "MIT License",
+				Path:     "LICENSE",
+				HTMLURL:  "https://github.com/owner/repo/blob/main/LICENSE",
+				Content:  "MIT License\n\nCopyright (c) 2024 Owner\n",
+			},
+		},
+		{
+			name: "no license detected",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposLicenseByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectedLicense: RepositoryLicense{
+				Message: "no license detected in owner/repo",
+			},
+		},
+		{
+			name: "other API errors are reported",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposLicenseByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Forbidden"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get repository license",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepositoryLicense(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var license RepositoryLicense
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &license))
+			assert.Equal(t, tc.expectedLicense, license)
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// repositoryStatsPollBackoff controls how get_repository_participation waits while GitHub computes
+// statistics it had not cached.
+var repositoryStatsPollBackoff = pollBackoff{Initial: time.Second, Max: 4 * time.Second, Factor: 2}
+
+// maxRepositoryStatsAttempts is how many times get_repository_participation asks for statistics
+// that are still being computed before giving up.
+const maxRepositoryStatsAttempts = 5
+
+// TrafficCount is the traffic in one day or week.
+type TrafficCount struct {
+	Timestamp time.Time `json:"timestamp"`
+	Count     int       `json:"count"`
+	Uniques   int       `json:"uniques"`
+}
+
+// TrafficSummary is the total traffic of the last 14 days and its breakdown per day or week.
+type TrafficSummary struct {
+	Count     int            `json:"count"`
+	Uniques   int            `json:"uniques"`
+	Breakdown []TrafficCount `json:"breakdown"`
+}
+
+// TrafficReferrer is a site that sent visitors to the repository.
+type TrafficReferrer struct {
+	Referrer string `json:"referrer"`
+	Count    int    `json:"count"`
+	Uniques  int    `json:"uniques"`
+}
+
+// TrafficPath is a page of the repository that visitors viewed.
+type TrafficPath struct {
+	Path    string `json:"path"`
+	Title   string `json:"title,omitempty"`
+	Count   int    `json:"count"`
+	Uniques int    `json:"uniques"`
+}
+
+// RepositoryTraffic is the traffic of a repository over the last 14 days.
+type RepositoryTraffic struct {
+	Views        TrafficSummary    `json:"views"`
+	Clones       TrafficSummary    `json:"clones"`
+	Referrers    []TrafficReferrer `json:"referrers"`
+	PopularPaths []TrafficPath     `json:"popular_paths"`
+}
+
+// RepositoryParticipation is the weekly commit count of a repository over the last year, oldest
+// week first.
+type RepositoryParticipation struct {
+	AllCommits        []int `json:"all_commits"`
+	OwnerCommits      []int `json:"owner_commits"`
+	TotalCommits      int   `json:"total_commits"`
+	OwnerTotalCommits int   `json:"owner_total_commits"`
+}
+
+func newTrafficSummary(count, uniques int, data []*github.TrafficData) TrafficSummary {
+	summary := TrafficSummary{Count: count, Uniques: uniques, Breakdown: make([]TrafficCount, 0, len(data))}
+	for _, d := range data {
+		summary.Breakdown = append(summary.Breakdown, TrafficCount{
+			Timestamp: d.GetTimestamp().Time,
+			Count:     d.GetCount(),
+			Uniques:   d.GetUniques(),
+		})
+	}
+	return summary
+}
+
+func sumInts(values []int) int {
+	total := 0
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+// GetRepositoryTraffic creates a tool to get the traffic of a repository.
+func GetRepositoryTraffic(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_traffic",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_TRAFFIC_DESCRIPTION", "Get the traffic of a GitHub repository over the last 14 days: page views and clones with their daily or weekly breakdown, the top 10 referring sites and the 10 most viewed paths. Requires push access to the repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_TRAFFIC_USER_TITLE", "Get repository traffic"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("per",
+				mcp.Description("Break views and clones down per day or per week (default: day)"),
+				mcp.Enum("day", "week"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			per, err := OptionalParam[string](request, "per")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			breakdown := &github.TrafficBreakdownOptions{Per: per}
+			views, resp, err := client.Repositories.ListTrafficViews(ctx, owner, repo, breakdown)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository views", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			clones, resp, err := client.Repositories.ListTrafficClones(ctx, owner, repo, breakdown)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository clones", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			referrers, resp, err := client.Repositories.ListTrafficReferrers(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository referrers", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			paths, resp, err := client.Repositories.ListTrafficPaths(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository popular paths", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			result := RepositoryTraffic{
+				Views:        newTrafficSummary(views.GetCount(), views.GetUniques(), views.Views),
+				Clones:       newTrafficSummary(clones.GetCount(), clones.GetUniques(), clones.Clones),
+				Referrers:    make([]TrafficReferrer, 0, len(referrers)),
+				PopularPaths: make([]TrafficPath, 0, len(paths)),
+			}
+			for _, r := range referrers {
+				result.Referrers = append(result.Referrers, TrafficReferrer{
+					Referrer: r.GetReferrer(),
+					Count:    r.GetCount(),
+					Uniques:  r.GetUniques(),
+				})
+			}
+			for _, p := range paths {
+				result.PopularPaths = append(result.PopularPaths, TrafficPath{
+					Path:    p.GetPath(),
+					Title:   p.GetTitle(),
+					Count:   p.GetCount(),
+					Uniques: p.GetUniques(),
+				})
+			}
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// GetRepositoryParticipation creates a tool to get the weekly commit counts of a repository.
+func GetRepositoryParticipation(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_participation",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_PARTICIPATION_DESCRIPTION", "Get the number of commits to the default branch of a GitHub repository in each of the last 52 weeks, oldest week first, for everyone and for the repository owner alone. Useful to judge whether a project is active.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_PARTICIPATION_USER_TITLE", "Get repository participation"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var participation *github.RepositoryParticipation
+			var apiErrResult *mcp.CallToolResult
+			computed, _, err := pollUntil(ctx, repositoryStatsPollBackoff, maxRepositoryStatsAttempts, func(ctx context.Context) (bool, error) {
+				stats, resp, err := client.Repositories.ListParticipation(ctx, owner, repo)
+				if err != nil {
+					// GitHub answers 202 while it computes statistics that were not cached.
+					if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
+						return false, nil
+					}
+					apiErrResult = ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository participation", resp, err)
+					return false, err
+				}
+				defer func() { _ = resp.Body.Close() }()
+
+				participation = stats
+				return true, nil
+			})
+			if apiErrResult != nil {
+				return apiErrResult, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			if !computed {
+				return mcp.NewToolResultText(fmt.Sprintf("GitHub is still computing the statistics of %s/%s. Try again in a few seconds.", owner, repo)), nil
+			}
+
+			return MarshalledTextResult(RepositoryParticipation{
+				AllCommits:        participation.All,
+				OwnerCommits:      participation.Owner,
+				TotalCommits:      sumInts(participation.All),
+				OwnerTotalCommits: sumInts(participation.Owner),
+			}), nil
+		}
+}
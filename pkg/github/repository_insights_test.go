@@ -0,0 +1,176 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetRepositoryTraffic(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositoryTraffic(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_repository_traffic", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	week := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	weekly := []*github.TrafficData{{Timestamp: &github.Timestamp{Time: week}, Count: github.Ptr(40), Uniques: github.Ptr(12)}}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "weekly traffic",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTrafficViewsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{"per": "week"}).andThen(
+						mockResponse(t, http.StatusOK, &github.TrafficViews{Count: github.Ptr(40), Uniques: github.Ptr(12), Views: weekly}),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposTrafficClonesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{"per": "week"}).andThen(
+						mockResponse(t, http.StatusOK, &github.TrafficClones{Count: github.Ptr(40), Uniques: github.Ptr(12), Clones: weekly}),
+					),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposTrafficPopularReferrersByOwnerByRepo,
+					[]*github.TrafficReferrer{{Referrer: github.Ptr("news.ycombinator.com"), Count: github.Ptr(30), Uniques: github.Ptr(9)}},
+				),
+				mock.WithRequestMatch(
+					mock.GetReposTrafficPopularPathsByOwnerByRepo,
+					[]*github.TrafficPath{{Path: github.Ptr("/owner/repo"), Title: github.Ptr("owner/repo"), Count: github.Ptr(25), Uniques: github.Ptr(10)}},
+				),
+			),
+		},
+		{
+			name: "no push access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTrafficViewsByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must have push access to repository"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get repository views",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepositoryTraffic(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"per":   "week",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned RepositoryTraffic
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			summary := TrafficSummary{Count: 40, Uniques: 12, Breakdown: []TrafficCount{{Timestamp: week, Count: 40, Uniques: 12}}}
+			assert.Equal(t, RepositoryTraffic{
+				Views:        summary,
+				Clones:       summary,
+				Referrers:    []TrafficReferrer{{Referrer: "news.ycombinator.com", Count: 30, Uniques: 9}},
+				PopularPaths: []TrafficPath{{Path: "/owner/repo", Title: "owner/repo", Count: 25, Uniques: 10}},
+			}, returned)
+		})
+	}
+}
+
+func Test_GetRepositoryParticipation(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositoryParticipation(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_repository_participation", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	originalBackoff := repositoryStatsPollBackoff
+	repositoryStatsPollBackoff = pollBackoff{Initial: time.Millisecond, Max: time.Millisecond, Factor: 1}
+	t.Cleanup(func() { repositoryStatsPollBackoff = originalBackoff })
+
+	computing := mockResponse(t, http.StatusAccepted, map[string]any{})
+
+	t.Run("statistics computed after a retry", func(t *testing.T) {
+		requests := 0
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposStatsParticipationByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					requests++
+					if requests == 1 {
+						computing(w, r)
+						return
+					}
+					mockResponse(t, http.StatusOK, &github.RepositoryParticipation{
+						All:   []int{3, 0, 5},
+						Owner: []int{1, 0, 2},
+					})(w, r)
+				}),
+			),
+		)
+		client := github.NewClient(mockedClient)
+		_, handler := GetRepositoryParticipation(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner": "owner",
+			"repo":  "repo",
+		}))
+		require.NoError(t, err)
+
+		var returned RepositoryParticipation
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		assert.Equal(t, RepositoryParticipation{
+			AllCommits:        []int{3, 0, 5},
+			OwnerCommits:      []int{1, 0, 2},
+			TotalCommits:      8,
+			OwnerTotalCommits: 3,
+		}, returned)
+		assert.Equal(t, 2, requests)
+	})
+
+	t.Run("statistics still being computed", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposStatsParticipationByOwnerByRepo,
+				computing,
+			),
+		)
+		client := github.NewClient(mockedClient)
+		_, handler := GetRepositoryParticipation(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner": "owner",
+			"repo":  "repo",
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "GitHub is still computing the statistics of owner/repo. Try again in a few seconds.", getTextResult(t, result).Text)
+	})
+}
@@ -0,0 +1,199 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// RepositorySettingChange is one repository setting update_repository changes, with its value
+// before and after the change.
+type RepositorySettingChange struct {
+	Setting string `json:"setting"`
+	From    any    `json:"from"`
+	To      any    `json:"to"`
+}
+
+// RepositorySettingsUpdate is the outcome of update_repository. Without confirm, Applied is false
+// and Changes previews what would change.
+type RepositorySettingsUpdate struct {
+	Repository string                    `json:"repository"`
+	Applied    bool                      `json:"applied"`
+	Changes    []RepositorySettingChange `json:"changes"`
+	Message    string                    `json:"message,omitempty"`
+}
+
+// repositoryStringSettings are the string settings update_repository can change, with how to
+// read the current value and set the new one.
+var repositoryStringSettings = []struct {
+	name string
+	get  func(*github.Repository) string
+	set  func(*github.Repository, string)
+}{
+	{"description", (*github.Repository).GetDescription, func(r *github.Repository, v string) { r.Description = github.Ptr(v) }},
+	{"homepage", (*github.Repository).GetHomepage, func(r *github.Repository, v string) { r.Homepage = github.Ptr(v) }},
+	{"default_branch", (*github.Repository).GetDefaultBranch, func(r *github.Repository, v string) { r.DefaultBranch = github.Ptr(v) }},
+	{"visibility", (*github.Repository).GetVisibility, func(r *github.Repository, v string) { r.Visibility = github.Ptr(v) }},
+}
+
+// repositoryBoolSettings are the boolean settings update_repository can change.
+var repositoryBoolSettings = []struct {
+	name string
+	get  func(*github.Repository) bool
+	set  func(*github.Repository, bool)
+}{
+	{"allow_merge_commit", (*github.Repository).GetAllowMergeCommit, func(r *github.Repository, v bool) { r.AllowMergeCommit = github.Ptr(v) }},
+	{"allow_squash_merge", (*github.Repository).GetAllowSquashMerge, func(r *github.Repository, v bool) { r.AllowSquashMerge = github.Ptr(v) }},
+	{"allow_rebase_merge", (*github.Repository).GetAllowRebaseMerge, func(r *github.Repository, v bool) { r.AllowRebaseMerge = github.Ptr(v) }},
+	{"allow_auto_merge", (*github.Repository).GetAllowAutoMerge, func(r *github.Repository, v bool) { r.AllowAutoMerge = github.Ptr(v) }},
+	{"delete_branch_on_merge", (*github.Repository).GetDeleteBranchOnMerge, func(r *github.Repository, v bool) { r.DeleteBranchOnMerge = github.Ptr(v) }},
+}
+
+// UpdateRepository creates a tool to change the settings of a repository.
+func UpdateRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_repository",
+			mcp.WithDescription(t("TOOL_UPDATE_REPOSITORY_DESCRIPTION", "Change the settings of a GitHub repository: description, homepage, default branch, allowed merge methods, auto-merge, deleting head branches after merge and visibility. Only the given settings change. Without confirm, nothing is changed and the current and new values are returned for review; call again with confirm set to apply them. Requires admin access.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_UPDATE_REPOSITORY_USER_TITLE", "Update repository settings"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Short description of the repository"),
+			),
+			mcp.WithString("homepage",
+				mcp.Description("URL of the project's website"),
+			),
+			mcp.WithString("default_branch",
+				mcp.Description("Branch to make the default branch. It must already exist"),
+			),
+			mcp.WithBoolean("allow_merge_commit",
+				mcp.Description("Allow merging pull requests with a merge commit"),
+			),
+			mcp.WithBoolean("allow_squash_merge",
+				mcp.Description("Allow squash-merging pull requests"),
+			),
+			mcp.WithBoolean("allow_rebase_merge",
+				mcp.Description("Allow rebase-merging pull requests"),
+			),
+			mcp.WithBoolean("allow_auto_merge",
+				mcp.Description("Allow pull requests to be merged automatically once their requirements are met"),
+			),
+			mcp.WithBoolean("delete_branch_on_merge",
+				mcp.Description("Delete head branches automatically after their pull requests are merged"),
+			),
+			mcp.WithString("visibility",
+				mcp.Description("Visibility of the repository. internal is only available to organizations on GitHub Enterprise"),
+				mcp.Enum("public", "private", "internal"),
+			),
+			mcp.WithBoolean("confirm",
+				mcp.Description("Apply the changes. Without it, the changes are only previewed"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			confirm, err := OptionalParam[bool](request, "confirm")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			stringValues := make(map[string]string)
+			for _, s := range repositoryStringSettings {
+				v, ok, err := OptionalParamOK[string](request, s.name)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if ok {
+					stringValues[s.name] = v
+				}
+			}
+			boolValues := make(map[string]bool)
+			for _, s := range repositoryBoolSettings {
+				v, ok, err := OptionalParamOK[bool](request, s.name)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if ok {
+					boolValues[s.name] = v
+				}
+			}
+			if len(stringValues) == 0 && len(boolValues) == 0 {
+				return mcp.NewToolResultError("no settings to change were given"), nil
+			}
+			if branch, ok := stringValues["default_branch"]; ok && branch == "" {
+				return mcp.NewToolResultError("default_branch must not be empty"), nil
+			}
+			if visibility, ok := stringValues["visibility"]; ok && visibility != "public" && visibility != "private" && visibility != "internal" {
+				return mcp.NewToolResultError(fmt.Sprintf("visibility must be public, private or internal, got %q", visibility)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			current, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get repository %s/%s", owner, repo), resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			// Only settings that differ from their current value are sent, so that a preview and
+			// the update agree on what changes.
+			update := &github.Repository{}
+			result := RepositorySettingsUpdate{
+				Repository: fmt.Sprintf("%s/%s", owner, repo),
+				Changes:    []RepositorySettingChange{},
+			}
+			for _, s := range repositoryStringSettings {
+				if v, ok := stringValues[s.name]; ok && v != s.get(current) {
+					s.set(update, v)
+					result.Changes = append(result.Changes, RepositorySettingChange{Setting: s.name, From: s.get(current), To: v})
+				}
+			}
+			for _, s := range repositoryBoolSettings {
+				if v, ok := boolValues[s.name]; ok && v != s.get(current) {
+					s.set(update, v)
+					result.Changes = append(result.Changes, RepositorySettingChange{Setting: s.name, From: s.get(current), To: v})
+				}
+			}
+
+			switch {
+			case len(result.Changes) == 0:
+				result.Message = "all settings already have the given values"
+				return MarshalledTextResult(result), nil
+			case !confirm:
+				result.Message = "nothing was changed; call again with confirm set to apply these changes"
+				return MarshalledTextResult(result), nil
+			}
+
+			_, resp, err = client.Repositories.Edit(ctx, owner, repo, update)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to update repository %s/%s", owner, repo), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result.Applied = true
+			return MarshalledTextResult(result), nil
+		}
+}
@@ -0,0 +1,169 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UpdateRepository(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_repository", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	current := mock.WithRequestMatchHandler(
+		mock.GetReposByOwnerByRepo,
+		mockResponse(t, http.StatusOK, &github.Repository{
+			Description:         github.Ptr("An MCP server"),
+			DefaultBranch:       github.Ptr("master"),
+			Visibility:          github.Ptr("private"),
+			AllowMergeCommit:    github.Ptr(true),
+			DeleteBranchOnMerge: github.Ptr(false),
+		}),
+	)
+	expectedChanges := []RepositorySettingChange{
+		{Setting: "default_branch", From: "master", To: "main"},
+		{Setting: "visibility", From: "private", To: "public"},
+		{Setting: "allow_merge_commit", From: true, To: false},
+		{Setting: "delete_branch_on_merge", From: false, To: true},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedResult RepositorySettingsUpdate
+	}{
+		{
+			// No PATCH handler is registered, so an update would fail the test
+			name:         "preview without confirm",
+			mockedClient: mock.NewMockedHTTPClient(current),
+			requestArgs: map[string]any{
+				"owner":                  "owner",
+				"repo":                   "repo",
+				"description":            "An MCP server",
+				"default_branch":         "main",
+				"visibility":             "public",
+				"allow_merge_commit":     false,
+				"delete_branch_on_merge": true,
+			},
+			expectedResult: RepositorySettingsUpdate{
+				Repository: "owner/repo",
+				Changes:    expectedChanges,
+				Message:    "nothing was changed; call again with confirm set to apply these changes",
+			},
+		},
+		{
+			name: "changes applied with confirm",
+			mockedClient: mock.NewMockedHTTPClient(
+				current,
+				mock.WithRequestMatchHandler(
+					mock.PatchReposByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"default_branch":         "main",
+						"visibility":             "public",
+						"allow_merge_commit":     false,
+						"delete_branch_on_merge": true,
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Repository{DefaultBranch: github.Ptr("main")}),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":                  "owner",
+				"repo":                   "repo",
+				"description":            "An MCP server",
+				"default_branch":         "main",
+				"visibility":             "public",
+				"allow_merge_commit":     false,
+				"delete_branch_on_merge": true,
+				"confirm":                true,
+			},
+			expectedResult: RepositorySettingsUpdate{
+				Repository: "owner/repo",
+				Applied:    true,
+				Changes:    expectedChanges,
+			},
+		},
+		{
+			name:         "settings already set",
+			mockedClient: mock.NewMockedHTTPClient(current),
+			requestArgs: map[string]any{
+				"owner":              "owner",
+				"repo":               "repo",
+				"default_branch":     "master",
+				"allow_merge_commit": true,
+				"confirm":            true,
+			},
+			expectedResult: RepositorySettingsUpdate{
+				Repository: "owner/repo",
+				Changes:    []RepositorySettingChange{},
+				Message:    "all settings already have the given values",
+			},
+		},
+		{
+			name:         "no settings given",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"confirm": true,
+			},
+			expectError:    true,
+			expectedErrMsg: "no settings to change were given",
+		},
+		{
+			name: "default branch does not exist",
+			mockedClient: mock.NewMockedHTTPClient(
+				current,
+				mock.WithRequestMatchHandler(
+					mock.PatchReposByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Validation Failed"}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"default_branch": "trunk",
+				"confirm":        true,
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update repository owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned RepositorySettingsUpdate
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxRepositoryTopics is the number of topics GitHub allows on a repository.
+const maxRepositoryTopics = 20
+
+var topicPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,49}$`)
+
+// RepositoryTopics are the topics of a repository.
+type RepositoryTopics struct {
+	Topics []string `json:"topics"`
+}
+
+// normalizeTopics lowercases topics and drops duplicates, so that "Go" and "go" are one topic,
+// and checks them against GitHub's rules before the API rejects the whole list.
+func normalizeTopics(topics []string) ([]string, error) {
+	normalized := make([]string, 0, len(topics))
+	seen := make(map[string]bool, len(topics))
+	for _, topic := range topics {
+		topic = strings.ToLower(strings.TrimSpace(topic))
+		if !topicPattern.MatchString(topic) {
+			return nil, fmt.Errorf("invalid topic %q: topics must start with a letter or number, contain only lowercase letters, numbers and hyphens, and be at most 50 characters", topic)
+		}
+		if seen[topic] {
+			continue
+		}
+		seen[topic] = true
+		normalized = append(normalized, topic)
+	}
+	if len(normalized) > maxRepositoryTopics {
+		return nil, fmt.Errorf("a repository can have at most %d topics, got %d", maxRepositoryTopics, len(normalized))
+	}
+	return normalized, nil
+}
+
+// ListRepositoryTopics creates a tool to list the topics of a repository.
+func ListRepositoryTopics(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_topics",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_TOPICS_DESCRIPTION", "List the topics of a GitHub repository, the tags that make it discoverable in search and on topic pages")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REPOSITORY_TOPICS_USER_TITLE", "List repository topics"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			topics, resp, err := client.Repositories.ListAllTopics(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list repository topics", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if topics == nil {
+				topics = []string{}
+			}
+			return MarshalledListPageResult(ctx, RepositoryTopics{Topics: topics}, topics, ListPageInfo{}, "the repository has no topics"), nil
+		}
+}
+
+// ReplaceRepositoryTopics creates a tool to replace the topics of a repository.
+func ReplaceRepositoryTopics(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("replace_repository_topics",
+			mcp.WithDescription(t("TOOL_REPLACE_REPOSITORY_TOPICS_DESCRIPTION", fmt.Sprintf("Replace all topics of a GitHub repository with the given list. Topics not in the list are removed, so to add a topic, list the current topics first and pass them along with the new one. An empty list removes all topics. Topics are lowercased; at most %d are allowed.", maxRepositoryTopics))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_REPLACE_REPOSITORY_TOPICS_USER_TITLE", "Replace repository topics"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithArray("topics",
+				mcp.Required(),
+				mcp.Description("The complete list of topics the repository should have, e.g. [\"go\", \"mcp\", \"github-api\"]"),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// An empty list is valid and clears the topics, so only a missing list is an error.
+			if _, ok := request.GetArguments()["topics"]; !ok {
+				return mcp.NewToolResultError("missing required parameter: topics"), nil
+			}
+			topics, err := OptionalStringArrayParam(request, "topics")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			topics, err = normalizeTopics(topics)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			replaced, resp, err := client.Repositories.ReplaceAllTopics(ctx, owner, repo, topics)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to replace repository topics", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if replaced == nil {
+				replaced = []string{}
+			}
+			return MarshalledTextResult(RepositoryTopics{Topics: replaced}), nil
+		}
+}
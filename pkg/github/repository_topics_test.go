@@ -0,0 +1,139 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListRepositoryTopics(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepositoryTopics(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_repository_topics", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposTopicsByOwnerByRepo,
+			expectPath(t, "/repos/owner/repo/topics").andThen(
+				mockResponse(t, http.StatusOK, map[string]any{"names": []string{"go", "mcp"}}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := ListRepositoryTopics(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+
+	var returned RepositoryTopics
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, []string{"go", "mcp"}, returned.Topics)
+}
+
+func Test_ReplaceRepositoryTopics(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ReplaceRepositoryTopics(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "replace_repository_topics", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "topics"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	replaceTopics := func(expected []any, replaced []string) *http.Client {
+		return mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.PutReposTopicsByOwnerByRepo,
+				expectRequestBody(t, map[string]any{"names": expected}).andThen(
+					mockResponse(t, http.StatusOK, map[string]any{"names": replaced}),
+				),
+			),
+		)
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedTopics []string
+	}{
+		{
+			name:         "topics lowercased and deduplicated",
+			mockedClient: replaceTopics([]any{"go", "mcp", "github-api"}, []string{"go", "mcp", "github-api"}),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"topics": []any{"Go", "mcp", " github-api ", "go"},
+			},
+			expectedTopics: []string{"go", "mcp", "github-api"},
+		},
+		{
+			name:         "empty list removes all topics",
+			mockedClient: replaceTopics([]any{}, []string{}),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"topics": []any{},
+			},
+			expectedTopics: []string{},
+		},
+		{
+			name:         "invalid topic",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"topics": []any{"machine learning"},
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid topic "machine learning"`,
+		},
+		{
+			name:         "missing topics",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: topics",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ReplaceRepositoryTopics(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned RepositoryTopics
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expectedTopics, returned.Topics)
+		})
+	}
+}
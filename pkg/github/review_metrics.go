@@ -0,0 +1,264 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultReviewMetricsDays         = 30
+	defaultReviewMetricsPullRequests = 100
+	maxReviewMetricsPullRequests     = 300
+	reviewMetricsConcurrency         = 5
+)
+
+// ReviewMetrics summarizes how quickly pull requests opened in a time window were
+// reviewed and merged. Medians are nil when no pull request in the sample was reviewed or merged.
+type ReviewMetrics struct {
+	Since                        time.Time `json:"since"`
+	Until                        time.Time `json:"until"`
+	PullRequestsAnalyzed         int       `json:"pull_requests_analyzed"`
+	Truncated                    bool      `json:"truncated"`
+	Reviewed                     int       `json:"reviewed"`
+	Merged                       int       `json:"merged"`
+	MedianTimeToFirstReviewHours *float64  `json:"median_time_to_first_review_hours"`
+	MedianTimeToMergeHours       *float64  `json:"median_time_to_merge_hours"`
+	Notes                        []string  `json:"notes,omitempty"`
+}
+
+// firstReviewTime returns when the first review by someone other than the author was submitted.
+// Pending reviews have not been submitted and are skipped.
+func firstReviewTime(pr *github.PullRequest, reviews []*github.PullRequestReview) (time.Time, bool) {
+	var first time.Time
+	for _, review := range reviews {
+		if review.GetState() == "PENDING" || review.SubmittedAt == nil {
+			continue
+		}
+		if review.GetUser().GetLogin() == pr.GetUser().GetLogin() {
+			continue
+		}
+		if first.IsZero() || review.SubmittedAt.Before(first) {
+			first = review.SubmittedAt.Time
+		}
+	}
+	return first, !first.IsZero()
+}
+
+// medianHours returns the median of durations in hours, rounded to two decimals, or nil when
+// there are none.
+func medianHours(durations []time.Duration) *float64 {
+	if len(durations) == 0 {
+		return nil
+	}
+	slices.Sort(durations)
+	mid := len(durations) / 2
+	median := durations[mid]
+	if len(durations)%2 == 0 {
+		median = (durations[mid-1] + durations[mid]) / 2
+	}
+	hours := math.Round(median.Hours()*100) / 100
+	return &hours
+}
+
+// computeReviewMetrics works out the medians for prs. firstReviews holds the first review time of
+// each pull request number that has been reviewed.
+func computeReviewMetrics(prs []*github.PullRequest, firstReviews map[int]time.Time) (toFirstReview, toMerge *float64, reviewed, merged int) {
+	var reviewDurations, mergeDurations []time.Duration
+	for _, pr := range prs {
+		created := pr.GetCreatedAt().Time
+		if first, ok := firstReviews[pr.GetNumber()]; ok {
+			reviewDurations = append(reviewDurations, first.Sub(created))
+		}
+		if pr.MergedAt != nil {
+			mergeDurations = append(mergeDurations, pr.MergedAt.Sub(created))
+		}
+	}
+	return medianHours(reviewDurations), medianHours(mergeDurations), len(reviewDurations), len(mergeDurations)
+}
+
+// PullRequestReviewMetrics creates a tool to compute review and merge time metrics for a repository's pull requests.
+func PullRequestReviewMetrics(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("pull_request_review_metrics",
+			mcp.WithDescription(t("TOOL_PULL_REQUEST_REVIEW_METRICS_DESCRIPTION", fmt.Sprintf("Compute the median time to first review and the median time to merge for pull requests opened in a repository during a time window. Reviews by the pull request author are not counted. At most max_pull_requests pull requests (up to %d) are analyzed, newest first, and the result says when the window held more.", maxReviewMetricsPullRequests))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_PULL_REQUEST_REVIEW_METRICS_USER_TITLE", "Pull request review metrics"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("since",
+				mcp.Description(fmt.Sprintf("Start of the window, as an ISO 8601 timestamp (YYYY-MM-DDTHH:MM:SSZ or YYYY-MM-DD). Defaults to %d days before until", defaultReviewMetricsDays)),
+			),
+			mcp.WithString("until",
+				mcp.Description("End of the window, as an ISO 8601 timestamp (YYYY-MM-DDTHH:MM:SSZ or YYYY-MM-DD). Defaults to now"),
+			),
+			mcp.WithString("base",
+				mcp.Description("Only include pull requests targeting this branch"),
+			),
+			mcp.WithNumber("max_pull_requests",
+				mcp.Description(fmt.Sprintf("Maximum number of pull requests to analyze (default %d, max %d)", defaultReviewMetricsPullRequests, maxReviewMetricsPullRequests)),
+				mcp.Min(1),
+				mcp.Max(maxReviewMetricsPullRequests),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sinceStr, err := OptionalParam[string](request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			untilStr, err := OptionalParam[string](request, "until")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := OptionalParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxPRs, err := OptionalIntParamWithDefault(request, "max_pull_requests", defaultReviewMetricsPullRequests)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxPRs < 1 || maxPRs > maxReviewMetricsPullRequests {
+				return mcp.NewToolResultError(fmt.Sprintf("max_pull_requests must be between 1 and %d", maxReviewMetricsPullRequests)), nil
+			}
+
+			until := time.Now().UTC()
+			if untilStr != "" {
+				if until, err = parseISOTimestamp(untilStr); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid until: %v", err)), nil
+				}
+			}
+			since := until.AddDate(0, 0, -defaultReviewMetricsDays)
+			if sinceStr != "" {
+				if since, err = parseISOTimestamp(sinceStr); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid since: %v", err)), nil
+				}
+			}
+			if !since.Before(until) {
+				return mcp.NewToolResultError("since must be before until"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Pull requests are listed newest first, so listing stops at the first one opened
+			// before the window.
+			var prs []*github.PullRequest
+			truncated := false
+			opts := &github.PullRequestListOptions{
+				State:       "all",
+				Base:        base,
+				Sort:        "created",
+				Direction:   "desc",
+				ListOptions: github.ListOptions{PerPage: 100},
+			}
+		pages:
+			for {
+				page, resp, err := client.PullRequests.List(ctx, owner, repo, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list pull requests", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				for _, pr := range page {
+					created := pr.GetCreatedAt().Time
+					if created.After(until) {
+						continue
+					}
+					if created.Before(since) {
+						break pages
+					}
+					if len(prs) == maxPRs {
+						truncated = true
+						break pages
+					}
+					prs = append(prs, pr)
+				}
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			reviews := make([][]*github.PullRequestReview, len(prs))
+			errs := make([]error, len(prs))
+			sem := make(chan struct{}, reviewMetricsConcurrency)
+			var wg sync.WaitGroup
+			for i, pr := range prs {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					select {
+					case sem <- struct{}{}:
+						defer func() { <-sem }()
+					case <-ctx.Done():
+						errs[i] = ctx.Err()
+						return
+					}
+
+					// The first page is enough: reviews are returned oldest first.
+					page, resp, err := client.PullRequests.ListReviews(ctx, owner, repo, pr.GetNumber(), &github.ListOptions{PerPage: 100})
+					if err != nil {
+						errs[i] = err
+						return
+					}
+					_ = resp.Body.Close()
+					reviews[i] = page
+				}()
+			}
+			wg.Wait()
+
+			firstReviews := map[int]time.Time{}
+			failed := 0
+			for i, pr := range prs {
+				if errs[i] != nil {
+					failed++
+					continue
+				}
+				if first, ok := firstReviewTime(pr, reviews[i]); ok {
+					firstReviews[pr.GetNumber()] = first
+				}
+			}
+
+			metrics := ReviewMetrics{
+				Since:                since,
+				Until:                until,
+				PullRequestsAnalyzed: len(prs),
+				Truncated:            truncated,
+			}
+			metrics.MedianTimeToFirstReviewHours, metrics.MedianTimeToMergeHours, metrics.Reviewed, metrics.Merged = computeReviewMetrics(prs, firstReviews)
+			if truncated {
+				metrics.Notes = append(metrics.Notes, fmt.Sprintf("the window holds more than %d pull requests; only the newest %d were analyzed, so narrow the window or raise max_pull_requests for a full picture", maxPRs, maxPRs))
+			}
+			if failed > 0 {
+				metrics.Notes = append(metrics.Notes, fmt.Sprintf("reviews could not be fetched for %d pull requests, which are left out of the time to first review", failed))
+			}
+
+			return MarshalledTextResult(metrics), nil
+		}
+}
@@ -0,0 +1,169 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_medianHours(t *testing.T) {
+	assert.Nil(t, medianHours(nil))
+	assert.Equal(t, 3.0, *medianHours([]time.Duration{5 * time.Hour, time.Hour, 3 * time.Hour}))
+	assert.Equal(t, 2.5, *medianHours([]time.Duration{4 * time.Hour, time.Hour, 2 * time.Hour, 3 * time.Hour}))
+	assert.Equal(t, 0.33, *medianHours([]time.Duration{20 * time.Minute}))
+}
+
+func Test_PullRequestReviewMetrics(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := PullRequestReviewMetrics(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "pull_request_review_metrics", tool.Name)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.Contains(t, tool.InputSchema.Properties, "until")
+	assert.Contains(t, tool.InputSchema.Properties, "max_pull_requests")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	at := func(month time.Month, day, hour int) *github.Timestamp {
+		return &github.Timestamp{Time: time.Date(2024, month, day, hour, 0, 0, 0, time.UTC)}
+	}
+	user := func(login string) *github.User {
+		return &github.User{Login: github.Ptr(login)}
+	}
+
+	// Newest first, as listed with sort=created and direction=desc.
+	prs := []*github.PullRequest{
+		// Opened after the window.
+		{Number: github.Ptr(5), User: user("alice"), CreatedAt: at(time.April, 2, 0)},
+		// Reviewed after 2h, merged after 24h.
+		{Number: github.Ptr(4), User: user("alice"), CreatedAt: at(time.March, 20, 0), MergedAt: at(time.March, 21, 0)},
+		// Reviewed after 6h once the author's own review is skipped, merged after 12h.
+		{Number: github.Ptr(3), User: user("alice"), CreatedAt: at(time.March, 10, 0), MergedAt: at(time.March, 10, 12)},
+		// Only a pending review, never merged.
+		{Number: github.Ptr(2), User: user("bob"), CreatedAt: at(time.March, 5, 0)},
+		// Opened before the window.
+		{Number: github.Ptr(1), User: user("bob"), CreatedAt: at(time.February, 20, 0)},
+	}
+	reviews := map[string][]*github.PullRequestReview{
+		"4": {{User: user("bob"), State: github.Ptr("APPROVED"), SubmittedAt: at(time.March, 20, 2)}},
+		"3": {
+			{User: user("alice"), State: github.Ptr("COMMENTED"), SubmittedAt: at(time.March, 10, 1)},
+			{User: user("carol"), State: github.Ptr("CHANGES_REQUESTED"), SubmittedAt: at(time.March, 10, 6)},
+			{User: user("bob"), State: github.Ptr("APPROVED"), SubmittedAt: at(time.March, 10, 8)},
+		},
+		"2": {{User: user("alice"), State: github.Ptr("PENDING")}},
+	}
+	reviewsHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The path is /repos/owner/repo/pulls/{number}/reviews.
+		parts := strings.Split(r.URL.Path, "/")
+		number := parts[len(parts)-2]
+		if number == "5" || number == "1" {
+			t.Errorf("reviews fetched for pull request %s outside the window", number)
+		}
+		mockResponse(t, http.StatusOK, reviews[number])(w, r)
+	})
+	newClient := func() *github.Client {
+		return github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposPullsByOwnerByRepo,
+				expectQueryParams(t, map[string]string{
+					"state":     "all",
+					"sort":      "created",
+					"direction": "desc",
+					"per_page":  "100",
+				}).andThen(
+					mockResponse(t, http.StatusOK, prs),
+				),
+			),
+			mock.WithRequestMatchHandler(mock.GetReposPullsReviewsByOwnerByRepoByPullNumber, reviewsHandler),
+		))
+	}
+	window := map[string]any{
+		"owner": "owner",
+		"repo":  "repo",
+		"since": "2024-03-01",
+		"until": "2024-03-31",
+	}
+
+	t.Run("medians over the window", func(t *testing.T) {
+		_, handler := PullRequestReviewMetrics(stubGetClientFn(newClient()), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(window))
+		require.NoError(t, err)
+
+		var metrics ReviewMetrics
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &metrics))
+		assert.Equal(t, 3, metrics.PullRequestsAnalyzed)
+		assert.False(t, metrics.Truncated)
+		assert.Equal(t, 2, metrics.Reviewed)
+		assert.Equal(t, 2, metrics.Merged)
+		require.NotNil(t, metrics.MedianTimeToFirstReviewHours)
+		assert.Equal(t, 4.0, *metrics.MedianTimeToFirstReviewHours)
+		require.NotNil(t, metrics.MedianTimeToMergeHours)
+		assert.Equal(t, 18.0, *metrics.MedianTimeToMergeHours)
+		assert.Empty(t, metrics.Notes)
+	})
+
+	t.Run("cap on analyzed pull requests is noted", func(t *testing.T) {
+		_, handler := PullRequestReviewMetrics(stubGetClientFn(newClient()), translations.NullTranslationHelper)
+
+		args := map[string]any{"max_pull_requests": float64(1)}
+		for k, v := range window {
+			args[k] = v
+		}
+		result, err := handler(context.Background(), createMCPRequest(args))
+		require.NoError(t, err)
+
+		var metrics ReviewMetrics
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &metrics))
+		assert.Equal(t, 1, metrics.PullRequestsAnalyzed)
+		assert.True(t, metrics.Truncated)
+		assert.Equal(t, 2.0, *metrics.MedianTimeToFirstReviewHours)
+		assert.Equal(t, 24.0, *metrics.MedianTimeToMergeHours)
+		require.Len(t, metrics.Notes, 1)
+		assert.Contains(t, metrics.Notes[0], "only the newest 1 were analyzed")
+	})
+
+	t.Run("no reviewed or merged pull requests", func(t *testing.T) {
+		_, handler := PullRequestReviewMetrics(stubGetClientFn(newClient()), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner": "owner",
+			"repo":  "repo",
+			"since": "2024-03-01",
+			"until": "2024-03-09",
+		}))
+		require.NoError(t, err)
+
+		var metrics ReviewMetrics
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &metrics))
+		assert.Equal(t, 1, metrics.PullRequestsAnalyzed)
+		assert.Nil(t, metrics.MedianTimeToFirstReviewHours)
+		assert.Nil(t, metrics.MedianTimeToMergeHours)
+	})
+
+	t.Run("since after until", func(t *testing.T) {
+		_, handler := PullRequestReviewMetrics(stubGetClientFn(github.NewClient(mock.NewMockedHTTPClient())), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner": "owner",
+			"repo":  "repo",
+			"since": "2024-03-31",
+			"until": "2024-03-01",
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "since must be before until", getErrorResult(t, result).Text)
+	})
+}
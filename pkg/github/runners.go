@@ -0,0 +1,333 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	RunnerStatusOnline  = "online"
+	RunnerStatusOffline = "offline"
+	RunnerStatusUnknown = "unknown"
+
+	descriptionRunnerOwner = "Organization name, or the repository owner when repo is provided"
+	descriptionRunnerRepo  = "Repository name. Omit to use the organization's runners instead of the repository's"
+)
+
+// MinimalRunner is the trimmed down view of a self-hosted runner returned by the runner tools.
+type MinimalRunner struct {
+	ID     int64    `json:"id"`
+	Name   string   `json:"name"`
+	OS     string   `json:"os"`
+	Status string   `json:"status"`
+	Busy   bool     `json:"busy"`
+	Labels []string `json:"labels,omitempty"`
+}
+
+// MinimalRunnerGroup is the trimmed down view of a self-hosted runner group.
+type MinimalRunnerGroup struct {
+	ID                       int64  `json:"id"`
+	Name                     string `json:"name"`
+	Visibility               string `json:"visibility"`
+	Default                  bool   `json:"default"`
+	AllowsPublicRepositories bool   `json:"allows_public_repositories"`
+}
+
+// parseRunnerStatus normalizes the status reported by the API, which should be "online" or
+// "offline", so agents can rely on the value.
+func parseRunnerStatus(status string) string {
+	switch strings.ToLower(strings.TrimSpace(status)) {
+	case RunnerStatusOnline:
+		return RunnerStatusOnline
+	case RunnerStatusOffline:
+		return RunnerStatusOffline
+	default:
+		return RunnerStatusUnknown
+	}
+}
+
+func convertToMinimalRunner(runner *github.Runner) MinimalRunner {
+	m := MinimalRunner{
+		ID:     runner.GetID(),
+		Name:   runner.GetName(),
+		OS:     runner.GetOS(),
+		Status: parseRunnerStatus(runner.GetStatus()),
+		Busy:   runner.GetBusy(),
+	}
+	for _, label := range runner.Labels {
+		m.Labels = append(m.Labels, label.GetName())
+	}
+	return m
+}
+
+// runnerScope reads the owner and optional repo parameters shared by the runner tools. An empty
+// repo means the runners belong to the organization named by owner.
+func runnerScope(request mcp.CallToolRequest) (owner, repo string, err error) {
+	owner, err = RequiredParam[string](request, "owner")
+	if err != nil {
+		return "", "", err
+	}
+	repo, err = OptionalParam[string](request, "repo")
+	if err != nil {
+		return "", "", err
+	}
+	return owner, repo, nil
+}
+
+// ListSelfHostedRunners creates a tool to list the self-hosted runners of a repository or organization.
+func ListSelfHostedRunners(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_self_hosted_runners",
+			mcp.WithDescription(t("TOOL_LIST_SELF_HOSTED_RUNNERS_DESCRIPTION", "List the self-hosted GitHub Actions runners of a repository, or of an organization when no repository is given, with their OS, online/offline status and labels")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_SELF_HOSTED_RUNNERS_USER_TITLE", "List self-hosted runners"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(descriptionRunnerOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Description(descriptionRunnerRepo),
+			),
+			mcp.WithString("name",
+				mcp.Description("Only return runners with this name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, err := runnerScope(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := OptionalParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.ListRunnersOptions{
+				ListOptions: github.ListOptions{
+					PerPage: pagination.PerPage,
+					Page:    pagination.Page,
+				},
+			}
+			if name != "" {
+				opts.Name = github.Ptr(name)
+			}
+
+			var runners *github.Runners
+			var resp *github.Response
+			if repo == "" {
+				runners, resp, err = client.Actions.ListOrganizationRunners(ctx, owner, opts)
+			} else {
+				runners, resp, err = client.Actions.ListRunners(ctx, owner, repo, opts)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list self-hosted runners", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]MinimalRunner, 0, len(runners.Runners))
+			for _, runner := range runners.Runners {
+				result = append(result, convertToMinimalRunner(runner))
+			}
+
+			return MarshalledListResult(ctx, result, "No self-hosted runners found"), nil
+		}
+}
+
+// GetRunner creates a tool to get a single self-hosted runner of a repository or organization.
+func GetRunner(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_runner",
+			mcp.WithDescription(t("TOOL_GET_RUNNER_DESCRIPTION", "Get a self-hosted GitHub Actions runner of a repository, or of an organization when no repository is given")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_RUNNER_USER_TITLE", "Get self-hosted runner"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(descriptionRunnerOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Description(descriptionRunnerRepo),
+			),
+			mcp.WithNumber("runner_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the runner"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, err := runnerScope(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runnerID, err := RequiredInt(request, "runner_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			runner, resp, err := getRunner(ctx, client, owner, repo, int64(runnerID))
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get runner", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(convertToMinimalRunner(runner)), nil
+		}
+}
+
+func getRunner(ctx context.Context, client *github.Client, owner, repo string, runnerID int64) (*github.Runner, *github.Response, error) {
+	if repo == "" {
+		return client.Actions.GetOrganizationRunner(ctx, owner, runnerID)
+	}
+	return client.Actions.GetRunner(ctx, owner, repo, runnerID)
+}
+
+// RemoveRunner creates a tool to deregister an offline self-hosted runner.
+func RemoveRunner(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_runner",
+			mcp.WithDescription(t("TOOL_REMOVE_RUNNER_DESCRIPTION", "Deregister an offline self-hosted GitHub Actions runner from a repository, or from an organization when no repository is given. Online runners are never removed.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_REMOVE_RUNNER_USER_TITLE", "Remove self-hosted runner"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(descriptionRunnerOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Description(descriptionRunnerRepo),
+			),
+			mcp.WithNumber("runner_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the runner"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, err := runnerScope(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runnerIDInt, err := RequiredInt(request, "runner_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runnerID := int64(runnerIDInt)
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			runner, resp, err := getRunner(ctx, client, owner, repo, runnerID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get runner", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			// Removing an online runner would kill whatever job it is running, so only clean up offline ones.
+			if status := parseRunnerStatus(runner.GetStatus()); status != RunnerStatusOffline {
+				return mcp.NewToolResultError(fmt.Sprintf("runner %s (%d) is %s, only offline runners can be removed", runner.GetName(), runnerID, status)), nil
+			}
+
+			if repo == "" {
+				resp, err = client.Actions.RemoveOrganizationRunner(ctx, owner, runnerID)
+			} else {
+				resp, err = client.Actions.RemoveRunner(ctx, owner, repo, runnerID)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to remove runner", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(map[string]any{
+				"message":   "Runner has been removed",
+				"runner_id": runnerID,
+				"name":      runner.GetName(),
+			}), nil
+		}
+}
+
+// ListRunnerGroups creates a tool to list the self-hosted runner groups of an organization.
+func ListRunnerGroups(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_runner_groups",
+			mcp.WithDescription(t("TOOL_LIST_RUNNER_GROUPS_DESCRIPTION", "List the self-hosted runner groups of an organization")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_RUNNER_GROUPS_USER_TITLE", "List runner groups"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("visible_to_repository",
+				mcp.Description("Only return runner groups that this repository (name only) is allowed to use"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			visibleTo, err := OptionalParam[string](request, "visible_to_repository")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			groups, resp, err := client.Actions.ListOrganizationRunnerGroups(ctx, org, &github.ListOrgRunnerGroupOptions{
+				ListOptions: github.ListOptions{
+					PerPage: pagination.PerPage,
+					Page:    pagination.Page,
+				},
+				VisibleToRepository: visibleTo,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list runner groups", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]MinimalRunnerGroup, 0, len(groups.RunnerGroups))
+			for _, group := range groups.RunnerGroups {
+				result = append(result, MinimalRunnerGroup{
+					ID:                       group.GetID(),
+					Name:                     group.GetName(),
+					Visibility:               group.GetVisibility(),
+					Default:                  group.GetDefault(),
+					AllowsPublicRepositories: group.GetAllowsPublicRepositories(),
+				})
+			}
+
+			return MarshalledListResult(ctx, result, "No runner groups found"), nil
+		}
+}
@@ -0,0 +1,339 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListSelfHostedRunners(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListSelfHostedRunners(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_self_hosted_runners", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "name")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner"})
+
+	mockRunners := &github.Runners{
+		TotalCount: 2,
+		Runners: []*github.Runner{
+			{
+				ID:     github.Ptr(int64(1)),
+				Name:   github.Ptr("linux-builder"),
+				OS:     github.Ptr("Linux"),
+				Status: github.Ptr("online"),
+				Busy:   github.Ptr(true),
+				Labels: []*github.RunnerLabels{
+					{Name: github.Ptr("self-hosted"), Type: github.Ptr("read-only")},
+					{Name: github.Ptr("gpu"), Type: github.Ptr("custom")},
+				},
+			},
+			{
+				ID:     github.Ptr(int64(2)),
+				Name:   github.Ptr("mac-builder"),
+				OS:     github.Ptr("macOS"),
+				Status: github.Ptr("Offline"),
+			},
+		},
+	}
+	expectedRunners := []MinimalRunner{
+		{ID: 1, Name: "linux-builder", OS: "Linux", Status: "online", Busy: true, Labels: []string{"self-hosted", "gpu"}},
+		{ID: 2, Name: "mac-builder", OS: "macOS", Status: "offline"},
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedErrMsg  string
+		expectedRunners []MinimalRunner
+	}{
+		{
+			name: "repository runners",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunnersByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"name":     "linux-builder",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockRunners),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"name":  "linux-builder",
+			},
+			expectedRunners: expectedRunners,
+		},
+		{
+			name: "organization runners when repo is omitted",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsActionsRunnersByOrg,
+					expectPath(t, "/orgs/octo-org/actions/runners").andThen(
+						mockResponse(t, http.StatusOK, mockRunners),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "octo-org",
+			},
+			expectedRunners: expectedRunners,
+		},
+		{
+			name: "list fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsActionsRunnersByOrg,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must have admin rights"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "octo-org",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list self-hosted runners",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListSelfHostedRunners(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+
+			var returned []MinimalRunner
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRunners, returned)
+		})
+	}
+}
+
+func Test_GetRunner(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRunner(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_runner", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "runner_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "runner_id"})
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetOrgsActionsRunnersByOrgByRunnerId,
+			expectPath(t, "/orgs/octo-org/actions/runners/7").andThen(
+				mockResponse(t, http.StatusOK, &github.Runner{
+					ID:     github.Ptr(int64(7)),
+					Name:   github.Ptr("windows-builder"),
+					OS:     github.Ptr("Windows"),
+					Status: github.Ptr("rebooting"),
+				}),
+			),
+		),
+	)
+
+	client := github.NewClient(mockedClient)
+	_, handler := GetRunner(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":     "octo-org",
+		"runner_id": float64(7),
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+
+	var returned MinimalRunner
+	err = json.Unmarshal([]byte(textContent.Text), &returned)
+	require.NoError(t, err)
+	assert.Equal(t, MinimalRunner{ID: 7, Name: "windows-builder", OS: "Windows", Status: RunnerStatusUnknown}, returned)
+}
+
+func Test_RemoveRunner(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RemoveRunner(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "remove_runner", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "runner_id"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "offline repository runner is removed",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunnersByOwnerByRepoByRunnerId,
+					&github.Runner{ID: github.Ptr(int64(3)), Name: github.Ptr("stale"), Status: github.Ptr("offline")},
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposActionsRunnersByOwnerByRepoByRunnerId,
+					mockResponse(t, http.StatusNoContent, ""),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"runner_id": float64(3),
+			},
+		},
+		{
+			name: "online organization runner is refused",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetOrgsActionsRunnersByOrgByRunnerId,
+					&github.Runner{ID: github.Ptr(int64(3)), Name: github.Ptr("busy"), Status: github.Ptr("online")},
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteOrgsActionsRunnersByOrgByRunnerId,
+					http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+						t.Error("online runner must not be removed")
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "octo-org",
+				"runner_id": float64(3),
+			},
+			expectError:    true,
+			expectedErrMsg: "runner busy (3) is online, only offline runners can be removed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RemoveRunner(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+
+			var returned map[string]any
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, "Runner has been removed", returned["message"])
+			assert.Equal(t, float64(3), returned["runner_id"])
+		})
+	}
+}
+
+func Test_ListRunnerGroups(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRunnerGroups(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_runner_groups", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "visible_to_repository")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetOrgsActionsRunnerGroupsByOrg,
+			expectQueryParams(t, map[string]string{
+				"visible_to_repository": "repo",
+				"page":                  "1",
+				"per_page":              "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, &github.RunnerGroups{
+					TotalCount: 1,
+					RunnerGroups: []*github.RunnerGroup{
+						{ID: github.Ptr(int64(1)), Name: github.Ptr("Default"), Visibility: github.Ptr("all"), Default: github.Ptr(true)},
+					},
+				}),
+			),
+		),
+	)
+
+	client := github.NewClient(mockedClient)
+	_, handler := ListRunnerGroups(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"org":                   "octo-org",
+		"visible_to_repository": "repo",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+
+	var returned []MinimalRunnerGroup
+	err = json.Unmarshal([]byte(textContent.Text), &returned)
+	require.NoError(t, err)
+	assert.Equal(t, []MinimalRunnerGroup{{ID: 1, Name: "Default", Visibility: "all", Default: true}}, returned)
+}
+
+func Test_parseRunnerStatus(t *testing.T) {
+	tests := map[string]string{
+		"online":   RunnerStatusOnline,
+		"Online":   RunnerStatusOnline,
+		"offline":  RunnerStatusOffline,
+		" OFFLINE": RunnerStatusOffline,
+		"":         RunnerStatusUnknown,
+		"idle":     RunnerStatusUnknown,
+	}
+	for input, expected := range tests {
+		assert.Equal(t, expected, parseRunnerStatus(input), "status %q", input)
+	}
+}
@@ -65,6 +65,18 @@ func searchHandler(
 		query = fmt.Sprintf("repo:%s/%s %s", owner, repo, query)
 	}
 
+	milestone, err := OptionalParam[string](request, "milestone")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if milestone != "" && !hasFilter(query, "milestone") {
+		if milestone == "none" {
+			query = fmt.Sprintf("%s no:milestone", query)
+		} else {
+			query = fmt.Sprintf("%s milestone:%q", query, milestone)
+		}
+	}
+
 	sort, err := OptionalParam[string](request, "sort")
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/github/github-mcp-server/internal/toolsnaps"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v74/github"
 	"github.com/migueleliasweb/go-github-mock/src/mock"
@@ -247,3 +248,148 @@ func Test_ListSecretScanningAlerts(t *testing.T) {
 		})
 	}
 }
+
+func Test_ListSecretScanningAlertLocations(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListSecretScanningAlertLocations(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_secret_scanning_alert_locations", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "alertNumber"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposSecretScanningAlertsLocationsByOwnerByRepoByAlertNumber,
+			expect(t, expectations{
+				path:        "/repos/owner/repo/secret-scanning/alerts/42/locations",
+				queryParams: map[string]string{"page": "1", "per_page": "30"},
+			}).andThen(
+				mockResponse(t, http.StatusOK, []*github.SecretScanningAlertLocation{
+					{
+						Type: github.Ptr("commit"),
+						Details: &github.SecretScanningAlertLocationDetails{
+							Path:      github.Ptr("config/settings.yml"),
+							Startline: github.Ptr(12),
+							EndLine:   github.Ptr(12),
+							CommitSHA: github.Ptr("f14d7debf9775f957cf4f1e8176da0786431f72b"),
+						},
+					},
+				}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := ListSecretScanningAlertLocations(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":       "owner",
+		"repo":        "repo",
+		"alertNumber": float64(42),
+	}))
+	require.NoError(t, err)
+
+	var returnedLocations []*github.SecretScanningAlertLocation
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returnedLocations))
+	require.Len(t, returnedLocations, 1)
+	assert.Equal(t, "commit", returnedLocations[0].GetType())
+	assert.Equal(t, "config/settings.yml", returnedLocations[0].GetDetails().GetPath())
+	assert.Equal(t, 12, returnedLocations[0].GetDetails().GetStartline())
+}
+
+func Test_UpdateSecretScanningAlert(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateSecretScanningAlert(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_secret_scanning_alert", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "alertNumber", "state"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedState  string
+	}{
+		{
+			name: "resolve as revoked",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposSecretScanningAlertsByOwnerByRepoByAlertNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"state":              "resolved",
+						"resolution":         "revoked",
+						"resolution_comment": "Key rotated",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.SecretScanningAlert{
+							Number:     github.Ptr(42),
+							State:      github.Ptr("resolved"),
+							Resolution: github.Ptr("revoked"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"state":              "resolved",
+				"resolution":         "revoked",
+				"resolution_comment": "Key rotated",
+			},
+			expectedState: "resolved",
+		},
+		{
+			name: "reopen",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposSecretScanningAlertsByOwnerByRepoByAlertNumber,
+					expectRequestBody(t, map[string]interface{}{"state": "open"}).andThen(
+						mockResponse(t, http.StatusOK, &github.SecretScanningAlert{
+							Number: github.Ptr(42),
+							State:  github.Ptr("open"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"state": "open",
+			},
+			expectedState: "open",
+		},
+		{
+			name:         "resolve without a resolution",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"state": "resolved",
+			},
+			expectError:    true,
+			expectedErrMsg: "resolution is required when state is resolved",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateSecretScanningAlert(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			args := map[string]interface{}{"owner": "owner", "repo": "repo", "alertNumber": float64(42)}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returnedAlert github.SecretScanningAlert
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returnedAlert))
+			assert.Equal(t, tc.expectedState, returnedAlert.GetState())
+		})
+	}
+}
@@ -173,12 +173,7 @@ func ListGlobalSecurityAdvisories(getClient GetClientFn, t translations.Translat
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list advisories: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(advisories)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal advisories: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledListResult(ctx, advisories, "no global security advisories matched"), nil
 		}
 }
 
@@ -250,6 +245,9 @@ func ListRepositorySecurityAdvisories(getClient GetClientFn, t translations.Tran
 
 			advisories, resp, err := client.SecurityAdvisories.ListRepositorySecurityAdvisories(ctx, owner, repo, opts)
 			if err != nil {
+				if msg, ok := repositoryAdvisoryAccessError(owner, repo, resp); ok {
+					return mcp.NewToolResultError(msg), nil
+				}
 				return nil, fmt.Errorf("failed to list repository security advisories: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
@@ -262,15 +260,291 @@ func ListRepositorySecurityAdvisories(getClient GetClientFn, t translations.Tran
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list repository advisories: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(advisories)
+			return MarshalledListResult(ctx, advisories, "no repository security advisories matched"), nil
+		}
+}
+
+// repositoryAdvisoryAccessError explains a 403 or 404 from the repository security advisories API,
+// which usually means the token can't see the repository's advisories rather than that there are none.
+func repositoryAdvisoryAccessError(owner, repo string, resp *github.Response) (string, bool) {
+	if resp == nil || (resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusNotFound) {
+		return "", false
+	}
+	return fmt.Sprintf("cannot access security advisories for %s/%s: the token needs the repo scope or the repository_advisories permission, "+
+		"and only repository admins and security managers can see unpublished advisories", owner, repo), true
+}
+
+func GetRepositorySecurityAdvisory(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_security_advisory",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_SECURITY_ADVISORY_DESCRIPTION", "Get a repository security advisory, including its state, severity, CVE ID and affected packages.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_SECURITY_ADVISORY_USER_TITLE", "Get a repository security advisory"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("The owner of the repository."),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository."),
+			),
+			mcp.WithString("ghsaId",
+				mcp.Required(),
+				mcp.Description("GitHub Security Advisory ID (format: GHSA-xxxx-xxxx-xxxx)."),
+			),
+		), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ghsaID, err := RequiredParam[string](request, "ghsaId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// go-github has no wrapper for this endpoint yet.
+			req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("repos/%s/%s/security-advisories/%s", owner, repo, ghsaID), nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+
+			var advisory github.SecurityAdvisory
+			resp, err := client.Do(ctx, req, &advisory)
+			if err != nil {
+				if msg, ok := repositoryAdvisoryAccessError(owner, repo, resp); ok {
+					return mcp.NewToolResultError(fmt.Sprintf("advisory %s not found or %s", ghsaID, msg)), nil
+				}
+				return nil, fmt.Errorf("failed to get repository security advisory: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(advisory)
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal advisories: %w", err)
+				return nil, fmt.Errorf("failed to marshal advisory: %w", err)
 			}
 
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
 
+// repositoryAdvisoryRequest is the body of a create repository security advisory request.
+type repositoryAdvisoryRequest struct {
+	Summary         string                            `json:"summary"`
+	Description     string                            `json:"description"`
+	Severity        string                            `json:"severity,omitempty"`
+	CVEID           string                            `json:"cve_id,omitempty"`
+	CWEIDs          []string                          `json:"cwe_ids,omitempty"`
+	Vulnerabilities []repositoryAdvisoryVulnerability `json:"vulnerabilities"`
+}
+
+type repositoryAdvisoryVulnerability struct {
+	Package                github.VulnerabilityPackage `json:"package"`
+	VulnerableVersionRange string                      `json:"vulnerable_version_range,omitempty"`
+	PatchedVersions        string                      `json:"patched_versions,omitempty"`
+}
+
+func CreateRepositorySecurityAdvisory(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_repository_security_advisory",
+			mcp.WithDescription(t("TOOL_CREATE_REPOSITORY_SECURITY_ADVISORY_DESCRIPTION", "Draft a new security advisory for a repository. The advisory stays private until a maintainer publishes it.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_REPOSITORY_SECURITY_ADVISORY_USER_TITLE", "Draft a repository security advisory"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("The owner of the repository."),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository."),
+			),
+			mcp.WithString("summary",
+				mcp.Required(),
+				mcp.Description("A short summary of the advisory."),
+			),
+			mcp.WithString("description",
+				mcp.Required(),
+				mcp.Description("A detailed description of what the advisory impacts."),
+			),
+			mcp.WithString("severity",
+				mcp.Description("The severity of the advisory."),
+				mcp.Enum("critical", "high", "medium", "low"),
+			),
+			mcp.WithString("cveId",
+				mcp.Description("The CVE ID, if one has already been assigned."),
+			),
+			mcp.WithArray("cweIds",
+				mcp.Description("CWE IDs, e.g. CWE-79."),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+			mcp.WithString("ecosystem",
+				mcp.Required(),
+				mcp.Description("The package ecosystem of the affected package."),
+				mcp.Enum("rubygems", "npm", "pip", "maven", "nuget", "composer", "go", "rust", "erlang", "actions", "pub", "other", "swift"),
+			),
+			mcp.WithString("packageName",
+				mcp.Required(),
+				mcp.Description("The name of the affected package."),
+			),
+			mcp.WithString("vulnerableVersionRange",
+				mcp.Description("The range of affected versions, e.g. '< 1.2.3'."),
+			),
+			mcp.WithString("patchedVersions",
+				mcp.Description("The versions that fix the vulnerability, e.g. '1.2.3'."),
+			),
+		), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			summary, err := RequiredParam[string](request, "summary")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			description, err := RequiredParam[string](request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			severity, err := OptionalParam[string](request, "severity")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			cveID, err := OptionalParam[string](request, "cveId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			cweIDs, err := OptionalStringArrayParam(request, "cweIds")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ecosystem, err := RequiredParam[string](request, "ecosystem")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			packageName, err := RequiredParam[string](request, "packageName")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			vulnerableRange, err := OptionalParam[string](request, "vulnerableVersionRange")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			patchedVersions, err := OptionalParam[string](request, "patchedVersions")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			body := repositoryAdvisoryRequest{
+				Summary:     summary,
+				Description: description,
+				Severity:    severity,
+				CVEID:       cveID,
+				CWEIDs:      cweIDs,
+				Vulnerabilities: []repositoryAdvisoryVulnerability{{
+					Package: github.VulnerabilityPackage{
+						Ecosystem: github.Ptr(ecosystem),
+						Name:      github.Ptr(packageName),
+					},
+					VulnerableVersionRange: vulnerableRange,
+					PatchedVersions:        patchedVersions,
+				}},
+			}
+
+			// go-github has no wrapper for this endpoint yet.
+			req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("repos/%s/%s/security-advisories", owner, repo), body)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+
+			var advisory github.SecurityAdvisory
+			resp, err := client.Do(ctx, req, &advisory)
+			if err != nil {
+				if msg, ok := repositoryAdvisoryAccessError(owner, repo, resp); ok {
+					return mcp.NewToolResultError(msg), nil
+				}
+				return nil, fmt.Errorf("failed to create repository security advisory: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(advisory)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal advisory: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+func RequestRepositorySecurityAdvisoryCVE(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("request_repository_security_advisory_cve",
+			mcp.WithDescription(t("TOOL_REQUEST_REPOSITORY_SECURITY_ADVISORY_CVE_DESCRIPTION", "Ask GitHub, as a CVE Numbering Authority, to assign a CVE ID to a draft repository security advisory. GitHub reviews the request and the ID appears on the advisory once assigned. Do not use this when the advisory already has a CVE ID.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_REQUEST_REPOSITORY_SECURITY_ADVISORY_CVE_USER_TITLE", "Request a CVE for a repository security advisory"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("The owner of the repository."),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository."),
+			),
+			mcp.WithString("ghsaId",
+				mcp.Required(),
+				mcp.Description("GitHub Security Advisory ID (format: GHSA-xxxx-xxxx-xxxx)."),
+			),
+		), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ghsaID, err := RequiredParam[string](request, "ghsaId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.SecurityAdvisories.RequestCVE(ctx, owner, repo, ghsaID)
+			if err != nil {
+				if msg, ok := repositoryAdvisoryAccessError(owner, repo, resp); ok {
+					return mcp.NewToolResultError(fmt.Sprintf("advisory %s not found or %s", ghsaID, msg)), nil
+				}
+				return nil, fmt.Errorf("failed to request CVE: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("CVE requested for advisory %s in %s/%s", ghsaID, owner, repo)), nil
+		}
+}
+
 func GetGlobalSecurityAdvisory(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_global_security_advisory",
 			mcp.WithDescription(t("TOOL_GET_GLOBAL_SECURITY_ADVISORY_DESCRIPTION", "Get a global security advisory")),
@@ -387,11 +661,6 @@ func ListOrgRepositorySecurityAdvisories(getClient GetClientFn, t translations.T
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list organization repository advisories: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(advisories)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal advisories: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledListResult(ctx, advisories, "no repository security advisories matched in the organization"), nil
 		}
 }
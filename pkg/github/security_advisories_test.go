@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/github/github-mcp-server/internal/toolsnaps"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v74/github"
 	"github.com/migueleliasweb/go-github-mock/src/mock"
@@ -283,6 +284,7 @@ func Test_ListRepositorySecurityAdvisories(t *testing.T) {
 		expectError        bool
 		expectedAdvisories []*github.SecurityAdvisory
 		expectedErrMsg     string
+		expectedToolErrMsg string
 	}{
 		{
 			name: "successful advisories listing (no filters)",
@@ -351,6 +353,20 @@ func Test_ListRepositorySecurityAdvisories(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to list repository security advisories",
 		},
+		{
+			name: "token lacks security advisory access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					GetReposSecurityAdvisoriesByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by personal access token"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedToolErrMsg: "cannot access security advisories for owner/repo",
+		},
 	}
 
 	for _, tc := range tests {
@@ -370,6 +386,12 @@ func Test_ListRepositorySecurityAdvisories(t *testing.T) {
 
 			require.NoError(t, err)
 
+			if tc.expectedToolErrMsg != "" {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedToolErrMsg)
+				return
+			}
+
 			textContent := getTextResult(t, result)
 
 			var returnedAdvisories []*github.SecurityAdvisory
@@ -524,3 +546,226 @@ func Test_ListOrgRepositorySecurityAdvisories(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetRepositorySecurityAdvisory(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositorySecurityAdvisory(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_repository_security_advisory", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "ghsaId")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ghsaId"})
+
+	mockAdvisory := &github.SecurityAdvisory{
+		GHSAID:   github.Ptr("GHSA-1111-1111-1111"),
+		CVEID:    github.Ptr("CVE-2024-1234"),
+		Summary:  github.Ptr("Path traversal in upload handler"),
+		Severity: github.Ptr("high"),
+		State:    github.Ptr("draft"),
+		Vulnerabilities: []*github.AdvisoryVulnerability{
+			{
+				Package:                &github.VulnerabilityPackage{Ecosystem: github.Ptr("npm"), Name: github.Ptr("uploader")},
+				VulnerableVersionRange: github.Ptr("< 1.2.3"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedErrMsg   string
+		expectedAdvisory *github.SecurityAdvisory
+	}{
+		{
+			name: "successful advisory fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposSecurityAdvisoriesByOwnerByRepoByGhsaId,
+					expectPath(t, "/repos/owner/repo/security-advisories/GHSA-1111-1111-1111").andThen(
+						mockResponse(t, http.StatusOK, mockAdvisory),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"ghsaId": "GHSA-1111-1111-1111",
+			},
+			expectedAdvisory: mockAdvisory,
+		},
+		{
+			name: "token lacks security advisory access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposSecurityAdvisoriesByOwnerByRepoByGhsaId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"ghsaId": "GHSA-1111-1111-1111",
+			},
+			expectError:    true,
+			expectedErrMsg: "advisory GHSA-1111-1111-1111 not found or cannot access security advisories for owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepositorySecurityAdvisory(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+
+			var returned github.SecurityAdvisory
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAdvisory.GetGHSAID(), returned.GetGHSAID())
+			assert.Equal(t, tc.expectedAdvisory.GetCVEID(), returned.GetCVEID())
+			assert.Equal(t, tc.expectedAdvisory.GetState(), returned.GetState())
+			require.Len(t, returned.Vulnerabilities, 1)
+			assert.Equal(t, "uploader", returned.Vulnerabilities[0].GetPackage().GetName())
+		})
+	}
+}
+
+func Test_CreateRepositorySecurityAdvisory(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateRepositorySecurityAdvisory(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_repository_security_advisory", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "summary", "description", "ecosystem", "packageName"})
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposSecurityAdvisoriesByOwnerByRepo,
+			expectRequestBody(t, map[string]any{
+				"summary":     "Path traversal in upload handler",
+				"description": "Uploads can write outside the target directory.",
+				"severity":    "high",
+				"cwe_ids":     []any{"CWE-22"},
+				"vulnerabilities": []any{
+					map[string]any{
+						"package":                  map[string]any{"ecosystem": "npm", "name": "uploader"},
+						"vulnerable_version_range": "< 1.2.3",
+						"patched_versions":         "1.2.3",
+					},
+				},
+			}).andThen(
+				mockResponse(t, http.StatusCreated, &github.SecurityAdvisory{
+					GHSAID: github.Ptr("GHSA-3333-3333-3333"),
+					State:  github.Ptr("draft"),
+				}),
+			),
+		),
+	)
+
+	client := github.NewClient(mockedClient)
+	_, handler := CreateRepositorySecurityAdvisory(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":                  "owner",
+		"repo":                   "repo",
+		"summary":                "Path traversal in upload handler",
+		"description":            "Uploads can write outside the target directory.",
+		"severity":               "high",
+		"cweIds":                 []any{"CWE-22"},
+		"ecosystem":              "npm",
+		"packageName":            "uploader",
+		"vulnerableVersionRange": "< 1.2.3",
+		"patchedVersions":        "1.2.3",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+
+	var returned github.SecurityAdvisory
+	err = json.Unmarshal([]byte(textContent.Text), &returned)
+	require.NoError(t, err)
+	assert.Equal(t, "GHSA-3333-3333-3333", returned.GetGHSAID())
+	assert.Equal(t, "draft", returned.GetState())
+}
+
+func Test_RequestRepositorySecurityAdvisoryCVE(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RequestRepositorySecurityAdvisoryCVE(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "request_repository_security_advisory_cve", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ghsaId"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "CVE requested",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposSecurityAdvisoriesCveByOwnerByRepoByGhsaId,
+					expectPath(t, "/repos/owner/repo/security-advisories/GHSA-3333-3333-3333/cve").andThen(
+						mockResponse(t, http.StatusAccepted, map[string]any{}),
+					),
+				),
+			),
+		},
+		{
+			name: "advisory not visible to the token",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposSecurityAdvisoriesCveByOwnerByRepoByGhsaId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "advisory GHSA-3333-3333-3333 not found or cannot access security advisories for owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RequestRepositorySecurityAdvisoryCVE(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"ghsaId": "GHSA-3333-3333-3333",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Equal(t, "CVE requested for advisory GHSA-3333-3333-3333 in owner/repo", getTextResult(t, result).Text)
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	securitySettingEnabled     = "enabled"
+	securitySettingDisabled    = "disabled"
+	securitySettingUnavailable = "unavailable"
+)
+
+// SecuritySettings summarizes which security features are enabled on a repository.
+type SecuritySettings struct {
+	AdvancedSecurity             string   `json:"advanced_security"`
+	DependabotAlerts             string   `json:"dependabot_alerts"`
+	DependabotSecurityUpdates    string   `json:"dependabot_security_updates"`
+	SecretScanning               string   `json:"secret_scanning"`
+	SecretScanningPushProtection string   `json:"secret_scanning_push_protection"`
+	CodeScanningDefaultSetup     string   `json:"code_scanning_default_setup"`
+	Notes                        []string `json:"notes,omitempty"`
+}
+
+// SecuritySettingUpdate is the outcome of changing a single security setting.
+type SecuritySettingUpdate struct {
+	Setting string `json:"setting"`
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+}
+
+// statusOrDisabled normalizes the status reported in a repository's security_and_analysis block.
+func statusOrDisabled(status string) string {
+	if status == "" {
+		return securitySettingDisabled
+	}
+	return status
+}
+
+// enabledString maps a boolean to the status strings used by the security_and_analysis API.
+func enabledString(enabled bool) string {
+	if enabled {
+		return securitySettingEnabled
+	}
+	return securitySettingDisabled
+}
+
+// GetSecurityAndAnalysisSettings creates a tool to report the security features enabled on a repository.
+func GetSecurityAndAnalysisSettings(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_security_and_analysis_settings",
+			mcp.WithDescription(t("TOOL_GET_SECURITY_AND_ANALYSIS_SETTINGS_DESCRIPTION", "Report whether Dependabot alerts, Dependabot security updates, secret scanning, push protection and code scanning default setup are enabled for a GitHub repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_SECURITY_AND_ANALYSIS_SETTINGS_USER_TITLE", "Get repository security settings"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("The owner of the repository."),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get repository",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			sa := repository.GetSecurityAndAnalysis()
+			settings := SecuritySettings{
+				AdvancedSecurity:             statusOrDisabled(sa.GetAdvancedSecurity().GetStatus()),
+				DependabotSecurityUpdates:    statusOrDisabled(sa.GetDependabotSecurityUpdates().GetStatus()),
+				SecretScanning:               statusOrDisabled(sa.GetSecretScanning().GetStatus()),
+				SecretScanningPushProtection: statusOrDisabled(sa.GetSecretScanningPushProtection().GetStatus()),
+			}
+			if repository.SecurityAndAnalysis == nil {
+				settings.Notes = append(settings.Notes, "security_and_analysis is only returned to users with admin access to the repository, so the secret scanning and Dependabot security update settings may be inaccurate")
+			}
+
+			alertsEnabled, resp, err := client.Repositories.GetVulnerabilityAlerts(ctx, owner, repo)
+			if err != nil {
+				settings.DependabotAlerts = securitySettingUnavailable
+				settings.Notes = append(settings.Notes, fmt.Sprintf("could not check Dependabot alerts: %v", err))
+			} else {
+				settings.DependabotAlerts = enabledString(alertsEnabled)
+			}
+			if resp != nil {
+				_ = resp.Body.Close()
+			}
+
+			defaultSetup, resp, err := client.CodeScanning.GetDefaultSetupConfiguration(ctx, owner, repo)
+			if err != nil {
+				settings.CodeScanningDefaultSetup = securitySettingUnavailable
+				settings.Notes = append(settings.Notes, fmt.Sprintf("could not check code scanning default setup: %v", err))
+			} else {
+				settings.CodeScanningDefaultSetup = defaultSetup.GetState()
+			}
+			if resp != nil {
+				_ = resp.Body.Close()
+			}
+
+			return MarshalledTextResult(settings), nil
+		}
+}
+
+// UpdateSecuritySettings creates a tool to enable or disable security features on a repository.
+func UpdateSecuritySettings(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_security_settings",
+			mcp.WithDescription(t("TOOL_UPDATE_SECURITY_SETTINGS_DESCRIPTION", "Enable or disable security features on a GitHub repository. Only the settings provided are changed, and the outcome of each change is reported separately, since organization policies can prevent individual settings from being changed.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_SECURITY_SETTINGS_USER_TITLE", "Update repository security settings"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("The owner of the repository."),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository."),
+			),
+			mcp.WithBoolean("dependabot_alerts",
+				mcp.Description("Enable or disable Dependabot alerts"),
+			),
+			mcp.WithBoolean("dependabot_security_updates",
+				mcp.Description("Enable or disable Dependabot security updates"),
+			),
+			mcp.WithBoolean("secret_scanning",
+				mcp.Description("Enable or disable secret scanning"),
+			),
+			mcp.WithBoolean("secret_scanning_push_protection",
+				mcp.Description("Enable or disable secret scanning push protection"),
+			),
+			mcp.WithBoolean("code_scanning_default_setup",
+				mcp.Description("Enable or disable code scanning default setup"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			type settingUpdater func(ctx context.Context, client *github.Client, enabled bool) (*github.Response, error)
+
+			// Settings are applied one at a time, in a fixed order, so that a policy
+			// preventing one change doesn't block the others.
+			settings := []struct {
+				name   string
+				update settingUpdater
+			}{
+				{"dependabot_alerts", func(ctx context.Context, client *github.Client, enabled bool) (*github.Response, error) {
+					if enabled {
+						return client.Repositories.EnableVulnerabilityAlerts(ctx, owner, repo)
+					}
+					return client.Repositories.DisableVulnerabilityAlerts(ctx, owner, repo)
+				}},
+				{"dependabot_security_updates", func(ctx context.Context, client *github.Client, enabled bool) (*github.Response, error) {
+					_, resp, err := client.Repositories.Edit(ctx, owner, repo, &github.Repository{
+						SecurityAndAnalysis: &github.SecurityAndAnalysis{
+							DependabotSecurityUpdates: &github.DependabotSecurityUpdates{Status: github.Ptr(enabledString(enabled))},
+						},
+					})
+					return resp, err
+				}},
+				{"secret_scanning", func(ctx context.Context, client *github.Client, enabled bool) (*github.Response, error) {
+					_, resp, err := client.Repositories.Edit(ctx, owner, repo, &github.Repository{
+						SecurityAndAnalysis: &github.SecurityAndAnalysis{
+							SecretScanning: &github.SecretScanning{Status: github.Ptr(enabledString(enabled))},
+						},
+					})
+					return resp, err
+				}},
+				{"secret_scanning_push_protection", func(ctx context.Context, client *github.Client, enabled bool) (*github.Response, error) {
+					_, resp, err := client.Repositories.Edit(ctx, owner, repo, &github.Repository{
+						SecurityAndAnalysis: &github.SecurityAndAnalysis{
+							SecretScanningPushProtection: &github.SecretScanningPushProtection{Status: github.Ptr(enabledString(enabled))},
+						},
+					})
+					return resp, err
+				}},
+				{"code_scanning_default_setup", func(ctx context.Context, client *github.Client, enabled bool) (*github.Response, error) {
+					state := "not-configured"
+					if enabled {
+						state = "configured"
+					}
+					_, resp, err := client.CodeScanning.UpdateDefaultSetupConfiguration(ctx, owner, repo, &github.UpdateDefaultSetupConfigurationOptions{State: state})
+					if isAcceptedError(err) {
+						// Enabling default setup kicks off an analysis run asynchronously.
+						return resp, nil
+					}
+					return resp, err
+				}},
+			}
+
+			requested := map[string]bool{}
+			for _, s := range settings {
+				enabled, ok, err := OptionalParamOK[bool](request, s.name)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if ok {
+					requested[s.name] = enabled
+				}
+			}
+			if len(requested) == 0 {
+				return mcp.NewToolResultError("at least one setting must be provided"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			results := []SecuritySettingUpdate{}
+			for _, s := range settings {
+				enabled, ok := requested[s.name]
+				if !ok {
+					continue
+				}
+
+				resp, err := s.update(ctx, client, enabled)
+				if resp != nil {
+					_ = resp.Body.Close()
+				}
+				if err != nil {
+					_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, fmt.Sprintf("failed to update %s", s.name), resp, err)
+					msg := err.Error()
+					if resp != nil && (resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusUnprocessableEntity) {
+						msg = fmt.Sprintf("%s (this setting may be enforced by an organization or enterprise policy, or require GitHub Advanced Security)", msg)
+					}
+					results = append(results, SecuritySettingUpdate{Setting: s.name, Status: "failed", Error: msg})
+					continue
+				}
+				results = append(results, SecuritySettingUpdate{Setting: s.name, Status: enabledString(enabled)})
+			}
+
+			return MarshalledTextResult(results), nil
+		}
+}
@@ -0,0 +1,267 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetSecurityAndAnalysisSettings(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetSecurityAndAnalysisSettings(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_security_and_analysis_settings", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockRepo := &github.Repository{
+		Name: github.Ptr("repo"),
+		SecurityAndAnalysis: &github.SecurityAndAnalysis{
+			AdvancedSecurity:             &github.AdvancedSecurity{Status: github.Ptr("enabled")},
+			SecretScanning:               &github.SecretScanning{Status: github.Ptr("enabled")},
+			SecretScanningPushProtection: &github.SecretScanningPushProtection{Status: github.Ptr("disabled")},
+			DependabotSecurityUpdates:    &github.DependabotSecurityUpdates{Status: github.Ptr("enabled")},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedErrMsg   string
+		expectedSettings SecuritySettings
+	}{
+		{
+			name: "all settings readable",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposVulnerabilityAlertsByOwnerByRepo,
+					mockResponse(t, http.StatusNoContent, ""),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCodeScanningDefaultSetupByOwnerByRepo,
+					&github.DefaultSetupConfiguration{State: github.Ptr("configured")},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedSettings: SecuritySettings{
+				AdvancedSecurity:             "enabled",
+				DependabotAlerts:             "enabled",
+				DependabotSecurityUpdates:    "enabled",
+				SecretScanning:               "enabled",
+				SecretScanningPushProtection: "disabled",
+				CodeScanningDefaultSetup:     "configured",
+			},
+		},
+		{
+			name: "alerts disabled and default setup unavailable",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposVulnerabilityAlertsByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, ""),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCodeScanningDefaultSetupByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Advanced Security must be enabled"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedSettings: SecuritySettings{
+				AdvancedSecurity:             "enabled",
+				DependabotAlerts:             "disabled",
+				DependabotSecurityUpdates:    "enabled",
+				SecretScanning:               "enabled",
+				SecretScanningPushProtection: "disabled",
+				CodeScanningDefaultSetup:     "unavailable",
+			},
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetSecurityAndAnalysisSettings(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+
+			var returned SecuritySettings
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			// Notes carry API error details, so only compare the settings themselves
+			returned.Notes = nil
+			assert.Equal(t, tc.expectedSettings, returned)
+		})
+	}
+}
+
+func Test_UpdateSecuritySettings(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateSecuritySettings(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_security_settings", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "dependabot_alerts")
+	assert.Contains(t, tool.InputSchema.Properties, "secret_scanning")
+	assert.Contains(t, tool.InputSchema.Properties, "secret_scanning_push_protection")
+	assert.Contains(t, tool.InputSchema.Properties, "code_scanning_default_setup")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedErrMsg  string
+		expectedResults []SecuritySettingUpdate
+	}{
+		{
+			name: "toggle secret scanning and dependabot alerts",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposVulnerabilityAlertsByOwnerByRepo,
+					mockResponse(t, http.StatusNoContent, ""),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"security_and_analysis": map[string]any{
+							"secret_scanning": map[string]any{"status": "disabled"},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Repository{Name: github.Ptr("repo")}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"dependabot_alerts": true,
+				"secret_scanning":   false,
+			},
+			expectedResults: []SecuritySettingUpdate{
+				{Setting: "dependabot_alerts", Status: "enabled"},
+				{Setting: "secret_scanning", Status: "disabled"},
+			},
+		},
+		{
+			name: "setting blocked by organization policy",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Secret scanning push protection is enforced by the organization"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":                           "owner",
+				"repo":                            "repo",
+				"secret_scanning_push_protection": false,
+			},
+			expectedResults: []SecuritySettingUpdate{
+				{Setting: "secret_scanning_push_protection", Status: "failed"},
+			},
+		},
+		{
+			name:         "no settings provided",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "at least one setting must be provided",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateSecuritySettings(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+
+			var returned []SecuritySettingUpdate
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			require.Len(t, returned, len(tc.expectedResults))
+			for i, expected := range tc.expectedResults {
+				assert.Equal(t, expected.Setting, returned[i].Setting)
+				assert.Equal(t, expected.Status, returned[i].Status)
+				if expected.Status == "failed" {
+					assert.Contains(t, returned[i].Error, "organization or enterprise policy")
+				}
+			}
+		})
+	}
+}
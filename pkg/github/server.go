@@ -1,6 +1,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -18,6 +19,7 @@ func NewServer(version string, opts ...server.ServerOption) *server.MCPServer {
 		server.WithToolCapabilities(true),
 		server.WithResourceCapabilities(true, true),
 		server.WithLogging(),
+		WithRepoRedirectNotes(),
 	}
 	opts = append(defaultOpts, opts...)
 
@@ -189,6 +191,36 @@ func OptionalStringArrayParam(r mcp.CallToolRequest, p string) ([]string, error)
 	}
 }
 
+// OptionalIntArrayParam is a helper function that can be used to fetch a requested parameter from the request.
+// It does the following checks:
+// 1. Checks if the parameter is present in the request, if not, it returns its zero-value
+// 2. If it is present, iterates the elements and checks each is a whole number
+func OptionalIntArrayParam(r mcp.CallToolRequest, p string) ([]int, error) {
+	// Check if the parameter is present in the request
+	if _, ok := r.GetArguments()[p]; !ok {
+		return []int{}, nil
+	}
+
+	switch v := r.GetArguments()[p].(type) {
+	case nil:
+		return []int{}, nil
+	case []int:
+		return v, nil
+	case []any:
+		intSlice := make([]int, len(v))
+		for i, v := range v {
+			f, ok := v.(float64)
+			if !ok || f != float64(int(f)) {
+				return []int{}, fmt.Errorf("parameter %s is not of type integer, is %T", p, v)
+			}
+			intSlice[i] = int(f)
+		}
+		return intSlice, nil
+	default:
+		return []int{}, fmt.Errorf("parameter %s could not be coerced to []int, is %T", p, r.GetArguments()[p])
+	}
+}
+
 // WithPagination adds REST API pagination parameters to a tool.
 // https://docs.github.com/en/rest/using-the-rest-api/using-pagination-in-the-rest-api
 func WithPagination() mcp.ToolOption {
@@ -341,3 +373,101 @@ func MarshalledTextResult(v any) *mcp.CallToolResult {
 
 	return mcp.NewToolResultText(string(data))
 }
+
+// ListResultStyle controls how list tools shape their results.
+type ListResultStyle string
+
+const (
+	// ListResultStyleArray returns list results as a bare JSON array. This is the default.
+	ListResultStyleArray ListResultStyle = "array"
+	// ListResultStyleEnvelope wraps list results in an object with a count, and an explicit
+	// message when nothing matched.
+	ListResultStyleEnvelope ListResultStyle = "envelope"
+)
+
+// ParseListResultStyle validates a list result style name. An empty name means ListResultStyleArray.
+func ParseListResultStyle(s string) (ListResultStyle, error) {
+	switch ListResultStyle(s) {
+	case "", ListResultStyleArray:
+		return ListResultStyleArray, nil
+	case ListResultStyleEnvelope:
+		return ListResultStyleEnvelope, nil
+	default:
+		return "", fmt.Errorf("invalid list result style %q, must be one of %q or %q", s, ListResultStyleArray, ListResultStyleEnvelope)
+	}
+}
+
+type listResultStyleKey struct{}
+
+// ContextWithListResultStyle returns a context that makes list tools use the given result style.
+func ContextWithListResultStyle(ctx context.Context, style ListResultStyle) context.Context {
+	return context.WithValue(ctx, listResultStyleKey{}, style)
+}
+
+func listResultStyleFromContext(ctx context.Context) ListResultStyle {
+	if style, ok := ctx.Value(listResultStyleKey{}).(ListResultStyle); ok {
+		return style
+	}
+	return ListResultStyleArray
+}
+
+// WithListResultStyle is a server option that makes every tool call use the given list result style.
+func WithListResultStyle(style ListResultStyle) server.ServerOption {
+	return server.WithToolHandlerMiddleware(func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return next(ContextWithListResultStyle(ctx, style), request)
+		}
+	})
+}
+
+// ListResult is the envelope list tools return when using ListResultStyleEnvelope.
+type ListResult[T any] struct {
+	Items   []T    `json:"items"`
+	Count   int    `json:"count"`
+	Message string `json:"message,omitempty"`
+	*ListPageInfo
+}
+
+// ListPageInfo tells how to get the rest of a list that a tool returned one page of.
+type ListPageInfo struct {
+	TotalCount  *int   `json:"total_count,omitempty"`
+	HasNextPage bool   `json:"has_next_page,omitempty"`
+	NextCursor  string `json:"next_cursor,omitempty"`
+}
+
+// MarshalledListResult marshals the result of a list tool in the style configured on the context.
+// emptyMessage explains an empty result in the envelope style, e.g. "no open issues matched".
+func MarshalledListResult[T any](ctx context.Context, items []T, emptyMessage string) *mcp.CallToolResult {
+	if items == nil {
+		items = []T{}
+	}
+	if listResultStyleFromContext(ctx) != ListResultStyleEnvelope {
+		return MarshalledTextResult(items)
+	}
+	return MarshalledTextResult(newListResult(items, emptyMessage, nil))
+}
+
+// MarshalledListPageResult marshals the result of a list tool that returns one page of a longer
+// list. The array style returns page, the tool's own result, unchanged so that its total count or
+// cursor is kept. The envelope style returns items with info about the rest of the list.
+func MarshalledListPageResult[T any](ctx context.Context, page any, items []T, info ListPageInfo, emptyMessage string) *mcp.CallToolResult {
+	if listResultStyleFromContext(ctx) != ListResultStyleEnvelope {
+		return MarshalledTextResult(page)
+	}
+	if items == nil {
+		items = []T{}
+	}
+	return MarshalledTextResult(newListResult(items, emptyMessage, &info))
+}
+
+func newListResult[T any](items []T, emptyMessage string, info *ListPageInfo) ListResult[T] {
+	result := ListResult[T]{
+		Items:        items,
+		Count:        len(items),
+		ListPageInfo: info,
+	}
+	if len(items) == 0 {
+		result.Message = emptyMessage
+	}
+	return result
+}
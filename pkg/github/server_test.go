@@ -5,13 +5,20 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
 	"net/http"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/github/github-mcp-server/pkg/raw"
 	"github.com/google/go-github/v74/github"
 	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func stubGetClientFn(client *github.Client) GetClientFn {
@@ -478,6 +485,65 @@ func TestOptionalStringArrayParam(t *testing.T) {
 	}
 }
 
+func TestOptionalIntArrayParam(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      map[string]interface{}
+		paramName   string
+		expected    []int
+		expectError bool
+	}{
+		{
+			name:        "parameter not in request",
+			params:      map[string]any{},
+			paramName:   "numbers",
+			expected:    []int{},
+			expectError: false,
+		},
+		{
+			name: "valid any array parameter",
+			params: map[string]any{
+				"numbers": []any{float64(1), float64(42)},
+			},
+			paramName:   "numbers",
+			expected:    []int{1, 42},
+			expectError: false,
+		},
+		{
+			name: "fractional number",
+			params: map[string]any{
+				"numbers": []any{float64(1.5)},
+			},
+			paramName:   "numbers",
+			expected:    []int{},
+			expectError: true,
+		},
+		{
+			name: "wrong slice type parameter",
+			params: map[string]any{
+				"numbers": []any{"1"},
+			},
+			paramName:   "numbers",
+			expected:    []int{},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			request := createMCPRequest(tc.params)
+			result, err := OptionalIntArrayParam(request, tc.paramName)
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
 func TestOptionalPaginationParams(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -560,3 +626,141 @@ func TestOptionalPaginationParams(t *testing.T) {
 		})
 	}
 }
+
+func TestMarshalledListResult(t *testing.T) {
+	type item struct {
+		Name string `json:"name"`
+	}
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		items        []item
+		expectedJSON string
+	}{
+		{
+			name:         "array style by default",
+			ctx:          context.Background(),
+			items:        []item{{Name: "a"}},
+			expectedJSON: `[{"name":"a"}]`,
+		},
+		{
+			name:         "array style with no results",
+			ctx:          context.Background(),
+			items:        nil,
+			expectedJSON: `[]`,
+		},
+		{
+			name:         "envelope style",
+			ctx:          ContextWithListResultStyle(context.Background(), ListResultStyleEnvelope),
+			items:        []item{{Name: "a"}, {Name: "b"}},
+			expectedJSON: `{"items":[{"name":"a"},{"name":"b"}],"count":2}`,
+		},
+		{
+			name:         "envelope style with no results",
+			ctx:          ContextWithListResultStyle(context.Background(), ListResultStyleEnvelope),
+			items:        nil,
+			expectedJSON: `{"items":[],"count":0,"message":"no open issues matched"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := MarshalledListResult(tc.ctx, tc.items, "no open issues matched")
+
+			textContent := getTextResult(t, result)
+			assert.JSONEq(t, tc.expectedJSON, textContent.Text)
+		})
+	}
+}
+
+func TestMarshalledListPageResult(t *testing.T) {
+	type page struct {
+		TotalCount int      `json:"total_count"`
+		Names      []string `json:"names"`
+	}
+	p := page{TotalCount: 3, Names: []string{"a"}}
+	info := ListPageInfo{TotalCount: github.Ptr(3), HasNextPage: true}
+
+	// The array style keeps the tool's own result, with its total count.
+	result := MarshalledListPageResult(context.Background(), p, p.Names, info, "no names found")
+	assert.JSONEq(t, `{"total_count":3,"names":["a"]}`, getTextResult(t, result).Text)
+
+	envelope := ContextWithListResultStyle(context.Background(), ListResultStyleEnvelope)
+	result = MarshalledListPageResult(envelope, p, p.Names, info, "no names found")
+	assert.JSONEq(t, `{"items":["a"],"count":1,"total_count":3,"has_next_page":true}`, getTextResult(t, result).Text)
+
+	result = MarshalledListPageResult(envelope, page{}, []string(nil), ListPageInfo{NextCursor: "abc"}, "no names found")
+	assert.JSONEq(t, `{"items":[],"count":0,"message":"no names found","next_cursor":"abc"}`, getTextResult(t, result).Text)
+}
+
+func TestParseListResultStyle(t *testing.T) {
+	style, err := ParseListResultStyle("")
+	require.NoError(t, err)
+	assert.Equal(t, ListResultStyleArray, style)
+
+	style, err = ParseListResultStyle("envelope")
+	require.NoError(t, err)
+	assert.Equal(t, ListResultStyleEnvelope, style)
+
+	_, err = ParseListResultStyle("xml")
+	assert.ErrorContains(t, err, `invalid list result style "xml"`)
+}
+
+// TestListToolsUseListResult checks that every list_ tool returns its result through
+// MarshalledListResult or MarshalledListPageResult, so that all of them follow the configured
+// list result style.
+func TestListToolsUseListResult(t *testing.T) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	require.NoError(t, err)
+
+	var listTools, bare []string
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Body == nil {
+					continue
+				}
+				var name string
+				usesListResult := false
+				ast.Inspect(fn.Body, func(n ast.Node) bool {
+					call, ok := n.(*ast.CallExpr)
+					if !ok {
+						return true
+					}
+					fun := call.Fun
+					if index, ok := fun.(*ast.IndexExpr); ok {
+						fun = index.X
+					}
+					switch f := fun.(type) {
+					case *ast.SelectorExpr:
+						if f.Sel.Name == "NewTool" && len(call.Args) > 0 {
+							if lit, ok := call.Args[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+								name, _ = strconv.Unquote(lit.Value)
+							}
+						}
+					case *ast.Ident:
+						if f.Name == "MarshalledListResult" || f.Name == "MarshalledListPageResult" {
+							usesListResult = true
+						}
+					}
+					return true
+				})
+				if !strings.HasPrefix(name, "list_") {
+					continue
+				}
+				listTools = append(listTools, name)
+				if !usesListResult {
+					bare = append(bare, name)
+				}
+			}
+		}
+	}
+
+	assert.NotEmpty(t, listTools)
+	assert.Empty(t, bare, "%d of %d list tools do not use the list result style", len(bare), len(listTools))
+}
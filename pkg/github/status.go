@@ -0,0 +1,187 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// githubStatusURL is the public Statuspage summary of github.com. It is a variable so tests can
+// point it at a local server.
+var githubStatusURL = "https://www.githubstatus.com/api/v2/summary.json"
+
+const (
+	// githubStatusCacheTTL is how long a fetched status is reused. Statuspage itself only updates
+	// the summary every few seconds, and agents tend to ask repeatedly while a call keeps failing.
+	githubStatusCacheTTL = time.Minute
+	githubStatusTimeout  = 10 * time.Second
+)
+
+// githubStatusHTTPClient fetches the status page. It is separate from the GitHub API client so
+// that the token is never sent to githubstatus.com.
+var githubStatusHTTPClient = &http.Client{Timeout: githubStatusTimeout}
+
+// statusSummary is the subset of the Statuspage summary.json response that is used.
+type statusSummary struct {
+	Page struct {
+		UpdatedAt time.Time `json:"updated_at"`
+	} `json:"page"`
+	Status struct {
+		Indicator   string `json:"indicator"`
+		Description string `json:"description"`
+	} `json:"status"`
+	Components []struct {
+		Name   string `json:"name"`
+		Status string `json:"status"`
+		Group  bool   `json:"group"`
+	} `json:"components"`
+	Incidents []struct {
+		Name            string    `json:"name"`
+		Status          string    `json:"status"`
+		Impact          string    `json:"impact"`
+		Shortlink       string    `json:"shortlink"`
+		CreatedAt       time.Time `json:"created_at"`
+		UpdatedAt       time.Time `json:"updated_at"`
+		IncidentUpdates []struct {
+			Body string `json:"body"`
+		} `json:"incident_updates"`
+	} `json:"incidents"`
+}
+
+// StatusComponent is the current status of a part of GitHub, such as Actions or Git operations.
+type StatusComponent struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// StatusIncident is an incident that has not been resolved yet.
+type StatusIncident struct {
+	Name         string    `json:"name"`
+	Status       string    `json:"status"`
+	Impact       string    `json:"impact"`
+	URL          string    `json:"url,omitempty"`
+	StartedAt    time.Time `json:"started_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	LatestUpdate string    `json:"latest_update,omitempty"`
+}
+
+// GitHubStatus summarizes githubstatus.com.
+type GitHubStatus struct {
+	Available   bool              `json:"available"`
+	Indicator   string            `json:"indicator,omitempty"`
+	Description string            `json:"description,omitempty"`
+	UpdatedAt   *time.Time        `json:"updated_at,omitempty"`
+	Components  []StatusComponent `json:"components,omitempty"`
+	Incidents   []StatusIncident  `json:"incidents,omitempty"`
+	Note        string            `json:"note,omitempty"`
+}
+
+// newGitHubStatus converts a Statuspage summary into the tool result.
+func newGitHubStatus(summary statusSummary) GitHubStatus {
+	status := GitHubStatus{
+		Available:   true,
+		Indicator:   summary.Status.Indicator,
+		Description: summary.Status.Description,
+		UpdatedAt:   &summary.Page.UpdatedAt,
+		Components:  []StatusComponent{},
+		Incidents:   []StatusIncident{},
+	}
+	for _, c := range summary.Components {
+		// Groups only roll up the status of the components in them.
+		if c.Group {
+			continue
+		}
+		status.Components = append(status.Components, StatusComponent{Name: c.Name, Status: c.Status})
+	}
+	for _, i := range summary.Incidents {
+		incident := StatusIncident{
+			Name:      i.Name,
+			Status:    i.Status,
+			Impact:    i.Impact,
+			URL:       i.Shortlink,
+			StartedAt: i.CreatedAt,
+			UpdatedAt: i.UpdatedAt,
+		}
+		// Statuspage lists updates newest first.
+		if len(i.IncidentUpdates) > 0 {
+			incident.LatestUpdate = i.IncidentUpdates[0].Body
+		}
+		status.Incidents = append(status.Incidents, incident)
+	}
+	return status
+}
+
+// fetchGitHubStatus downloads and parses the status summary.
+func fetchGitHubStatus(ctx context.Context) (GitHubStatus, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, githubStatusURL, nil)
+	if err != nil {
+		return GitHubStatus{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := githubStatusHTTPClient.Do(req)
+	if err != nil {
+		return GitHubStatus{}, fmt.Errorf("failed to fetch %s: %w", githubStatusURL, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return GitHubStatus{}, fmt.Errorf("failed to fetch %s: unexpected status %s", githubStatusURL, resp.Status)
+	}
+
+	var summary statusSummary
+	if err := json.NewDecoder(resp.Body).Decode(&summary); err != nil {
+		return GitHubStatus{}, fmt.Errorf("failed to parse status summary: %w", err)
+	}
+	return newGitHubStatus(summary), nil
+}
+
+// GetGitHubStatus creates a tool to report the status of github.com from githubstatus.com.
+func GetGitHubStatus(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	var (
+		mu        sync.Mutex
+		cached    GitHubStatus
+		fetchedAt time.Time
+	)
+
+	return mcp.NewTool("get_github_status",
+			mcp.WithDescription(t("TOOL_GET_GITHUB_STATUS_DESCRIPTION", "Get the current status of github.com from githubstatus.com, including the status of each component and any unresolved incidents. Use this when GitHub API calls fail unexpectedly, to tell whether GitHub is having an outage. Not available for GitHub Enterprise hosts.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_GITHUB_STATUS_USER_TITLE", "Get GitHub status"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+		),
+		func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// githubstatus.com only covers github.com. Enterprise Server and GHE.com hosts have
+			// their own status reporting, which is not exposed through a common API.
+			if host := client.BaseURL.Hostname(); host != "api.github.com" {
+				return MarshalledTextResult(GitHubStatus{
+					Note: fmt.Sprintf("githubstatus.com only reports the status of github.com, not %s. Ask the administrators of %s where its status is published.", webHostForAPI(client.BaseURL), webHostForAPI(client.BaseURL)),
+				}), nil
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if fetchedAt.IsZero() || time.Since(fetchedAt) > githubStatusCacheTTL {
+				status, err := fetchGitHubStatus(ctx)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				cached, fetchedAt = status, time.Now()
+			}
+
+			return MarshalledTextResult(cached), nil
+		}
+}
@@ -0,0 +1,139 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleStatusSummary = `{
+  "page": {"id": "kctbh9vrtdwd", "name": "GitHub", "url": "https://www.githubstatus.com", "updated_at": "2025-06-12T18:20:04.000Z"},
+  "status": {"indicator": "minor", "description": "Partially Degraded Service"},
+  "components": [
+    {"id": "8l4ygp009s5s", "name": "Git Operations", "status": "operational", "group": false},
+    {"id": "br0l2tvcx85d", "name": "Actions", "status": "degraded_performance", "group": false},
+    {"id": "grp1", "name": "Infrastructure", "status": "operational", "group": true}
+  ],
+  "incidents": [
+    {
+      "name": "Incident with Actions",
+      "status": "investigating",
+      "impact": "minor",
+      "shortlink": "https://stspg.io/abc123",
+      "created_at": "2025-06-12T17:55:10.000Z",
+      "updated_at": "2025-06-12T18:20:04.000Z",
+      "incident_updates": [
+        {"status": "investigating", "body": "Actions jobs are starting slowly."},
+        {"status": "investigating", "body": "We are investigating reports of degraded performance for Actions."}
+      ]
+    }
+  ]
+}`
+
+func Test_GetGitHubStatus(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetGitHubStatus(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_github_status", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.Empty(t, tool.InputSchema.Required)
+
+	t.Run("active incident is reported and the result is cached", func(t *testing.T) {
+		var requests atomic.Int32
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			requests.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(sampleStatusSummary))
+		}))
+		defer testServer.Close()
+
+		originalURL := githubStatusURL
+		githubStatusURL = testServer.URL
+		t.Cleanup(func() { githubStatusURL = originalURL })
+
+		_, handler := GetGitHubStatus(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+
+		for range 2 {
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{}))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			var status GitHubStatus
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &status))
+
+			updatedAt := time.Date(2025, 6, 12, 18, 20, 4, 0, time.UTC)
+			assert.Equal(t, GitHubStatus{
+				Available:   true,
+				Indicator:   "minor",
+				Description: "Partially Degraded Service",
+				UpdatedAt:   &updatedAt,
+				Components: []StatusComponent{
+					{Name: "Git Operations", Status: "operational"},
+					{Name: "Actions", Status: "degraded_performance"},
+				},
+				Incidents: []StatusIncident{
+					{
+						Name:         "Incident with Actions",
+						Status:       "investigating",
+						Impact:       "minor",
+						URL:          "https://stspg.io/abc123",
+						StartedAt:    time.Date(2025, 6, 12, 17, 55, 10, 0, time.UTC),
+						UpdatedAt:    updatedAt,
+						LatestUpdate: "Actions jobs are starting slowly.",
+					},
+				},
+			}, status)
+		}
+		assert.Equal(t, int32(1), requests.Load(), "second call should be served from the cache")
+	})
+
+	t.Run("status page error", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer testServer.Close()
+
+		originalURL := githubStatusURL
+		githubStatusURL = testServer.URL
+		t.Cleanup(func() { githubStatusURL = originalURL })
+
+		_, handler := GetGitHubStatus(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{}))
+		require.NoError(t, err)
+		errorContent := getErrorResult(t, result)
+		assert.Contains(t, errorContent.Text, "unexpected status 503 Service Unavailable")
+	})
+
+	t.Run("enterprise host is not looked up", func(t *testing.T) {
+		client := github.NewClient(nil)
+		baseURL, err := url.Parse("https://ghes.example.com/api/v3/")
+		require.NoError(t, err)
+		client.BaseURL = baseURL
+
+		_, handler := GetGitHubStatus(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{}))
+		require.NoError(t, err)
+		textContent := getTextResult(t, result)
+
+		var status GitHubStatus
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &status))
+		assert.False(t, status.Available)
+		assert.Contains(t, status.Note, "not ghes.example.com")
+	})
+}
@@ -0,0 +1,356 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// stackManifest describes a manifest file that identifies an ecosystem when found at the root of
+// a repository.
+type stackManifest struct {
+	Ecosystem      string
+	PackageManager string
+	// Frameworks maps a dependency name to the framework it indicates.
+	Frameworks map[string]string
+}
+
+// stackManifests are the manifest files detect_tech_stack looks for, by file name.
+var stackManifests = map[string]stackManifest{
+	"package.json": {
+		Ecosystem:      "JavaScript/TypeScript",
+		PackageManager: "npm",
+		Frameworks: map[string]string{
+			"react":         "React",
+			"next":          "Next.js",
+			"vue":           "Vue",
+			"nuxt":          "Nuxt",
+			"@angular/core": "Angular",
+			"svelte":        "Svelte",
+			"express":       "Express",
+			"@nestjs/core":  "NestJS",
+		},
+	},
+	"go.mod": {
+		Ecosystem:      "Go",
+		PackageManager: "go modules",
+		Frameworks: map[string]string{
+			"github.com/gin-gonic/gin":    "Gin",
+			"github.com/labstack/echo/v4": "Echo",
+			"github.com/gofiber/fiber/v2": "Fiber",
+			"github.com/go-chi/chi/v5":    "chi",
+			"github.com/spf13/cobra":      "Cobra",
+			"google.golang.org/grpc":      "gRPC",
+			"github.com/gorilla/mux":      "Gorilla",
+		},
+	},
+	"requirements.txt": {
+		Ecosystem:      "Python",
+		PackageManager: "pip",
+		Frameworks: map[string]string{
+			"django":  "Django",
+			"flask":   "Flask",
+			"fastapi": "FastAPI",
+			"torch":   "PyTorch",
+		},
+	},
+	"Cargo.toml": {
+		Ecosystem:      "Rust",
+		PackageManager: "cargo",
+		Frameworks: map[string]string{
+			"actix-web": "Actix Web",
+			"axum":      "Axum",
+			"rocket":    "Rocket",
+			"tokio":     "Tokio",
+		},
+	},
+	"pom.xml": {
+		Ecosystem:      "Java",
+		PackageManager: "maven",
+		Frameworks: map[string]string{
+			"spring-boot": "Spring Boot",
+			"quarkus":     "Quarkus",
+			"micronaut":   "Micronaut",
+		},
+	},
+}
+
+// nodeLockfiles identify the package manager of a JavaScript project, which package.json alone
+// does not tell. The first one found wins.
+var nodeLockfiles = []struct{ file, packageManager string }{
+	{"pnpm-lock.yaml", "pnpm"},
+	{"yarn.lock", "yarn"},
+	{"bun.lockb", "bun"},
+}
+
+// LanguageShare is the share of a repository's code written in a language.
+type LanguageShare struct {
+	Name    string  `json:"name"`
+	Bytes   int     `json:"bytes"`
+	Percent float64 `json:"percent"`
+}
+
+// DetectedManifest is a manifest file found at the root of a repository.
+type DetectedManifest struct {
+	Path           string   `json:"path"`
+	Ecosystem      string   `json:"ecosystem"`
+	PackageManager string   `json:"package_manager"`
+	Frameworks     []string `json:"frameworks,omitempty"`
+}
+
+// TechStack is a best guess at the technologies a repository uses.
+type TechStack struct {
+	PrimaryLanguage string             `json:"primary_language,omitempty"`
+	Languages       []LanguageShare    `json:"languages"`
+	Manifests       []DetectedManifest `json:"manifests"`
+	PackageManagers []string           `json:"package_managers"`
+	Frameworks      []string           `json:"frameworks"`
+	Notes           []string           `json:"notes,omitempty"`
+}
+
+// manifestDependencies returns the names of the dependencies declared in a manifest, lowercased.
+// Only package.json is parsed properly; the other formats are matched line by line, which is
+// enough to recognize well-known dependencies.
+func manifestDependencies(name, content string) ([]string, error) {
+	if name == "package.json" {
+		var pkg struct {
+			Dependencies    map[string]string `json:"dependencies"`
+			DevDependencies map[string]string `json:"devDependencies"`
+		}
+		if err := json.Unmarshal([]byte(content), &pkg); err != nil {
+			return nil, fmt.Errorf("failed to parse package.json: %w", err)
+		}
+		var deps []string
+		for dep := range pkg.Dependencies {
+			deps = append(deps, strings.ToLower(dep))
+		}
+		for dep := range pkg.DevDependencies {
+			deps = append(deps, strings.ToLower(dep))
+		}
+		return deps, nil
+	}
+
+	var deps []string
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.ToLower(strings.TrimSpace(line))
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "//") {
+			continue
+		}
+		deps = append(deps, line)
+	}
+	return deps, nil
+}
+
+// detectFrameworks returns the frameworks indicated by deps, in name order.
+func detectFrameworks(name string, manifest stackManifest, deps []string) []string {
+	found := map[string]bool{}
+	for _, dep := range deps {
+		for key, framework := range manifest.Frameworks {
+			if dependencyMatches(name, dep, key) {
+				found[framework] = true
+			}
+		}
+	}
+	frameworks := make([]string, 0, len(found))
+	for framework := range found {
+		frameworks = append(frameworks, framework)
+	}
+	sort.Strings(frameworks)
+	return frameworks
+}
+
+// dependencyMatches reports whether a dependency, or manifest line for formats that are not
+// parsed, refers to key.
+func dependencyMatches(manifest, dep, key string) bool {
+	switch manifest {
+	case "package.json":
+		return dep == key
+	case "requirements.txt":
+		// Lines look like "django>=4.2" or "Flask[async]==3.0".
+		name := strings.FieldsFunc(dep, func(r rune) bool { return strings.ContainsRune("<>=!~[; ", r) })
+		return len(name) > 0 && name[0] == key
+	case "Cargo.toml":
+		return strings.HasPrefix(dep, key+" ") || strings.HasPrefix(dep, key+"=")
+	case "pom.xml":
+		return strings.Contains(dep, "<artifactid>"+key) || strings.Contains(dep, "<groupid>io."+key)
+	default:
+		// go.mod require lines, with or without the require keyword.
+		fields := strings.Fields(strings.TrimPrefix(dep, "require "))
+		return len(fields) > 0 && fields[0] == key
+	}
+}
+
+// languageShares converts the byte counts GitHub reports into shares, largest first.
+func languageShares(languages map[string]int) []LanguageShare {
+	total := 0
+	for _, bytes := range languages {
+		total += bytes
+	}
+	shares := make([]LanguageShare, 0, len(languages))
+	for name, bytes := range languages {
+		share := LanguageShare{Name: name, Bytes: bytes}
+		if total > 0 {
+			share.Percent = float64(bytes*10000/total) / 100
+		}
+		shares = append(shares, share)
+	}
+	sort.Slice(shares, func(i, j int) bool {
+		if shares[i].Bytes != shares[j].Bytes {
+			return shares[i].Bytes > shares[j].Bytes
+		}
+		return shares[i].Name < shares[j].Name
+	})
+	return shares
+}
+
+// DetectTechStack creates a tool to work out the languages, package managers and frameworks of a repository.
+func DetectTechStack(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("detect_tech_stack",
+			mcp.WithDescription(t("TOOL_DETECT_TECH_STACK_DESCRIPTION", "Detect the tech stack of a GitHub repository: its languages by share of code, and the package managers and well-known frameworks declared in manifest files at the root (package.json, go.mod, requirements.txt, Cargo.toml, pom.xml). Repositories with several manifests list all of them. Use this to get project context before working in an unfamiliar repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DETECT_TECH_STACK_USER_TITLE", "Detect tech stack"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit to read manifests from. Defaults to the default branch. Language statistics always describe the default branch."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			languages, resp, err := client.Repositories.ListLanguages(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list repository languages", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			stack := TechStack{
+				Languages:       languageShares(languages),
+				Manifests:       []DetectedManifest{},
+				PackageManagers: []string{},
+				Frameworks:      []string{},
+			}
+			if len(stack.Languages) > 0 {
+				stack.PrimaryLanguage = stack.Languages[0].Name
+			}
+
+			opts := &github.RepositoryContentGetOptions{Ref: ref}
+			_, root, resp, err := client.Repositories.GetContents(ctx, owner, repo, "", opts)
+			if err != nil {
+				// An empty repository has no root directory.
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					_ = resp.Body.Close()
+					stack.Notes = append(stack.Notes, "the repository has no files, so no manifests were found")
+					return MarshalledTextResult(stack), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list repository root", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			rootFiles := map[string]bool{}
+			for _, entry := range root {
+				if entry.GetType() == "file" {
+					rootFiles[entry.GetName()] = true
+				}
+			}
+
+			names := make([]string, 0, len(stackManifests))
+			for name := range stackManifests {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			packageManagers := map[string]bool{}
+			frameworks := map[string]bool{}
+			for _, name := range names {
+				if !rootFiles[name] {
+					continue
+				}
+				manifest := stackManifests[name]
+				detected := DetectedManifest{
+					Path:           name,
+					Ecosystem:      manifest.Ecosystem,
+					PackageManager: manifest.PackageManager,
+				}
+				if name == "package.json" {
+					for _, lockfile := range nodeLockfiles {
+						if rootFiles[lockfile.file] {
+							detected.PackageManager = lockfile.packageManager
+							break
+						}
+					}
+				}
+
+				// A manifest that cannot be read still identifies the ecosystem.
+				file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, name, opts)
+				if resp != nil {
+					_ = resp.Body.Close()
+				}
+				var content string
+				if err == nil && file != nil {
+					content, err = file.GetContent()
+				}
+				if err != nil {
+					stack.Notes = append(stack.Notes, fmt.Sprintf("%s could not be read, so its frameworks are unknown: %v", name, err))
+				} else if deps, err := manifestDependencies(name, content); err != nil {
+					stack.Notes = append(stack.Notes, err.Error())
+				} else {
+					detected.Frameworks = detectFrameworks(name, manifest, deps)
+				}
+
+				stack.Manifests = append(stack.Manifests, detected)
+				packageManagers[detected.PackageManager] = true
+				for _, framework := range detected.Frameworks {
+					frameworks[framework] = true
+				}
+			}
+
+			for pm := range packageManagers {
+				stack.PackageManagers = append(stack.PackageManagers, pm)
+			}
+			sort.Strings(stack.PackageManagers)
+			for framework := range frameworks {
+				stack.Frameworks = append(stack.Frameworks, framework)
+			}
+			sort.Strings(stack.Frameworks)
+
+			if len(stack.Manifests) == 0 {
+				stack.Notes = append(stack.Notes, "no known manifest file was found at the repository root; the stack is inferred from languages only")
+			}
+
+			return MarshalledTextResult(stack), nil
+		}
+}
@@ -0,0 +1,158 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockRepositoryFiles serves the root directory listing and the content of each file in files.
+func mockRepositoryFiles(t *testing.T, files map[string]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/repos/owner/repo/contents")
+		path = strings.TrimPrefix(path, "/")
+		if path == "" {
+			var entries []*github.RepositoryContent
+			for name := range files {
+				entries = append(entries, &github.RepositoryContent{Name: github.Ptr(name), Path: github.Ptr(name), Type: github.Ptr("file")})
+			}
+			entries = append(entries, &github.RepositoryContent{Name: github.Ptr("src"), Path: github.Ptr("src"), Type: github.Ptr("dir")})
+			mockResponse(t, http.StatusOK, entries)(w, r)
+			return
+		}
+		content, ok := files[path]
+		if !ok {
+			mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"})(w, r)
+			return
+		}
+		mockResponse(t, http.StatusOK, &github.RepositoryContent{
+			Name:     github.Ptr(path),
+			Path:     github.Ptr(path),
+			Type:     github.Ptr("file"),
+			Encoding: github.Ptr("base64"),
+			Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(content))),
+		})(w, r)
+	}
+}
+
+func Test_DetectTechStack(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DetectTechStack(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "detect_tech_stack", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name          string
+		languages     map[string]int
+		files         map[string]string
+		expectedStack TechStack
+	}{
+		{
+			name:      "Go service with a TypeScript frontend",
+			languages: map[string]int{"Go": 7500, "TypeScript": 2000, "CSS": 500},
+			files: map[string]string{
+				"go.mod": "module example.com/app\n\ngo 1.23\n\nrequire (\n\tgithub.com/gin-gonic/gin v1.10.0\n\tgithub.com/spf13/cobra v1.9.1\n)\n",
+				"package.json": `{
+					"dependencies": {"react": "^18.0.0", "next": "^14.0.0"},
+					"devDependencies": {"typescript": "^5.0.0"}
+				}`,
+				"pnpm-lock.yaml": "lockfileVersion: '9.0'\n",
+				"README.md":      "# app\n",
+			},
+			expectedStack: TechStack{
+				PrimaryLanguage: "Go",
+				Languages: []LanguageShare{
+					{Name: "Go", Bytes: 7500, Percent: 75},
+					{Name: "TypeScript", Bytes: 2000, Percent: 20},
+					{Name: "CSS", Bytes: 500, Percent: 5},
+				},
+				Manifests: []DetectedManifest{
+					{Path: "go.mod", Ecosystem: "Go", PackageManager: "go modules", Frameworks: []string{"Cobra", "Gin"}},
+					{Path: "package.json", Ecosystem: "JavaScript/TypeScript", PackageManager: "pnpm", Frameworks: []string{"Next.js", "React"}},
+				},
+				PackageManagers: []string{"go modules", "pnpm"},
+				Frameworks:      []string{"Cobra", "Gin", "Next.js", "React"},
+			},
+		},
+		{
+			name:      "Python and Rust with an unreadable manifest",
+			languages: map[string]int{"Python": 900, "Rust": 100},
+			files: map[string]string{
+				"requirements.txt": "# web\nDjango>=4.2\ncelery==5.3.0\nflask[async]\n",
+				"Cargo.toml":       "[package]\nname = \"ext\"\n\n[dependencies]\ntokio = { version = \"1\", features = [\"full\"] }\n",
+				"package.json":     "{not json",
+			},
+			expectedStack: TechStack{
+				PrimaryLanguage: "Python",
+				Languages: []LanguageShare{
+					{Name: "Python", Bytes: 900, Percent: 90},
+					{Name: "Rust", Bytes: 100, Percent: 10},
+				},
+				Manifests: []DetectedManifest{
+					{Path: "Cargo.toml", Ecosystem: "Rust", PackageManager: "cargo", Frameworks: []string{"Tokio"}},
+					{Path: "package.json", Ecosystem: "JavaScript/TypeScript", PackageManager: "npm", Frameworks: nil},
+					{Path: "requirements.txt", Ecosystem: "Python", PackageManager: "pip", Frameworks: []string{"Django", "Flask"}},
+				},
+				PackageManagers: []string{"cargo", "npm", "pip"},
+				Frameworks:      []string{"Django", "Flask", "Tokio"},
+				Notes:           []string{"failed to parse package.json: invalid character 'n' looking for beginning of object key string"},
+			},
+		},
+		{
+			name:      "no manifests",
+			languages: map[string]int{"Shell": 42},
+			files:     map[string]string{"install.sh": "#!/bin/sh\n"},
+			expectedStack: TechStack{
+				PrimaryLanguage: "Shell",
+				Languages:       []LanguageShare{{Name: "Shell", Bytes: 42, Percent: 100}},
+				Manifests:       []DetectedManifest{},
+				PackageManagers: []string{},
+				Frameworks:      []string{},
+				Notes:           []string{"no known manifest file was found at the repository root; the stack is inferred from languages only"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedClient := mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposLanguagesByOwnerByRepo,
+					tc.languages,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockRepositoryFiles(t, tc.files),
+				),
+			)
+			client := github.NewClient(mockedClient)
+			_, handler := DetectTechStack(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			}))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			var stack TechStack
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &stack))
+			assert.Equal(t, tc.expectedStack, stack)
+		})
+	}
+}
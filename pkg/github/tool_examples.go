@@ -0,0 +1,164 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/toolsets"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// toolExamples holds example arguments for tools whose parameters are easy to get wrong. The
+// examples are added to the tool descriptions when the toolset group is built.
+var toolExamples = map[string][]map[string]any{
+	"get_file_contents": {
+		{"owner": "github", "repo": "github-mcp-server", "path": "README.md"},
+		{"owner": "github", "repo": "github-mcp-server", "path": "pkg/github/", "ref": "refs/heads/main"},
+	},
+	"search_code": {
+		{"query": "content:NewMCPServer language:go repo:github/github-mcp-server"},
+	},
+	"search_issues": {
+		{"query": "is:open label:bug", "owner": "github", "repo": "github-mcp-server", "sort": "updated", "order": "desc"},
+	},
+	"get_issue": {
+		{"owner": "github", "repo": "github-mcp-server", "issue_number": 42},
+	},
+	"create_issue": {
+		{"owner": "github", "repo": "github-mcp-server", "title": "Document the projects toolset", "body": "The README does not mention the projects toolset.", "labels": []string{"documentation"}},
+	},
+	"list_issues": {
+		{"owner": "github", "repo": "github-mcp-server", "state": "OPEN", "labels": []string{"bug"}, "orderBy": "UPDATED_AT", "direction": "DESC"},
+	},
+	"list_pull_requests": {
+		{"owner": "github", "repo": "github-mcp-server", "state": "open", "base": "main", "sort": "updated", "direction": "desc"},
+	},
+	"create_pull_request": {
+		{"owner": "github", "repo": "github-mcp-server", "title": "Fix typo in README", "head": "fix-typo", "base": "main", "draft": true},
+	},
+	"update_merge_queue": {
+		{"owner": "github", "repo": "github-mcp-server", "branch": "main", "merge_method": "SQUASH", "max_entries_to_merge": 5},
+	},
+}
+
+// AddToolExamples appends the examples declared for the tools in tsg to their descriptions.
+// Examples that do not match their tool's schema are logged, so call it once logging is set up.
+func AddToolExamples(tsg *toolsets.ToolsetGroup) {
+	tsg.UpdateTools(withToolExamples)
+}
+
+// withToolExamples appends the examples declared for tool to its description. An example that
+// does not satisfy the tool's input schema is logged and left out; Test_ToolExamplesMatchSchemas
+// keeps the table from drifting from the tools.
+func withToolExamples(tool mcp.Tool) mcp.Tool {
+	examples, ok := toolExamples[tool.Name]
+	if !ok {
+		return tool
+	}
+
+	encoded := make([]string, 0, len(examples))
+	for i, example := range examples {
+		args, err := validateToolArguments(tool.InputSchema, example)
+		if err != nil {
+			slog.Warn("dropping tool example that does not match its schema", "tool", tool.Name, "example", i, "error", err)
+			continue
+		}
+		b, err := json.Marshal(args)
+		if err != nil {
+			slog.Warn("dropping tool example that cannot be encoded", "tool", tool.Name, "example", i, "error", err)
+			continue
+		}
+		encoded = append(encoded, string(b))
+	}
+	if len(encoded) == 0 {
+		return tool
+	}
+
+	tool.Description += "\n\nExample arguments:\n" + strings.Join(encoded, "\n")
+	return tool
+}
+
+// validateToolArguments checks args against the subset of JSON schema that tool definitions use:
+// required and unknown properties, types, enums, numeric bounds and array item types. It returns
+// the arguments as a client would send them, with numbers as float64 and arrays as []any.
+func validateToolArguments(schema mcp.ToolInputSchema, args map[string]any) (map[string]any, error) {
+	encoded, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, err
+	}
+
+	for _, name := range schema.Required {
+		if _, ok := decoded[name]; !ok {
+			return nil, fmt.Errorf("missing required parameter %q", name)
+		}
+	}
+
+	names := make([]string, 0, len(decoded))
+	for name := range decoded {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		property, ok := schema.Properties[name].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("unknown parameter %q", name)
+		}
+		if err := validateSchemaValue(property, decoded[name]); err != nil {
+			return nil, fmt.Errorf("parameter %q: %w", name, err)
+		}
+	}
+	return decoded, nil
+}
+
+func validateSchemaValue(schema map[string]any, value any) error {
+	switch schema["type"] {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected a string, got %T", value)
+		}
+		if enum, ok := schema["enum"].([]string); ok && !slices.Contains(enum, s) {
+			return fmt.Errorf("%q is not one of %s", s, strings.Join(enum, ", "))
+		}
+	case "number":
+		n, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("expected a number, got %T", value)
+		}
+		if minimum, ok := schema["minimum"].(float64); ok && n < minimum {
+			return fmt.Errorf("%v is less than the minimum of %v", n, minimum)
+		}
+		if maximum, ok := schema["maximum"].(float64); ok && n > maximum {
+			return fmt.Errorf("%v is greater than the maximum of %v", n, maximum)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("expected a boolean, got %T", value)
+		}
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("expected an array, got %T", value)
+		}
+		if itemSchema, ok := schema["items"].(map[string]any); ok {
+			for i, item := range items {
+				if err := validateSchemaValue(itemSchema, item); err != nil {
+					return fmt.Errorf("item %d: %w", i, err)
+				}
+			}
+		}
+	case "object":
+		if _, ok := value.(map[string]any); !ok {
+			return fmt.Errorf("expected an object, got %T", value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package github
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ToolExamplesMatchSchemas(t *testing.T) {
+	tsg := DefaultToolsetGroup(false, stubGetClientFn(github.NewClient(nil)), nil, nil, translations.NullTranslationHelper, 5000)
+	AddToolExamples(tsg)
+
+	tools := map[string]mcp.Tool{}
+	for _, toolset := range tsg.Toolsets {
+		for _, tool := range toolset.GetAvailableTools() {
+			tools[tool.Tool.Name] = tool.Tool
+		}
+	}
+
+	for name, examples := range toolExamples {
+		t.Run(name, func(t *testing.T) {
+			tool, ok := tools[name]
+			require.True(t, ok, "examples are declared for a tool that does not exist")
+			require.NotEmpty(t, examples)
+			for i, example := range examples {
+				_, err := validateToolArguments(tool.InputSchema, example)
+				assert.NoError(t, err, "example %d", i)
+			}
+			assert.Contains(t, tool.Description, "\n\nExample arguments:\n")
+		})
+	}
+}
+
+func Test_ValidateToolArguments(t *testing.T) {
+	tool, _ := UpdateMergeQueue(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			args: map[string]any{"owner": "o", "repo": "r", "branch": "main", "merge_method": "MERGE", "max_entries_to_build": 10},
+		},
+		{
+			name:        "missing required parameter",
+			args:        map[string]any{"owner": "o", "repo": "r"},
+			expectedErr: `missing required parameter "branch"`,
+		},
+		{
+			name:        "unknown parameter",
+			args:        map[string]any{"owner": "o", "repo": "r", "branch": "main", "method": "MERGE"},
+			expectedErr: `unknown parameter "method"`,
+		},
+		{
+			name:        "wrong type",
+			args:        map[string]any{"owner": "o", "repo": "r", "branch": "main", "max_entries_to_build": "10"},
+			expectedErr: `parameter "max_entries_to_build": expected a number, got string`,
+		},
+		{
+			name:        "value not in enum",
+			args:        map[string]any{"owner": "o", "repo": "r", "branch": "main", "merge_method": "merge"},
+			expectedErr: `parameter "merge_method": "merge" is not one of MERGE, SQUASH, REBASE`,
+		},
+		{
+			name:        "value out of bounds",
+			args:        map[string]any{"owner": "o", "repo": "r", "branch": "main", "check_response_timeout_minutes": 0},
+			expectedErr: `parameter "check_response_timeout_minutes": 0 is less than the minimum of 1`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := validateToolArguments(tool.InputSchema, tc.args)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func Test_WithToolExamplesDropsInvalidExamples(t *testing.T) {
+	tool, _ := GetIssue(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+
+	original := toolExamples
+	t.Cleanup(func() { toolExamples = original })
+	toolExamples = map[string][]map[string]any{
+		"get_issue": {
+			{"owner": "github", "repo": "github-mcp-server", "issue_number": "42"},
+			{"owner": "github", "repo": "github-mcp-server", "issue_number": 7},
+		},
+	}
+
+	var logBuffer bytes.Buffer
+	defaultLogger := slog.Default()
+	t.Cleanup(func() { slog.SetDefault(defaultLogger) })
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logBuffer, nil)))
+
+	described := withToolExamples(tool)
+	assert.Equal(t, tool.Description+"\n\nExample arguments:\n"+`{"issue_number":7,"owner":"github","repo":"github-mcp-server"}`, described.Description)
+	assert.Contains(t, logBuffer.String(), "dropping tool example that does not match its schema")
+	assert.Contains(t, logBuffer.String(), `parameter \"issue_number\": expected a number, got string`)
+
+	toolExamples = map[string][]map[string]any{
+		"get_issue": {{"owner": "github"}},
+	}
+	assert.Equal(t, tool.Description, withToolExamples(tool).Description, "a tool without valid examples keeps its description")
+}
+
+func Test_DefaultToolsetGroupLeavesExamplesOut(t *testing.T) {
+	original := toolExamples
+	t.Cleanup(func() { toolExamples = original })
+	toolExamples = map[string][]map[string]any{
+		"get_issue": {{"owner": "github"}},
+	}
+
+	var logBuffer bytes.Buffer
+	defaultLogger := slog.Default()
+	t.Cleanup(func() { slog.SetDefault(defaultLogger) })
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logBuffer, nil)))
+
+	// The server counts its tools with DefaultToolsetGroup before logging is set up, so building
+	// the group must not validate the examples.
+	DefaultToolsetGroup(false, stubGetClientFn(github.NewClient(nil)), nil, nil, translations.NullTranslationHelper, 5000)
+	assert.Empty(t, logBuffer.String())
+
+	AddToolExamples(DefaultToolsetGroup(false, stubGetClientFn(github.NewClient(nil)), nil, nil, translations.NullTranslationHelper, 5000))
+	assert.Contains(t, logBuffer.String(), "dropping tool example that does not match its schema")
+}
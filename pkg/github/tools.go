@@ -18,6 +18,7 @@ var DefaultTools = []string{"all"}
 
 func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetGQLClientFn, getRawClient raw.GetRawClientFn, t translations.TranslationHelperFunc, contentWindowSize int) *toolsets.ToolsetGroup {
 	tsg := toolsets.NewToolsetGroup(readOnly)
+	operations := NewOperationRegistry()
 
 	// Define all available features with their default state (disabled)
 	// Create toolsets
@@ -25,26 +26,75 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 		AddReadTools(
 			toolsets.NewServerTool(SearchRepositories(getClient, t)),
 			toolsets.NewServerTool(GetFileContents(getClient, getRawClient, t)),
+			toolsets.NewServerTool(GetRawContent(getClient, getRawClient, t)),
 			toolsets.NewServerTool(ListCommits(getClient, t)),
+			toolsets.NewServerTool(CompareRefs(getClient, t)),
+			toolsets.NewServerTool(GetBlame(getGQLClient, t)),
+			toolsets.NewServerTool(ListRepositoryActivity(getClient, t)),
+			toolsets.NewServerTool(SuggestReviewersForFile(getClient, t)),
+			toolsets.NewServerTool(ResolveGitHubURL(getClient, t)),
+			toolsets.NewServerTool(AnalyzeCodeownersCoverage(getClient, t)),
 			toolsets.NewServerTool(SearchCode(getClient, t)),
 			toolsets.NewServerTool(GetCommit(getClient, t)),
+			toolsets.NewServerTool(GetGitRef(getClient, t)),
+			toolsets.NewServerTool(GetGitTree(getClient, t)),
+			toolsets.NewServerTool(GetGitBlob(getClient, t)),
 			toolsets.NewServerTool(ListBranches(getClient, t)),
 			toolsets.NewServerTool(ListTags(getClient, t)),
 			toolsets.NewServerTool(GetTag(getClient, t)),
+			toolsets.NewServerTool(ListTagProtection(getClient, t)),
+			toolsets.NewServerTool(GetBranchProtection(getClient, t)),
+			toolsets.NewServerTool(ListRulesets(getClient, t)),
+			toolsets.NewServerTool(GetRuleset(getClient, t)),
+			toolsets.NewServerTool(ListCollaborators(getClient, t)),
+			toolsets.NewServerTool(GetDeploymentProtectionRules(getClient, t)),
+			toolsets.NewServerTool(CompareDeploymentProtection(getClient, t)),
 			toolsets.NewServerTool(ListReleases(getClient, t)),
 			toolsets.NewServerTool(GetLatestRelease(getClient, t)),
 			toolsets.NewServerTool(GetReleaseByTag(getClient, t)),
 			toolsets.NewServerTool(ListStarredRepositories(getClient, t)),
+			toolsets.NewServerTool(ListStargazers(getClient, t)),
+			toolsets.NewServerTool(GetRepositoryTraffic(getClient, t)),
+			toolsets.NewServerTool(GetRepositoryParticipation(getClient, t)),
+			toolsets.NewServerTool(ListWatchers(getClient, t)),
+			toolsets.NewServerTool(ListForks(getClient, t)),
+			toolsets.NewServerTool(GetForkNetwork(getClient, t)),
+			toolsets.NewServerTool(GetRepositoryLicense(getClient, t)),
+			toolsets.NewServerTool(ListLicenses(getClient, t)),
+			toolsets.NewServerTool(GetLicense(getClient, t)),
+			toolsets.NewServerTool(ListGitignoreTemplates(getClient, t)),
+			toolsets.NewServerTool(GetGitignoreTemplate(getClient, t)),
+			toolsets.NewServerTool(ListRepositoryTopics(getClient, t)),
+			toolsets.NewServerTool(DetectTechStack(getClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(CreateOrUpdateFile(getClient, t)),
 			toolsets.NewServerTool(CreateRepository(getClient, t)),
+			toolsets.NewServerTool(UpdateRepository(getClient, t)),
+			toolsets.NewServerTool(ArchiveRepository(getClient, t)),
+			toolsets.NewServerTool(UnarchiveRepository(getClient, t)),
+			toolsets.NewServerTool(TransferRepository(getClient, t)),
 			toolsets.NewServerTool(ForkRepository(getClient, t)),
+			toolsets.NewServerTool(SyncFork(getClient, t)),
 			toolsets.NewServerTool(CreateBranch(getClient, t)),
 			toolsets.NewServerTool(PushFiles(getClient, t)),
 			toolsets.NewServerTool(DeleteFile(getClient, t)),
+			toolsets.NewServerTool(CreateGitCommit(getClient, t)),
+			toolsets.NewServerTool(CreateGitRef(getClient, t)),
+			toolsets.NewServerTool(UpdateGitRef(getClient, t)),
 			toolsets.NewServerTool(StarRepository(getClient, t)),
 			toolsets.NewServerTool(UnstarRepository(getClient, t)),
+			toolsets.NewServerTool(ReplaceRepositoryTopics(getClient, t)),
+			toolsets.NewServerTool(CreateTag(getClient, t)),
+			toolsets.NewServerTool(DeleteTag(getClient, t)),
+			toolsets.NewServerTool(CreateTagProtection(getClient, t)),
+			toolsets.NewServerTool(UpdateBranchProtection(getClient, t)),
+			toolsets.NewServerTool(UpdateRuleset(getClient, t)),
+			toolsets.NewServerTool(AddCollaborator(getClient, t)),
+			toolsets.NewServerTool(RemoveCollaborator(getClient, t)),
+			toolsets.NewServerTool(CreateRelease(getClient, t)),
+			toolsets.NewServerTool(UpdateRelease(getClient, t)),
+			toolsets.NewServerTool(DeleteRelease(getClient, t)),
 		).
 		AddResourceTemplates(
 			toolsets.NewServerResourceTemplate(GetRepositoryResourceContent(getClient, getRawClient, t)),
@@ -59,17 +109,33 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(SearchIssues(getClient, t)),
 			toolsets.NewServerTool(ListIssues(getGQLClient, t)),
 			toolsets.NewServerTool(GetIssueComments(getClient, t)),
+			toolsets.NewServerTool(SummarizeIssue(getClient, t)),
 			toolsets.NewServerTool(ListIssueTypes(getClient, t)),
 			toolsets.NewServerTool(ListSubIssues(getClient, t)),
+			toolsets.NewServerTool(ListLabels(getClient, t)),
+			toolsets.NewServerTool(ListMilestones(getClient, t)),
+			toolsets.NewServerTool(ListReactions(getClient, getGQLClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(CreateIssue(getClient, t)),
 			toolsets.NewServerTool(AddIssueComment(getClient, t)),
 			toolsets.NewServerTool(UpdateIssue(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(SetMilestoneForIssues(getClient, t)),
+			toolsets.NewServerTool(BulkLabelByQuery(getClient, t)),
+			toolsets.NewServerTool(SetIssueLabels(getClient, t)),
+			toolsets.NewServerTool(CreateIssues(getClient, t)),
 			toolsets.NewServerTool(AssignCopilotToIssue(getGQLClient, t)),
 			toolsets.NewServerTool(AddSubIssue(getClient, t)),
 			toolsets.NewServerTool(RemoveSubIssue(getClient, t)),
 			toolsets.NewServerTool(ReprioritizeSubIssue(getClient, t)),
+			toolsets.NewServerTool(CreateLabel(getClient, t)),
+			toolsets.NewServerTool(UpdateLabel(getClient, t)),
+			toolsets.NewServerTool(DeleteLabel(getClient, t)),
+			toolsets.NewServerTool(CreateMilestone(getClient, t)),
+			toolsets.NewServerTool(UpdateMilestone(getClient, t)),
+			toolsets.NewServerTool(CloseMilestone(getClient, t)),
+			toolsets.NewServerTool(AddReaction(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(RemoveReaction(getClient, getGQLClient, t)),
 		).AddPrompts(
 		toolsets.NewServerPrompt(AssignCodingAgentPrompt(t)),
 		toolsets.NewServerPrompt(IssueToFixWorkflowPrompt(t)),
@@ -81,6 +147,18 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 	orgs := toolsets.NewToolset("orgs", "GitHub Organization related tools").
 		AddReadTools(
 			toolsets.NewServerTool(SearchOrgs(getClient, t)),
+			toolsets.NewServerTool(ListOrgMembers(getClient, t)),
+			toolsets.NewServerTool(ListOrgTeams(getClient, t)),
+			toolsets.NewServerTool(GetTeamMembership(getClient, t)),
+			toolsets.NewServerTool(ListTeamRepositories(getClient, t)),
+			toolsets.NewServerTool(GetCopilotBilling(getClient, t)),
+			toolsets.NewServerTool(ListCopilotSeats(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(AddTeamMember(getClient, t)),
+			toolsets.NewServerTool(RemoveTeamMember(getClient, t)),
+			toolsets.NewServerTool(AddCopilotSeats(getClient, t)),
+			toolsets.NewServerTool(RemoveCopilotSeats(getClient, t)),
 		)
 	pullRequests := toolsets.NewToolset("pull_requests", "GitHub Pull Request related tools").
 		AddReadTools(
@@ -89,21 +167,42 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(GetPullRequestFiles(getClient, t)),
 			toolsets.NewServerTool(SearchPullRequests(getClient, t)),
 			toolsets.NewServerTool(GetPullRequestStatus(getClient, t)),
+			toolsets.NewServerTool(GetPullRequestCheckAnnotations(getClient, t)),
 			toolsets.NewServerTool(GetPullRequestReviewComments(getClient, t)),
+			toolsets.NewServerTool(ListPullRequestReviewThreads(getClient, t)),
+			toolsets.NewServerTool(ListPullRequestReviewRequests(getClient, t)),
+			toolsets.NewServerTool(GetPullRequestReviewThreadContext(getClient, t)),
+			toolsets.NewServerTool(ListPullRequestCommitComments(getClient, t)),
 			toolsets.NewServerTool(GetPullRequestReviews(getClient, t)),
+			toolsets.NewServerTool(PullRequestReviewMetrics(getClient, t)),
 			toolsets.NewServerTool(GetPullRequestDiff(getClient, t)),
+			toolsets.NewServerTool(CheckMergeability(getClient, operations, t)),
+			toolsets.NewServerTool(GetMergeQueue(getClient, t)),
+			toolsets.NewServerTool(ListMergeQueueEntries(getGQLClient, t)),
+			toolsets.NewServerTool(GetPullRequestLinkedIssues(getGQLClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(MergePullRequest(getClient, t)),
+			toolsets.NewServerTool(UpdatePullRequestAutoMerge(getGQLClient, t)),
+			toolsets.NewServerTool(MarkPullRequestReadyForReview(getGQLClient, t)),
+			toolsets.NewServerTool(ConvertPullRequestToDraft(getGQLClient, t)),
 			toolsets.NewServerTool(UpdatePullRequestBranch(getClient, t)),
 			toolsets.NewServerTool(CreatePullRequest(getClient, t)),
+			toolsets.NewServerTool(OpenPullRequestWithChanges(getClient, t)),
 			toolsets.NewServerTool(UpdatePullRequest(getClient, getGQLClient, t)),
 			toolsets.NewServerTool(RequestCopilotReview(getClient, t)),
+			toolsets.NewServerTool(RequestPullRequestReviewers(getClient, t)),
+			toolsets.NewServerTool(RemovePullRequestReviewRequests(getClient, t)),
+			toolsets.NewServerTool(UpdateMergeQueue(getClient, t)),
+			toolsets.NewServerTool(EnqueuePullRequest(getGQLClient, t)),
+			toolsets.NewServerTool(DequeuePullRequest(getGQLClient, t)),
 
 			// Reviews
 			toolsets.NewServerTool(CreateAndSubmitPullRequestReview(getGQLClient, t)),
+			toolsets.NewServerTool(CreatePullRequestReview(getClient, t)),
 			toolsets.NewServerTool(CreatePendingPullRequestReview(getGQLClient, t)),
 			toolsets.NewServerTool(AddCommentToPendingReview(getGQLClient, t)),
+			toolsets.NewServerTool(ReplyToPullRequestReviewThread(getClient, t)),
 			toolsets.NewServerTool(SubmitPendingPullRequestReview(getGQLClient, t)),
 			toolsets.NewServerTool(DeletePendingPullRequestReview(getGQLClient, t)),
 		)
@@ -111,16 +210,27 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 		AddReadTools(
 			toolsets.NewServerTool(GetCodeScanningAlert(getClient, t)),
 			toolsets.NewServerTool(ListCodeScanningAlerts(getClient, t)),
+			toolsets.NewServerTool(GetSecurityAndAnalysisSettings(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(UpdateSecuritySettings(getClient, t)),
+			toolsets.NewServerTool(DismissCodeScanningAlert(getClient, t)),
+			toolsets.NewServerTool(ReopenCodeScanningAlert(getClient, t)),
 		)
 	secretProtection := toolsets.NewToolset("secret_protection", "Secret protection related tools, such as GitHub Secret Scanning").
 		AddReadTools(
 			toolsets.NewServerTool(GetSecretScanningAlert(getClient, t)),
 			toolsets.NewServerTool(ListSecretScanningAlerts(getClient, t)),
+			toolsets.NewServerTool(ListSecretScanningAlertLocations(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(UpdateSecretScanningAlert(getClient, t)),
 		)
 	dependabot := toolsets.NewToolset("dependabot", "Dependabot tools").
 		AddReadTools(
 			toolsets.NewServerTool(GetDependabotAlert(getClient, t)),
 			toolsets.NewServerTool(ListDependabotAlerts(getClient, t)),
+			toolsets.NewServerTool(GetDependabotConfig(getClient, t)),
 		)
 
 	notifications := toolsets.NewToolset("notifications", "GitHub Notifications related tools").
@@ -141,6 +251,11 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(GetDiscussion(getGQLClient, t)),
 			toolsets.NewServerTool(GetDiscussionComments(getGQLClient, t)),
 			toolsets.NewServerTool(ListDiscussionCategories(getGQLClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(CreateDiscussion(getGQLClient, t)),
+			toolsets.NewServerTool(AddDiscussionComment(getGQLClient, t)),
+			toolsets.NewServerTool(MarkDiscussionCommentAsAnswer(getGQLClient, t)),
 		)
 
 	actions := toolsets.NewToolset("actions", "GitHub Actions workflows and CI/CD operations").
@@ -148,19 +263,39 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(ListWorkflows(getClient, t)),
 			toolsets.NewServerTool(ListWorkflowRuns(getClient, t)),
 			toolsets.NewServerTool(GetWorkflowRun(getClient, t)),
-			toolsets.NewServerTool(GetWorkflowRunLogs(getClient, t)),
+			toolsets.NewServerTool(WaitForWorkflowRun(getClient, operations, t)),
+			toolsets.NewServerTool(GetWorkflowRunLogs(getClient, t, contentWindowSize)),
 			toolsets.NewServerTool(ListWorkflowJobs(getClient, t)),
+			toolsets.NewServerTool(AnalyzeWorkflowRun(getClient, t)),
+			toolsets.NewServerTool(CompareWorkflowRuns(getClient, t)),
 			toolsets.NewServerTool(GetJobLogs(getClient, t, contentWindowSize)),
 			toolsets.NewServerTool(ListWorkflowRunArtifacts(getClient, t)),
 			toolsets.NewServerTool(DownloadWorkflowRunArtifact(getClient, t)),
 			toolsets.NewServerTool(GetWorkflowRunUsage(getClient, t)),
+			toolsets.NewServerTool(GetWorkflowUsage(getClient, t)),
+			toolsets.NewServerTool(GetActionsBilling(getClient, t)),
+			toolsets.NewServerTool(ListSelfHostedRunners(getClient, t)),
+			toolsets.NewServerTool(GetRunner(getClient, t)),
+			toolsets.NewServerTool(ListRunnerGroups(getClient, t)),
+			toolsets.NewServerTool(ListOrgSecrets(getClient, t)),
+			toolsets.NewServerTool(ListOrgVariables(getClient, t)),
+			toolsets.NewServerTool(ListVariables(getClient, t)),
+			toolsets.NewServerTool(GetVariable(getClient, t)),
+			toolsets.NewServerTool(GetCombinedCommitStatus(getClient, t)),
+			toolsets.NewServerTool(ListCommitCheckRuns(getClient, t)),
+			toolsets.NewServerTool(ListCommitCheckSuites(getClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(RunWorkflow(getClient, t)),
+			toolsets.NewServerTool(CreateRepositoryDispatch(getClient, t)),
 			toolsets.NewServerTool(RerunWorkflowRun(getClient, t)),
 			toolsets.NewServerTool(RerunFailedJobs(getClient, t)),
 			toolsets.NewServerTool(CancelWorkflowRun(getClient, t)),
 			toolsets.NewServerTool(DeleteWorkflowRunLogs(getClient, t)),
+			toolsets.NewServerTool(RemoveRunner(getClient, t)),
+			toolsets.NewServerTool(SetOrgSecret(getClient, t)),
+			toolsets.NewServerTool(SetOrgVariable(getClient, t)),
+			toolsets.NewServerTool(CreateCommitStatus(getClient, t)),
 		)
 
 	securityAdvisories := toolsets.NewToolset("security_advisories", "Security advisories related tools").
@@ -168,7 +303,60 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(ListGlobalSecurityAdvisories(getClient, t)),
 			toolsets.NewServerTool(GetGlobalSecurityAdvisory(getClient, t)),
 			toolsets.NewServerTool(ListRepositorySecurityAdvisories(getClient, t)),
+			toolsets.NewServerTool(GetRepositorySecurityAdvisory(getClient, t)),
 			toolsets.NewServerTool(ListOrgRepositorySecurityAdvisories(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(CreateRepositorySecurityAdvisory(getClient, t)),
+			toolsets.NewServerTool(RequestRepositorySecurityAdvisoryCVE(getClient, t)),
+		)
+
+	deployments := toolsets.NewToolset("deployments", "GitHub Deployments and deployment environments related tools").
+		AddReadTools(
+			toolsets.NewServerTool(ListDeployments(getClient, t)),
+			toolsets.NewServerTool(ListDeploymentStatuses(getClient, t)),
+			toolsets.NewServerTool(ListEnvironments(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(CreateDeployment(getClient, t)),
+			toolsets.NewServerTool(CreateDeploymentStatus(getClient, t)),
+			toolsets.NewServerTool(CreateOrUpdateEnvironment(getClient, t)),
+		)
+
+	packages := toolsets.NewToolset("packages", "GitHub Packages related tools").
+		AddReadTools(
+			toolsets.NewServerTool(ListPackages(getClient, t)),
+			toolsets.NewServerTool(GetPackage(getClient, t)),
+			toolsets.NewServerTool(ListPackageVersions(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(DeletePackageVersion(getClient, t)),
+		)
+
+	webhooks := toolsets.NewToolset("webhooks", "Repository and organization webhook related tools").
+		AddReadTools(
+			toolsets.NewServerTool(ListWebhooks(getClient, t)),
+			toolsets.NewServerTool(ListWebhookDeliveries(getClient, t)),
+			toolsets.NewServerTool(GetWebhookDelivery(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(CreateWebhook(getClient, t)),
+			toolsets.NewServerTool(UpdateWebhook(getClient, t)),
+			toolsets.NewServerTool(PingWebhook(getClient, t)),
+			toolsets.NewServerTool(DeleteWebhook(getClient, t)),
+			toolsets.NewServerTool(RedeliverWebhookDelivery(getClient, t)),
+		)
+
+	codespaces := toolsets.NewToolset("codespaces", "GitHub Codespaces related tools").
+		AddReadTools(
+			toolsets.NewServerTool(ListCodespaces(getClient, t)),
+			toolsets.NewServerTool(ListCodespaceMachines(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(CreateCodespace(getClient, t)),
+			toolsets.NewServerTool(StartCodespace(getClient, t)),
+			toolsets.NewServerTool(StopCodespace(getClient, t)),
+			toolsets.NewServerTool(DeleteCodespace(getClient, t)),
 		)
 
 	// Keep experiments alive so the system doesn't error out when it's always enabled
@@ -179,15 +367,36 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(GetMe(getClient, t)),
 			toolsets.NewServerTool(GetTeams(getClient, getGQLClient, t)),
 			toolsets.NewServerTool(GetTeamMembers(getGQLClient, t)),
+			toolsets.NewServerTool(GetGitHubStatus(getClient, t)),
+			toolsets.NewServerTool(RenderMarkdown(getClient, t)),
+			toolsets.NewServerTool(CancelOperation(operations, t)),
 		)
 
 	gists := toolsets.NewToolset("gists", "GitHub Gist related tools").
 		AddReadTools(
 			toolsets.NewServerTool(ListGists(getClient, t)),
+			toolsets.NewServerTool(GetGist(getClient, t)),
+			toolsets.NewServerTool(IsGistStarred(getClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(CreateGist(getClient, t)),
 			toolsets.NewServerTool(UpdateGist(getClient, t)),
+			toolsets.NewServerTool(DeleteGist(getClient, t)),
+			toolsets.NewServerTool(StarGist(getClient, t)),
+			toolsets.NewServerTool(UnstarGist(getClient, t)),
+			toolsets.NewServerTool(ForkGist(getClient, t)),
+		)
+
+	projects := toolsets.NewToolset("projects", "GitHub Projects related tools").
+		AddReadTools(
+			toolsets.NewServerTool(ListProjects(getGQLClient, t)),
+			toolsets.NewServerTool(GetProject(getGQLClient, t)),
+			toolsets.NewServerTool(ListProjectItems(getGQLClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(AddProjectItem(getGQLClient, t)),
+			toolsets.NewServerTool(UpdateProjectItemField(getGQLClient, t)),
+			toolsets.NewServerTool(MoveProjectItem(getGQLClient, t)),
 		)
 
 	// Add toolsets to the group
@@ -205,7 +414,12 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 	tsg.AddToolset(experiments)
 	tsg.AddToolset(discussions)
 	tsg.AddToolset(gists)
+	tsg.AddToolset(projects)
 	tsg.AddToolset(securityAdvisories)
+	tsg.AddToolset(deployments)
+	tsg.AddToolset(packages)
+	tsg.AddToolset(webhooks)
+	tsg.AddToolset(codespaces)
 
 	return tsg
 }
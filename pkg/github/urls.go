@@ -0,0 +1,196 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// lineAnchorPattern matches the fragment GitHub adds to blob URLs when lines are selected, such as
+// L10, L10-L20 or L10C3-L20C8.
+var lineAnchorPattern = regexp.MustCompile(`^L(\d+)(?:C\d+)?(?:-L(\d+)(?:C\d+)?)?$`)
+
+// FileLines is the part of a file a blob URL points at.
+type FileLines struct {
+	Owner      string `json:"owner"`
+	Repo       string `json:"repo"`
+	Ref        string `json:"ref"`
+	Path       string `json:"path"`
+	StartLine  int    `json:"start_line"`
+	EndLine    int    `json:"end_line"`
+	TotalLines int    `json:"total_lines"`
+	Content    string `json:"content"`
+}
+
+// webHostForAPI returns the host of the GitHub web UI that is served alongside the REST API at
+// apiURL: api.github.com for github.com, api.<tenant>.ghe.com for <tenant>.ghe.com, and the
+// same host for GitHub Enterprise Server, whose API lives under /api/v3/.
+func webHostForAPI(apiURL *url.URL) string {
+	host := apiURL.Hostname()
+	if strings.HasPrefix(apiURL.Path, "/api/") {
+		return host
+	}
+	return strings.TrimPrefix(host, "api.")
+}
+
+// parseLineAnchor returns the line range selected by a blob URL fragment. An empty fragment
+// selects no range.
+func parseLineAnchor(fragment string) (start, end int, err error) {
+	if fragment == "" {
+		return 0, 0, nil
+	}
+	m := lineAnchorPattern.FindStringSubmatch(fragment)
+	if m == nil {
+		return 0, 0, fmt.Errorf("unsupported line anchor #%s", fragment)
+	}
+	start, _ = strconv.Atoi(m[1])
+	end = start
+	if m[2] != "" {
+		end, _ = strconv.Atoi(m[2])
+	}
+	if start < 1 || end < start {
+		return 0, 0, fmt.Errorf("invalid line range #%s", fragment)
+	}
+	return start, end, nil
+}
+
+// ResolveGitHubURL creates a tool that fetches the data behind a GitHub web URL by dispatching to
+// the matching tool.
+func ResolveGitHubURL(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	getPullRequestTool, getPullRequest := GetPullRequest(getClient, t)
+	getIssueTool, getIssue := GetIssue(getClient, t)
+	getCommitTool, getCommit := GetCommit(getClient, t)
+	getWorkflowRunTool, getWorkflowRun := GetWorkflowRun(getClient, t)
+
+	// call runs another tool's handler with the given arguments.
+	call := func(ctx context.Context, tool mcp.Tool, h server.ToolHandlerFunc, args map[string]any) (*mcp.CallToolResult, error) {
+		request := mcp.CallToolRequest{}
+		request.Params.Name = tool.Name
+		request.Params.Arguments = args
+		return h(ctx, request)
+	}
+
+	return mcp.NewTool("resolve_github_url",
+			mcp.WithDescription(t("TOOL_RESOLVE_GITHUB_URL_DESCRIPTION", "Fetch the data behind a GitHub web URL. Supports file URLs (/blob/, optionally with a #L10-L20 line anchor, returning those lines), pull requests (/pull/N), issues (/issues/N), commits (/commit/SHA) and workflow runs (/actions/runs/N). The URL must belong to the GitHub host this server is configured for.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_RESOLVE_GITHUB_URL_USER_TITLE", "Resolve GitHub URL"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("url",
+				mcp.Required(),
+				mcp.Description("GitHub web URL, for example https://github.com/owner/repo/blob/main/README.md#L1-L10"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			rawURL, err := RequiredParam[string](request, "url")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			u, err := url.Parse(rawURL)
+			if err != nil || (u.Scheme != "https" && u.Scheme != "http") {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid URL %q", rawURL)), nil
+			}
+			host := webHostForAPI(client.BaseURL)
+			if !strings.EqualFold(strings.TrimPrefix(u.Hostname(), "www."), host) {
+				return mcp.NewToolResultError(fmt.Sprintf("URL host %s does not match the configured GitHub host %s", u.Hostname(), host)), nil
+			}
+
+			segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+			if len(segments) < 4 {
+				return mcp.NewToolResultError(fmt.Sprintf("unsupported GitHub URL %q: expected a file, pull request, issue, commit or workflow run URL", rawURL)), nil
+			}
+			owner, repo, kind, rest := segments[0], segments[1], segments[2], segments[3:]
+
+			switch kind {
+			case "blob":
+				if len(rest) < 2 {
+					return mcp.NewToolResultError(fmt.Sprintf("blob URL %q is missing a file path", rawURL)), nil
+				}
+				start, end, err := parseLineAnchor(u.Fragment)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				// Branch names containing slashes cannot be told apart from the path, so the first
+				// segment is taken as the ref. Permalinks use a commit SHA and are unaffected.
+				ref, path := rest[0], strings.Join(rest[1:], "/")
+				return getFileLines(ctx, client, owner, repo, ref, path, start, end)
+			case "pull":
+				number, err := strconv.Atoi(rest[0])
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid pull request number %q", rest[0])), nil
+				}
+				return call(ctx, getPullRequestTool, getPullRequest, map[string]any{"owner": owner, "repo": repo, "pullNumber": float64(number)})
+			case "issues":
+				number, err := strconv.Atoi(rest[0])
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid issue number %q", rest[0])), nil
+				}
+				return call(ctx, getIssueTool, getIssue, map[string]any{"owner": owner, "repo": repo, "issue_number": float64(number)})
+			case "commit":
+				return call(ctx, getCommitTool, getCommit, map[string]any{"owner": owner, "repo": repo, "sha": rest[0]})
+			case "actions":
+				if len(rest) >= 2 && rest[0] == "runs" {
+					runID, err := strconv.ParseInt(rest[1], 10, 64)
+					if err != nil {
+						return mcp.NewToolResultError(fmt.Sprintf("invalid workflow run ID %q", rest[1])), nil
+					}
+					return call(ctx, getWorkflowRunTool, getWorkflowRun, map[string]any{"owner": owner, "repo": repo, "run_id": float64(runID)})
+				}
+			}
+
+			return mcp.NewToolResultError(fmt.Sprintf("unsupported GitHub URL %q: expected a file, pull request, issue, commit or workflow run URL", rawURL)), nil
+		}
+}
+
+// getFileLines fetches a file and returns the lines from start to end, or the whole file if no
+// range is given.
+func getFileLines(ctx context.Context, client *github.Client, owner, repo, ref, path string, start, end int) (*mcp.CallToolResult, error) {
+	file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: ref})
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get %s at %s", path, ref), resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if file == nil {
+		return mcp.NewToolResultError(fmt.Sprintf("%s at %s is a directory, not a file", path, ref)), nil
+	}
+
+	content, err := file.GetContent()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode file content: %w", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+
+	if start == 0 {
+		start, end = 1, len(lines)
+	}
+	if start > len(lines) {
+		return mcp.NewToolResultError(fmt.Sprintf("line %d is past the end of %s, which has %d lines", start, path, len(lines))), nil
+	}
+	end = min(end, len(lines))
+
+	return MarshalledTextResult(FileLines{
+		Owner:      owner,
+		Repo:       repo,
+		Ref:        ref,
+		Path:       path,
+		StartLine:  start,
+		EndLine:    end,
+		TotalLines: len(lines),
+		Content:    strings.Join(lines[start-1:end], "\n"),
+	}), nil
+}
@@ -0,0 +1,149 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ResolveGitHubURL(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ResolveGitHubURL(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "resolve_github_url", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"url"})
+
+	mockFile := &github.RepositoryContent{
+		Type:     github.Ptr("file"),
+		Path:     github.Ptr("src/main.go"),
+		Encoding: github.Ptr("base64"),
+		// "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"hi\")\n}\n"
+		Content: github.Ptr("cGFja2FnZSBtYWluCgppbXBvcnQgImZtdCIKCmZ1bmMgbWFpbigpIHsKCWZtdC5QcmludGxuKCJoaSIpCn0K"),
+	}
+	mockPR := &github.PullRequest{
+		Number: github.Ptr(42),
+		Title:  github.Ptr("Add a feature"),
+		State:  github.Ptr("open"),
+	}
+
+	t.Run("blob URL with a line range", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposContentsByOwnerByRepoByPath,
+				expect(t, expectations{
+					path:        "/repos/owner/repo/contents/src/main.go",
+					queryParams: map[string]string{"ref": "abc123"},
+				}).andThen(
+					mockResponse(t, http.StatusOK, mockFile),
+				),
+			),
+		)
+		client := github.NewClient(mockedClient)
+		_, handler := ResolveGitHubURL(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"url": "https://github.com/owner/repo/blob/abc123/src/main.go#L5-L7",
+		}))
+		require.NoError(t, err)
+		textContent := getTextResult(t, result)
+
+		var lines FileLines
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &lines))
+		assert.Equal(t, FileLines{
+			Owner:      "owner",
+			Repo:       "repo",
+			Ref:        "abc123",
+			Path:       "src/main.go",
+			StartLine:  5,
+			EndLine:    7,
+			TotalLines: 7,
+			Content:    "func main() {\n\tfmt.Println(\"hi\")\n}",
+		}, lines)
+	})
+
+	t.Run("pull request URL", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposPullsByOwnerByRepoByPullNumber,
+				expectPath(t, "/repos/owner/repo/pulls/42").andThen(
+					mockResponse(t, http.StatusOK, mockPR),
+				),
+			),
+		)
+		client := github.NewClient(mockedClient)
+		_, handler := ResolveGitHubURL(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"url": "https://github.com/owner/repo/pull/42/files",
+		}))
+		require.NoError(t, err)
+		textContent := getTextResult(t, result)
+
+		var pr github.PullRequest
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &pr))
+		assert.Equal(t, 42, pr.GetNumber())
+		assert.Equal(t, "Add a feature", pr.GetTitle())
+	})
+
+	errorTests := []struct {
+		name           string
+		url            string
+		expectedErrMsg string
+	}{
+		{
+			name:           "host does not match",
+			url:            "https://ghes.example.com/owner/repo/pull/42",
+			expectedErrMsg: "URL host ghes.example.com does not match the configured GitHub host github.com",
+		},
+		{
+			name:           "unsupported URL",
+			url:            "https://github.com/owner/repo/wiki/Home",
+			expectedErrMsg: "unsupported GitHub URL",
+		},
+		{
+			name:           "invalid line anchor",
+			url:            "https://github.com/owner/repo/blob/main/README.md#L9-L3",
+			expectedErrMsg: "invalid line range #L9-L3",
+		},
+	}
+
+	for _, tc := range errorTests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient())
+			_, handler := ResolveGitHubURL(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"url": tc.url,
+			}))
+			require.NoError(t, err)
+			errorContent := getErrorResult(t, result)
+			assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+		})
+	}
+}
+
+func Test_WebHostForAPI(t *testing.T) {
+	tests := map[string]string{
+		"https://api.github.com/":          "github.com",
+		"https://api.octocorp.ghe.com/":    "octocorp.ghe.com",
+		"https://ghes.example.com/api/v3/": "ghes.example.com",
+	}
+	for apiURL, expected := range tests {
+		u, err := url.Parse(apiURL)
+		require.NoError(t, err)
+		assert.Equal(t, expected, webHostForAPI(u), apiURL)
+	}
+}
@@ -0,0 +1,210 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maskedVariableValue replaces the value of a variable whose name matches a mask pattern.
+const maskedVariableValue = "***"
+
+// VariableMask is a list of glob patterns, such as *_URL or *TOKEN*, for Actions variable names
+// whose values are hidden from tool results. Matching ignores case.
+type VariableMask []string
+
+// ParseVariableMask validates glob patterns for a VariableMask. Empty patterns are dropped.
+func ParseVariableMask(patterns []string) (VariableMask, error) {
+	var mask VariableMask
+	for _, pattern := range patterns {
+		pattern = strings.ToUpper(strings.TrimSpace(pattern))
+		if pattern == "" {
+			continue
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid variable mask pattern %q: %w", pattern, err)
+		}
+		mask = append(mask, pattern)
+	}
+	return mask, nil
+}
+
+// Masks reports whether the value of the variable called name should be hidden.
+func (m VariableMask) Masks(name string) bool {
+	name = strings.ToUpper(name)
+	for _, pattern := range m {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+type variableMaskKey struct{}
+
+// ContextWithVariableMask returns a context that makes variable tools hide the values of
+// variables matching mask.
+func ContextWithVariableMask(ctx context.Context, mask VariableMask) context.Context {
+	return context.WithValue(ctx, variableMaskKey{}, mask)
+}
+
+func variableMaskFromContext(ctx context.Context) VariableMask {
+	mask, _ := ctx.Value(variableMaskKey{}).(VariableMask)
+	return mask
+}
+
+// WithVariableMask is a server option that makes every tool call hide the values of variables
+// matching mask.
+func WithVariableMask(mask VariableMask) server.ServerOption {
+	return server.WithToolHandlerMiddleware(func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return next(ContextWithVariableMask(ctx, mask), request)
+		}
+	})
+}
+
+// maskVariableValue returns the value to show for the variable called name, and whether it was masked.
+func maskVariableValue(ctx context.Context, name, value string) (string, bool) {
+	if variableMaskFromContext(ctx).Masks(name) {
+		return maskedVariableValue, true
+	}
+	return value, false
+}
+
+// RepoVariable is a GitHub Actions variable of a repository.
+type RepoVariable struct {
+	Name      string     `json:"name"`
+	Value     string     `json:"value"`
+	Masked    bool       `json:"masked,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+func newRepoVariable(ctx context.Context, v *github.ActionsVariable) RepoVariable {
+	variable := RepoVariable{Name: v.Name}
+	variable.Value, variable.Masked = maskVariableValue(ctx, v.Name, v.Value)
+	if v.CreatedAt != nil {
+		variable.CreatedAt = &v.CreatedAt.Time
+	}
+	if v.UpdatedAt != nil {
+		variable.UpdatedAt = &v.UpdatedAt.Time
+	}
+	return variable
+}
+
+// ListVariables creates a tool to list the GitHub Actions variables of a repository.
+func ListVariables(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_variables",
+			mcp.WithDescription(t("TOOL_LIST_VARIABLES_DESCRIPTION", "List the GitHub Actions variables of a repository with their values. Values of variables the server is configured to mask are replaced with ***.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_VARIABLES_USER_TITLE", "List repository variables"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			variables, resp, err := client.Actions.ListRepoVariables(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list repository variables", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]RepoVariable, 0, len(variables.Variables))
+			for _, v := range variables.Variables {
+				result = append(result, newRepoVariable(ctx, v))
+			}
+
+			return MarshalledListResult(ctx, result, "no repository variables found"), nil
+		}
+}
+
+// GetVariable creates a tool to get a GitHub Actions variable of a repository.
+func GetVariable(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_variable",
+			mcp.WithDescription(t("TOOL_GET_VARIABLE_DESCRIPTION", "Get a GitHub Actions variable of a repository by name. The value is replaced with *** when the server is configured to mask it.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_VARIABLE_USER_TITLE", "Get repository variable"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Variable name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := RequiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			variable, resp, err := client.Actions.GetRepoVariable(ctx, owner, repo, name)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("variable %s not found in %s/%s", name, owner, repo)), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository variable", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newRepoVariable(ctx, variable)), nil
+		}
+}
@@ -0,0 +1,142 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_VariableMask(t *testing.T) {
+	mask, err := ParseVariableMask([]string{"*_URL", " *token* ", "", "DB_?"})
+	require.NoError(t, err)
+	assert.Equal(t, VariableMask{"*_URL", "*TOKEN*", "DB_?"}, mask)
+
+	tests := []struct {
+		name   string
+		masked bool
+	}{
+		{name: "DATABASE_URL", masked: true},
+		{name: "database_url", masked: true},
+		{name: "URL", masked: false},
+		{name: "NPM_TOKEN_ID", masked: true},
+		{name: "TOKEN", masked: true},
+		{name: "DB_1", masked: true},
+		{name: "DB_10", masked: false},
+		{name: "REGION", masked: false},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.masked, mask.Masks(tc.name), tc.name)
+	}
+
+	assert.False(t, VariableMask(nil).Masks("DATABASE_URL"))
+
+	_, err = ParseVariableMask([]string{"[A-"})
+	assert.ErrorContains(t, err, `invalid variable mask pattern "[A-"`)
+}
+
+func Test_ListVariables(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListVariables(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_variables", tool.Name)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposActionsVariablesByOwnerByRepo,
+			expectPath(t, "/repos/owner/repo/actions/variables").andThen(
+				mockResponse(t, http.StatusOK, &github.ActionsVariables{
+					TotalCount: 2,
+					Variables: []*github.ActionsVariable{
+						{Name: "REGION", Value: "eu-west-1"},
+						{Name: "STAGING_URL", Value: "https://staging.internal.example.com"},
+					},
+				}),
+			),
+		),
+	)
+	_, handler := ListVariables(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+	t.Run("values are returned without a mask", func(t *testing.T) {
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{"owner": "owner", "repo": "repo"}))
+		require.NoError(t, err)
+
+		var variables []RepoVariable
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &variables))
+		assert.Equal(t, []RepoVariable{
+			{Name: "REGION", Value: "eu-west-1"},
+			{Name: "STAGING_URL", Value: "https://staging.internal.example.com"},
+		}, variables)
+	})
+
+	t.Run("matching values are masked", func(t *testing.T) {
+		ctx := ContextWithVariableMask(context.Background(), VariableMask{"*_URL"})
+		result, err := handler(ctx, createMCPRequest(map[string]any{"owner": "owner", "repo": "repo"}))
+		require.NoError(t, err)
+
+		textContent := getTextResult(t, result)
+		assert.NotContains(t, textContent.Text, "staging.internal.example.com")
+		var variables []RepoVariable
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &variables))
+		assert.Equal(t, []RepoVariable{
+			{Name: "REGION", Value: "eu-west-1"},
+			{Name: "STAGING_URL", Value: "***", Masked: true},
+		}, variables)
+	})
+}
+
+func Test_GetVariable(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetVariable(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_variable", tool.Name)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "name"})
+
+	t.Run("matching value is masked", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposActionsVariablesByOwnerByRepoByName,
+				expectPath(t, "/repos/owner/repo/actions/variables/NPM_TOKEN_HINT").andThen(
+					mockResponse(t, http.StatusOK, &github.ActionsVariable{Name: "NPM_TOKEN_HINT", Value: "npm_abc"}),
+				),
+			),
+		)
+		_, handler := GetVariable(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		ctx := ContextWithVariableMask(context.Background(), VariableMask{"*TOKEN*"})
+		result, err := handler(ctx, createMCPRequest(map[string]any{"owner": "owner", "repo": "repo", "name": "NPM_TOKEN_HINT"}))
+		require.NoError(t, err)
+
+		var variable RepoVariable
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &variable))
+		assert.Equal(t, RepoVariable{Name: "NPM_TOKEN_HINT", Value: "***", Masked: true}, variable)
+	})
+
+	t.Run("missing variable", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposActionsVariablesByOwnerByRepoByName,
+				mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+			),
+		)
+		_, handler := GetVariable(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{"owner": "owner", "repo": "repo", "name": "REGION"}))
+		require.NoError(t, err)
+		assert.Equal(t, "variable REGION not found in owner/repo", getErrorResult(t, result).Text)
+	})
+}
@@ -0,0 +1,730 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Webhook is a repository or organization webhook. The secret is never returned by GitHub.
+type Webhook struct {
+	ID           int64                `json:"id"`
+	URL          string               `json:"url"`
+	ContentType  string               `json:"content_type,omitempty"`
+	InsecureSSL  bool                 `json:"insecure_ssl,omitempty"`
+	Active       bool                 `json:"active"`
+	Events       []string             `json:"events"`
+	LastResponse *WebhookLastResponse `json:"last_response,omitempty"`
+	CreatedAt    *time.Time           `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time           `json:"updated_at,omitempty"`
+}
+
+// WebhookLastResponse is the outcome of the most recent delivery of a webhook.
+type WebhookLastResponse struct {
+	Code    int    `json:"code,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// WebhookDelivery is one attempt to deliver an event to a webhook. Request and Response are only
+// filled in by get_webhook_delivery.
+type WebhookDelivery struct {
+	ID          int64                   `json:"id"`
+	GUID        string                  `json:"guid"`
+	Event       string                  `json:"event"`
+	Action      string                  `json:"action,omitempty"`
+	Status      string                  `json:"status"`
+	StatusCode  int                     `json:"status_code"`
+	Redelivery  bool                    `json:"redelivery,omitempty"`
+	Duration    float64                 `json:"duration_seconds"`
+	DeliveredAt *time.Time              `json:"delivered_at,omitempty"`
+	Request     *WebhookDeliveryMessage `json:"request,omitempty"`
+	Response    *WebhookDeliveryMessage `json:"response,omitempty"`
+}
+
+// WebhookDeliveryMessage is the request sent for a delivery, or the response the receiver gave.
+type WebhookDeliveryMessage struct {
+	Headers map[string]string `json:"headers,omitempty"`
+	Payload json.RawMessage   `json:"payload,omitempty"`
+}
+
+// WebhookDeliveriesPage is a page of webhook deliveries, newest first.
+type WebhookDeliveriesPage struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+	PageInfo   struct {
+		HasNextPage bool   `json:"hasNextPage"`
+		EndCursor   string `json:"endCursor,omitempty"`
+	} `json:"pageInfo"`
+}
+
+func newWebhook(h *github.Hook) Webhook {
+	hook := Webhook{
+		ID:          h.GetID(),
+		URL:         h.GetConfig().GetURL(),
+		ContentType: h.GetConfig().GetContentType(),
+		InsecureSSL: h.GetConfig().GetInsecureSSL() == "1",
+		Active:      h.GetActive(),
+		Events:      h.Events,
+	}
+	if hook.Events == nil {
+		hook.Events = []string{}
+	}
+	if h.LastResponse != nil {
+		last := &WebhookLastResponse{}
+		// The code is null until the hook has been delivered to once.
+		if code, ok := h.LastResponse["code"].(float64); ok {
+			last.Code = int(code)
+		}
+		last.Status, _ = h.LastResponse["status"].(string)
+		last.Message, _ = h.LastResponse["message"].(string)
+		hook.LastResponse = last
+	}
+	if h.CreatedAt != nil {
+		hook.CreatedAt = &h.CreatedAt.Time
+	}
+	if h.UpdatedAt != nil {
+		hook.UpdatedAt = &h.UpdatedAt.Time
+	}
+	return hook
+}
+
+func newWebhookDelivery(d *github.HookDelivery) WebhookDelivery {
+	delivery := WebhookDelivery{
+		ID:         d.GetID(),
+		GUID:       d.GetGUID(),
+		Event:      d.GetEvent(),
+		Action:     d.GetAction(),
+		Status:     d.GetStatus(),
+		StatusCode: d.GetStatusCode(),
+		Redelivery: d.GetRedelivery(),
+	}
+	if d.Duration != nil {
+		delivery.Duration = *d.Duration
+	}
+	if d.DeliveredAt != nil {
+		delivery.DeliveredAt = &d.DeliveredAt.Time
+	}
+	if d.Request != nil {
+		delivery.Request = &WebhookDeliveryMessage{Headers: d.Request.Headers}
+		if d.Request.RawPayload != nil {
+			delivery.Request.Payload = *d.Request.RawPayload
+		}
+	}
+	if d.Response != nil {
+		delivery.Response = &WebhookDeliveryMessage{Headers: d.Response.Headers}
+		if d.Response.RawPayload != nil {
+			delivery.Response.Payload = *d.Response.RawPayload
+		}
+	}
+	return delivery
+}
+
+// webhookTarget is the owner of a webhook: a repository, or an organization when repo is empty.
+// The REST API has the same operations for both under different paths.
+type webhookTarget struct {
+	owner string
+	repo  string
+}
+
+// withWebhookTarget adds the owner and repo parameters shared by all webhook tools.
+func withWebhookTarget() []mcp.ToolOption {
+	return []mcp.ToolOption{
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner, or the organization for an organization webhook"),
+		),
+		mcp.WithString("repo",
+			mcp.Description("Repository name. Omit to manage the webhooks of the organization given as owner"),
+		),
+	}
+}
+
+func requiredWebhookTarget(request mcp.CallToolRequest) (webhookTarget, error) {
+	owner, err := RequiredParam[string](request, "owner")
+	if err != nil {
+		return webhookTarget{}, err
+	}
+	repo, err := OptionalParam[string](request, "repo")
+	if err != nil {
+		return webhookTarget{}, err
+	}
+	return webhookTarget{owner: owner, repo: repo}, nil
+}
+
+func (w webhookTarget) String() string {
+	if w.repo == "" {
+		return "organization " + w.owner
+	}
+	return w.owner + "/" + w.repo
+}
+
+func (w webhookTarget) list(ctx context.Context, client *github.Client, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+	if w.repo == "" {
+		return client.Organizations.ListHooks(ctx, w.owner, opts)
+	}
+	return client.Repositories.ListHooks(ctx, w.owner, w.repo, opts)
+}
+
+func (w webhookTarget) get(ctx context.Context, client *github.Client, id int64) (*github.Hook, *github.Response, error) {
+	if w.repo == "" {
+		return client.Organizations.GetHook(ctx, w.owner, id)
+	}
+	return client.Repositories.GetHook(ctx, w.owner, w.repo, id)
+}
+
+func (w webhookTarget) create(ctx context.Context, client *github.Client, hook *github.Hook) (*github.Hook, *github.Response, error) {
+	if w.repo == "" {
+		return client.Organizations.CreateHook(ctx, w.owner, hook)
+	}
+	return client.Repositories.CreateHook(ctx, w.owner, w.repo, hook)
+}
+
+func (w webhookTarget) edit(ctx context.Context, client *github.Client, id int64, hook *github.Hook) (*github.Hook, *github.Response, error) {
+	if w.repo == "" {
+		return client.Organizations.EditHook(ctx, w.owner, id, hook)
+	}
+	return client.Repositories.EditHook(ctx, w.owner, w.repo, id, hook)
+}
+
+func (w webhookTarget) editConfig(ctx context.Context, client *github.Client, id int64, config *github.HookConfig) (*github.HookConfig, *github.Response, error) {
+	if w.repo == "" {
+		return client.Organizations.EditHookConfiguration(ctx, w.owner, id, config)
+	}
+	return client.Repositories.EditHookConfiguration(ctx, w.owner, w.repo, id, config)
+}
+
+func (w webhookTarget) ping(ctx context.Context, client *github.Client, id int64) (*github.Response, error) {
+	if w.repo == "" {
+		return client.Organizations.PingHook(ctx, w.owner, id)
+	}
+	return client.Repositories.PingHook(ctx, w.owner, w.repo, id)
+}
+
+func (w webhookTarget) delete(ctx context.Context, client *github.Client, id int64) (*github.Response, error) {
+	if w.repo == "" {
+		return client.Organizations.DeleteHook(ctx, w.owner, id)
+	}
+	return client.Repositories.DeleteHook(ctx, w.owner, w.repo, id)
+}
+
+func (w webhookTarget) listDeliveries(ctx context.Context, client *github.Client, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+	if w.repo == "" {
+		return client.Organizations.ListHookDeliveries(ctx, w.owner, id, opts)
+	}
+	return client.Repositories.ListHookDeliveries(ctx, w.owner, w.repo, id, opts)
+}
+
+func (w webhookTarget) getDelivery(ctx context.Context, client *github.Client, hookID, deliveryID int64) (*github.HookDelivery, *github.Response, error) {
+	if w.repo == "" {
+		return client.Organizations.GetHookDelivery(ctx, w.owner, hookID, deliveryID)
+	}
+	return client.Repositories.GetHookDelivery(ctx, w.owner, w.repo, hookID, deliveryID)
+}
+
+func (w webhookTarget) redeliver(ctx context.Context, client *github.Client, hookID, deliveryID int64) (*github.HookDelivery, *github.Response, error) {
+	if w.repo == "" {
+		return client.Organizations.RedeliverHookDelivery(ctx, w.owner, hookID, deliveryID)
+	}
+	return client.Repositories.RedeliverHookDelivery(ctx, w.owner, w.repo, hookID, deliveryID)
+}
+
+// withWebhookSettings adds the parameters used to configure a webhook. The URL is only required when
+// creating one.
+func withWebhookSettings(create bool) []mcp.ToolOption {
+	urlOptions := []mcp.PropertyOption{mcp.Description("URL the payloads are delivered to")}
+	if create {
+		urlOptions = append(urlOptions, mcp.Required())
+	}
+	return []mcp.ToolOption{
+		mcp.WithString("url", urlOptions...),
+		mcp.WithArray("events",
+			mcp.Description(`Events that trigger the webhook, such as "push" or "pull_request". Use ["*"] for all events. Defaults to ["push"] when creating`),
+			mcp.Items(map[string]any{"type": "string"}),
+		),
+		mcp.WithString("content_type",
+			mcp.Description("Media type of the payloads. Defaults to json when creating"),
+			mcp.Enum("json", "form"),
+		),
+		mcp.WithString("secret",
+			mcp.Description("Secret used to sign the payloads in the X-Hub-Signature-256 header"),
+		),
+		mcp.WithBoolean("insecure_ssl",
+			mcp.Description("Skip TLS certificate verification when delivering payloads. Not recommended"),
+		),
+		mcp.WithBoolean("active",
+			mcp.Description("Whether deliveries are sent. Defaults to true when creating"),
+		),
+	}
+}
+
+// webhookConfig reads the configuration parameters. The config is nil when none were given.
+func webhookConfig(request mcp.CallToolRequest) (*github.HookConfig, error) {
+	var config github.HookConfig
+	changed := false
+	for name, field := range map[string]**string{
+		"url":          &config.URL,
+		"content_type": &config.ContentType,
+		"secret":       &config.Secret,
+	} {
+		v, ok, err := OptionalParamOK[string](request, name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			*field = github.Ptr(v)
+			changed = true
+		}
+	}
+	if v, ok, err := OptionalParamOK[bool](request, "insecure_ssl"); err != nil {
+		return nil, err
+	} else if ok {
+		config.InsecureSSL = github.Ptr("0")
+		if v {
+			config.InsecureSSL = github.Ptr("1")
+		}
+		changed = true
+	}
+	if !changed {
+		return nil, nil
+	}
+	return &config, nil
+}
+
+// ListWebhooks creates a tool to list the webhooks of a repository or organization.
+func ListWebhooks(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_LIST_WEBHOOKS_DESCRIPTION", "List the webhooks of a GitHub repository or organization, with their events and the result of their last delivery.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_LIST_WEBHOOKS_USER_TITLE", "List webhooks"),
+			ReadOnlyHint: ToBoolPtr(true),
+		}),
+	}
+	options = append(options, withWebhookTarget()...)
+	options = append(options, WithPagination())
+
+	return mcp.NewTool("list_webhooks", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			target, err := requiredWebhookTarget(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			hooks, resp, err := target.list(ctx, client, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list webhooks of %s", target), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]Webhook, 0, len(hooks))
+			for _, h := range hooks {
+				result = append(result, newWebhook(h))
+			}
+			return MarshalledListResult(ctx, result, "no webhooks found"), nil
+		}
+}
+
+// CreateWebhook creates a tool to add a webhook to a repository or organization.
+func CreateWebhook(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_CREATE_WEBHOOK_DESCRIPTION", "Create a webhook on a GitHub repository or organization. GitHub sends a ping event to the new webhook right away.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_CREATE_WEBHOOK_USER_TITLE", "Create webhook"),
+			ReadOnlyHint: ToBoolPtr(false),
+		}),
+	}
+	options = append(options, withWebhookTarget()...)
+	options = append(options, withWebhookSettings(true)...)
+
+	return mcp.NewTool("create_webhook", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			target, err := requiredWebhookTarget(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if _, err := RequiredParam[string](request, "url"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			config, err := webhookConfig(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if config.ContentType == nil {
+				config.ContentType = github.Ptr("json")
+			}
+			events, err := OptionalStringArrayParam(request, "events")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(events) == 0 {
+				events = []string{"push"}
+			}
+			active, err := OptionalBoolParamWithDefault(request, "active", true)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			hook, resp, err := target.create(ctx, client, &github.Hook{
+				Name:   github.Ptr("web"),
+				Config: config,
+				Events: events,
+				Active: github.Ptr(active),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to create webhook on %s", target), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newWebhook(hook)), nil
+		}
+}
+
+// UpdateWebhook creates a tool to change the configuration, events or state of a webhook.
+func UpdateWebhook(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_UPDATE_WEBHOOK_DESCRIPTION", "Update a webhook of a GitHub repository or organization. Only the given settings are changed; events replaces the whole list of events.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_UPDATE_WEBHOOK_USER_TITLE", "Update webhook"),
+			ReadOnlyHint: ToBoolPtr(false),
+		}),
+	}
+	options = append(options, withWebhookTarget()...)
+	options = append(options, mcp.WithNumber("hook_id",
+		mcp.Required(),
+		mcp.Description("ID of the webhook"),
+	))
+	options = append(options, withWebhookSettings(false)...)
+
+	return mcp.NewTool("update_webhook", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			target, err := requiredWebhookTarget(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hookID, err := RequiredInt(request, "hook_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			config, err := webhookConfig(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			var edit github.Hook
+			changed := false
+			events, err := OptionalStringArrayParam(request, "events")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(events) > 0 {
+				edit.Events = events
+				changed = true
+			}
+			if active, ok, err := OptionalParamOK[bool](request, "active"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				edit.Active = github.Ptr(active)
+				changed = true
+			}
+			if config == nil && !changed {
+				return mcp.NewToolResultError("No update parameters provided."), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// The configuration has its own endpoint, which leaves the settings that are not given
+			// alone, so the secret survives a change of URL.
+			if config != nil {
+				_, resp, err := target.editConfig(ctx, client, int64(hookID), config)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to update configuration of webhook %d", hookID), resp, err), nil
+				}
+				_ = resp.Body.Close()
+			}
+
+			var hook *github.Hook
+			var resp *github.Response
+			if changed {
+				hook, resp, err = target.edit(ctx, client, int64(hookID), &edit)
+			} else {
+				hook, resp, err = target.get(ctx, client, int64(hookID))
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to update webhook %d", hookID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newWebhook(hook)), nil
+		}
+}
+
+// PingWebhook creates a tool to send a ping event to a webhook.
+func PingWebhook(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_PING_WEBHOOK_DESCRIPTION", "Send a ping event to a webhook of a GitHub repository or organization to check that it is reachable. Use list_webhook_deliveries to see the result.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_PING_WEBHOOK_USER_TITLE", "Ping webhook"),
+			ReadOnlyHint: ToBoolPtr(false),
+		}),
+	}
+	options = append(options, withWebhookTarget()...)
+	options = append(options, mcp.WithNumber("hook_id",
+		mcp.Required(),
+		mcp.Description("ID of the webhook"),
+	))
+
+	return mcp.NewTool("ping_webhook", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			target, err := requiredWebhookTarget(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hookID, err := RequiredInt(request, "hook_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := target.ping(ctx, client, int64(hookID))
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to ping webhook %d", hookID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Ping sent to webhook %d of %s", hookID, target)), nil
+		}
+}
+
+// DeleteWebhook creates a tool to delete a webhook.
+func DeleteWebhook(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_DELETE_WEBHOOK_DESCRIPTION", "Delete a webhook of a GitHub repository or organization, together with its delivery history.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           t("TOOL_DELETE_WEBHOOK_USER_TITLE", "Delete webhook"),
+			ReadOnlyHint:    ToBoolPtr(false),
+			DestructiveHint: ToBoolPtr(true),
+		}),
+	}
+	options = append(options, withWebhookTarget()...)
+	options = append(options, mcp.WithNumber("hook_id",
+		mcp.Required(),
+		mcp.Description("ID of the webhook"),
+	))
+
+	return mcp.NewTool("delete_webhook", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			target, err := requiredWebhookTarget(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hookID, err := RequiredInt(request, "hook_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := target.delete(ctx, client, int64(hookID))
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to delete webhook %d", hookID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Successfully deleted webhook %d of %s", hookID, target)), nil
+		}
+}
+
+// ListWebhookDeliveries creates a tool to list the recent deliveries of a webhook.
+func ListWebhookDeliveries(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_LIST_WEBHOOK_DELIVERIES_DESCRIPTION", "List the recent deliveries of a webhook of a GitHub repository or organization, newest first, with the HTTP status the receiver answered. Use get_webhook_delivery to see the request and response of one delivery.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_LIST_WEBHOOK_DELIVERIES_USER_TITLE", "List webhook deliveries"),
+			ReadOnlyHint: ToBoolPtr(true),
+		}),
+	}
+	options = append(options, withWebhookTarget()...)
+	options = append(options, mcp.WithNumber("hook_id",
+		mcp.Required(),
+		mcp.Description("ID of the webhook"),
+	))
+	options = append(options, WithCursorPagination())
+
+	return mcp.NewTool("list_webhook_deliveries", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			target, err := requiredWebhookTarget(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hookID, err := RequiredInt(request, "hook_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalCursorPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			deliveries, resp, err := target.listDeliveries(ctx, client, int64(hookID), &github.ListCursorOptions{
+				PerPage: pagination.PerPage,
+				Cursor:  pagination.After,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list deliveries of webhook %d", hookID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			page := WebhookDeliveriesPage{Deliveries: make([]WebhookDelivery, 0, len(deliveries))}
+			for _, d := range deliveries {
+				page.Deliveries = append(page.Deliveries, newWebhookDelivery(d))
+			}
+			// Deliveries paginate with a cursor in the Link header, which go-github parses.
+			page.PageInfo.HasNextPage = resp.Cursor != ""
+			page.PageInfo.EndCursor = resp.Cursor
+
+			info := ListPageInfo{HasNextPage: page.PageInfo.HasNextPage, NextCursor: page.PageInfo.EndCursor}
+			return MarshalledListPageResult(ctx, page, page.Deliveries, info, fmt.Sprintf("webhook %d has no deliveries", hookID)), nil
+		}
+}
+
+// GetWebhookDelivery creates a tool to get the request and response of one webhook delivery.
+func GetWebhookDelivery(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_GET_WEBHOOK_DELIVERY_DESCRIPTION", "Get one delivery of a webhook of a GitHub repository or organization, including the headers and payload that were sent and the response the receiver gave.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_GET_WEBHOOK_DELIVERY_USER_TITLE", "Get webhook delivery"),
+			ReadOnlyHint: ToBoolPtr(true),
+		}),
+	}
+	options = append(options, withWebhookTarget()...)
+	options = append(options,
+		mcp.WithNumber("hook_id",
+			mcp.Required(),
+			mcp.Description("ID of the webhook"),
+		),
+		mcp.WithNumber("delivery_id",
+			mcp.Required(),
+			mcp.Description("ID of the delivery, from list_webhook_deliveries"),
+		),
+	)
+
+	return mcp.NewTool("get_webhook_delivery", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			target, err := requiredWebhookTarget(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hookID, err := RequiredInt(request, "hook_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			deliveryID, err := RequiredInt(request, "delivery_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			delivery, resp, err := target.getDelivery(ctx, client, int64(hookID), int64(deliveryID))
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get delivery %d of webhook %d", deliveryID, hookID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newWebhookDelivery(delivery)), nil
+		}
+}
+
+// RedeliverWebhookDelivery creates a tool to send a past webhook delivery again.
+func RedeliverWebhookDelivery(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_REDELIVER_WEBHOOK_DELIVERY_DESCRIPTION", "Send a past delivery of a webhook of a GitHub repository or organization again, with the original payload. The new attempt shows up in list_webhook_deliveries as a redelivery.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_REDELIVER_WEBHOOK_DELIVERY_USER_TITLE", "Redeliver webhook delivery"),
+			ReadOnlyHint: ToBoolPtr(false),
+		}),
+	}
+	options = append(options, withWebhookTarget()...)
+	options = append(options,
+		mcp.WithNumber("hook_id",
+			mcp.Required(),
+			mcp.Description("ID of the webhook"),
+		),
+		mcp.WithNumber("delivery_id",
+			mcp.Required(),
+			mcp.Description("ID of the delivery to send again"),
+		),
+	)
+
+	return mcp.NewTool("redeliver_webhook_delivery", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			target, err := requiredWebhookTarget(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hookID, err := RequiredInt(request, "hook_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			deliveryID, err := RequiredInt(request, "delivery_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			_, resp, err := target.redeliver(ctx, client, int64(hookID), int64(deliveryID))
+			// GitHub queues the redelivery and answers 202, which go-github reports as an error.
+			if err != nil && !(resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err)) {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to redeliver delivery %d of webhook %d", deliveryID, hookID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Redelivery of delivery %d to webhook %d of %s requested", deliveryID, hookID, target)), nil
+		}
+}
@@ -0,0 +1,450 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mockHook = &github.Hook{
+	ID:     github.Ptr(int64(12)),
+	Name:   github.Ptr("web"),
+	Active: github.Ptr(true),
+	Events: []string{"push", "pull_request"},
+	Config: &github.HookConfig{
+		URL:         github.Ptr("https://ci.example.com/hook"),
+		ContentType: github.Ptr("json"),
+		InsecureSSL: github.Ptr("0"),
+		Secret:      github.Ptr("********"),
+	},
+	LastResponse: map[string]any{"code": 502, "status": "active", "message": "Bad Gateway"},
+}
+
+func Test_ListWebhooks(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListWebhooks(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_webhooks", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		requestArgs  map[string]any
+	}{
+		{
+			name: "repository webhooks",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposHooksByOwnerByRepo,
+					expectPath(t, "/repos/owner/repo/hooks").andThen(
+						mockResponse(t, http.StatusOK, []*github.Hook{mockHook}),
+					),
+				),
+			),
+			requestArgs: map[string]any{"owner": "owner", "repo": "repo"},
+		},
+		{
+			name: "organization webhooks",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsHooksByOrg,
+					expectPath(t, "/orgs/acme/hooks").andThen(
+						mockResponse(t, http.StatusOK, []*github.Hook{mockHook}),
+					),
+				),
+			),
+			requestArgs: map[string]any{"owner": "acme"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListWebhooks(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			var returned []Webhook
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, []Webhook{{
+				ID:           12,
+				URL:          "https://ci.example.com/hook",
+				ContentType:  "json",
+				Active:       true,
+				Events:       []string{"push", "pull_request"},
+				LastResponse: &WebhookLastResponse{Code: 502, Status: "active", Message: "Bad Gateway"},
+			}}, returned)
+		})
+	}
+}
+
+func Test_CreateWebhook(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateWebhook(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_webhook", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "url"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposHooksByOwnerByRepo,
+			expectRequestBody(t, map[string]any{
+				"name":   "web",
+				"active": true,
+				"events": []any{"push"},
+				"config": map[string]any{
+					"url":          "https://ci.example.com/hook",
+					"content_type": "json",
+					"secret":       "s3cret",
+				},
+			}).andThen(
+				mockResponse(t, http.StatusCreated, mockHook),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := CreateWebhook(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":  "owner",
+		"repo":   "repo",
+		"url":    "https://ci.example.com/hook",
+		"secret": "s3cret",
+	}))
+	require.NoError(t, err)
+
+	var returned Webhook
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, int64(12), returned.ID)
+}
+
+func Test_UpdateWebhook(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateWebhook(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_webhook", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "hook_id"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "change the URL and deactivate",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposHooksConfigByOwnerByRepoByHookId,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/hooks/12/config",
+						requestBody: map[string]any{"url": "https://ci.example.com/v2/hook"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.HookConfig{URL: github.Ptr("https://ci.example.com/v2/hook")}),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposHooksByOwnerByRepoByHookId,
+					expectRequestBody(t, map[string]any{"active": false}).andThen(
+						mockResponse(t, http.StatusOK, mockHook),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"repo":   "repo",
+				"url":    "https://ci.example.com/v2/hook",
+				"active": false,
+			},
+		},
+		{
+			name: "change only the organization webhook secret",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchOrgsHooksConfigByOrgByHookId,
+					expectRequestBody(t, map[string]any{"secret": "rotated"}).andThen(
+						mockResponse(t, http.StatusOK, &github.HookConfig{}),
+					),
+				),
+				mock.WithRequestMatch(
+					mock.GetOrgsHooksByOrgByHookId,
+					mockHook,
+				),
+			),
+			requestArgs: map[string]any{
+				"secret": "rotated",
+			},
+		},
+		{
+			name:         "nothing to update",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"repo": "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "No update parameters provided.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateWebhook(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			args := map[string]any{"owner": "owner", "hook_id": float64(12)}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned Webhook
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, int64(12), returned.ID)
+		})
+	}
+}
+
+func Test_PingWebhook(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := PingWebhook(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "ping_webhook", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "hook_id"})
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposHooksPingsByOwnerByRepoByHookId,
+			expectPath(t, "/repos/owner/repo/hooks/12/pings").andThen(
+				mockResponse(t, http.StatusNoContent, nil),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := PingWebhook(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":   "owner",
+		"repo":    "repo",
+		"hook_id": float64(12),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "Ping sent to webhook 12 of owner/repo", getTextResult(t, result).Text)
+}
+
+func Test_DeleteWebhook(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteWebhook(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_webhook", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "hook_id"})
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteOrgsHooksByOrgByHookId,
+			expectPath(t, "/orgs/acme/hooks/12").andThen(
+				mockResponse(t, http.StatusNoContent, nil),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := DeleteWebhook(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":   "acme",
+		"hook_id": float64(12),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "Successfully deleted webhook 12 of organization acme", getTextResult(t, result).Text)
+}
+
+func Test_ListWebhookDeliveries(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListWebhookDeliveries(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_webhook_deliveries", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "hook_id"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposHooksDeliveriesByOwnerByRepoByHookId,
+			expectQueryParams(t, map[string]string{
+				"cursor":   "v1_100",
+				"per_page": "2",
+			}).andThen(
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/hooks/12/deliveries?cursor=v1_98&per_page=2>; rel="next"`)
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode([]*github.HookDelivery{
+						{ID: github.Ptr(int64(100)), Event: github.Ptr("push"), Status: github.Ptr("Bad Gateway"), StatusCode: github.Ptr(502)},
+						{ID: github.Ptr(int64(99)), Event: github.Ptr("ping"), Status: github.Ptr("OK"), StatusCode: github.Ptr(200)},
+					})
+				}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := ListWebhookDeliveries(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":   "owner",
+		"repo":    "repo",
+		"hook_id": float64(12),
+		"perPage": float64(2),
+		"after":   "v1_100",
+	}))
+	require.NoError(t, err)
+
+	var returned WebhookDeliveriesPage
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	require.Len(t, returned.Deliveries, 2)
+	assert.Equal(t, 502, returned.Deliveries[0].StatusCode)
+	assert.True(t, returned.PageInfo.HasNextPage)
+	assert.Equal(t, "v1_98", returned.PageInfo.EndCursor)
+}
+
+func Test_GetWebhookDelivery(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetWebhookDelivery(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_webhook_delivery", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "hook_id", "delivery_id"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	requestPayload := json.RawMessage(`{"ref":"refs/heads/main"}`)
+	responsePayload := json.RawMessage(`"upstream timed out"`)
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetOrgsHooksDeliveriesByOrgByHookIdByDeliveryId,
+			expectPath(t, "/orgs/acme/hooks/12/deliveries/100").andThen(
+				mockResponse(t, http.StatusOK, &github.HookDelivery{
+					ID:         github.Ptr(int64(100)),
+					Event:      github.Ptr("push"),
+					Status:     github.Ptr("Bad Gateway"),
+					StatusCode: github.Ptr(502),
+					Request: &github.HookRequest{
+						Headers:    map[string]string{"X-GitHub-Event": "push"},
+						RawPayload: &requestPayload,
+					},
+					Response: &github.HookResponse{
+						Headers:    map[string]string{"Server": "nginx"},
+						RawPayload: &responsePayload,
+					},
+				}),
+			),
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := GetWebhookDelivery(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":       "acme",
+		"hook_id":     float64(12),
+		"delivery_id": float64(100),
+	}))
+	require.NoError(t, err)
+
+	var returned WebhookDelivery
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	require.NotNil(t, returned.Request)
+	require.NotNil(t, returned.Response)
+	assert.Equal(t, "push", returned.Request.Headers["X-GitHub-Event"])
+	assert.JSONEq(t, `{"ref":"refs/heads/main"}`, string(returned.Request.Payload))
+	assert.JSONEq(t, `"upstream timed out"`, string(returned.Response.Payload))
+}
+
+func Test_RedeliverWebhookDelivery(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RedeliverWebhookDelivery(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "redeliver_webhook_delivery", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "hook_id", "delivery_id"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "redelivery queued",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposHooksDeliveriesAttemptsByOwnerByRepoByHookIdByDeliveryId,
+					expectPath(t, "/repos/owner/repo/hooks/12/deliveries/100/attempts").andThen(
+						mockResponse(t, http.StatusAccepted, map[string]any{}),
+					),
+				),
+			),
+		},
+		{
+			name: "delivery not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposHooksDeliveriesAttemptsByOwnerByRepoByHookIdByDeliveryId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to redeliver delivery 100 of webhook 12",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RedeliverWebhookDelivery(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"hook_id":     float64(12),
+				"delivery_id": float64(100),
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			assert.Equal(t, "Redelivery of delivery 100 to webhook 12 of owner/repo requested", getTextResult(t, result).Text)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package github
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf8"
+)
+
+// maxArtifactTextBytes caps the text returned for all files of an artifact together.
+const maxArtifactTextBytes = 512 << 10
+
+// maxArtifactFileBytes caps the uncompressed size of a single file read from an artifact, which
+// anyone who can run a workflow can upload.
+const maxArtifactFileBytes = 10 << 20
+
+// ArtifactFile is a file in a workflow run artifact. Content is only set for text files, as long
+// as the text of the artifact fits in the response.
+type ArtifactFile struct {
+	Path    string `json:"path"`
+	Size    uint64 `json:"size"`
+	Binary  bool   `json:"binary,omitempty"`
+	Content string `json:"content,omitempty"`
+}
+
+// ArtifactContents are the files of a workflow run artifact.
+type ArtifactContents struct {
+	ArtifactID int64          `json:"artifact_id"`
+	Files      []ArtifactFile `json:"files"`
+	Notes      []string       `json:"notes,omitempty"`
+}
+
+// isText reports whether content looks like text rather than binary data.
+func isText(content []byte) bool {
+	return utf8.Valid(content) && !bytes.ContainsRune(content, 0)
+}
+
+// zipFileTooLargeError is returned for a file in an archive that is larger than the limit it
+// was read with.
+type zipFileTooLargeError struct {
+	name  string
+	limit int64
+}
+
+func (e *zipFileTooLargeError) Error() string {
+	return fmt.Sprintf("%s is larger than %d bytes uncompressed", e.name, e.limit)
+}
+
+// zipFileReader reads a file in an archive and fails once more than limit bytes come out of it.
+type zipFileReader struct {
+	io.ReadCloser
+	name  string
+	limit int64
+	read  int64
+}
+
+func (r *zipFileReader) Read(p []byte) (int, error) {
+	if int64(len(p)) > r.limit-r.read+1 {
+		p = p[:r.limit-r.read+1]
+	}
+	n, err := r.ReadCloser.Read(p)
+	r.read += int64(n)
+	if r.read > r.limit {
+		return n, &zipFileTooLargeError{name: r.name, limit: r.limit}
+	}
+	return n, err
+}
+
+// openZipFile opens a file in an archive, refusing files larger than limit bytes uncompressed.
+// The size in the file header is checked first, but the header can lie, so the reader enforces
+// the limit as well. Archives come from workflows, which anyone who can run one controls.
+func openZipFile(f *zip.File, limit int64) (*zipFileReader, error) {
+	if f.UncompressedSize64 > uint64(limit) {
+		return nil, &zipFileTooLargeError{name: f.Name, limit: limit}
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	return &zipFileReader{ReadCloser: rc, name: f.Name, limit: limit}, nil
+}
+
+// readZipFile returns the contents of a file in an archive, refusing files larger than limit
+// bytes uncompressed.
+func readZipFile(f *zip.File, limit int64) ([]byte, error) {
+	r, err := openZipFile(f, limit)
+	if err == nil {
+		defer func() { _ = r.Close() }()
+		var data []byte
+		if data, err = io.ReadAll(r); err == nil {
+			return data, nil
+		}
+	}
+	var tooLarge *zipFileTooLargeError
+	if errors.As(err, &tooLarge) {
+		return nil, err
+	}
+	return nil, fmt.Errorf("failed to read %s from archive: %w", f.Name, err)
+}
+
+// findArtifactFile returns the contents of the file at path in an artifact archive.
+func findArtifactFile(archive []byte, path string) ([]byte, error) {
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open artifact archive: %w", err)
+	}
+	for _, f := range reader.File {
+		if f.Name == path && !f.FileInfo().IsDir() {
+			return readZipFile(f, maxArtifactFileBytes)
+		}
+	}
+	return nil, fmt.Errorf("the artifact has no file %s", path)
+}
+
+// extractArtifactFiles lists the files of an artifact archive, with the contents of text files
+// until maxTextBytes of text have been returned.
+func extractArtifactFiles(archive []byte, maxTextBytes int) ([]ArtifactFile, []string, error) {
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open artifact archive: %w", err)
+	}
+
+	files := []ArtifactFile{}
+	var omitted []string
+	remaining := maxTextBytes
+	for _, f := range reader.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		file := ArtifactFile{Path: f.Name, Size: f.UncompressedSize64}
+		if f.UncompressedSize64 > uint64(remaining) {
+			omitted = append(omitted, f.Name)
+			files = append(files, file)
+			continue
+		}
+		data, err := readZipFile(f, int64(remaining))
+		if err != nil {
+			return nil, nil, err
+		}
+		if isText(data) {
+			file.Content = string(data)
+			remaining -= len(data)
+		} else {
+			file.Binary = true
+		}
+		files = append(files, file)
+	}
+
+	var notes []string
+	if len(omitted) > 0 {
+		notes = append(notes, fmt.Sprintf("the contents of %d files were left out to keep the response small; get them one at a time with path", len(omitted)))
+	}
+	return files, notes, nil
+}
@@ -0,0 +1,88 @@
+package github
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/flate"
+	"hash/crc32"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ExtractArtifactFiles(t *testing.T) {
+	archive := zipArchive(t, map[string]string{
+		"report/junit.xml": "<testsuite failures=\"1\"/>",
+		"coverage.out":     strings.Repeat("x", 100),
+		"bin/tool":         "\x7fELF\x00\x01",
+	})
+
+	t.Run("text files have their contents", func(t *testing.T) {
+		files, notes, err := extractArtifactFiles(archive, 1024)
+		require.NoError(t, err)
+		assert.Empty(t, notes)
+		assert.ElementsMatch(t, []ArtifactFile{
+			{Path: "report/junit.xml", Size: 25, Content: "<testsuite failures=\"1\"/>"},
+			{Path: "coverage.out", Size: 100, Content: strings.Repeat("x", 100)},
+			{Path: "bin/tool", Size: 6, Binary: true},
+		}, files)
+	})
+
+	t.Run("contents beyond the budget are left out", func(t *testing.T) {
+		files, notes, err := extractArtifactFiles(archive, 50)
+		require.NoError(t, err)
+		require.Len(t, files, 3)
+		for _, f := range files {
+			if f.Path == "coverage.out" {
+				assert.Empty(t, f.Content)
+			}
+		}
+		assert.Equal(t, []string{"the contents of 1 files were left out to keep the response small; get them one at a time with path"}, notes)
+	})
+
+	t.Run("single file", func(t *testing.T) {
+		content, err := findArtifactFile(archive, "report/junit.xml")
+		require.NoError(t, err)
+		assert.Equal(t, "<testsuite failures=\"1\"/>", string(content))
+
+		_, err = findArtifactFile(archive, "missing.txt")
+		assert.EqualError(t, err, "the artifact has no file missing.txt")
+	})
+	t.Run("oversized file is refused", func(t *testing.T) {
+		archive := zipArchive(t, map[string]string{
+			"bomb.bin": strings.Repeat("\x00", maxArtifactFileBytes+1),
+		})
+		_, err := findArtifactFile(archive, "bomb.bin")
+		assert.EqualError(t, err, "bomb.bin is larger than 10485760 bytes uncompressed")
+	})
+
+	t.Run("file larger than its header says is refused", func(t *testing.T) {
+		content := bytes.Repeat([]byte{0}, maxArtifactFileBytes+1)
+		var compressed bytes.Buffer
+		fw, err := flate.NewWriter(&compressed, flate.BestCompression)
+		require.NoError(t, err)
+		_, err = fw.Write(content)
+		require.NoError(t, err)
+		require.NoError(t, fw.Close())
+
+		var buf bytes.Buffer
+		w := zip.NewWriter(&buf)
+		f, err := w.CreateRaw(&zip.FileHeader{
+			Name:               "bomb.bin",
+			Method:             zip.Deflate,
+			CRC32:              crc32.ChecksumIEEE(content),
+			CompressedSize64:   uint64(compressed.Len()),
+			UncompressedSize64: 100,
+		})
+		require.NoError(t, err)
+		_, err = f.Write(compressed.Bytes())
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		// archive/zip stops at the size in the header, so the read fails instead of going on.
+		_, err = findArtifactFile(buf.Bytes(), "bomb.bin")
+		assert.ErrorContains(t, err, "failed to read bomb.bin from archive")
+	})
+}
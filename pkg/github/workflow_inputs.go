@@ -0,0 +1,174 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"gopkg.in/yaml.v3"
+)
+
+// workflowDispatchInput is an input declared under on.workflow_dispatch.inputs of a workflow.
+type workflowDispatchInput struct {
+	Required bool     `yaml:"required"`
+	Type     string   `yaml:"type"`
+	Options  []string `yaml:"options"`
+	Default  any      `yaml:"default"`
+}
+
+// parseWorkflowDispatchInputs returns the inputs a workflow file declares for workflow_dispatch,
+// and whether the workflow can be dispatched at all. The on key can be an event name, a list of
+// event names or a map of events to their settings.
+func parseWorkflowDispatchInputs(content []byte) (map[string]workflowDispatchInput, bool, error) {
+	var workflow struct {
+		On yaml.Node `yaml:"on"`
+	}
+	if err := yaml.Unmarshal(content, &workflow); err != nil {
+		return nil, false, fmt.Errorf("invalid workflow YAML: %w", err)
+	}
+
+	on := workflow.On
+	switch on.Kind {
+	case yaml.ScalarNode:
+		return nil, on.Value == "workflow_dispatch", nil
+	case yaml.SequenceNode:
+		for _, event := range on.Content {
+			if event.Value == "workflow_dispatch" {
+				return nil, true, nil
+			}
+		}
+		return nil, false, nil
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(on.Content); i += 2 {
+			if on.Content[i].Value != "workflow_dispatch" {
+				continue
+			}
+			var dispatch struct {
+				Inputs map[string]workflowDispatchInput `yaml:"inputs"`
+			}
+			if err := on.Content[i+1].Decode(&dispatch); err != nil {
+				return nil, true, fmt.Errorf("invalid workflow_dispatch inputs: %w", err)
+			}
+			return dispatch.Inputs, true, nil
+		}
+		return nil, false, nil
+	}
+	return nil, false, nil
+}
+
+// workflowInputValue checks a value given for input and converts it to the string the dispatch
+// API expects, so that JSON booleans and numbers can be given for boolean and number inputs.
+func workflowInputValue(name string, input workflowDispatchInput, value any) (string, error) {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case bool:
+		s = strconv.FormatBool(v)
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return "", fmt.Errorf("input %s must be a string, number or boolean", name)
+	}
+
+	switch input.Type {
+	case "boolean":
+		if s != "true" && s != "false" {
+			return "", fmt.Errorf("input %s is a boolean, got %q", name, s)
+		}
+	case "number":
+		if _, err := strconv.ParseFloat(s, 64); err != nil {
+			return "", fmt.Errorf("input %s is a number, got %q", name, s)
+		}
+	case "choice":
+		found := false
+		for _, option := range input.Options {
+			if option == s {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return "", fmt.Errorf("input %s must be one of %s, got %q", name, strings.Join(input.Options, ", "), s)
+		}
+	}
+	return s, nil
+}
+
+// workflowDispatchInputs checks the inputs given for a workflow_dispatch run against the inputs
+// the workflow declares, and returns them as the strings the API expects. When declared is nil,
+// because the workflow could not be read, the inputs are only converted.
+func workflowDispatchInputs(declared map[string]workflowDispatchInput, given map[string]any) (map[string]any, error) {
+	inputs := make(map[string]any, len(given))
+	var unknown []string
+	for name, value := range given {
+		input, ok := declared[name]
+		if !ok && declared != nil {
+			unknown = append(unknown, name)
+			continue
+		}
+		s, err := workflowInputValue(name, input, value)
+		if err != nil {
+			return nil, err
+		}
+		inputs[name] = s
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		accepted := make([]string, 0, len(declared))
+		for name := range declared {
+			accepted = append(accepted, name)
+		}
+		sort.Strings(accepted)
+		if len(accepted) == 0 {
+			return nil, fmt.Errorf("the workflow declares no inputs, got %s", strings.Join(unknown, ", "))
+		}
+		return nil, fmt.Errorf("unknown inputs %s, the workflow accepts %s", strings.Join(unknown, ", "), strings.Join(accepted, ", "))
+	}
+
+	var missing []string
+	for name, input := range declared {
+		if _, ok := inputs[name]; !ok && input.Required && input.Default == nil {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("missing required inputs: %s", strings.Join(missing, ", "))
+	}
+	return inputs, nil
+}
+
+// getWorkflowFile returns the contents of a workflow file at ref. workflowID is the numeric ID
+// or the file name of the workflow.
+func getWorkflowFile(ctx context.Context, client *github.Client, owner, repo, workflowID, ref string) ([]byte, error) {
+	var workflow *github.Workflow
+	var resp *github.Response
+	var err error
+	if id, parseErr := strconv.ParseInt(workflowID, 10, 64); parseErr == nil {
+		workflow, resp, err = client.Actions.GetWorkflowByID(ctx, owner, repo, id)
+	} else {
+		workflow, resp, err = client.Actions.GetWorkflowByFileName(ctx, owner, repo, workflowID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	_ = resp.Body.Close()
+
+	file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, workflow.GetPath(), &github.RepositoryContentGetOptions{Ref: ref})
+	if err != nil {
+		return nil, err
+	}
+	_ = resp.Body.Close()
+	if file == nil {
+		return nil, fmt.Errorf("%s is not a file", workflow.GetPath())
+	}
+	content, err := file.GetContent()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(content), nil
+}
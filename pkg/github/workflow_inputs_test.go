@@ -0,0 +1,121 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseWorkflowDispatchInputs(t *testing.T) {
+	t.Run("inputs are read from the on map", func(t *testing.T) {
+		inputs, dispatchable, err := parseWorkflowDispatchInputs([]byte(`
+on:
+  push:
+  workflow_dispatch:
+    inputs:
+      environment:
+        type: choice
+        options: [staging, production]
+        required: true
+      dry_run:
+        type: boolean
+        default: true
+`))
+		require.NoError(t, err)
+		assert.True(t, dispatchable)
+		assert.Equal(t, map[string]workflowDispatchInput{
+			"environment": {Type: "choice", Options: []string{"staging", "production"}, Required: true},
+			"dry_run":     {Type: "boolean", Default: true},
+		}, inputs)
+	})
+
+	for name, content := range map[string]string{
+		"event name":               "on: workflow_dispatch",
+		"list of events":           "on: [push, workflow_dispatch]",
+		"dispatch without inputs":  "on:\n  workflow_dispatch:\n",
+		"dispatch with empty list": "on:\n  workflow_dispatch:\n    inputs: {}\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			inputs, dispatchable, err := parseWorkflowDispatchInputs([]byte(content))
+			require.NoError(t, err)
+			assert.True(t, dispatchable)
+			assert.Empty(t, inputs)
+		})
+	}
+
+	t.Run("workflow without dispatch trigger", func(t *testing.T) {
+		_, dispatchable, err := parseWorkflowDispatchInputs([]byte("on:\n  push:\n    branches: [main]\n"))
+		require.NoError(t, err)
+		assert.False(t, dispatchable)
+	})
+}
+
+func Test_WorkflowDispatchInputs(t *testing.T) {
+	declared := map[string]workflowDispatchInput{
+		"environment": {Type: "choice", Options: []string{"staging", "production"}, Required: true},
+		"dry_run":     {Type: "boolean", Default: true},
+		"replicas":    {Type: "number"},
+		"note":        {Required: true, Default: "deploy"},
+	}
+
+	tests := []struct {
+		name     string
+		given    map[string]any
+		expected map[string]any
+		errMsg   string
+	}{
+		{
+			name:     "typed values become strings",
+			given:    map[string]any{"environment": "staging", "dry_run": false, "replicas": float64(3)},
+			expected: map[string]any{"environment": "staging", "dry_run": "false", "replicas": "3"},
+		},
+		{
+			name:   "choice must be an option",
+			given:  map[string]any{"environment": "qa"},
+			errMsg: `input environment must be one of staging, production, got "qa"`,
+		},
+		{
+			name:   "boolean must be true or false",
+			given:  map[string]any{"environment": "staging", "dry_run": "yes"},
+			errMsg: `input dry_run is a boolean, got "yes"`,
+		},
+		{
+			name:   "number must parse",
+			given:  map[string]any{"environment": "staging", "replicas": "many"},
+			errMsg: `input replicas is a number, got "many"`,
+		},
+		{
+			name:   "unknown inputs list the accepted ones",
+			given:  map[string]any{"environment": "staging", "region": "eu"},
+			errMsg: "unknown inputs region, the workflow accepts dry_run, environment, note, replicas",
+		},
+		{
+			name:   "required inputs without default must be given",
+			given:  map[string]any{"dry_run": true},
+			errMsg: "missing required inputs: environment",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			inputs, err := workflowDispatchInputs(declared, tc.given)
+			if tc.errMsg != "" {
+				assert.EqualError(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, inputs)
+		})
+	}
+
+	t.Run("undeclared inputs are only converted", func(t *testing.T) {
+		inputs, err := workflowDispatchInputs(nil, map[string]any{"anything": true})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"anything": "true"}, inputs)
+	})
+
+	t.Run("workflow without inputs", func(t *testing.T) {
+		_, err := workflowDispatchInputs(map[string]workflowDispatchInput{}, map[string]any{"anything": true})
+		assert.EqualError(t, err, "the workflow declares no inputs, got anything")
+	})
+}
@@ -0,0 +1,176 @@
+package github
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/buffer"
+)
+
+// maxArchiveBytes caps the size of a log or artifact archive that is downloaded.
+const maxArchiveBytes = 50 << 20
+
+// maxJobLogBytes caps the uncompressed size of a single file in a log archive, and
+// maxJobLogsBytes the uncompressed size of all files read from one archive together. Logs are
+// compressed well, so a small archive can hold far more than the download limit. They are
+// variables so that tests can lower them.
+var (
+	maxJobLogBytes  int64 = 64 << 20
+	maxJobLogsBytes int64 = 256 << 20
+)
+
+// JobLog is the log of one job of a workflow run, extracted from the run's log archive.
+type JobLog struct {
+	Name       string `json:"name"`
+	Content    string `json:"content"`
+	TotalLines int    `json:"total_lines"`
+}
+
+// WorkflowRunLogs are the job logs of a workflow run.
+type WorkflowRunLogs struct {
+	RunID int64    `json:"run_id"`
+	Jobs  []JobLog `json:"jobs"`
+	Notes []string `json:"notes,omitempty"`
+}
+
+// logFileName splits the name of a file in a log archive, e.g. "3_build.txt", into its order and
+// name. Files without an order prefix get -1.
+func logFileName(file string) (int, string) {
+	name := strings.TrimSuffix(path.Base(file), ".txt")
+	prefix, rest, ok := strings.Cut(name, "_")
+	if !ok {
+		return -1, name
+	}
+	order, err := strconv.Atoi(prefix)
+	if err != nil {
+		return -1, name
+	}
+	return order, rest
+}
+
+// extractJobLogs reads the job logs from a workflow run log archive. The archive has a file per
+// job at the top level, and a directory per job with a file per step; the step files are only
+// used for jobs without a top-level file. Jobs whose name does not contain jobName, ignoring case,
+// are skipped. Only the last tail lines of each log are kept while it is read, or every line if tail
+// is not positive.
+func extractJobLogs(archive []byte, jobName string, tail int) ([]JobLog, error) {
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log archive: %w", err)
+	}
+
+	type stepFile struct {
+		order int
+		file  *zip.File
+	}
+	jobFiles := map[string]*zip.File{}
+	jobOrder := map[string]int{}
+	steps := map[string][]stepFile{}
+	for _, f := range reader.File {
+		if f.FileInfo().IsDir() || !strings.HasSuffix(f.Name, ".txt") {
+			continue
+		}
+		dir, _ := path.Split(f.Name)
+		if dir == "" {
+			order, name := logFileName(f.Name)
+			jobFiles[name] = f
+			jobOrder[name] = order
+			continue
+		}
+		job := strings.TrimSuffix(dir, "/")
+		order, _ := logFileName(f.Name)
+		steps[job] = append(steps[job], stepFile{order: order, file: f})
+		if _, ok := jobOrder[job]; !ok {
+			jobOrder[job] = len(reader.File)
+		}
+	}
+
+	names := make([]string, 0, len(jobOrder))
+	for name := range jobOrder {
+		if jobName == "" || strings.Contains(strings.ToLower(name), strings.ToLower(jobName)) {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if jobOrder[names[i]] != jobOrder[names[j]] {
+			return jobOrder[names[i]] < jobOrder[names[j]]
+		}
+		return names[i] < names[j]
+	})
+
+	remaining := maxJobLogsBytes
+	readLines := func(ring *buffer.RingBuffer, f *zip.File) error {
+		limit := min(maxJobLogBytes, remaining)
+		r, err := openZipFile(f, limit)
+		if err == nil {
+			err = ring.ReadLines(r)
+			remaining -= r.read
+			_ = r.Close()
+		}
+		var tooLarge *zipFileTooLargeError
+		switch {
+		case err == nil:
+			return nil
+		case !errors.As(err, &tooLarge):
+			return fmt.Errorf("failed to read %s from log archive: %w", f.Name, err)
+		case limit < maxJobLogBytes:
+			return fmt.Errorf("the logs of the matching jobs are larger than %d bytes uncompressed together; pick fewer jobs with job_name", maxJobLogsBytes)
+		default:
+			return tooLarge
+		}
+	}
+
+	jobs := make([]JobLog, 0, len(names))
+	for _, name := range names {
+		ring := buffer.NewRingBuffer(tail)
+		if f, ok := jobFiles[name]; ok {
+			if err := readLines(ring, f); err != nil {
+				return nil, err
+			}
+		} else {
+			jobSteps := steps[name]
+			sort.Slice(jobSteps, func(i, j int) bool { return jobSteps[i].order < jobSteps[j].order })
+			for _, step := range jobSteps {
+				if err := readLines(ring, step.file); err != nil {
+					return nil, err
+				}
+			}
+		}
+		jobs = append(jobs, JobLog{Name: name, Content: ring.String(), TotalLines: ring.TotalLines()})
+	}
+	return jobs, nil
+}
+
+// downloadArchive downloads a log or artifact archive from the URL the API redirects to. The
+// URL is signed, so no token is sent.
+func downloadArchive(ctx context.Context, archiveURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, archiveURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxArchiveBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxArchiveBytes {
+		return nil, fmt.Errorf("the archive is larger than %d MB", maxArchiveBytes>>20)
+	}
+	return data, nil
+}
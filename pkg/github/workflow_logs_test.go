@@ -0,0 +1,82 @@
+package github
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// zipArchive builds a log archive holding files.
+func zipArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func Test_ExtractJobLogs(t *testing.T) {
+	archive := zipArchive(t, map[string]string{
+		"0_build.txt":                 "checkout\ncompile\nbuild ok\n",
+		"1_test (ubuntu).txt":         "checkout\nrun tests\nFAIL: TestParse\n",
+		"build/1_Set up job.txt":      "checkout\n",
+		"build/2_Compile.txt":         "compile\nbuild ok\n",
+		"lint/2_Run linter.txt":       "lint.go:3: unused variable\n",
+		"lint/1_Set up job.txt":       "setting up\n",
+		"test (ubuntu)/1_Run go.txt":  "run tests\n",
+		"test (ubuntu)/system.txt":    "runner info\n",
+		"test (ubuntu)/ignored.json":  "{}",
+		"lint/":                       "",
+		"test (ubuntu)/2_Cleanup.txt": "cleanup\n",
+	})
+
+	t.Run("all jobs in order", func(t *testing.T) {
+		jobs, err := extractJobLogs(archive, "", 0)
+		require.NoError(t, err)
+		require.Len(t, jobs, 3)
+		assert.Equal(t, JobLog{Name: "build", Content: "checkout\ncompile\nbuild ok", TotalLines: 3}, jobs[0])
+		assert.Equal(t, "test (ubuntu)", jobs[1].Name)
+		// lint has no top-level file, so its step files are joined in step order
+		assert.Equal(t, JobLog{Name: "lint", Content: "setting up\nlint.go:3: unused variable", TotalLines: 2}, jobs[2])
+	})
+
+	t.Run("jobs are filtered by name and cut to the tail", func(t *testing.T) {
+		jobs, err := extractJobLogs(archive, "TEST", 1)
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, JobLog{Name: "test (ubuntu)", Content: "FAIL: TestParse", TotalLines: 3}, jobs[0])
+	})
+
+	t.Run("invalid archive", func(t *testing.T) {
+		_, err := extractJobLogs([]byte("not a zip"), "", 0)
+		assert.ErrorContains(t, err, "failed to open log archive")
+	})
+
+	t.Run("oversized logs are refused", func(t *testing.T) {
+		originalFile, originalTotal := maxJobLogBytes, maxJobLogsBytes
+		t.Cleanup(func() { maxJobLogBytes, maxJobLogsBytes = originalFile, originalTotal })
+		maxJobLogBytes, maxJobLogsBytes = 64, 100
+
+		_, err := extractJobLogs(zipArchive(t, map[string]string{
+			"0_build.txt": strings.Repeat("a\n", 33),
+		}), "", 1)
+		assert.EqualError(t, err, "0_build.txt is larger than 64 bytes uncompressed")
+
+		// Each step fits on its own, but together they are over the limit for the archive.
+		_, err = extractJobLogs(zipArchive(t, map[string]string{
+			"build/1_Set up job.txt": strings.Repeat("a\n", 30),
+			"build/2_Compile.txt":    strings.Repeat("b\n", 30),
+		}), "", 1)
+		assert.EqualError(t, err, "the logs of the matching jobs are larger than 100 bytes uncompressed together; pick fewer jobs with job_name")
+	})
+}
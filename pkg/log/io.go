@@ -1,6 +1,7 @@
 package log
 
 import (
+	"bytes"
 	"io"
 
 	"log/slog"
@@ -12,15 +13,40 @@ type IOLogger struct {
 	reader io.Reader
 	writer io.Writer
 	logger *slog.Logger
+	redact func(line []byte) []byte
+
+	// pending holds the start of a line that has been read but not yet logged.
+	pending []byte
+}
+
+// IOLoggerOption configures an IOLogger.
+type IOLoggerOption func(*IOLogger)
+
+// WithRedactor makes the IOLogger pass every line read from the underlying io.Reader through
+// redact before logging it, so that sensitive values never reach the log. Lines are only logged
+// once they are complete, so a value split across reads is still redacted.
+func WithRedactor(redact func(line []byte) []byte) IOLoggerOption {
+	return func(l *IOLogger) {
+		l.redact = redact
+	}
+}
+
+// flusher is implemented by buffered writers, such as bufio.Writer.
+type flusher interface {
+	Flush() error
 }
 
 // NewIOLogger creates a new IOLogger instance
-func NewIOLogger(r io.Reader, w io.Writer, logger *slog.Logger) *IOLogger {
-	return &IOLogger{
+func NewIOLogger(r io.Reader, w io.Writer, logger *slog.Logger, opts ...IOLoggerOption) *IOLogger {
+	l := &IOLogger{
 		reader: r,
 		writer: w,
 		logger: logger,
 	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
 }
 
 // Read reads data from the underlying io.Reader and logs it.
@@ -29,12 +55,33 @@ func (l *IOLogger) Read(p []byte) (n int, err error) {
 		return 0, io.EOF
 	}
 	n, err = l.reader.Read(p)
-	if n > 0 {
-		l.logger.Info("[stdin]: received bytes", "count", n, "data", string(p[:n]))
+	if l.redact == nil {
+		if n > 0 {
+			l.logger.Info("[stdin]: received bytes", "count", n, "data", string(p[:n]))
+		}
+		return n, err
+	}
+
+	l.pending = append(l.pending, p[:n]...)
+	for {
+		i := bytes.IndexByte(l.pending, '\n')
+		if i < 0 {
+			break
+		}
+		l.logRead(l.pending[:i+1])
+		l.pending = l.pending[i+1:]
+	}
+	if err != nil && len(l.pending) > 0 {
+		l.logRead(l.pending)
+		l.pending = nil
 	}
 	return n, err
 }
 
+func (l *IOLogger) logRead(line []byte) {
+	l.logger.Info("[stdin]: received bytes", "count", len(line), "data", string(l.redact(line)))
+}
+
 // Write writes data to the underlying io.Writer and logs it.
 func (l *IOLogger) Write(p []byte) (n int, err error) {
 	if l.writer == nil {
@@ -43,3 +90,12 @@ func (l *IOLogger) Write(p []byte) (n int, err error) {
 	l.logger.Info("[stdout]: sending bytes", "count", len(p), "data", string(p))
 	return l.writer.Write(p)
 }
+
+// Close flushes any data buffered by the underlying io.Writer. It does not close the
+// underlying streams, which belong to whoever opened them.
+func (l *IOLogger) Close() error {
+	if f, ok := l.writer.(flusher); ok {
+		return f.Flush()
+	}
+	return nil
+}
@@ -1,9 +1,12 @@
 package log
 
 import (
+	"bufio"
 	"bytes"
+	"io"
 	"strings"
 	"testing"
+	"testing/iotest"
 
 	"log/slog"
 
@@ -57,6 +60,69 @@ func TestLoggedReadWriter(t *testing.T) {
 	})
 }
 
+func TestIOLoggerRedactor(t *testing.T) {
+	var logBuffer bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logBuffer, &slog.HandlerOptions{ReplaceAttr: removeTimeAttr}))
+	redact := func(line []byte) []byte {
+		return bytes.ReplaceAll(line, []byte("hunter2"), []byte("[REDACTED]"))
+	}
+
+	// The secret is split across reads, and the last line has no newline.
+	input := "first hunter2\nsecond hun" + "ter2\nlast"
+	lrw := NewIOLogger(iotest.OneByteReader(strings.NewReader(input)), nil, logger, WithRedactor(redact))
+
+	data, err := io.ReadAll(lrw)
+	assert.NoError(t, err)
+	assert.Equal(t, input, string(data), "the data passed on is not redacted")
+	assert.NotContains(t, logBuffer.String(), "hunter2")
+	assert.Equal(t, 3, strings.Count(logBuffer.String(), "[stdin]"))
+	assert.Contains(t, logBuffer.String(), `data="second [REDACTED]\n"`)
+	assert.Contains(t, logBuffer.String(), "data=last")
+}
+
+func TestIOLoggerClose(t *testing.T) {
+	t.Run("Close flushes buffered writes", func(t *testing.T) {
+		var writeBuffer bytes.Buffer
+		buffered := bufio.NewWriter(&writeBuffer)
+		logger := slog.New(slog.NewTextHandler(&bytes.Buffer{}, nil))
+
+		lrw := NewIOLogger(nil, buffered, logger)
+
+		_, err := lrw.Write([]byte("final entry"))
+		assert.NoError(t, err)
+		assert.Empty(t, writeBuffer.String(), "data should still be buffered")
+
+		assert.NoError(t, lrw.Close())
+		assert.Equal(t, "final entry", writeBuffer.String())
+	})
+
+	t.Run("Close leaves the underlying streams open", func(t *testing.T) {
+		reader := &closeCounter{Reader: strings.NewReader("")}
+		writer := &closeCounter{Reader: strings.NewReader("")}
+		logger := slog.New(slog.NewTextHandler(&bytes.Buffer{}, nil))
+
+		lrw := NewIOLogger(reader, writer, logger)
+
+		assert.NoError(t, lrw.Close())
+		assert.Equal(t, 0, reader.closed)
+		assert.Equal(t, 0, writer.closed)
+	})
+}
+
+type closeCounter struct {
+	*strings.Reader
+	closed int
+}
+
+func (c *closeCounter) Write(p []byte) (int, error) {
+	return len(p), nil
+}
+
+func (c *closeCounter) Close() error {
+	c.closed++
+	return nil
+}
+
 func removeTimeAttr(groups []string, a slog.Attr) slog.Attr {
 	if a.Key == slog.TimeKey && len(groups) == 0 {
 		return slog.Attr{}
@@ -0,0 +1,159 @@
+package raw
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+)
+
+// lfsPointerVersion is the first line of every Git LFS pointer file.
+const lfsPointerVersion = "version https://git-lfs.github.com/spec/v1"
+
+// lfsPointerMaxSize is the largest file that is checked for being an LFS pointer. Pointers are
+// well under 200 bytes; git-lfs itself ignores anything over 1024.
+const lfsPointerMaxSize = 1024
+
+const lfsMediaType = "application/vnd.git-lfs+json"
+
+var lfsOIDPattern = regexp.MustCompile(`^sha256:([0-9a-f]{64})$`)
+
+// ErrLFSNotConfigured is returned by GetLFSObject when the client was not set up with WithLFS.
+var ErrLFSNotConfigured = errors.New("git LFS resolution is not enabled")
+
+// LFSPointer identifies a Git LFS object, as stored in a pointer file in place of the content.
+type LFSPointer struct {
+	OID  string `json:"oid"`
+	Size int64  `json:"size"`
+}
+
+// ParseLFSPointer reports whether content is a Git LFS pointer file, and if so which object it
+// points to.
+func ParseLFSPointer(content []byte) (LFSPointer, bool) {
+	if len(content) > lfsPointerMaxSize || !bytes.HasPrefix(content, []byte(lfsPointerVersion+"\n")) {
+		return LFSPointer{}, false
+	}
+	var pointer LFSPointer
+	for _, line := range bytes.Split(bytes.TrimSpace(content), []byte("\n"))[1:] {
+		key, value, ok := bytes.Cut(line, []byte(" "))
+		if !ok {
+			return LFSPointer{}, false
+		}
+		switch string(key) {
+		case "oid":
+			m := lfsOIDPattern.FindSubmatch(value)
+			if m == nil {
+				return LFSPointer{}, false
+			}
+			pointer.OID = string(m[1])
+		case "size":
+			size, err := strconv.ParseInt(string(value), 10, 64)
+			if err != nil || size < 0 {
+				return LFSPointer{}, false
+			}
+			pointer.Size = size
+		}
+	}
+	if pointer.OID == "" {
+		return LFSPointer{}, false
+	}
+	return pointer, true
+}
+
+// WithLFS returns a copy of c that can download Git LFS objects. lfsURL is the web root of the
+// host, e.g. https://github.com/, under which each repository serves the LFS batch API.
+// Objects are downloaded with httpClient, which must not add the GitHub token: download URLs
+// usually point at storage outside GitHub that rejects extra credentials.
+func (c *Client) WithLFS(lfsURL *url.URL, httpClient *http.Client) *Client {
+	clone := *c
+	clone.lfsURL = lfsURL
+	clone.lfsHTTP = httpClient
+	return &clone
+}
+
+// LFSEnabled reports whether the client can download Git LFS objects.
+func (c *Client) LFSEnabled() bool {
+	return c.lfsURL != nil
+}
+
+type lfsBatchRequest struct {
+	Operation string       `json:"operation"`
+	Transfers []string     `json:"transfers"`
+	Objects   []LFSPointer `json:"objects"`
+}
+
+type lfsBatchResponse struct {
+	Objects []struct {
+		OID     string `json:"oid"`
+		Actions struct {
+			Download *struct {
+				Href   string            `json:"href"`
+				Header map[string]string `json:"header"`
+			} `json:"download"`
+		} `json:"actions"`
+		Error *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	} `json:"objects"`
+}
+
+// GetLFSObject downloads the Git LFS object pointer refers to in owner/repo. It asks the LFS
+// batch API where the object is stored and then fetches it from there.
+func (c *Client) GetLFSObject(ctx context.Context, owner, repo string, pointer LFSPointer) (*http.Response, error) {
+	if !c.LFSEnabled() {
+		return nil, ErrLFSNotConfigured
+	}
+
+	batchURL := c.lfsURL.JoinPath(owner, repo+".git", "info", "lfs", "objects", "batch").String()
+	req, err := c.newRequest(ctx, "POST", batchURL, &lfsBatchRequest{
+		Operation: "download",
+		Transfers: []string{"basic"},
+		Objects:   []LFSPointer{pointer},
+	})
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", lfsMediaType)
+	req.Header.Set("Content-Type", lfsMediaType)
+
+	resp, err := c.client.Client().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("LFS batch request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("LFS batch request failed with status %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+
+	var batch lfsBatchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&batch); err != nil {
+		return nil, fmt.Errorf("failed to decode LFS batch response: %w", err)
+	}
+	if len(batch.Objects) != 1 {
+		return nil, fmt.Errorf("LFS batch response has %d objects, expected 1", len(batch.Objects))
+	}
+	object := batch.Objects[0]
+	if object.Error != nil {
+		return nil, fmt.Errorf("LFS object %s: %s (code %d)", pointer.OID, object.Error.Message, object.Error.Code)
+	}
+	if object.Actions.Download == nil {
+		return nil, fmt.Errorf("LFS object %s has no download action", pointer.OID)
+	}
+
+	download, err := http.NewRequestWithContext(ctx, "GET", object.Actions.Download.Href, nil)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range object.Actions.Download.Header {
+		download.Header.Set(key, value)
+	}
+	return c.lfsHTTP.Do(download)
+}
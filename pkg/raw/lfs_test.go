@@ -0,0 +1,124 @@
+package raw
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testLFSOID = "4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393"
+
+func TestParseLFSPointer(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    LFSPointer
+		ok      bool
+	}{
+		{
+			name:    "pointer",
+			content: "version https://git-lfs.github.com/spec/v1\noid sha256:" + testLFSOID + "\nsize 12345\n",
+			want:    LFSPointer{OID: testLFSOID, Size: 12345},
+			ok:      true,
+		},
+		{
+			name:    "pointer with extension lines",
+			content: "version https://git-lfs.github.com/spec/v1\next-0-foo sha256:" + testLFSOID + "\noid sha256:" + testLFSOID + "\nsize 7\n",
+			want:    LFSPointer{OID: testLFSOID, Size: 7},
+			ok:      true,
+		},
+		{
+			name:    "regular file",
+			content: "# README\n",
+		},
+		{
+			name:    "missing oid",
+			content: "version https://git-lfs.github.com/spec/v1\nsize 12345\n",
+		},
+		{
+			name:    "malformed oid",
+			content: "version https://git-lfs.github.com/spec/v1\noid sha256:abc\nsize 1\n",
+		},
+		{
+			name:    "too large to be a pointer",
+			content: "version https://git-lfs.github.com/spec/v1\noid sha256:" + testLFSOID + "\nsize 1\n" + strings.Repeat("x", lfsPointerMaxSize),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pointer, ok := ParseLFSPointer([]byte(tc.content))
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.want, pointer)
+		})
+	}
+}
+
+func TestGetLFSObject(t *testing.T) {
+	base, _ := url.Parse("https://raw.example.com/")
+	lfsURL, _ := url.Parse("https://github.example.com/")
+	downloadPattern := mock.EndpointPattern{Pattern: "/storage/{oid}", Method: "GET"}
+	pointer := LFSPointer{OID: testLFSOID, Size: 5}
+
+	t.Run("not configured", func(t *testing.T) {
+		client := NewClient(github.NewClient(nil), base)
+		assert.False(t, client.LFSEnabled())
+		_, err := client.GetLFSObject(context.Background(), "octocat", "hello", pointer)
+		assert.ErrorIs(t, err, ErrLFSNotConfigured)
+	})
+
+	t.Run("downloads through the batch API", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				PostLFSObjectsBatchByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "/octocat/hello.git/info/lfs/objects/batch", r.URL.Path)
+					assert.Equal(t, "application/vnd.git-lfs+json", r.Header.Get("Accept"))
+					var batch lfsBatchRequest
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&batch))
+					assert.Equal(t, lfsBatchRequest{Operation: "download", Transfers: []string{"basic"}, Objects: []LFSPointer{pointer}}, batch)
+					_, _ = w.Write([]byte(`{"objects": [{"oid": "` + testLFSOID + `", "actions": {"download": {"href": "https://storage.example.com/storage/` + testLFSOID + `", "header": {"X-Storage-Token": "signed"}}}}]}`))
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				downloadPattern,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "signed", r.Header.Get("X-Storage-Token"))
+					_, _ = w.Write([]byte("hello"))
+				}),
+			),
+		)
+		client := NewClient(github.NewClient(mockedClient), base).WithLFS(lfsURL, mockedClient)
+		require.True(t, client.LFSEnabled())
+
+		resp, err := client.GetLFSObject(context.Background(), "octocat", "hello", pointer)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(body))
+	})
+
+	t.Run("object error", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				PostLFSObjectsBatchByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					_, _ = w.Write([]byte(`{"objects": [{"oid": "` + testLFSOID + `", "error": {"code": 404, "message": "Object does not exist"}}]}`))
+				}),
+			),
+		)
+		client := NewClient(github.NewClient(mockedClient), base).WithLFS(lfsURL, mockedClient)
+
+		_, err := client.GetLFSObject(context.Background(), "octocat", "hello", pointer)
+		assert.EqualError(t, err, "LFS object "+testLFSOID+": Object does not exist (code 404)")
+	})
+}
@@ -16,6 +16,10 @@ type GetRawClientFn func(context.Context) (*Client, error)
 type Client struct {
 	url    *url.URL
 	client *gogithub.Client
+
+	// lfsURL and lfsHTTP are only set by WithLFS.
+	lfsURL  *url.URL
+	lfsHTTP *http.Client
 }
 
 // NewClient creates a new instance of the raw API Client with the provided GitHub client and provided URL.
@@ -18,3 +18,7 @@ var GetRawReposContentsByOwnerByRepoBySHAByPath mock.EndpointPattern = mock.Endp
 	Pattern: "/{owner}/{repo}/{sha}/{path:.*}",
 	Method:  "GET",
 }
+var PostLFSObjectsBatchByOwnerByRepo mock.EndpointPattern = mock.EndpointPattern{
+	Pattern: "/{owner}/{repo}.git/info/lfs/objects/batch",
+	Method:  "POST",
+}
@@ -2,6 +2,8 @@ package toolsets
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -62,35 +64,31 @@ type Toolset struct {
 	prompts []server.ServerPrompt
 }
 
+// GetActiveTools returns the tools of an enabled toolset, sorted by name.
 func (t *Toolset) GetActiveTools() []server.ServerTool {
 	if t.Enabled {
-		if t.readOnly {
-			return t.readTools
-		}
-		return append(t.readTools, t.writeTools...)
+		return t.GetAvailableTools()
 	}
 	return nil
 }
 
+// GetAvailableTools returns the tools of the toolset whether or not it is enabled, sorted by
+// name. The result is a new slice, so callers may reorder it.
 func (t *Toolset) GetAvailableTools() []server.ServerTool {
-	if t.readOnly {
-		return t.readTools
+	tools := slices.Clone(t.readTools)
+	if !t.readOnly {
+		tools = append(tools, t.writeTools...)
 	}
-	return append(t.readTools, t.writeTools...)
+	slices.SortFunc(tools, func(a, b server.ServerTool) int {
+		return strings.Compare(a.Tool.Name, b.Tool.Name)
+	})
+	return tools
 }
 
 func (t *Toolset) RegisterTools(s *server.MCPServer) {
-	if !t.Enabled {
-		return
-	}
-	for _, tool := range t.readTools {
+	for _, tool := range t.GetActiveTools() {
 		s.AddTool(tool.Tool, tool.Handler)
 	}
-	if !t.readOnly {
-		for _, tool := range t.writeTools {
-			s.AddTool(tool.Tool, tool.Handler)
-		}
-	}
 }
 
 func (t *Toolset) AddResourceTemplates(templates ...server.ServerResourceTemplate) *Toolset {
@@ -164,6 +162,9 @@ type ToolsetGroup struct {
 	Toolsets     map[string]*Toolset
 	everythingOn bool
 	readOnly     bool
+	// order holds the toolset names in the order they were added, so that everything the
+	// group registers or lists comes out the same on every run.
+	order []string
 }
 
 func NewToolsetGroup(readOnly bool) *ToolsetGroup {
@@ -178,9 +179,17 @@ func (tg *ToolsetGroup) AddToolset(ts *Toolset) {
 	if tg.readOnly {
 		ts.SetReadOnly()
 	}
+	if _, exists := tg.Toolsets[ts.Name]; !exists {
+		tg.order = append(tg.order, ts.Name)
+	}
 	tg.Toolsets[ts.Name] = ts
 }
 
+// ToolsetNames returns the names of the toolsets in the order they were added.
+func (tg *ToolsetGroup) ToolsetNames() []string {
+	return slices.Clone(tg.order)
+}
+
 func NewToolset(name string, description string) *Toolset {
 	return &Toolset{
 		Name:        name,
@@ -238,8 +247,24 @@ func (tg *ToolsetGroup) EnableToolset(name string) error {
 	return nil
 }
 
-func (tg *ToolsetGroup) RegisterAll(s *server.MCPServer) {
+// UpdateTools replaces the definition of every tool in the group with the result of fn. It is
+// meant for decorating tool definitions after all toolsets have been added.
+func (tg *ToolsetGroup) UpdateTools(fn func(mcp.Tool) mcp.Tool) {
 	for _, toolset := range tg.Toolsets {
+		for i := range toolset.readTools {
+			toolset.readTools[i].Tool = fn(toolset.readTools[i].Tool)
+		}
+		for i := range toolset.writeTools {
+			toolset.writeTools[i].Tool = fn(toolset.writeTools[i].Tool)
+		}
+	}
+}
+
+// RegisterAll registers the enabled toolsets in the order they were added, and the tools of each
+// toolset sorted by name.
+func (tg *ToolsetGroup) RegisterAll(s *server.MCPServer) {
+	for _, name := range tg.order {
+		toolset := tg.Toolsets[name]
 		toolset.RegisterTools(s)
 		toolset.RegisterResourcesTemplates(s)
 		toolset.RegisterPrompts(s)
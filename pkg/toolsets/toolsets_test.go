@@ -2,7 +2,11 @@ package toolsets
 
 import (
 	"errors"
+	"slices"
 	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 )
 
 func TestNewToolsetGroupIsEmptyWithoutEverythingOn(t *testing.T) {
@@ -250,3 +254,57 @@ func TestToolsetGroup_GetToolset(t *testing.T) {
 		t.Errorf("expected error to be ToolsetDoesNotExistError, got %v", err)
 	}
 }
+
+func TestToolsetGroup_UpdateTools(t *testing.T) {
+	readOnly := true
+	notReadOnly := false
+	toolset := NewToolset("my-toolset", "desc").
+		AddReadTools(NewServerTool(mcp.Tool{Name: "read", Annotations: mcp.ToolAnnotation{ReadOnlyHint: &readOnly}}, nil)).
+		AddWriteTools(NewServerTool(mcp.Tool{Name: "write", Annotations: mcp.ToolAnnotation{ReadOnlyHint: &notReadOnly}}, nil))
+	tsg := NewToolsetGroup(false)
+	tsg.AddToolset(toolset)
+
+	tsg.UpdateTools(func(tool mcp.Tool) mcp.Tool {
+		tool.Description = "updated " + tool.Name
+		return tool
+	})
+
+	for _, tool := range toolset.GetAvailableTools() {
+		if tool.Tool.Description != "updated "+tool.Tool.Name {
+			t.Errorf("expected tool %s to be updated, got description %q", tool.Tool.Name, tool.Tool.Description)
+		}
+	}
+}
+
+func TestToolsetGroup_Order(t *testing.T) {
+	readOnly := true
+	notReadOnly := false
+	tool := func(name string, readOnlyHint *bool) server.ServerTool {
+		return NewServerTool(mcp.Tool{Name: name, Annotations: mcp.ToolAnnotation{ReadOnlyHint: readOnlyHint}}, nil)
+	}
+
+	tsg := NewToolsetGroup(false)
+	for _, name := range []string{"repos", "issues", "actions"} {
+		tsg.AddToolset(NewToolset(name, "desc").
+			AddReadTools(tool(name+"_read_b", &readOnly), tool(name+"_read_a", &readOnly)).
+			AddWriteTools(tool(name+"_a_write", &notReadOnly)))
+	}
+	// Replacing a toolset keeps its place.
+	tsg.AddToolset(NewToolset("repos", "replaced").AddReadTools(tool("repos_read", &readOnly)))
+
+	if got, want := tsg.ToolsetNames(), []string{"repos", "issues", "actions"}; !slices.Equal(got, want) {
+		t.Errorf("expected toolsets %v, got %v", want, got)
+	}
+
+	toolset, err := tsg.GetToolset("issues")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, tool := range toolset.GetAvailableTools() {
+		names = append(names, tool.Tool.Name)
+	}
+	if want := []string{"issues_a_write", "issues_read_a", "issues_read_b"}; !slices.Equal(names, want) {
+		t.Errorf("expected tools %v, got %v", want, names)
+	}
+}